@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/xabi93/racers/internal/results"
+	"github.com/xabi93/racers/internal/server"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
+	"github.com/xabi93/racers/internal/users"
+)
+
+const importResultsUsage = `usage: racers import-results -race <id> [flags] <file>
+
+Imports the finish times of a timing system results file, use - as file to read stdin.
+The token authenticates the race owner, it defaults to the RACERS_TOKEN environment variable.
+
+flags:
+`
+
+// ImportResults runs the import-results command, reporting the matched results and the issues to out
+func ImportResults(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import-results", flag.ContinueOnError)
+	fs.SetOutput(out)
+	fs.Usage = func() {
+		fmt.Fprint(out, importResultsUsage)
+		fs.PrintDefaults()
+	}
+
+	var (
+		req      service.ImportResults
+		mapping  results.Mapping
+		token    string
+		tenantID string
+	)
+	fs.StringVar(&req.RaceID, "race", "", "race id")
+	fs.StringVar(&req.Format, "format", results.FormatChip, "file format, one of "+strings.Join(results.Formats(), ", "))
+	fs.StringVar(&mapping.Bib, "bib-column", "bib", "csv format bib column")
+	fs.StringVar(&mapping.ChipTime, "chip-time-column", "", "csv format chip time column")
+	fs.StringVar(&mapping.GunTime, "gun-time-column", "", "csv format gun time column")
+	fs.StringVar(&mapping.Status, "status-column", "", "csv format status column")
+	fs.StringVar(&token, "token", os.Getenv("RACERS_TOKEN"), "race owner token")
+	fs.StringVar(&tenantID, "tenant", string(tenant.Default), "tenant of the race")
+	fs.BoolVar(&req.DryRun, "dry-run", false, "report what would be imported without recording the results")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 || req.RaceID == "" {
+		fs.Usage()
+		return errors.New("race and file are required")
+	}
+	req.Mapping = mapping
+
+	tid, err := tenant.NewID(tenantID)
+	if err != nil {
+		return err
+	}
+
+	req.File = os.Stdin
+	if path := fs.Arg(0); path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		req.File = f
+	}
+
+	conf, err := server.LoadConf()
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := postgres.Connect(conf.Postgres)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	if err := postgres.CheckRole(context.Background(), sqlDB); err != nil {
+		return err
+	}
+
+	if err := postgres.RunMigrations(conf.Postgres, sqlDB); err != nil {
+		return err
+	}
+
+	db, err := postgres.New(sqlDB)
+	if err != nil {
+		return err
+	}
+
+	u := users.Users{UsersProvider: users.Mock{}}
+	ctx, err := u.Authenticate(tenant.WithID(context.Background(), tid), token)
+	if err != nil {
+		return err
+	}
+	if t := u.Current(ctx).Tenant; t != "" && t != tenantID {
+		return tenant.ErrConflict
+	}
+
+	// the user is let in the tenant as the server does, the token valid for all the tenants only lets the
+	// members in and with their role in the tenant
+	exists, err := postgres.NewTenants(db).Exists(ctx, tid)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("unknown tenant %s", tid)
+	}
+	if ctx, err = u.Admit(postgres.NewTenantMembers(db))(ctx, tid); err != nil {
+		return err
+	}
+
+	races := service.NewRaces(
+		postgres.NewRaces(db), postgres.NewTeams(db), postgres.NewOrganizations(db), u, postgres.TransactionFactory(db), postgres.NewEvents(db),
+	)
+
+	imp, err := races.ImportResults(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return printImport(out, imp, req.DryRun)
+}
+
+func printImport(out io.Writer, imp service.ResultsImport, dryRun bool) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(w, "LINE\tBIB\tCOMPETITOR\tTIME")
+	for _, r := range imp.Results {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\n", r.Line, r.Bib, r.Competitor, r.Time)
+	}
+
+	if len(imp.Issues) > 0 {
+		fmt.Fprintln(w, "\nLINE\tBIB\tISSUE\tDETAIL")
+		for _, i := range imp.Issues {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i.Line, i.Bib, i.Kind, i.Err)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	status := "committed"
+	switch {
+	case dryRun:
+		status = "dry run, nothing recorded"
+	case !imp.Committed:
+		status = "nothing to record"
+	}
+
+	_, err := fmt.Fprintf(out, "\n%d results, %d skipped, %d issues: %s\n", len(imp.Results), imp.Skipped, len(imp.Issues), status)
+
+	return err
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -12,7 +13,12 @@ import (
 )
 
 func main() {
-	if err := Run(os.Stdout); err != nil {
+	run := func() error { return Run(os.Stdout) }
+	if len(os.Args) > 1 && os.Args[1] == "import-results" {
+		run = func() error { return ImportResults(os.Args[2:], os.Stdout) }
+	}
+
+	if err := run(); err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
@@ -35,6 +41,10 @@ func Run(out io.Writer) error {
 	}
 	defer db.Close()
 
+	if err := postgres.CheckRole(context.Background(), db); err != nil {
+		return err
+	}
+
 	if err := postgres.RunMigrations(conf.Postgres, db); err != nil {
 		return err
 	}
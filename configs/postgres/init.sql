@@ -0,0 +1,4 @@
+-- the service connects as a regular role, the row level security policies isolating the tenants do not
+-- apply to superusers nor BYPASSRLS roles
+CREATE ROLE racers LOGIN PASSWORD 'racers' NOSUPERUSER NOBYPASSRLS;
+CREATE DATABASE racers OWNER racers;
@@ -0,0 +1,218 @@
+package racers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CourseFormat is the format of the file a course is uploaded with
+type CourseFormat string
+
+const (
+	CourseFormatGPX CourseFormat = "gpx"
+	CourseFormatKML CourseFormat = "kml"
+)
+
+// CourseFile is the original file of a course as it was uploaded
+type CourseFile struct {
+	Format CourseFormat
+	Data   []byte
+}
+
+// ContentType returns the media type of the file
+func (f CourseFile) ContentType() string {
+	if f.Format == CourseFormatKML {
+		return "application/vnd.google-earth.kml+xml"
+	}
+
+	return "application/gpx+xml"
+}
+
+// CoursePoint is a position of a course, elevation in metres
+type CoursePoint struct {
+	Lat       float64
+	Lon       float64
+	Elevation float64
+	// NoElevation is set when the track has no elevation at the point, it does not count for the elevation gain and loss
+	NoElevation bool
+}
+
+// InvalidCourseError means the course track is not valid
+type InvalidCourseError struct{ Reason string }
+
+func (err InvalidCourseError) Error() string {
+	return fmt.Sprintf("invalid course: %s", err.Reason)
+}
+
+// courseTolerance is the max distance in metres a simplified course deviates from the original track
+const courseTolerance = 5
+
+// Course is the geometry of a race course, the points are a simplification of the uploaded track
+type Course struct {
+	Points []CoursePoint
+	// Distance, ElevationGain and ElevationLoss are computed from the original track
+	Distance      Distance
+	ElevationGain int
+	ElevationLoss int
+}
+
+// NewCourse validates the track points and returns a Course with its measures and simplified points
+func NewCourse(points []CoursePoint) (Course, error) {
+	if len(points) < 2 {
+		return Course{}, InvalidCourseError{fmt.Sprintf("a course needs at least 2 points, got %d", len(points))}
+	}
+
+	var (
+		distance, gain, loss float64
+		// elevated is the last point with elevation, the gaps are measured against it
+		elevated *CoursePoint
+	)
+	for i, p := range points {
+		if p.Lat < -90 || p.Lat > 90 || p.Lon < -180 || p.Lon > 180 {
+			return Course{}, InvalidCourseError{fmt.Sprintf("point %d out of range: %f,%f", i, p.Lat, p.Lon)}
+		}
+		if i > 0 {
+			distance += haversine(points[i-1], p)
+		}
+		if p.NoElevation {
+			continue
+		}
+
+		if elevated != nil {
+			if d := p.Elevation - elevated.Elevation; d > 0 {
+				gain += d
+			} else {
+				loss -= d
+			}
+		}
+		elevated = &points[i]
+	}
+
+	if distance < 1 {
+		return Course{}, InvalidCourseError{"the track has no length"}
+	}
+
+	return Course{
+		Points:        simplify(points, courseTolerance),
+		Distance:      Distance(math.Round(distance)),
+		ElevationGain: int(math.Round(gain)),
+		ElevationLoss: int(math.Round(loss)),
+	}, nil
+}
+
+// Polyline returns the course points in the encoded polyline algorithm format
+func (c Course) Polyline() string {
+	var (
+		b                strings.Builder
+		prevLat, prevLon int
+	)
+	for _, p := range c.Points {
+		lat, lon := int(math.Round(p.Lat*1e5)), int(math.Round(p.Lon*1e5))
+		encodePolylineValue(&b, lat-prevLat)
+		encodePolylineValue(&b, lon-prevLon)
+		prevLat, prevLon = lat, lon
+	}
+
+	return b.String()
+}
+
+func encodePolylineValue(b *strings.Builder, v int) {
+	v <<= 1
+	if v < 0 {
+		v = ^v
+	}
+
+	for v >= 0x20 {
+		b.WriteByte(byte((0x20 | (v & 0x1f)) + 63))
+		v >>= 5
+	}
+	b.WriteByte(byte(v + 63))
+}
+
+const earthRadius = 6371008.8
+
+// haversine returns the distance in metres between two points
+func haversine(a, b CoursePoint) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat, dLon := lat2-lat1, (b.Lon-a.Lon)*math.Pi/180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// simplify removes the points that deviate less than tolerance metres from the track, using Douglas-Peucker
+func simplify(points []CoursePoint, tolerance float64) []CoursePoint {
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	type span struct{ first, last int }
+	stack := []span{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		var (
+			maxDist float64
+			index   int
+		)
+		for i := s.first + 1; i < s.last; i++ {
+			if d := crossTrackDistance(points[i], points[s.first], points[s.last]); d > maxDist {
+				maxDist, index = d, i
+			}
+		}
+
+		if maxDist > tolerance {
+			keep[index] = true
+			stack = append(stack, span{s.first, index}, span{index, s.last})
+		}
+	}
+
+	result := make([]CoursePoint, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// crossTrackDistance returns the distance in metres from p to the segment a-b,
+// projecting the points on a plane, accurate enough for the short segments of a track
+func crossTrackDistance(p, a, b CoursePoint) float64 {
+	cos := math.Cos(a.Lat * math.Pi / 180)
+	project := func(q CoursePoint) (float64, float64) {
+		return (q.Lon - a.Lon) * math.Pi / 180 * earthRadius * cos, (q.Lat - a.Lat) * math.Pi / 180 * earthRadius
+	}
+
+	px, py := project(p)
+	bx, by := project(b)
+
+	l := bx*bx + by*by
+	if l == 0 {
+		return math.Hypot(px, py)
+	}
+
+	t := math.Max(0, math.Min(1, (px*bx+py*by)/l))
+
+	return math.Hypot(px-t*bx, py-t*by)
+}
+
+// SetCourse attaches a course to the race, or to one of its categories when category is not empty
+func (r *Race) SetCourse(category CategoryName, c Course) error {
+	if category == "" {
+		r.Course = &c
+		return nil
+	}
+
+	for i := range r.Categories {
+		if r.Categories[i].Name == category {
+			r.Categories[i].Course = &c
+			return nil
+		}
+	}
+
+	return UnknownCategoryError{r.ID, category}
+}
@@ -0,0 +1,85 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+)
+
+func TestNewCourse(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("when invalid track returns InvalidCourseError", func(t *testing.T) {
+		for name, points := range map[string][]racers.CoursePoint{
+			"one point":    {{Lat: 43, Lon: -2}},
+			"out of range": {{Lat: 43, Lon: -2}, {Lat: 91, Lon: -2}},
+			"no length":    {{Lat: 43, Lon: -2}, {Lat: 43, Lon: -2}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := racers.NewCourse(points)
+				require.True(errors.As(err, &racers.InvalidCourseError{}))
+			})
+		}
+	})
+
+	t.Run("when valid track computes the measures and simplifies the points", func(t *testing.T) {
+		course, err := racers.NewCourse([]racers.CoursePoint{
+			{Lat: 0, Lon: 0, Elevation: 100},
+			{Lat: 0, Lon: 0.005, Elevation: 120},
+			{Lat: 0, Lon: 0.01, Elevation: 110},
+			{Lat: 0.01, Lon: 0.01, Elevation: 150},
+		})
+		require.NoError(err)
+
+		require.InDelta(2224, int(course.Distance), 2)
+		require.Equal(60, course.ElevationGain)
+		require.Equal(10, course.ElevationLoss)
+		require.Equal([]racers.CoursePoint{
+			{Lat: 0, Lon: 0, Elevation: 100},
+			{Lat: 0, Lon: 0.01, Elevation: 110},
+			{Lat: 0.01, Lon: 0.01, Elevation: 150},
+		}, course.Points)
+	})
+
+	t.Run("when points have no elevation measures the gaps from the last elevation", func(t *testing.T) {
+		course, err := racers.NewCourse([]racers.CoursePoint{
+			{Lat: 0, Lon: 0, NoElevation: true},
+			{Lat: 0, Lon: 0.005, Elevation: 120},
+			{Lat: 0, Lon: 0.01, NoElevation: true},
+			{Lat: 0.01, Lon: 0.01, Elevation: 150},
+			{Lat: 0.01, Lon: 0.02, Elevation: 140},
+		})
+		require.NoError(err)
+
+		require.Equal(30, course.ElevationGain)
+		require.Equal(10, course.ElevationLoss)
+	})
+}
+
+func TestCoursePolyline(t *testing.T) {
+	course := racers.Course{Points: []racers.CoursePoint{
+		{Lat: 38.5, Lon: -120.2},
+		{Lat: 40.7, Lon: -120.95},
+		{Lat: 43.252, Lon: -126.453},
+	}}
+
+	require.Equal(t, "_p~iF~ps|U_ulLnnqC_mqNvxq`@", course.Polyline())
+}
+
+func TestRaceSetCourse(t *testing.T) {
+	require := require.New(t)
+
+	course := racers.Course{Distance: 10000}
+	r := racers.Race{ID: raceID, Categories: racers.RaceCategories{{Name: "10K"}}}
+
+	require.NoError(r.SetCourse("", course))
+	require.Equal(&course, r.Course)
+
+	require.NoError(r.SetCourse("10K", course))
+	require.Equal(&course, r.Categories[0].Course)
+
+	err := r.SetCourse("5K", course)
+	require.True(errors.As(err, &racers.UnknownCategoryError{}))
+}
@@ -0,0 +1,136 @@
+// Package ical writes iCalendar (RFC 5545) feeds
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the media type of the feeds
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	productID = "-//racers//racers//EN"
+	// lineLength is the max octets of a content line, longer lines are folded
+	lineLength = 75
+
+	utcFormat = "20060102T150405Z"
+)
+
+// Event is a calendar entry
+type Event struct {
+	// UID identifies the event across feeds and updates
+	UID string
+	// Sequence is the revision of the event, calendar clients replace the events with lower sequences
+	Sequence int
+	// Start is written in UTC, the clients show it in their time zone without needing its definition
+	Start    time.Time
+	Summary  string
+	Location string
+	URL      string
+	// Status is empty for confirmed events
+	Status string
+}
+
+// Status values of the events
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is a feed of events
+type Calendar struct {
+	// Name is shown by the clients as the calendar title
+	Name   string
+	Events []Event
+}
+
+// Write encodes the calendar, stamped with the given time
+func (c Calendar) Write(w io.Writer, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	lw := lineWriter{w: bw}
+
+	lw.line("BEGIN", "VCALENDAR")
+	lw.line("VERSION", "2.0")
+	lw.line("PRODID", productID)
+	lw.line("CALSCALE", "GREGORIAN")
+	lw.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	for _, e := range c.Events {
+		lw.line("BEGIN", "VEVENT")
+		lw.line("UID", escape(e.UID))
+		lw.line("DTSTAMP", stamp.UTC().Format(utcFormat))
+		lw.line("SEQUENCE", fmt.Sprint(e.Sequence))
+		lw.line("DTSTART", e.Start.UTC().Format(utcFormat))
+		lw.line("SUMMARY", escape(e.Summary))
+		if e.Location != "" {
+			lw.line("LOCATION", escape(e.Location))
+		}
+		if e.URL != "" {
+			lw.line("URL", e.URL)
+		}
+		status := e.Status
+		if status == "" {
+			status = StatusConfirmed
+		}
+		lw.line("STATUS", status)
+		lw.line("END", "VEVENT")
+	}
+
+	lw.line("END", "VCALENDAR")
+	if lw.err != nil {
+		return lw.err
+	}
+
+	return bw.Flush()
+}
+
+// lineWriter writes folded content lines, keeping the first error
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) line(name, value string) {
+	if lw.err != nil {
+		return
+	}
+
+	_, lw.err = lw.w.WriteString(fold(name + ":" + value))
+}
+
+// fold splits the line in lines of at most 75 octets without breaking runes,
+// the continuation lines start with a space
+func fold(line string) string {
+	var b strings.Builder
+	limit := lineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space counts in the continuation lines
+		limit = lineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+
+	return b.String()
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// escape escapes the text values
+func escape(s string) string {
+	return escaper.Replace(s)
+}
@@ -0,0 +1,75 @@
+package ical_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/ical"
+)
+
+func TestCalendarWrite(t *testing.T) {
+	require := require.New(t)
+
+	stamp := time.Date(2030, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	t.Run("writes the events", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := ical.Calendar{
+			Name: "Races",
+			Events: []ical.Event{{
+				UID:      "1@racers",
+				Sequence: 2,
+				Start:    time.Date(2030, 4, 12, 9, 0, 0, 0, time.UTC),
+				Summary:  "Behobia, San Sebastián",
+				URL:      "https://racers.example/races/1",
+			}},
+		}.Write(&buf, stamp)
+		require.NoError(err)
+
+		require.Equal(strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//racers//racers//EN",
+			"CALSCALE:GREGORIAN",
+			"METHOD:PUBLISH",
+			"X-WR-CALNAME:Races",
+			"BEGIN:VEVENT",
+			"UID:1@racers",
+			"DTSTAMP:20300102T100000Z",
+			"SEQUENCE:2",
+			"DTSTART:20300412T090000Z",
+			`SUMMARY:Behobia\, San Sebastián`,
+			"URL:https://racers.example/races/1",
+			"STATUS:CONFIRMED",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n"), buf.String())
+	})
+
+	t.Run("writes local times in UTC", func(t *testing.T) {
+		madrid, err := time.LoadLocation("Europe/Madrid")
+		require.NoError(err)
+
+		var buf bytes.Buffer
+		err = ical.Calendar{Events: []ical.Event{{UID: "1", Start: time.Date(2030, 4, 12, 9, 0, 0, 0, madrid)}}}.Write(&buf, stamp)
+		require.NoError(err)
+
+		require.Contains(buf.String(), "DTSTART:20300412T070000Z\r\n")
+		require.NotContains(buf.String(), "TZID")
+	})
+
+	t.Run("folds long lines", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := ical.Calendar{Events: []ical.Event{{UID: "1", Summary: strings.Repeat("á", 60)}}}.Write(&buf, stamp)
+		require.NoError(err)
+
+		for _, line := range strings.Split(buf.String(), "\r\n") {
+			require.LessOrEqual(len(line), 75)
+		}
+		require.Contains(buf.String(), "\r\n "+strings.Repeat("á", 10))
+	})
+}
@@ -0,0 +1,218 @@
+package jobs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InvalidScheduleError means the cron expression can not be parsed
+type InvalidScheduleError struct {
+	Expr   string
+	Reason string
+}
+
+func (err InvalidScheduleError) Error() string {
+	return fmt.Sprintf("invalid schedule %q: %s", err.Expr, err.Reason)
+}
+
+// field is the range of values of a cron expression field, with the names it accepts
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minutes = field{name: "minute", min: 0, max: 59}
+	hours   = field{name: "hour", min: 0, max: 23}
+	days    = field{name: "day of month", min: 1, max: 31}
+	months  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// weekdays accept 7 as Sunday too
+	weekdays = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// descriptors are the shorthands of the common schedules
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule is a cron expression with the minute, hour, day of month, month and day of week fields,
+// the times are matched in UTC
+type Schedule struct {
+	expr                              string
+	minute, hour, day, month, weekday uint64
+	// anyDay and anyWeekday are set when the field is *, when both day fields are restricted
+	// the days matching either of them match
+	anyDay, anyWeekday bool
+}
+
+// ParseSchedule parses the cron expression, fields accept *, values, ranges, steps and lists
+// like "*/15 6-22 * JAN-JUN MON,FRI", and the @hourly, @daily, @weekly, @monthly and @yearly shorthands
+func ParseSchedule(expr string) (Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if d, ok := descriptors[spec]; ok {
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, InvalidScheduleError{expr, "expected 5 fields"}
+	}
+
+	s := Schedule{expr: expr, anyDay: fields[2] == "*", anyWeekday: fields[4] == "*"}
+	for i, f := range []struct {
+		field field
+		set   *uint64
+	}{
+		{minutes, &s.minute},
+		{hours, &s.hour},
+		{days, &s.day},
+		{months, &s.month},
+		{weekdays, &s.weekday},
+	} {
+		set, err := f.field.parse(fields[i])
+		if err != nil {
+			return Schedule{}, InvalidScheduleError{expr, err.Error()}
+		}
+		*f.set = set
+	}
+	// Sunday is 0
+	if s.weekday&(1<<7) != 0 {
+		s.weekday |= 1
+	}
+
+	return s, nil
+}
+
+// MustParseSchedule parses the cron expression and panics when it is not valid, for the fixed schedules
+func MustParseSchedule(expr string) Schedule {
+	s, err := ParseSchedule(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// parse returns the set of values of the field expression, a bit per value
+func (f field) parse(expr string) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(expr, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid %s step %q", f.name, part[i+1:])
+			}
+			rng, step = part[:i], s
+		}
+
+		from, to := f.min, f.max
+		switch i := strings.Index(rng, "-"); {
+		case rng == "*":
+		case i >= 0:
+			var err error
+			if from, err = f.value(rng[:i]); err != nil {
+				return 0, err
+			}
+			if to, err = f.value(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if from > to {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rng)
+			}
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, err
+			}
+			// a single value with a step runs from the value to the end of the range, like 5/15
+			from = v
+			if step == 1 {
+				to = v
+			}
+		}
+
+		for v := from; v <= to; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %q", f.name, s)
+	}
+
+	return v, nil
+}
+
+// maxSearch bounds the search of the next time, the expressions that never match like 30 of February stop there
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Next returns the first time after the given one matching the schedule, in UTC,
+// the zero time when it never matches
+func (s Schedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxSearch)
+
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchesDay(t time.Time) bool {
+	day, weekday := has(s.day, t.Day()), has(s.weekday, int(t.Weekday()))
+	if !s.anyDay && !s.anyWeekday {
+		return day || weekday
+	}
+
+	return day && weekday
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0
+}
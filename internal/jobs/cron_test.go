@@ -0,0 +1,90 @@
+package jobs_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/jobs"
+)
+
+func TestSchedule(t *testing.T) {
+	// Monday
+	from := time.Date(2030, 1, 7, 10, 17, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		expr string
+		next []time.Time
+	}{
+		{"* * * * *", []time.Time{
+			time.Date(2030, 1, 7, 10, 18, 0, 0, time.UTC),
+			time.Date(2030, 1, 7, 10, 19, 0, 0, time.UTC),
+		}},
+		{"*/15 * * * *", []time.Time{
+			time.Date(2030, 1, 7, 10, 30, 0, 0, time.UTC),
+			time.Date(2030, 1, 7, 10, 45, 0, 0, time.UTC),
+			time.Date(2030, 1, 7, 11, 0, 0, 0, time.UTC),
+		}},
+		{"5/20 9-10 * * *", []time.Time{
+			time.Date(2030, 1, 7, 10, 25, 0, 0, time.UTC),
+			time.Date(2030, 1, 7, 10, 45, 0, 0, time.UTC),
+			time.Date(2030, 1, 8, 9, 5, 0, 0, time.UTC),
+		}},
+		{"0 6 * * SAT,sun", []time.Time{
+			time.Date(2030, 1, 12, 6, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 13, 6, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 19, 6, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 29 FEB *", []time.Time{
+			time.Date(2032, 2, 29, 0, 0, 0, 0, time.UTC),
+		}},
+		// the day is either the 1st or a Friday when both are restricted
+		{"0 12 1 * 5", []time.Time{
+			time.Date(2030, 1, 11, 12, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 18, 12, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 25, 12, 0, 0, 0, time.UTC),
+			time.Date(2030, 2, 1, 12, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 * * 7", []time.Time{
+			time.Date(2030, 1, 13, 0, 0, 0, 0, time.UTC),
+		}},
+		{"@daily", []time.Time{
+			time.Date(2030, 1, 8, 0, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 9, 0, 0, 0, 0, time.UTC),
+		}},
+		{"@monthly", []time.Time{
+			time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			s, err := jobs.ParseSchedule(tc.expr)
+			require.NoError(t, err)
+
+			at := from
+			for _, next := range tc.next {
+				at = s.Next(at)
+				require.Equal(t, next, at)
+			}
+		})
+	}
+
+	t.Run("the times are matched in UTC", func(t *testing.T) {
+		madrid, err := time.LoadLocation("Europe/Madrid")
+		require.NoError(t, err)
+
+		next := jobs.MustParseSchedule("0 12 * * *").Next(time.Date(2030, 1, 7, 12, 30, 0, 0, madrid))
+		require.Equal(t, time.Date(2030, 1, 7, 12, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("never matching schedules return the zero time", func(t *testing.T) {
+		require.True(t, jobs.MustParseSchedule("0 0 30 2 *").Next(from).IsZero())
+	})
+
+	t.Run("when the expression is not valid returns InvalidScheduleError", func(t *testing.T) {
+		for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@often"} {
+			_, err := jobs.ParseSchedule(expr)
+			require.True(t, errors.As(err, &jobs.InvalidScheduleError{}), expr)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+// Package jobs runs the periodic tasks on cron schedules, with a single replica executing each run
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+)
+
+// Job is a task run on a schedule, Run returns the number of items it processed
+type Job struct {
+	Name     string
+	Schedule Schedule
+	Run      func(ctx context.Context) (int, error)
+}
+
+// RunStatus is the state of a run of a job
+type RunStatus string
+
+// Run statuses, a run is running until it succeeds or fails
+const (
+	RunRunning   RunStatus = "RUNNING"
+	RunSucceeded RunStatus = "SUCCEEDED"
+	RunFailed    RunStatus = "FAILED"
+)
+
+// Run is the execution of a job at one of its scheduled times
+type Run struct {
+	Job         string
+	ScheduledAt time.Time
+	StartedAt   time.Time
+	// FinishedAt is zero while the run is running
+	FinishedAt time.Time
+	Status     RunStatus
+	Processed  int
+	// Error is the failure reason of the failed runs
+	Error string
+}
+
+// Store persists the runs of the jobs and elects the replica that executes each one
+type Store interface {
+	// Lock takes the lock of the job, it returns false when another replica holds it.
+	// The lock is held until unlock is called
+	Lock(ctx context.Context, job string) (unlock func() error, ok bool, err error)
+	// Start saves the running run, it returns false when the job already ran at the scheduled time
+	Start(ctx context.Context, run Run) (bool, error)
+	// Finish saves the result of the run
+	Finish(ctx context.Context, run Run) error
+}
+
+// metrics are the run history and the next fire time of the jobs
+type metrics struct {
+	runs        *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	processed   *prometheus.CounterVec
+	lastSuccess *prometheus.GaugeVec
+	nextRun     *prometheus.GaugeVec
+}
+
+const (
+	jobLabel    = "job"
+	statusLabel = "status"
+)
+
+func newMetrics(registerer prometheus.Registerer, namespace string) metrics {
+	m := metrics{
+		runs: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      "job_runs_total",
+				Help:      "Total number of runs of the job executed by the replica, by result.",
+				Namespace: namespace,
+			},
+			[]string{jobLabel, statusLabel},
+		),
+		duration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      "job_run_duration_seconds",
+				Help:      "The time taken by the runs of the job.",
+				Namespace: namespace,
+			},
+			[]string{jobLabel},
+		),
+		processed: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      "job_processed_total",
+				Help:      "Total number of items processed by the runs of the job.",
+				Namespace: namespace,
+			},
+			[]string{jobLabel},
+		),
+		lastSuccess: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:      "job_last_success_timestamp_seconds",
+				Help:      "Scheduled time of the last successful run of the job executed by the replica.",
+				Namespace: namespace,
+			},
+			[]string{jobLabel},
+		),
+		nextRun: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name:      "job_next_run_timestamp_seconds",
+				Help:      "Next time the job is scheduled to run.",
+				Namespace: namespace,
+			},
+			[]string{jobLabel},
+		),
+	}
+
+	registerer.MustRegister(m.runs, m.duration, m.processed, m.lastSuccess, m.nextRun)
+
+	return m
+}
+
+// NewScheduler returns a scheduler running the jobs, the metrics are registered with the namespace
+func NewScheduler(store Store, logger log.Logger, registerer prometheus.Registerer, namespace string, jobs ...Job) Scheduler {
+	return Scheduler{store, logger, newMetrics(registerer, namespace), jobs}
+}
+
+// Scheduler runs the jobs on their schedules, every replica runs a scheduler and the store
+// elects the one executing each run
+type Scheduler struct {
+	store   Store
+	logger  log.Logger
+	metrics metrics
+	jobs    []Job
+}
+
+// Start runs the jobs on their schedules until the context is done, the runs missed while no replica
+// was running are skipped
+func (s Scheduler) Start(ctx context.Context) {
+	done := make(chan struct{})
+	for _, job := range s.jobs {
+		go func(job Job) {
+			s.schedule(ctx, job)
+			done <- struct{}{}
+		}(job)
+	}
+
+	for range s.jobs {
+		<-done
+	}
+}
+
+func (s Scheduler) schedule(ctx context.Context, job Job) {
+	for {
+		next := job.Schedule.Next(time.Now())
+		if next.IsZero() {
+			s.logger.Error(ctx, fmt.Errorf("job %s schedule %s never runs", job.Name, job.Schedule), nil)
+			return
+		}
+		s.metrics.nextRun.WithLabelValues(job.Name).Set(float64(next.Unix()))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		run, err := s.Execute(ctx, job, next)
+		if err != nil {
+			s.logger.Error(ctx, err, log.Payload{"job": job.Name})
+			continue
+		}
+		switch {
+		case run == nil:
+		case run.Status == RunFailed:
+			s.logger.Error(ctx, fmt.Errorf("job %s failed: %s", job.Name, run.Error), log.Payload{"job": job.Name})
+		default:
+			s.logger.Info(ctx, fmt.Sprintf("job %s run", job.Name), log.Payload{
+				"job":       job.Name,
+				"processed": run.Processed,
+				"duration":  run.FinishedAt.Sub(run.StartedAt).String(),
+			})
+		}
+	}
+}
+
+// Execute runs the job at the scheduled time unless another replica is running it or already ran it,
+// it returns nil when the job did not run. The failures of the job are saved in the run, the error
+// is returned when the run could not be saved
+func (s Scheduler) Execute(ctx context.Context, job Job, scheduled time.Time) (*Run, error) {
+	unlock, ok, err := s.store.Lock(ctx, job.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "locking job %s", job.Name)
+	}
+	if !ok {
+		return nil, nil
+	}
+	defer func() {
+		if err := unlock(); err != nil {
+			s.logger.Error(ctx, errors.Wrap(err, "unlocking job %s", job.Name), nil)
+		}
+	}()
+
+	run := Run{Job: job.Name, ScheduledAt: scheduled, StartedAt: time.Now(), Status: RunRunning}
+	started, err := s.store.Start(ctx, run)
+	if err != nil {
+		return nil, errors.Wrap(err, "starting job %s", job.Name)
+	}
+	if !started {
+		return nil, nil
+	}
+
+	run.Processed, err = job.Run(ctx)
+	run.FinishedAt = time.Now()
+	run.Status = RunSucceeded
+	if err != nil {
+		run.Status, run.Error = RunFailed, err.Error()
+	}
+
+	s.metrics.runs.WithLabelValues(job.Name, string(run.Status)).Inc()
+	s.metrics.duration.WithLabelValues(job.Name).Observe(run.FinishedAt.Sub(run.StartedAt).Seconds())
+	s.metrics.processed.WithLabelValues(job.Name).Add(float64(run.Processed))
+	if run.Status == RunSucceeded {
+		s.metrics.lastSuccess.WithLabelValues(job.Name).Set(float64(scheduled.Unix()))
+	}
+
+	if err := s.store.Finish(ctx, run); err != nil {
+		return &run, errors.Wrap(err, "finishing job %s", job.Name)
+	}
+
+	return &run, nil
+}
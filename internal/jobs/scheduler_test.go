@@ -0,0 +1,172 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/jobs"
+)
+
+// store keeps the runs in memory, the lock is held by another replica when locked is set
+type store struct {
+	locked bool
+	runs   map[string]jobs.Run
+}
+
+func (s *store) Lock(context.Context, string) (func() error, bool, error) {
+	if s.locked {
+		return nil, false, nil
+	}
+	s.locked = true
+
+	return func() error {
+		s.locked = false
+		return nil
+	}, true, nil
+}
+
+func key(run jobs.Run) string {
+	return run.Job + run.ScheduledAt.String()
+}
+
+func (s *store) Start(_ context.Context, run jobs.Run) (bool, error) {
+	if _, ok := s.runs[key(run)]; ok {
+		return false, nil
+	}
+	s.runs[key(run)] = run
+
+	return true, nil
+}
+
+func (s *store) Finish(_ context.Context, run jobs.Run) error {
+	s.runs[key(run)] = run
+	return nil
+}
+
+func TestSchedulerExecute(t *testing.T) {
+	require := require.New(t)
+
+	st := &store{runs: make(map[string]jobs.Run)}
+	registry := prometheus.NewRegistry()
+
+	var calls int
+	fail := false
+	job := jobs.Job{
+		Name:     "close-registrations",
+		Schedule: jobs.MustParseSchedule("*/5 * * * *"),
+		Run: func(context.Context) (int, error) {
+			calls++
+			if fail {
+				return 1, errors.New("database is gone")
+			}
+			return 3, nil
+		},
+	}
+	scheduler := jobs.NewScheduler(st, log.NoopLogger{}, registry, "racers", job)
+	at := time.Date(2030, 1, 7, 10, 5, 0, 0, time.UTC)
+
+	t.Run("records the successful runs", func(t *testing.T) {
+		run, err := scheduler.Execute(context.Background(), job, at)
+		require.NoError(err)
+		require.NotNil(run)
+		require.Equal(jobs.RunSucceeded, run.Status)
+		require.Equal(3, run.Processed)
+		require.Equal(*run, st.runs[key(*run)])
+		require.False(st.locked, "the lock is released")
+	})
+
+	t.Run("skips the runs already executed", func(t *testing.T) {
+		run, err := scheduler.Execute(context.Background(), job, at)
+		require.NoError(err)
+		require.Nil(run)
+		require.Equal(1, calls)
+	})
+
+	t.Run("skips the runs while another replica holds the lock", func(t *testing.T) {
+		st.locked = true
+		defer func() { st.locked = false }()
+
+		run, err := scheduler.Execute(context.Background(), job, at.Add(5*time.Minute))
+		require.NoError(err)
+		require.Nil(run)
+		require.Equal(1, calls)
+	})
+
+	t.Run("records the failed runs", func(t *testing.T) {
+		fail = true
+
+		run, err := scheduler.Execute(context.Background(), job, at.Add(10*time.Minute))
+		require.NoError(err)
+		require.Equal(jobs.RunFailed, run.Status)
+		require.Equal("database is gone", run.Error)
+		require.Equal(jobs.RunFailed, st.runs[key(*run)].Status)
+	})
+
+	t.Run("exposes the run history", func(t *testing.T) {
+		require.NoError(testutil.GatherAndCompare(registry, strings.NewReader(fmt.Sprintf(`
+# HELP racers_job_runs_total Total number of runs of the job executed by the replica, by result.
+# TYPE racers_job_runs_total counter
+racers_job_runs_total{job="close-registrations",status="FAILED"} 1
+racers_job_runs_total{job="close-registrations",status="SUCCEEDED"} 1
+# HELP racers_job_processed_total Total number of items processed by the runs of the job.
+# TYPE racers_job_processed_total counter
+racers_job_processed_total{job="close-registrations"} 4
+# HELP racers_job_last_success_timestamp_seconds Scheduled time of the last successful run of the job executed by the replica.
+# TYPE racers_job_last_success_timestamp_seconds gauge
+racers_job_last_success_timestamp_seconds{job="close-registrations"} %g
+`, float64(at.Unix()))), "racers_job_runs_total", "racers_job_processed_total", "racers_job_last_success_timestamp_seconds"))
+	})
+}
+
+func TestSchedulerStart(t *testing.T) {
+	st := &store{runs: make(map[string]jobs.Run)}
+	registry := prometheus.NewRegistry()
+	job := jobs.Job{
+		Name:     "reminders",
+		Schedule: jobs.MustParseSchedule("@hourly"),
+		Run:      func(context.Context) (int, error) { return 0, nil },
+	}
+	scheduler := jobs.NewScheduler(st, log.NoopLogger{}, registry, "racers", job)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		scheduler.Start(ctx)
+		close(done)
+	}()
+
+	next := float64(job.Schedule.Next(time.Now()).Unix())
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(gauge(t, registry, "racers_job_next_run_timestamp_seconds")) == next
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the scheduler did not stop")
+	}
+}
+
+// gauge returns a collector with the value of the gathered gauge
+func gauge(t *testing.T, g prometheus.Gatherer, name string) prometheus.Collector {
+	families, err := g.Gather()
+	require.NoError(t, err)
+
+	value := prometheus.NewGauge(prometheus.GaugeOpts{Name: name})
+	for _, f := range families {
+		if f.GetName() == name && len(f.Metric) > 0 {
+			value.Set(f.Metric[0].GetGauge().GetValue())
+		}
+	}
+
+	return value
+}
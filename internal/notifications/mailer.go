@@ -0,0 +1,119 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/quotedprintable"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is an html email to a single recipient
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	// Headers are the extra headers of the message, like List-Unsubscribe
+	Headers map[string]string
+}
+
+// Bytes returns the message in RFC 5322 format, sent from the given address
+func (m Message) Bytes(from string, date time.Time) ([]byte, error) {
+	var b bytes.Buffer
+
+	headers := map[string]string{
+		"From":                      from,
+		"To":                        m.To,
+		"Subject":                   mime.QEncoding.Encode("utf-8", m.Subject),
+		"Date":                      date.Format(time.RFC1123Z),
+		"MIME-Version":              "1.0",
+		"Content-Type":              `text/html; charset="utf-8"`,
+		"Content-Transfer-Encoding": "quoted-printable",
+	}
+	for k, v := range m.Headers {
+		headers[k] = v
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if strings.ContainsAny(headers[k], "\r\n") {
+			return nil, fmt.Errorf("invalid header %s", k)
+		}
+		fmt.Fprintf(&b, "%s: %s\r\n", k, headers[k])
+	}
+	b.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&b)
+	if _, err := w.Write([]byte(m.HTML)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Mailer sends the emails
+type Mailer interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// Memory keeps the messages sent, for tests
+type Memory struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func (m *Memory) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, msg)
+
+	return nil
+}
+
+// Sent returns the messages sent, in order
+func (m *Memory) Sent() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Message(nil), m.sent...)
+}
+
+// NewDir returns a mailer writing each message as an .eml file in the directory, for development
+func NewDir(dir, from string) Dir {
+	return Dir{dir, from}
+}
+
+type Dir struct {
+	dir  string
+	from string
+}
+
+func (d Dir) Send(_ context.Context, m Message) error {
+	now := time.Now()
+	b, err := m.Bytes(d.from, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", now.UnixNano(), strings.NewReplacer("@", "_at_", "/", "_").Replace(m.To))
+
+	return ioutil.WriteFile(filepath.Join(d.dir, name), b, 0o644)
+}
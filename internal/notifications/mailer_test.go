@@ -0,0 +1,59 @@
+package notifications_test
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/notifications"
+)
+
+func TestMessageBytes(t *testing.T) {
+	date := time.Date(2030, 11, 10, 10, 0, 0, 0, time.UTC)
+
+	t.Run("encodes the headers and the body", func(t *testing.T) {
+		require := require.New(t)
+
+		b, err := notifications.Message{
+			To:      "runner@racers.test",
+			Subject: "Inscripción confirmada",
+			HTML:    `<p style="color:#888">Hola</p>`,
+			Headers: map[string]string{"List-Unsubscribe": "<https://racers.example/unsubscribe>"},
+		}.Bytes("racers <no-reply@racers.test>", date)
+		require.NoError(err)
+
+		msg := string(b)
+		require.Contains(msg, "From: racers <no-reply@racers.test>\r\n")
+		require.Contains(msg, "To: runner@racers.test\r\n")
+		require.Contains(msg, "Subject: =?utf-8?q?Inscripci=C3=B3n_confirmada?=\r\n")
+		require.Contains(msg, "Date: Sun, 10 Nov 2030 10:00:00 +0000\r\n")
+		require.Contains(msg, "List-Unsubscribe: <https://racers.example/unsubscribe>\r\n")
+		require.True(strings.HasSuffix(msg, "\r\n\r\n<p style=3D\"color:#888\">Hola</p>"))
+	})
+
+	t.Run("rejects headers with line breaks", func(t *testing.T) {
+		_, err := notifications.Message{
+			To:      "runner@racers.test\r\nBcc: everyone@racers.test",
+			Subject: "Hi",
+		}.Bytes("no-reply@racers.test", date)
+		require.Error(t, err)
+	})
+}
+
+func TestDir(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "mail")
+	require.NoError(err)
+
+	mailer := notifications.NewDir(dir, "no-reply@racers.test")
+	require.NoError(mailer.Send(context.Background(), notifications.Message{To: "runner@racers.test", Subject: "Hi", HTML: "<p>Hi</p>"}))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(err)
+	require.Len(files, 1)
+	require.True(strings.HasSuffix(files[0].Name(), "-runner_at_racers.test.eml"))
+}
@@ -0,0 +1,390 @@
+// Package notifications emails the users about the events of their races and registrations
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+// consumer is the name the notifier cursor is stored with
+const consumer = "notifications"
+
+const defaultLocale = service.DefaultLocale
+
+// batchSize is the number of events read at once
+const batchSize = 100
+
+// settleDelay is how old the events must be to be processed, so the transactions that published events
+// before the last one processed are committed when the cursor passes them
+const settleDelay = 10 * time.Second
+
+// UnsubscribePath is where the unsubscribe links point to, with the token query param
+const UnsubscribePath = "/notifications/unsubscribe"
+
+func NewNotifier(
+	events service.EventsStream,
+	races service.RacesGetter,
+	users service.UsersGetter,
+	prefs service.Notifications,
+	checkIns service.CheckIns,
+	mailer Mailer,
+	templates Templates,
+	publicURL string,
+) Notifier {
+	return Notifier{events, races, users, prefs, checkIns, mailer, templates, strings.TrimSuffix(publicURL, "/")}
+}
+
+// Notifier consumes the published events and emails the users involved, the events are processed
+// at least once: an event is emailed again if the notifier stops before moving the cursor past it
+type Notifier struct {
+	events    service.EventsStream
+	races     service.RacesGetter
+	users     service.UsersGetter
+	prefs     service.Notifications
+	checkIns  service.CheckIns
+	mailer    Mailer
+	templates Templates
+	publicURL string
+}
+
+// notification is an email to send about an event
+type notification struct {
+	kind       service.NotificationKind
+	email      string
+	race       racers.RaceID
+	recipients []racers.UserID
+	// competitors sends the email to all the competitors of the race instead of the recipients
+	competitors bool
+	// transfer is the race the competitors were moved to, nil unless they were
+	transfer *racers.RaceID
+	// sender is the user the email is on behalf of, nil when it is not on behalf of anyone
+	sender *racers.UserID
+	// data fills the event details of the email of a recipient
+	data func(r racers.Race, u racers.UserID, loc string, d *emailData)
+}
+
+// Process emails the events published since the last processed, it returns the number of emails sent
+func (n Notifier) Process(ctx context.Context) (int, error) {
+	cursor, err := n.events.Cursor(ctx, consumer)
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+	for {
+		events, err := n.events.After(ctx, cursor, time.Now().Add(-settleDelay), batchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, e := range events {
+			s, err := n.notify(ctx, e)
+			sent += s
+			if err != nil {
+				return sent, errors.Wrap(err, "notifying event %s", e.ID)
+			}
+
+			cursor = &service.EventsCursor{OccurredAt: e.OccurredAt, ID: e.ID}
+			if err := n.events.SaveCursor(ctx, consumer, *cursor); err != nil {
+				return sent, err
+			}
+		}
+
+		if len(events) < batchSize {
+			return sent, nil
+		}
+	}
+}
+
+// notify emails the recipients of the event, the events with nothing to notify are skipped
+func (n Notifier) notify(ctx context.Context, e service.StoredEvent) (int, error) {
+	notif, ok, err := n.notification(e)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	race, err := n.races.Get(ctx, notif.race)
+	if errors.Is(err, service.ErrRaceNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var transfer *racers.Race
+	if notif.transfer != nil {
+		t, err := n.races.Get(ctx, *notif.transfer)
+		if err != nil {
+			return 0, err
+		}
+		transfer = &t
+	}
+
+	var sender string
+	if notif.sender != nil {
+		u, err := n.users.Get(ctx, *notif.sender)
+		if err != nil && !errors.Is(err, service.ErrUserNotFound) {
+			return 0, err
+		}
+		sender = u.Name
+	}
+
+	recipients := notif.recipients
+	if notif.competitors {
+		recipients = race.Competitors.List()
+	}
+
+	var sent int
+	for _, recipient := range recipients {
+		user, err := n.users.Get(ctx, recipient)
+		if errors.Is(err, service.ErrUserNotFound) {
+			continue
+		}
+		if err != nil {
+			return sent, err
+		}
+		if user.Email == "" {
+			continue
+		}
+
+		prefs, err := n.prefs.PreferencesOf(ctx, user.ID)
+		if err != nil {
+			return sent, err
+		}
+		if !prefs.Enabled(notif.kind) {
+			continue
+		}
+
+		msg, err := n.message(race, transfer, sender, user, prefs.Locale, notif)
+		if err != nil {
+			return sent, err
+		}
+
+		if err := n.mailer.Send(ctx, msg); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (n Notifier) message(race racers.Race, transfer *racers.Race, sender string, user racers.User, loc string, notif notification) (Message, error) {
+	unsubscribe := fmt.Sprintf("%s%s?token=%s", n.publicURL, UnsubscribePath, url.QueryEscape(n.prefs.UnsubscribeToken(user.ID, notif.kind)))
+
+	data := emailData{
+		Name:           user.Name,
+		Race:           n.raceData(race, loc),
+		Sender:         sender,
+		UnsubscribeURL: unsubscribe,
+	}
+	if transfer != nil {
+		data.Transfer = n.raceData(*transfer, loc)
+	}
+	if notif.data != nil {
+		notif.data(race, user.ID, loc, &data)
+	}
+
+	subject, html, err := n.templates.Render(loc, notif.email, data)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		To:      user.Email,
+		Subject: subject,
+		HTML:    html,
+		Headers: map[string]string{"List-Unsubscribe": fmt.Sprintf("<%s>", unsubscribe)},
+	}, nil
+}
+
+func (n Notifier) raceData(race racers.Race, loc string) raceData {
+	return raceData{
+		Name: string(race.Name),
+		Date: formatDate(loc, race.LocalDate()),
+		URL:  fmt.Sprintf("%s/races/%s", n.publicURL, id.ID(race.ID)),
+	}
+}
+
+// notification returns what to email about the event, false when the event is not notified
+func (n Notifier) notification(e service.StoredEvent) (notification, bool, error) {
+	switch e.Type {
+	case "RaceCreated":
+		var p struct {
+			Race struct {
+				ID    racers.RaceID
+				Owner racers.UserID
+			} `json:"race"`
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return notification{
+			kind:       service.NotificationOrganizer,
+			email:      emailRaceCreated,
+			race:       p.Race.ID,
+			recipients: []racers.UserID{p.Race.Owner},
+		}, true, nil
+
+	case "UserJoinedRace":
+		var p struct {
+			User struct{ ID racers.UserID }
+			Race struct{ ID racers.RaceID }
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return n.registration(p.Race.ID, p.User.ID), true, nil
+
+	case "RegistrationTransferred":
+		var p struct {
+			Race racers.RaceID
+			To   racers.UserID
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return n.registration(p.Race, p.To), true, nil
+
+	case "RegistrationTransferOffered":
+		var p struct {
+			Race racers.RaceID
+			From racers.UserID
+			To   racers.UserID
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return notification{
+			kind:       service.NotificationRegistrations,
+			email:      emailRegistrationOffer,
+			race:       p.Race,
+			recipients: []racers.UserID{p.To},
+			sender:     &p.From,
+		}, true, nil
+
+	case "RegistrationConfirmed":
+		var p struct {
+			Race       racers.RaceID
+			Competitor racers.UserID
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return notification{
+			kind:       service.NotificationRegistrations,
+			email:      emailPaymentConfirmed,
+			race:       p.Race,
+			recipients: []racers.UserID{p.Competitor},
+			data: func(r racers.Race, u racers.UserID, _ string, d *emailData) {
+				d.CheckInCode = n.checkIns.Token(r.ID, u)
+			},
+		}, true, nil
+
+	case "RegistrationExpired":
+		var p struct {
+			Race       racers.RaceID
+			Competitor racers.UserID
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		return notification{
+			kind:       service.NotificationRegistrations,
+			email:      emailRegistrationExpired,
+			race:       p.Race,
+			recipients: []racers.UserID{p.Competitor},
+		}, true, nil
+
+	case "RaceRescheduled", "RaceReminded":
+		var p struct{ Race racers.RaceID }
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		email := emailRaceRescheduled
+		if e.Type == "RaceReminded" {
+			email = emailRaceReminder
+		}
+
+		return notification{
+			kind:        service.NotificationRaceUpdates,
+			email:       email,
+			race:        p.Race,
+			competitors: true,
+		}, true, nil
+
+	case "CompetitorReleased", "CompetitorTransferred":
+		var p struct {
+			Race       racers.RaceID
+			Competitor racers.UserID
+			Fee        racers.Money
+			To         *racers.RaceID
+		}
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return notification{}, false, err
+		}
+
+		email := emailRaceCancelled
+		if e.Type == "CompetitorTransferred" {
+			email = emailRaceTransferred
+		}
+
+		return notification{
+			kind:       service.NotificationRaceUpdates,
+			email:      email,
+			race:       p.Race,
+			recipients: []racers.UserID{p.Competitor},
+			transfer:   p.To,
+			data: func(r racers.Race, _ racers.UserID, _ string, d *emailData) {
+				if r.Cancellation != nil {
+					d.Reason = r.Cancellation.Reason
+				}
+				if !p.Fee.IsZero() {
+					d.Refund = formatMoney(p.Fee)
+				}
+			},
+		}, true, nil
+	}
+
+	return notification{}, false, nil
+}
+
+// registration is the email of the competitor joining the race, with the fee left to pay or the check-in code
+// when the registration is confirmed
+func (n Notifier) registration(race racers.RaceID, competitor racers.UserID) notification {
+	return notification{
+		kind:       service.NotificationRegistrations,
+		email:      emailRegistration,
+		race:       race,
+		recipients: []racers.UserID{competitor},
+		data: func(r racers.Race, u racers.UserID, loc string, d *emailData) {
+			reg, ok := r.Registration(u)
+			if !ok {
+				return
+			}
+			switch reg.Status {
+			case racers.RegistrationConfirmed:
+				d.CheckInCode = n.checkIns.Token(r.ID, u)
+			case racers.RegistrationPendingPayment:
+				d.Fee = formatMoney(reg.Fee)
+				d.PayBefore = formatDate(loc, reg.ExpiresAt.In(r.Location()))
+			}
+		},
+	}
+}
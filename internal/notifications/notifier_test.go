@@ -0,0 +1,288 @@
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/notifications"
+	"github.com/xabi93/racers/internal/service"
+)
+
+// stream is an in-memory events stream
+type stream struct {
+	events []service.StoredEvent
+	cursor *service.EventsCursor
+}
+
+func (s *stream) publish(t *testing.T, payload interface{}, occurredAt time.Time) {
+	b, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	s.events = append(s.events, service.StoredEvent{
+		ID:         id.Generate(),
+		Type:       reflect.TypeOf(payload).Name(),
+		Payload:    b,
+		OccurredAt: occurredAt,
+	})
+}
+
+func (s *stream) After(_ context.Context, after *service.EventsCursor, before time.Time, limit int) ([]service.StoredEvent, error) {
+	var result []service.StoredEvent
+	for _, e := range s.events {
+		if after != nil && !e.OccurredAt.After(after.OccurredAt) {
+			continue
+		}
+		if !e.OccurredAt.Before(before) || len(result) == limit {
+			break
+		}
+		result = append(result, e)
+	}
+
+	return result, nil
+}
+
+func (s *stream) Cursor(context.Context, string) (*service.EventsCursor, error) {
+	return s.cursor, nil
+}
+
+func (s *stream) SaveCursor(_ context.Context, _ string, c service.EventsCursor) error {
+	s.cursor = &c
+	return nil
+}
+
+type races map[racers.RaceID]racers.Race
+
+func (r races) All(context.Context) ([]racers.Race, error) { return nil, nil }
+
+func (r races) Get(_ context.Context, id racers.RaceID) (racers.Race, error) {
+	race, ok := r[id]
+	if !ok {
+		return racers.Race{}, service.ErrRaceNotFound
+	}
+	return race, nil
+}
+
+type users map[racers.UserID]racers.User
+
+func (u users) Current(context.Context) racers.User { return racers.User{} }
+
+func (u users) Get(_ context.Context, id racers.UserID) (racers.User, error) {
+	user, ok := u[id]
+	if !ok {
+		return racers.User{}, service.ErrUserNotFound
+	}
+	return user, nil
+}
+
+func (u users) List(_ context.Context, ids []racers.UserID) ([]racers.User, error) {
+	var list []racers.User
+	for _, id := range ids {
+		if user, ok := u[id]; ok {
+			list = append(list, user)
+		}
+	}
+	return list, nil
+}
+
+type preferences map[racers.UserID]service.NotificationPreferences
+
+func (p preferences) Get(_ context.Context, user racers.UserID) (service.NotificationPreferences, error) {
+	prefs, ok := p[user]
+	if !ok {
+		return service.NotificationPreferences{}, service.ErrNotificationPreferencesNotFound
+	}
+	return prefs, nil
+}
+
+func (p preferences) Save(_ context.Context, prefs service.NotificationPreferences) error {
+	p[prefs.User] = prefs
+	return nil
+}
+
+func TestNotifier(t *testing.T) {
+	require := require.New(t)
+
+	owner := racers.User{ID: racers.UserID(id.Generate()), Name: "Ana & Co", Email: "owner@racers.test"}
+	runner := racers.User{ID: racers.UserID(id.Generate()), Name: "Runner", Email: "runner@racers.test"}
+	optedOut := racers.User{ID: racers.UserID(id.Generate()), Name: "Opted out", Email: "out@racers.test"}
+	noEmail := racers.User{ID: racers.UserID(id.Generate()), Name: "No email"}
+
+	race := racers.Race{
+		ID:    racers.RaceID(id.Generate()),
+		Name:  "Behobia",
+		Date:  racers.RaceDate(time.Date(2030, 11, 10, 10, 0, 0, 0, time.UTC)),
+		Owner: owner.ID,
+	}
+	for _, u := range []racers.User{runner, optedOut, noEmail} {
+		require.NoError(race.Join(u, "", time.Now()))
+	}
+
+	prefs := preferences{
+		runner.ID:   {User: runner.ID, Locale: "es"},
+		optedOut.ID: {User: optedOut.ID, Locale: "en", Disabled: []service.NotificationKind{service.NotificationRaceUpdates}},
+	}
+	notificationsService := service.NewNotifications(prefs, users{}, []byte("secret"))
+	checkIns := service.NewCheckIns(nil, nil, nil, nil, nil, []byte("secret"))
+
+	templates, err := notifications.NewTemplates()
+	require.NoError(err)
+
+	events := &stream{}
+	mailer := &notifications.Memory{}
+	notifier := notifications.NewNotifier(
+		events,
+		races{race.ID: race},
+		users{owner.ID: owner, runner.ID: runner, optedOut.ID: optedOut, noEmail.ID: noEmail},
+		notificationsService,
+		checkIns,
+		mailer,
+		templates,
+		"https://racers.example/",
+	)
+
+	past := time.Now().Add(-time.Minute)
+	events.publish(t, service.RaceCreated{Race: race}, past)
+	events.publish(t, service.UserJoinedRace{User: runner, Race: race}, past.Add(time.Second))
+	events.publish(t, service.RaceRescheduled{Race: race.ID, Date: race.Date, Sequence: 1}, past.Add(2*time.Second))
+	events.publish(t, service.RaceReminded{Race: race.ID, Date: race.Date}, past.Add(3*time.Second))
+	// not settled yet, the transaction publishing it could still be running
+	events.publish(t, service.RegistrationExpired{Race: race.ID, Competitor: runner.ID}, time.Now())
+
+	sent, err := notifier.Process(context.Background())
+	require.NoError(err)
+	require.Equal(4, sent)
+
+	msgs := mailer.Sent()
+	require.Len(msgs, 4)
+
+	require.Equal("owner@racers.test", msgs[0].To)
+	require.Equal("Your race Behobia is ready", msgs[0].Subject)
+	require.Contains(msgs[0].HTML, `<a href="https://racers.example/races/`+id.ID(race.ID).String()+`">Behobia</a>`)
+	require.Contains(msgs[0].HTML, "Sunday, November 10, 2030 at 10:00 UTC")
+	require.Contains(msgs[0].HTML, "Hi Ana &amp; Co,")
+
+	require.Equal("runner@racers.test", msgs[1].To)
+	require.Equal("Te has inscrito en Behobia", msgs[1].Subject)
+	require.Contains(msgs[1].HTML, "domingo 10 de noviembre de 2030")
+	require.Contains(msgs[1].HTML, checkIns.Token(race.ID, runner.ID), "the free registration is confirmed")
+
+	require.Equal("runner@racers.test", msgs[2].To)
+	require.Equal("Behobia cambia de fecha", msgs[2].Subject)
+
+	// the unsubscribe link opts the recipient out
+	unsubscribe := strings.Trim(msgs[2].Headers["List-Unsubscribe"], "<>")
+	require.True(strings.HasPrefix(unsubscribe, "https://racers.example"+notifications.UnsubscribePath+"?token="))
+	require.Contains(msgs[2].HTML, unsubscribe)
+
+	require.Equal("runner@racers.test", msgs[3].To)
+	require.Equal("Behobia es hoy", msgs[3].Subject)
+
+	sent, err = notifier.Process(context.Background())
+	require.NoError(err)
+	require.Zero(sent, "the processed events are not emailed again")
+}
+
+func TestNotifier_Cancellation(t *testing.T) {
+	require := require.New(t)
+
+	runner := racers.User{ID: racers.UserID(id.Generate()), Name: "Runner", Email: "runner@racers.test"}
+	transferred := racers.User{ID: racers.UserID(id.Generate()), Name: "Transferred", Email: "transferred@racers.test"}
+
+	race := racers.Race{
+		ID:           racers.RaceID(id.Generate()),
+		Name:         "Behobia",
+		Date:         racers.RaceDate(time.Date(2030, 11, 10, 10, 0, 0, 0, time.UTC)),
+		Status:       racers.RaceCancelled,
+		Cancellation: &racers.RaceCancellation{Reason: "Storm warning"},
+	}
+	target := racers.Race{
+		ID:   racers.RaceID(id.Generate()),
+		Name: "Zegama",
+		Date: racers.RaceDate(time.Date(2030, 12, 1, 9, 0, 0, 0, time.UTC)),
+	}
+
+	templates, err := notifications.NewTemplates()
+	require.NoError(err)
+
+	events := &stream{}
+	mailer := &notifications.Memory{}
+	notifier := notifications.NewNotifier(
+		events,
+		races{race.ID: race, target.ID: target},
+		users{runner.ID: runner, transferred.ID: transferred},
+		service.NewNotifications(preferences{}, users{}, []byte("secret")),
+		service.NewCheckIns(nil, nil, nil, nil, nil, []byte("secret")),
+		mailer,
+		templates,
+		"https://racers.example",
+	)
+
+	past := time.Now().Add(-time.Minute)
+	fee := racers.Money{Amount: 2000, Currency: "EUR"}
+	events.publish(t, service.CompetitorReleased{Race: race.ID, Competitor: runner.ID, Payment: "pay_1", Fee: fee}, past)
+	events.publish(t, service.CompetitorTransferred{Race: race.ID, Competitor: transferred.ID, To: target.ID}, past.Add(time.Second))
+
+	sent, err := notifier.Process(context.Background())
+	require.NoError(err)
+	require.Equal(2, sent)
+
+	msgs := mailer.Sent()
+	require.Equal("runner@racers.test", msgs[0].To)
+	require.Equal("Behobia was cancelled", msgs[0].Subject)
+	require.Contains(msgs[0].HTML, "was cancelled: Storm warning")
+	require.Contains(msgs[0].HTML, "Your entry fee of 20.00 EUR is refunded.")
+
+	require.Equal("transferred@racers.test", msgs[1].To)
+	require.Contains(msgs[1].HTML, `moved to <a href="https://racers.example/races/`+id.ID(target.ID).String()+`">Zegama</a> on Sunday, December 1, 2030`)
+	require.NotContains(msgs[1].HTML, "refunded")
+}
+
+func TestNotifier_RegistrationTransfer(t *testing.T) {
+	require := require.New(t)
+
+	from := racers.User{ID: racers.UserID(id.Generate()), Name: "Ana", Email: "ana@racers.test"}
+	to := racers.User{ID: racers.UserID(id.Generate()), Name: "Friend", Email: "friend@racers.test"}
+	race := racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        "Behobia",
+		Date:        racers.RaceDate(time.Date(2030, 11, 10, 10, 0, 0, 0, time.UTC)),
+		Competitors: racers.NewRaceCompetitors(to.ID),
+	}
+
+	templates, err := notifications.NewTemplates()
+	require.NoError(err)
+
+	events := &stream{}
+	mailer := &notifications.Memory{}
+	notifier := notifications.NewNotifier(
+		events,
+		races{race.ID: race},
+		users{from.ID: from, to.ID: to},
+		service.NewNotifications(preferences{}, users{}, []byte("secret")),
+		service.NewCheckIns(nil, nil, nil, nil, nil, []byte("secret")),
+		mailer,
+		templates,
+		"https://racers.example",
+	)
+
+	past := time.Now().Add(-time.Minute)
+	events.publish(t, service.RegistrationTransferOffered{Race: race.ID, From: from.ID, To: to.ID}, past)
+	events.publish(t, service.RegistrationTransferred{Race: race.ID, From: from.ID, To: to.ID}, past.Add(time.Second))
+
+	sent, err := notifier.Process(context.Background())
+	require.NoError(err)
+	require.Equal(2, sent)
+
+	msgs := mailer.Sent()
+	require.Equal("friend@racers.test", msgs[0].To)
+	require.Equal("Ana offers you a spot in Behobia", msgs[0].Subject)
+	require.Equal("friend@racers.test", msgs[1].To)
+	require.Equal("You joined Behobia", msgs[1].Subject)
+}
@@ -0,0 +1,52 @@
+package notifications
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTPConfig is the server the emails are sent through
+type SMTPConfig struct {
+	Host     string `env:"SMTP_HOST"`
+	Port     string `env:"SMTP_PORT" envDefault:"587"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	// From is the sender address of the emails
+	From string `env:"SMTP_FROM" envDefault:"racers <no-reply@racers.local>"`
+}
+
+// NewSMTP returns a mailer sending through the SMTP server, authenticating when the username is set.
+// The connection is upgraded with STARTTLS when the server supports it
+func NewSMTP(c SMTPConfig) SMTP {
+	return SMTP{c}
+}
+
+type SMTP struct {
+	conf SMTPConfig
+}
+
+func (s SMTP) Send(_ context.Context, m Message) error {
+	b, err := m.Bytes(s.conf.From, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.conf.Username != "" {
+		auth = smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)
+	}
+
+	return smtp.SendMail(net.JoinHostPort(s.conf.Host, s.conf.Port), auth, envelopeAddress(s.conf.From), []string{m.To}, b)
+}
+
+// envelopeAddress returns the bare address of the sender, the envelope does not take display names
+func envelopeAddress(from string) string {
+	if a, err := mail.ParseAddress(from); err == nil {
+		return a.Address
+	}
+
+	return from
+}
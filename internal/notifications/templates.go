@@ -0,0 +1,288 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// Emails sent, each one is a template in every locale
+const (
+	emailRaceCreated         = "race_created"
+	emailRegistration        = "registration"
+	emailRegistrationExpired = "registration_expired"
+	emailRaceRescheduled     = "race_rescheduled"
+	emailRaceReminder        = "race_reminder"
+	emailRaceCancelled       = "race_cancelled"
+	emailRaceTransferred     = "race_transferred"
+	emailRegistrationOffer   = "registration_offer"
+	emailPaymentConfirmed    = "payment_confirmed"
+)
+
+// emailData is what the templates are rendered with
+type emailData struct {
+	// Name is the name of the recipient
+	Name string
+	Race raceData
+	// Fee is empty when nothing is left to pay
+	Fee string
+	// PayBefore is when the pending registration is released, empty when it is confirmed
+	PayBefore string
+	// Reason is why the race was cancelled
+	Reason string
+	// Refund is the fee refunded, empty when nothing was refunded
+	Refund string
+	// Transfer is the race the competitor was moved to when the race was cancelled
+	Transfer raceData
+	// Sender is the name of the competitor offering the registration
+	Sender string
+	// CheckInCode is the payload of the QR code scanned at the packet pickup, empty until the registration is confirmed
+	CheckInCode    string
+	UnsubscribeURL string
+}
+
+type raceData struct {
+	Name string
+	Date string
+	URL  string
+}
+
+// email is the text of an email in a locale, the subject is plain text and the body html
+type email struct {
+	Subject string
+	Body    string
+}
+
+// locale are the texts of the emails in a language
+type locale struct {
+	// Footer is the html after every email, with the unsubscribe link
+	Footer   string
+	Emails   map[string]email
+	Months   [12]string
+	Weekdays [7]string
+	// Date formats the date with the month and weekday names
+	Date func(l locale, t time.Time) string
+}
+
+var locales = map[string]locale{
+	"en": {
+		Footer: `<p style="color:#888;font-size:12px">You receive this email because you are registered in racers.
+<a href="{{.UnsubscribeURL}}">Unsubscribe</a> from these notifications.</p>`,
+		Emails: map[string]email{
+			emailRaceCreated: {
+				Subject: `Your race {{.Race.Name}} is ready`,
+				Body: `<p>Hi {{.Name}},</p>
+<p>Your race <a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}} is published and open for registrations.</p>`,
+			},
+			emailRegistration: {
+				Subject: `You joined {{.Race.Name}}`,
+				Body: `<p>Hi {{.Name}},</p>
+<p>You joined <a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}}.</p>
+{{if .PayBefore}}<p>Your spot is held until {{.PayBefore}}, pay the entry fee of {{.Fee}} before to confirm it.</p>{{end}}
+{{if .CheckInCode}}<p>Show this code at the packet pickup to check in:</p><p><code>{{.CheckInCode}}</code></p>{{end}}`,
+			},
+			emailRegistrationExpired: {
+				Subject: `Your registration in {{.Race.Name}} expired`,
+				Body: `<p>Hi {{.Name}},</p>
+<p>The entry fee of <a href="{{.Race.URL}}">{{.Race.Name}}</a> was not paid in time and your spot was released. You can join again while there are spots left.</p>`,
+			},
+			emailRaceRescheduled: {
+				Subject: `{{.Race.Name}} was rescheduled`,
+				Body: `<p>Hi {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> moved to {{.Race.Date}}.</p>`,
+			},
+			emailRaceReminder: {
+				Subject: `{{.Race.Name}} is today`,
+				Body: `<p>Hi {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> starts on {{.Race.Date}}. Good luck!</p>`,
+			},
+			emailRaceCancelled: {
+				Subject: `{{.Race.Name}} was cancelled`,
+				Body: `<p>Hi {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}} was cancelled: {{.Reason}}</p>
+{{if .Refund}}<p>Your entry fee of {{.Refund}} is refunded.</p>{{end}}`,
+			},
+			emailRaceTransferred: {
+				Subject: `{{.Race.Name}} was cancelled`,
+				Body: `<p>Hi {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}} was cancelled: {{.Reason}}</p>
+<p>Your registration was moved to <a href="{{.Transfer.URL}}">{{.Transfer.Name}}</a> on {{.Transfer.Date}}.</p>`,
+			},
+			emailRegistrationOffer: {
+				Subject: `{{.Sender}} offers you a spot in {{.Race.Name}}`,
+				Body: `<p>Hi {{.Name}},</p>
+<p>{{.Sender}} offers you the registration in <a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}}. Accept it from the race page to take the spot.</p>`,
+			},
+			emailPaymentConfirmed: {
+				Subject: `Your registration in {{.Race.Name}} is confirmed`,
+				Body: `<p>Hi {{.Name}},</p>
+<p>We received the entry fee of <a href="{{.Race.URL}}">{{.Race.Name}}</a> on {{.Race.Date}}, your spot is confirmed.</p>
+<p>Show this code at the packet pickup to check in:</p><p><code>{{.CheckInCode}}</code></p>`,
+			},
+		},
+		Months:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		Weekdays: [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		Date: func(l locale, t time.Time) string {
+			return fmt.Sprintf("%s, %s %d, %d at %s", l.Weekdays[t.Weekday()], l.Months[t.Month()-1], t.Day(), t.Year(), t.Format("15:04 MST"))
+		},
+	},
+	"es": {
+		Footer: `<p style="color:#888;font-size:12px">Recibes este correo porque estás registrado en racers.
+<a href="{{.UnsubscribeURL}}">Darse de baja</a> de estas notificaciones.</p>`,
+		Emails: map[string]email{
+			emailRaceCreated: {
+				Subject: `Tu carrera {{.Race.Name}} está lista`,
+				Body: `<p>Hola {{.Name}},</p>
+<p>Tu carrera <a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}} está publicada y abierta a inscripciones.</p>`,
+			},
+			emailRegistration: {
+				Subject: `Te has inscrito en {{.Race.Name}}`,
+				Body: `<p>Hola {{.Name}},</p>
+<p>Te has inscrito en <a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}}.</p>
+{{if .PayBefore}}<p>Tu plaza está reservada hasta el {{.PayBefore}}, paga la inscripción de {{.Fee}} antes para confirmarla.</p>{{end}}
+{{if .CheckInCode}}<p>Muestra este código en la recogida de dorsales:</p><p><code>{{.CheckInCode}}</code></p>{{end}}`,
+			},
+			emailRegistrationExpired: {
+				Subject: `Tu inscripción en {{.Race.Name}} ha caducado`,
+				Body: `<p>Hola {{.Name}},</p>
+<p>La inscripción de <a href="{{.Race.URL}}">{{.Race.Name}}</a> no se pagó a tiempo y tu plaza se ha liberado. Puedes inscribirte de nuevo mientras queden plazas.</p>`,
+			},
+			emailRaceRescheduled: {
+				Subject: `{{.Race.Name}} cambia de fecha`,
+				Body: `<p>Hola {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> se celebrará el {{.Race.Date}}.</p>`,
+			},
+			emailRaceReminder: {
+				Subject: `{{.Race.Name}} es hoy`,
+				Body: `<p>Hola {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> empieza el {{.Race.Date}}. ¡Suerte!</p>`,
+			},
+			emailRaceCancelled: {
+				Subject: `{{.Race.Name}} se ha cancelado`,
+				Body: `<p>Hola {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}} se ha cancelado: {{.Reason}}</p>
+{{if .Refund}}<p>Te devolvemos la inscripción de {{.Refund}}.</p>{{end}}`,
+			},
+			emailRaceTransferred: {
+				Subject: `{{.Race.Name}} se ha cancelado`,
+				Body: `<p>Hola {{.Name}},</p>
+<p><a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}} se ha cancelado: {{.Reason}}</p>
+<p>Tu inscripción se ha trasladado a <a href="{{.Transfer.URL}}">{{.Transfer.Name}}</a> del {{.Transfer.Date}}.</p>`,
+			},
+			emailRegistrationOffer: {
+				Subject: `{{.Sender}} te ofrece su plaza en {{.Race.Name}}`,
+				Body: `<p>Hola {{.Name}},</p>
+<p>{{.Sender}} te ofrece su inscripción en <a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}}. Acéptala desde la página de la carrera para quedarte con la plaza.</p>`,
+			},
+			emailPaymentConfirmed: {
+				Subject: `Tu inscripción en {{.Race.Name}} está confirmada`,
+				Body: `<p>Hola {{.Name}},</p>
+<p>Hemos recibido el pago de <a href="{{.Race.URL}}">{{.Race.Name}}</a> del {{.Race.Date}}, tu plaza está confirmada.</p>
+<p>Muestra este código en la recogida de dorsales:</p><p><code>{{.CheckInCode}}</code></p>`,
+			},
+		},
+		Months:   [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		Weekdays: [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		Date: func(l locale, t time.Time) string {
+			return fmt.Sprintf("%s %d de %s de %d a las %s", l.Weekdays[t.Weekday()], t.Day(), l.Months[t.Month()-1], t.Year(), t.Format("15:04 MST"))
+		},
+	},
+}
+
+// Templates renders the emails in the language of the recipients
+type Templates struct {
+	subjects map[string]map[string]*texttemplate.Template
+	bodies   map[string]map[string]*template.Template
+}
+
+const layout = `<!DOCTYPE html>
+<html>
+<body style="font-family:sans-serif">
+{{template "body" .}}
+{{template "footer" .}}
+</body>
+</html>`
+
+// NewTemplates parses the templates of every locale
+func NewTemplates() (Templates, error) {
+	t := Templates{
+		subjects: make(map[string]map[string]*texttemplate.Template),
+		bodies:   make(map[string]map[string]*template.Template),
+	}
+
+	for name, l := range locales {
+		t.subjects[name] = make(map[string]*texttemplate.Template)
+		t.bodies[name] = make(map[string]*template.Template)
+
+		base, err := template.New("layout").Parse(layout)
+		if err != nil {
+			return Templates{}, err
+		}
+		if _, err := base.New("footer").Parse(l.Footer); err != nil {
+			return Templates{}, fmt.Errorf("parsing %s footer: %w", name, err)
+		}
+
+		for e, text := range l.Emails {
+			subject, err := texttemplate.New(e).Parse(text.Subject)
+			if err != nil {
+				return Templates{}, fmt.Errorf("parsing %s %s subject: %w", name, e, err)
+			}
+			t.subjects[name][e] = subject
+
+			clone, err := base.Clone()
+			if err != nil {
+				return Templates{}, err
+			}
+			body, err := clone.New("body").Parse(text.Body)
+			if err != nil {
+				return Templates{}, fmt.Errorf("parsing %s %s body: %w", name, e, err)
+			}
+			t.bodies[name][e] = body
+		}
+	}
+
+	return t, nil
+}
+
+// Render returns the subject and the html body of the email in the locale, in the default locale when
+// the locale is not known
+func (t Templates) Render(loc, name string, data emailData) (string, string, error) {
+	if _, ok := t.bodies[loc]; !ok {
+		loc = defaultLocale
+	}
+
+	body, ok := t.bodies[loc][name]
+	if !ok {
+		return "", "", fmt.Errorf("unknown email %s", name)
+	}
+
+	var subject, html bytes.Buffer
+	if err := t.subjects[loc][name].Execute(&subject, data); err != nil {
+		return "", "", err
+	}
+	if err := body.ExecuteTemplate(&html, "layout", data); err != nil {
+		return "", "", err
+	}
+
+	return strings.TrimSpace(subject.String()), html.String(), nil
+}
+
+// formatDate returns the date in the locale
+func formatDate(loc string, t time.Time) string {
+	l, ok := locales[loc]
+	if !ok {
+		l = locales[defaultLocale]
+	}
+
+	return l.Date(l, t)
+}
+
+// formatMoney returns the amount in the major unit of the currency
+func formatMoney(m racers.Money) string {
+	return fmt.Sprintf("%d.%02d %s", m.Amount/100, m.Amount%100, m.Currency)
+}
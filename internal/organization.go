@@ -0,0 +1,157 @@
+package racers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xabi93/racers/internal/id"
+)
+
+type (
+	// OrganizationID defines a unique identifier for an organization
+	OrganizationID id.ID
+	// InvalidOrganizationIDError means the given id is not valid
+	InvalidOrganizationIDError struct{ error }
+)
+
+func (err InvalidOrganizationIDError) Error() string {
+	return fmt.Sprintf("invalid organization id: %s", err.error)
+}
+
+// NewOrganizationID validates the id and returns an OrganizationID instance
+func NewOrganizationID(s string) (OrganizationID, error) {
+	id, err := id.NewID(s)
+	if err != nil {
+		return OrganizationID{}, InvalidOrganizationIDError{err}
+	}
+
+	return OrganizationID(id), nil
+}
+
+type (
+	// OrganizationName defines the name of an organization
+	OrganizationName             string
+	InvalidOrganizationNameError struct{ error }
+)
+
+func (err InvalidOrganizationNameError) Error() string {
+	return fmt.Sprintf("invalid organization name: %s", err.error)
+}
+
+// NewOrganizationName validates the name and returns an OrganizationName instance
+func NewOrganizationName(s string) (OrganizationName, error) {
+	if s == "" {
+		return "", InvalidOrganizationNameError{errors.New("empty name")}
+	}
+
+	return OrganizationName(s), nil
+}
+
+type (
+	// OrganizationRole is what a member can do in an organization
+	OrganizationRole             string
+	InvalidOrganizationRoleError struct{ Value string }
+)
+
+const (
+	// OrganizationAdmin manages the members and all the races of the organization
+	OrganizationAdmin OrganizationRole = "ADMIN"
+	// OrganizationMember belongs to the organization and sees its races
+	OrganizationMember OrganizationRole = "MEMBER"
+)
+
+func (err InvalidOrganizationRoleError) Error() string {
+	return fmt.Sprintf("invalid organization role: %s", err.Value)
+}
+
+// NewOrganizationRole validates the role and returns an OrganizationRole instance
+func NewOrganizationRole(s string) (OrganizationRole, error) {
+	switch r := OrganizationRole(s); r {
+	case OrganizationAdmin, OrganizationMember:
+		return r, nil
+	}
+
+	return "", InvalidOrganizationRoleError{s}
+}
+
+// OrganizationMembers are the roles of the members of an organization
+type OrganizationMembers map[UserID]OrganizationRole
+
+// Organization is a club or federation that owns races
+type Organization struct {
+	ID      OrganizationID
+	Name    OrganizationName
+	Members OrganizationMembers
+}
+
+// CreateOrganization creates an organization with the user as its first admin
+func CreateOrganization(id OrganizationID, name OrganizationName, admin UserID) Organization {
+	return Organization{ID: id, Name: name, Members: OrganizationMembers{admin: OrganizationAdmin}}
+}
+
+// OrganizationMemberError means the membership can not be changed
+type OrganizationMemberError struct {
+	OrganizationID OrganizationID
+	UserID         UserID
+	Reason         string
+}
+
+func (err OrganizationMemberError) Error() string {
+	return fmt.Sprintf("membership of user %s in organization %s can not be changed: %s", err.UserID, err.OrganizationID, err.Reason)
+}
+
+// IsMember returns if the user belongs to the organization
+func (o Organization) IsMember(u UserID) bool {
+	_, ok := o.Members[u]
+
+	return ok
+}
+
+// IsAdmin returns if the user manages the organization
+func (o Organization) IsAdmin(u UserID) bool {
+	return o.Members[u] == OrganizationAdmin
+}
+
+// AddMember adds the user with the role, or changes its role when it is already a member.
+// The last admin can not be demoted
+func (o *Organization) AddMember(u UserID, role OrganizationRole) error {
+	current, ok := o.Members[u]
+	switch {
+	case ok && current == role:
+		return OrganizationMemberError{o.ID, u, fmt.Sprintf("already %s", role)}
+	case current == OrganizationAdmin && o.admins() == 1:
+		return OrganizationMemberError{o.ID, u, "the organization needs an admin"}
+	}
+
+	if o.Members == nil {
+		o.Members = make(OrganizationMembers)
+	}
+	o.Members[u] = role
+
+	return nil
+}
+
+// RemoveMember removes the user from the organization, the last admin can not leave
+func (o *Organization) RemoveMember(u UserID) error {
+	role, ok := o.Members[u]
+	switch {
+	case !ok:
+		return OrganizationMemberError{o.ID, u, "not a member"}
+	case role == OrganizationAdmin && o.admins() == 1:
+		return OrganizationMemberError{o.ID, u, "the organization needs an admin"}
+	}
+	delete(o.Members, u)
+
+	return nil
+}
+
+func (o Organization) admins() int {
+	var admins int
+	for _, r := range o.Members {
+		if r == OrganizationAdmin {
+			admins++
+		}
+	}
+
+	return admins
+}
@@ -0,0 +1,72 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganizationRole(t *testing.T) {
+	require := require.New(t)
+
+	role, err := racers.NewOrganizationRole("ADMIN")
+	require.NoError(err)
+	require.Equal(racers.OrganizationAdmin, role)
+
+	_, err = racers.NewOrganizationRole("OWNER")
+	require.True(errors.As(err, &racers.InvalidOrganizationRoleError{}))
+}
+
+func TestOrganizationMembers(t *testing.T) {
+	admin := racers.UserID(id.Generate())
+	user := racers.UserID(id.Generate())
+
+	newOrganization := func() racers.Organization {
+		return racers.CreateOrganization(racers.OrganizationID(id.Generate()), "Club", admin)
+	}
+
+	t.Run("the creator is the admin", func(t *testing.T) {
+		require := require.New(t)
+		o := newOrganization()
+
+		require.True(o.IsAdmin(admin))
+		require.False(o.IsMember(user))
+	})
+
+	t.Run("the members can be promoted", func(t *testing.T) {
+		require := require.New(t)
+		o := newOrganization()
+
+		require.NoError(o.AddMember(user, racers.OrganizationMember))
+		require.True(o.IsMember(user))
+		require.False(o.IsAdmin(user))
+
+		require.NoError(o.AddMember(user, racers.OrganizationAdmin))
+		require.True(o.IsAdmin(user))
+
+		require.True(errors.As(o.AddMember(user, racers.OrganizationAdmin), &racers.OrganizationMemberError{}))
+	})
+
+	t.Run("the last admin can not be demoted nor leave", func(t *testing.T) {
+		require := require.New(t)
+		o := newOrganization()
+
+		require.True(errors.As(o.AddMember(admin, racers.OrganizationMember), &racers.OrganizationMemberError{}))
+		require.True(errors.As(o.RemoveMember(admin), &racers.OrganizationMemberError{}))
+
+		require.NoError(o.AddMember(user, racers.OrganizationAdmin))
+		require.NoError(o.RemoveMember(admin))
+		require.False(o.IsMember(admin))
+	})
+
+	t.Run("when the user is not a member returns OrganizationMemberError", func(t *testing.T) {
+		require := require.New(t)
+		o := newOrganization()
+
+		require.True(errors.As(o.RemoveMember(user), &racers.OrganizationMemberError{}))
+	})
+}
@@ -0,0 +1,122 @@
+// Package payments signs the payment gateway callbacks and provides an in-memory gateway
+package payments
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+// SignatureHeader is the header the callbacks carry their signature in
+const SignatureHeader = "X-Payment-Signature"
+
+// Sign returns the hex HMAC-SHA256 of the body with the shared secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the body in constant time
+func Verify(secret, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// ErrUnknownPayment means the gateway did not start the payment
+var ErrUnknownPayment = errors.New("unknown payment")
+
+// FakePayment is a payment of the fake gateway
+type FakePayment struct {
+	service.PaymentCheckout
+	Refunded bool
+}
+
+// Fake is an in-memory gateway, the payments never complete on their own: Complete returns the
+// signed callback the gateway would send once the competitor pays
+type Fake struct {
+	secret  []byte
+	baseURL string
+
+	mu       sync.Mutex
+	payments map[string]*FakePayment
+}
+
+// NewFake returns a fake gateway signing the callbacks with the secret, its checkout pages are under the base url
+func NewFake(secret []byte, baseURL string) *Fake {
+	return &Fake{secret: secret, baseURL: strings.TrimSuffix(baseURL, "/"), payments: make(map[string]*FakePayment)}
+}
+
+func (f *Fake) Checkout(_ context.Context, c service.PaymentCheckout) (service.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	paymentID := fmt.Sprintf("fake_%s", id.Generate())
+	f.payments[paymentID] = &FakePayment{PaymentCheckout: c}
+
+	return service.Payment{ID: paymentID, URL: fmt.Sprintf("%s/payments/fake/%s", f.baseURL, paymentID)}, nil
+}
+
+func (f *Fake) Refund(_ context.Context, payment string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[payment]
+	if !ok {
+		return ErrUnknownPayment
+	}
+	p.Refunded = true
+
+	return nil
+}
+
+// Payment returns the payment with the id
+func (f *Fake) Payment(payment string) (FakePayment, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[payment]
+	if !ok {
+		return FakePayment{}, false
+	}
+
+	return *p, true
+}
+
+// Complete returns the body of the callback of the paid payment and its signature
+func (f *Fake) Complete(payment string) ([]byte, string, error) {
+	p, ok := f.Payment(payment)
+	if !ok {
+		return nil, "", ErrUnknownPayment
+	}
+
+	body, err := json.Marshal(service.PaymentCallback{
+		Payment:      payment,
+		RaceID:       id.ID(p.Race).String(),
+		CompetitorID: id.ID(p.Competitor).String(),
+		Amount:       p.Fee.Amount,
+		Currency:     string(p.Fee.Currency),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, Sign(f.secret, body), nil
+}
@@ -0,0 +1,62 @@
+package payments_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/payments"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestSignature(t *testing.T) {
+	require := require.New(t)
+
+	secret, body := []byte("secret"), []byte(`{"payment_id":"1"}`)
+	signature := payments.Sign(secret, body)
+
+	require.True(payments.Verify(secret, body, signature))
+	require.False(payments.Verify([]byte("other"), body, signature))
+	require.False(payments.Verify(secret, []byte(`{"payment_id":"2"}`), signature))
+	require.False(payments.Verify(secret, body, "not hex"))
+}
+
+func TestFake(t *testing.T) {
+	require := require.New(t)
+
+	secret := []byte("secret")
+	fake := payments.NewFake(secret, "https://racers.example/")
+	checkout := service.PaymentCheckout{
+		Race:       racers.RaceID(id.Generate()),
+		Competitor: racers.UserID(id.Generate()),
+		Fee:        racers.Money{Amount: 2000, Currency: "EUR"},
+	}
+
+	payment, err := fake.Checkout(context.Background(), checkout)
+	require.NoError(err)
+	require.Equal("https://racers.example/payments/fake/"+payment.ID, payment.URL)
+
+	body, signature, err := fake.Complete(payment.ID)
+	require.NoError(err)
+	require.True(payments.Verify(secret, body, signature))
+
+	var callback service.PaymentCallback
+	require.NoError(json.Unmarshal(body, &callback))
+	require.Equal(service.PaymentCallback{
+		Payment:      payment.ID,
+		RaceID:       id.ID(checkout.Race).String(),
+		CompetitorID: id.ID(checkout.Competitor).String(),
+		Amount:       2000,
+		Currency:     "EUR",
+	}, callback)
+
+	require.NoError(fake.Refund(context.Background(), payment.ID))
+	p, ok := fake.Payment(payment.ID)
+	require.True(ok)
+	require.True(p.Refunded)
+
+	require.Equal(payments.ErrUnknownPayment, fake.Refund(context.Background(), "unknown"))
+}
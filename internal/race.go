@@ -60,6 +60,25 @@ func NewRaceDate(t time.Time) (RaceDate, error) {
 	return RaceDate(t), nil
 }
 
+type (
+	// Distance is a length in metres
+	Distance             int
+	InvalidDistanceError struct{ Distance int }
+)
+
+func (err InvalidDistanceError) Error() string {
+	return fmt.Sprintf("invalid distance: %d metres", err.Distance)
+}
+
+// NewDistance validates the metres and returns a Distance instance
+func NewDistance(metres int) (Distance, error) {
+	if metres <= 0 {
+		return 0, InvalidDistanceError{metres}
+	}
+
+	return Distance(metres), nil
+}
+
 func NewRaceCompetitors(users ...UserID) RaceCompetitors {
 	ul := make(userList, len(users))
 	for _, u := range users {
@@ -72,11 +91,82 @@ func NewRaceCompetitors(users ...UserID) RaceCompetitors {
 type RaceCompetitors struct{ userList }
 
 type Race struct {
-	ID          RaceID
-	Name        RaceName
-	Date        RaceDate
+	ID   RaceID
+	Name RaceName
+	Date RaceDate
+	// Description is the free text the organizers present the race with
+	Description string
 	Owner       UserID
+	// Venue is nil when the race has no venue, its times are shown in UTC
+	Venue       *Venue
 	Competitors RaceCompetitors
+	Results     RaceResults
+	// Teams is nil when the race does not allow teams to enter
+	Teams       *RaceTeams
+	TeamEntries RaceTeamEntries
+	// Relay is nil when the race is not a relay
+	Relay        *RaceRelay
+	RelayEntries RelayEntries
+	// Categories are empty when the race has a single category
+	Categories      RaceCategories
+	CategoryEntries RaceCategoryEntries
+	// Course is nil until the owner uploads the course track
+	Course *Course
+	// Checkpoints are the intermediate timing points, in course order
+	Checkpoints RaceCheckpoints
+	Passages    RacePassages
+	// Bibs is nil when the race has no bib numbers
+	Bibs       *RaceBibs
+	BibEntries RaceBibEntries
+	// Sequence is the revision of the race schedule, increased every time it is rescheduled
+	Sequence int
+	// Series is nil when the race was not generated by a series
+	Series *SeriesInstance
+	// Price is nil when the race is free, the categories can override it
+	Price *RacePrice
+	// Registrations are the payment state of the competitors of paid races,
+	// competitors without registration are confirmed
+	Registrations RaceRegistrations
+	Status        RaceStatus
+	// RegistrationDeadline is nil when the registration is open until the race is finished
+	RegistrationDeadline *time.Time
+	// Reminded is set once the competitors are reminded on the race day, the reschedules reset it
+	Reminded bool
+	// Cancellation is nil unless the race is cancelled
+	Cancellation *RaceCancellation
+	// Transfers is nil when the competitors can not transfer their registrations
+	Transfers             *TransferPolicy
+	RegistrationTransfers RegistrationTransfers
+	// Staff are the roles of the users besides the owner
+	Staff    RaceStaff
+	CheckIns RaceCheckIns
+	// Organization is nil when the race is not owned by an organization, its admins manage the race
+	Organization *OrganizationID
+}
+
+// HasCompetitor returns if the user joined the race
+func (r Race) HasCompetitor(u UserID) bool {
+	return r.Competitors.is(u)
+}
+
+// Reschedule moves the race to the new date, the category start times and the registration deadline
+// are moved by the same offset
+func (r *Race) Reschedule(date RaceDate) {
+	offset := time.Time(date).Sub(time.Time(r.Date))
+	if offset == 0 {
+		return
+	}
+
+	for i := range r.Categories {
+		r.Categories[i].StartTime = r.Categories[i].StartTime.Add(offset)
+	}
+	if r.RegistrationDeadline != nil {
+		deadline := r.RegistrationDeadline.Add(offset)
+		r.RegistrationDeadline = &deadline
+	}
+	r.Date = date
+	r.Sequence++
+	r.Reminded = false
 }
 
 type CompetitorInRaceError struct {
@@ -88,12 +178,71 @@ func (err CompetitorInRaceError) Error() string {
 	return fmt.Sprintf("competitor %s already joined race %s", err.CompetitorID, err.RaceID)
 }
 
-func (r *Race) Join(u User) error {
+// Join adds the user to the race competitors in the given category,
+// the category is required when the race has categories and must be empty otherwise.
+// When the race is not free the registration is pending until the fee at the given instant is paid
+func (r *Race) Join(u User, category CategoryName, now time.Time) error {
+	return r.join(u, category, now, nil)
+}
+
+// join adds the user to the race with the discount of the code, nil when there is no code
+func (r *Race) join(u User, category CategoryName, now time.Time, code *DiscountCode) error {
+	if !r.RegistrationOpen(now) {
+		return RegistrationClosedError{r.ID, r.Status}
+	}
+
+	return r.enter(u, category, now, code)
+}
+
+// enter adds the user to the category when it is eligible and there are spots left
+func (r *Race) enter(u User, category CategoryName, now time.Time, code *DiscountCode) error {
 	if r.Competitors.is(u.ID) {
 		return CompetitorInRaceError{r.ID, u.ID}
 	}
 
-	r.Competitors.add(u.ID)
+	if len(r.Categories) == 0 {
+		if category != "" {
+			return UnknownCategoryError{r.ID, category}
+		}
+
+		return r.register(u.ID, "", now, code)
+	}
+
+	c, ok := r.Categories.Get(category)
+	if !ok {
+		return UnknownCategoryError{r.ID, category}
+	}
+
+	if err := c.checkEligibility(u, r.Date); err != nil {
+		return err
+	}
+
+	if c.Capacity > 0 && r.CategoryEntries.count(c.Name) >= c.Capacity {
+		return CategoryFullError{r.ID, c.Name, c.Capacity}
+	}
+
+	return r.register(u.ID, c.Name, now, code)
+}
+
+// register adds the competitor to the category, assigns its bib number when the strategy does it on registration
+// and holds the spot until the fee is paid
+func (r *Race) register(competitor UserID, category CategoryName, now time.Time, code *DiscountCode) error {
+	bib, err := r.nextBib(category)
+	if err != nil {
+		return err
+	}
+
+	r.Competitors.add(competitor)
+	if category != "" {
+		if r.CategoryEntries == nil {
+			r.CategoryEntries = make(RaceCategoryEntries)
+		}
+		r.CategoryEntries[competitor] = category
+	}
+	if bib != 0 {
+		r.setBib(competitor, bib)
+	}
+	r.requirePayment(competitor, category, now, code)
 
 	return nil
 }
@@ -0,0 +1,234 @@
+package racers
+
+import (
+	"fmt"
+)
+
+type (
+	// Bib is the number a competitor wears in a race
+	Bib             int
+	InvalidBibError struct{ Value int }
+)
+
+func (err InvalidBibError) Error() string {
+	return fmt.Sprintf("invalid bib number: %d", err.Value)
+}
+
+// NewBib validates the number and returns a Bib instance
+func NewBib(n int) (Bib, error) {
+	if n <= 0 {
+		return 0, InvalidBibError{n}
+	}
+
+	return Bib(n), nil
+}
+
+type (
+	// BibStrategy defines how bib numbers are assigned when competitors join a race
+	BibStrategy             string
+	InvalidBibStrategyError struct{ Value string }
+)
+
+const (
+	// BibStrategyManual leaves the numbers to be assigned by the race owner
+	BibStrategyManual BibStrategy = "manual"
+	// BibStrategySequential assigns the next number of the race in registration order
+	BibStrategySequential BibStrategy = "sequential"
+	// BibStrategyCategoryRange assigns the next number of the range of the competitor category
+	BibStrategyCategoryRange BibStrategy = "category_range"
+)
+
+func (err InvalidBibStrategyError) Error() string {
+	return fmt.Sprintf("invalid bib strategy: %s", err.Value)
+}
+
+// NewBibStrategy validates the strategy and returns a BibStrategy instance
+func NewBibStrategy(s string) (BibStrategy, error) {
+	switch st := BibStrategy(s); st {
+	case BibStrategyManual, BibStrategySequential, BibStrategyCategoryRange:
+		return st, nil
+	}
+
+	return "", InvalidBibStrategyError{s}
+}
+
+// BibRange are the numbers reserved for a category, both included
+type BibRange struct {
+	From Bib
+	To   Bib
+}
+
+func (br BibRange) overlaps(other BibRange) bool {
+	return br.From <= other.To && other.From <= br.To
+}
+
+// InvalidRaceBibsError means the bibs configuration of a race is not valid
+type InvalidRaceBibsError struct{ Reason string }
+
+func (err InvalidRaceBibsError) Error() string {
+	return fmt.Sprintf("invalid race bibs: %s", err.Reason)
+}
+
+// RaceBibs configures the bib numbers of a race
+type RaceBibs struct {
+	Strategy BibStrategy
+	// First is the number the sequential strategy starts from
+	First Bib
+}
+
+// NewRaceBibs validates the configuration against the race categories,
+// the category range strategy requires a range for every category and ranges can not overlap
+func NewRaceBibs(strategy BibStrategy, first Bib, categories RaceCategories) (RaceBibs, error) {
+	if strategy == BibStrategySequential && first <= 0 {
+		return RaceBibs{}, InvalidRaceBibsError{fmt.Sprintf("invalid first bib %d", first)}
+	}
+
+	for i, c := range categories {
+		if c.Bibs == nil {
+			if strategy == BibStrategyCategoryRange {
+				return RaceBibs{}, InvalidRaceBibsError{fmt.Sprintf("category %s has no bib range", c.Name)}
+			}
+			continue
+		}
+
+		if strategy != BibStrategyCategoryRange {
+			return RaceBibs{}, InvalidRaceBibsError{fmt.Sprintf("category %s has a bib range but the strategy is %s", c.Name, strategy)}
+		}
+
+		if c.Bibs.From <= 0 || c.Bibs.To < c.Bibs.From {
+			return RaceBibs{}, InvalidRaceBibsError{fmt.Sprintf("invalid bib range %d-%d of category %s", c.Bibs.From, c.Bibs.To, c.Name)}
+		}
+
+		for _, other := range categories[:i] {
+			if other.Bibs != nil && c.Bibs.overlaps(*other.Bibs) {
+				return RaceBibs{}, InvalidRaceBibsError{fmt.Sprintf("bib ranges of categories %s and %s overlap", other.Name, c.Name)}
+			}
+		}
+	}
+
+	if strategy == BibStrategyCategoryRange && len(categories) == 0 {
+		return RaceBibs{}, InvalidRaceBibsError{"category range strategy requires categories"}
+	}
+
+	return RaceBibs{Strategy: strategy, First: first}, nil
+}
+
+// RaceBibEntries are the bib numbers of the competitors
+type RaceBibEntries map[UserID]Bib
+
+// competitor returns the competitor wearing the bib
+func (e RaceBibEntries) competitor(bib Bib) (UserID, bool) {
+	for u, b := range e {
+		if b == bib {
+			return u, true
+		}
+	}
+
+	return UserID{}, false
+}
+
+// BibsNotEnabledError means the race does not assign bib numbers
+type BibsNotEnabledError struct{ RaceID RaceID }
+
+func (err BibsNotEnabledError) Error() string {
+	return fmt.Sprintf("race %s has no bib numbers", err.RaceID)
+}
+
+// BibTakenError means the bib number is already assigned to another competitor
+type BibTakenError struct {
+	RaceID       RaceID
+	Bib          Bib
+	CompetitorID UserID
+}
+
+func (err BibTakenError) Error() string {
+	return fmt.Sprintf("bib %d of race %s is assigned to %s", err.Bib, err.RaceID, err.CompetitorID)
+}
+
+// BibRangeExhaustedError means every number of the category range is assigned
+type BibRangeExhaustedError struct {
+	RaceID   RaceID
+	Category CategoryName
+}
+
+func (err BibRangeExhaustedError) Error() string {
+	return fmt.Sprintf("no bib numbers left in category %s of race %s", err.Category, err.RaceID)
+}
+
+// UnknownBibError means no competitor of the race wears the bib
+type UnknownBibError struct {
+	RaceID RaceID
+	Bib    Bib
+}
+
+func (err UnknownBibError) Error() string {
+	return fmt.Sprintf("bib %d is not assigned in race %s", err.Bib, err.RaceID)
+}
+
+// nextBib returns the number the strategy assigns to a competitor joining the category,
+// zero when the race numbers are not assigned on registration
+func (r Race) nextBib(category CategoryName) (Bib, error) {
+	if r.Bibs == nil {
+		return 0, nil
+	}
+
+	switch r.Bibs.Strategy {
+	case BibStrategySequential:
+		next := r.Bibs.First
+		for _, b := range r.BibEntries {
+			if b >= next {
+				next = b + 1
+			}
+		}
+		return next, nil
+	case BibStrategyCategoryRange:
+		c, _ := r.Categories.Get(category)
+		if c.Bibs == nil {
+			return 0, nil
+		}
+		for b := c.Bibs.From; b <= c.Bibs.To; b++ {
+			if _, taken := r.BibEntries.competitor(b); !taken {
+				return b, nil
+			}
+		}
+		return 0, BibRangeExhaustedError{r.ID, category}
+	}
+
+	return 0, nil
+}
+
+func (r *Race) setBib(competitor UserID, bib Bib) {
+	if r.BibEntries == nil {
+		r.BibEntries = make(RaceBibEntries)
+	}
+	r.BibEntries[competitor] = bib
+}
+
+// AssignBib sets the bib number of a competitor, overriding the one assigned by the strategy
+func (r *Race) AssignBib(competitor UserID, bib Bib) error {
+	if r.Bibs == nil {
+		return BibsNotEnabledError{r.ID}
+	}
+
+	if !r.Competitors.is(competitor) {
+		return CompetitorNotInRaceError{r.ID, competitor}
+	}
+
+	if other, ok := r.BibEntries.competitor(bib); ok && other != competitor {
+		return BibTakenError{r.ID, bib, other}
+	}
+
+	r.setBib(competitor, bib)
+
+	return nil
+}
+
+// CompetitorByBib returns the competitor wearing the bib
+func (r Race) CompetitorByBib(bib Bib) (UserID, error) {
+	u, ok := r.BibEntries.competitor(bib)
+	if !ok {
+		return UserID{}, UnknownBibError{r.ID, bib}
+	}
+
+	return u, nil
+}
@@ -0,0 +1,157 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestBib(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewBib(0)
+	require.True(errors.As(err, &racers.InvalidBibError{}))
+
+	bib, err := racers.NewBib(42)
+	require.NoError(err)
+	require.Equal(racers.Bib(42), bib)
+}
+
+func TestBibStrategy(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewBibStrategy("random")
+	require.True(errors.As(err, &racers.InvalidBibStrategyError{}))
+
+	strategy, err := racers.NewBibStrategy("category_range")
+	require.NoError(err)
+	require.Equal(racers.BibStrategyCategoryRange, strategy)
+}
+
+func TestRaceBibs(t *testing.T) {
+	require := require.New(t)
+
+	withRange := func(name racers.CategoryName, from, to racers.Bib) racers.RaceCategory {
+		return racers.RaceCategory{Name: name, Bibs: &racers.BibRange{From: from, To: to}}
+	}
+
+	for name, tc := range map[string]struct {
+		strategy   racers.BibStrategy
+		first      racers.Bib
+		categories racers.RaceCategories
+	}{
+		"sequential without first":          {strategy: racers.BibStrategySequential},
+		"sequential with category ranges":   {racers.BibStrategySequential, 1, racers.RaceCategories{withRange("10K", 1, 100)}},
+		"category range without categories": {strategy: racers.BibStrategyCategoryRange},
+		"category without range":            {strategy: racers.BibStrategyCategoryRange, categories: racers.RaceCategories{{Name: "10K"}}},
+		"invalid range":                     {strategy: racers.BibStrategyCategoryRange, categories: racers.RaceCategories{withRange("10K", 100, 1)}},
+		"overlapping ranges": {strategy: racers.BibStrategyCategoryRange, categories: racers.RaceCategories{
+			withRange("10K", 1, 100), withRange("21K", 100, 200),
+		}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := racers.NewRaceBibs(tc.strategy, tc.first, tc.categories)
+			require.True(errors.As(err, &racers.InvalidRaceBibsError{}))
+		})
+	}
+
+	bibs, err := racers.NewRaceBibs(racers.BibStrategyCategoryRange, 0, racers.RaceCategories{
+		withRange("10K", 1, 100), withRange("21K", 101, 200),
+	})
+	require.NoError(err)
+	require.Equal(racers.RaceBibs{Strategy: racers.BibStrategyCategoryRange}, bibs)
+}
+
+func TestRaceJoinAssignsBib(t *testing.T) {
+	require := require.New(t)
+
+	first, second := racers.User{ID: racers.UserID(id.Generate())}, racers.User{ID: racers.UserID(id.Generate())}
+
+	t.Run("Given the sequential strategy, assigns the next number", func(t *testing.T) {
+		r := racers.Race{ID: raceID, Date: raceDate, Bibs: &racers.RaceBibs{Strategy: racers.BibStrategySequential, First: 100}}
+
+		require.NoError(r.Join(first, "", time.Now()))
+		require.NoError(r.Join(second, "", time.Now()))
+		require.Equal(racers.RaceBibEntries{first.ID: 100, second.ID: 101}, r.BibEntries)
+	})
+
+	t.Run("Given the manual strategy, does not assign a number", func(t *testing.T) {
+		r := racers.Race{ID: raceID, Date: raceDate, Bibs: &racers.RaceBibs{Strategy: racers.BibStrategyManual}}
+
+		require.NoError(r.Join(first, "", time.Now()))
+		require.Empty(r.BibEntries)
+	})
+
+	t.Run("Given the category range strategy, assigns the next number of the range", func(t *testing.T) {
+		r := racers.Race{
+			ID:   raceID,
+			Date: raceDate,
+			Categories: racers.RaceCategories{
+				{Name: "10K", StartTime: time.Time(raceDate), Bibs: &racers.BibRange{From: 1, To: 1}},
+				{Name: "21K", StartTime: time.Time(raceDate), Bibs: &racers.BibRange{From: 500, To: 600}},
+			},
+			Bibs: &racers.RaceBibs{Strategy: racers.BibStrategyCategoryRange},
+		}
+
+		require.NoError(r.Join(first, "10K", time.Now()))
+		require.NoError(r.Join(second, "21K", time.Now()))
+		require.Equal(racers.RaceBibEntries{first.ID: 1, second.ID: 500}, r.BibEntries)
+
+		err := r.Join(raceCompetitor, "10K", time.Now())
+		require.True(errors.As(err, &racers.BibRangeExhaustedError{}))
+		require.NotContains(r.Competitors.List(), raceCompetitor.ID)
+	})
+}
+
+func TestRaceAssignBib(t *testing.T) {
+	require := require.New(t)
+
+	other := racers.UserID(id.Generate())
+	newRace := func() racers.Race {
+		return racers.Race{
+			ID:          raceID,
+			Competitors: racers.NewRaceCompetitors(raceCompetitor.ID, other),
+			Bibs:        &racers.RaceBibs{Strategy: racers.BibStrategyManual},
+			BibEntries:  racers.RaceBibEntries{other: 7},
+		}
+	}
+
+	t.Run("Given a race without bibs, returns BibsNotEnabledError", func(t *testing.T) {
+		r := newRace()
+		r.Bibs = nil
+
+		err := r.AssignBib(raceCompetitor.ID, 1)
+		require.True(errors.As(err, &racers.BibsNotEnabledError{}))
+	})
+
+	t.Run("When the user is not a competitor, returns CompetitorNotInRaceError", func(t *testing.T) {
+		r := newRace()
+
+		err := r.AssignBib(racers.UserID(id.Generate()), 1)
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+	})
+
+	t.Run("When the bib is assigned to another competitor, returns BibTakenError", func(t *testing.T) {
+		r := newRace()
+
+		err := r.AssignBib(raceCompetitor.ID, 7)
+		require.True(errors.As(err, &racers.BibTakenError{}))
+	})
+
+	t.Run("Assigns the bib and resolves the competitor by it", func(t *testing.T) {
+		r := newRace()
+
+		require.NoError(r.AssignBib(raceCompetitor.ID, 8))
+
+		competitor, err := r.CompetitorByBib(8)
+		require.NoError(err)
+		require.Equal(raceCompetitor.ID, competitor)
+
+		_, err = r.CompetitorByBib(9)
+		require.True(errors.As(err, &racers.UnknownBibError{}))
+	})
+}
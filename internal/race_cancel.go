@@ -0,0 +1,156 @@
+package racers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xabi93/racers/internal/id"
+)
+
+// RaceCancelled is the status of the races called off, they keep no competitors once all of them
+// are transferred or released
+const RaceCancelled RaceStatus = "CANCELLED"
+
+// RaceTransfer is the race and category the competitors of a cancelled race are moved to,
+// the category is empty when the race has a single category
+type RaceTransfer struct {
+	Race     RaceID
+	Category CategoryName
+}
+
+// RaceCancellation is why and when the race was cancelled
+type RaceCancellation struct {
+	Reason string
+	At     time.Time
+	// Transfer is nil when the competitors are released
+	Transfer *RaceTransfer
+}
+
+// InvalidCancellationReasonError means the race is cancelled without a reason for the competitors
+type InvalidCancellationReasonError struct{}
+
+func (err InvalidCancellationReasonError) Error() string {
+	return "cancellation reason cannot be empty"
+}
+
+// RaceNotCancellableError means the race is finished or already cancelled
+type RaceNotCancellableError struct {
+	RaceID RaceID
+	Status RaceStatus
+}
+
+func (err RaceNotCancellableError) Error() string {
+	return fmt.Sprintf("race %s can not be cancelled, it is %s", err.RaceID, err.Status)
+}
+
+// InvalidTransferError means the competitors can not be transferred to the race
+type InvalidTransferError struct {
+	RaceID RaceID
+	Reason string
+}
+
+func (err InvalidTransferError) Error() string {
+	return fmt.Sprintf("competitors can not be transferred to race %s: %s", err.RaceID, err.Reason)
+}
+
+// Cancel calls the race off, the competitors stay in the race until they are transferred or released
+func (r *Race) Cancel(reason string, transfer *RaceTransfer, now time.Time) error {
+	if r.Status == RaceFinished || r.Status == RaceCancelled {
+		return RaceNotCancellableError{r.ID, r.Status}
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return InvalidCancellationReasonError{}
+	}
+
+	if transfer != nil && transfer.Race == r.ID {
+		return InvalidTransferError{transfer.Race, "it is the cancelled race"}
+	}
+
+	r.Status = RaceCancelled
+	r.Cancellation = &RaceCancellation{Reason: reason, At: now, Transfer: transfer}
+
+	return nil
+}
+
+// CheckTransfer returns an error if the competitors of a cancelled race can not be moved to the category
+// of this race at the given instant
+func (r Race) CheckTransfer(category CategoryName, now time.Time) error {
+	if !r.RegistrationOpen(now) {
+		return InvalidTransferError{r.ID, "registration is closed"}
+	}
+
+	if len(r.Categories) == 0 {
+		if category != "" {
+			return UnknownCategoryError{r.ID, category}
+		}
+
+		return nil
+	}
+
+	if _, ok := r.Categories.Get(category); !ok {
+		return UnknownCategoryError{r.ID, category}
+	}
+
+	return nil
+}
+
+// ReleasedCompetitor is a competitor withdrawn from a cancelled race
+type ReleasedCompetitor struct {
+	ID UserID
+	// Registration is nil when the competitor had no registration to pay
+	Registration *Registration
+}
+
+// ReleaseCompetitors withdraws up to limit competitors of the cancelled race, in a stable order, and returns them
+// with the registrations they had
+func (r *Race) ReleaseCompetitors(limit int) []ReleasedCompetitor {
+	if r.Status != RaceCancelled {
+		return nil
+	}
+
+	ids := r.Competitors.List()
+	sort.Slice(ids, func(i, j int) bool { return id.ID(ids[i]).String() < id.ID(ids[j]).String() })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	released := make([]ReleasedCompetitor, len(ids))
+	for i, c := range ids {
+		released[i] = ReleasedCompetitor{ID: c}
+		if reg, ok := r.Registrations[c]; ok {
+			released[i].Registration = &reg
+			delete(r.Registrations, c)
+		}
+		r.withdraw(c)
+	}
+
+	return released
+}
+
+// TransferIn joins the competitor of a cancelled race to the category while there are spots left.
+// The registration of the cancelled race is honoured: a confirmed one stays confirmed with its payment
+// and a pending one holds the spot for a new payment window. Without registration the fee of this race applies
+func (r *Race) TransferIn(u User, category CategoryName, from *Registration, now time.Time) error {
+	if err := r.enter(u, category, now, nil); err != nil {
+		return err
+	}
+	if from == nil {
+		return nil
+	}
+
+	reg := *from
+	if reg.Status == RegistrationPendingPayment {
+		reg.Payment = ""
+		reg.ExpiresAt = now.Add(PaymentWindow)
+	}
+	if r.Registrations == nil {
+		r.Registrations = make(RaceRegistrations)
+	}
+	r.Registrations[u.ID] = reg
+
+	return nil
+}
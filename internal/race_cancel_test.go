@@ -0,0 +1,116 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaceCancel(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	fee := racers.Money{Amount: 2000, Currency: "EUR"}
+
+	paidRace := func(competitors ...racers.User) racers.Race {
+		r := racers.Race{
+			ID:    racers.RaceID(id.Generate()),
+			Date:  racers.RaceDate(now.AddDate(0, 1, 0)),
+			Price: &racers.RacePrice{Currency: "EUR", Amount: 2000},
+		}
+		for _, c := range competitors {
+			require.NoError(r.Join(c, "", now))
+		}
+
+		return r
+	}
+
+	t.Run("cancelling requires a reason and a race not finished", func(t *testing.T) {
+		r := paidRace()
+
+		err := r.Cancel(" ", nil, now)
+		require.True(errors.As(err, &racers.InvalidCancellationReasonError{}))
+
+		err = r.Cancel("storm", &racers.RaceTransfer{Race: r.ID}, now)
+		require.True(errors.As(err, &racers.InvalidTransferError{}))
+
+		require.NoError(r.Cancel("storm", nil, now))
+		require.Equal(racers.RaceCancelled, r.Status)
+		require.Equal(&racers.RaceCancellation{Reason: "storm", At: now}, r.Cancellation)
+
+		err = r.Cancel("storm", nil, now)
+		require.True(errors.As(err, &racers.RaceNotCancellableError{}))
+
+		err = r.Join(racers.User{ID: racers.UserID(id.Generate())}, "", now)
+		require.True(errors.As(err, &racers.RegistrationClosedError{}))
+		require.False(r.MarkFinished(now.AddDate(1, 0, 0)))
+	})
+
+	t.Run("releases the competitors in batches with their registrations", func(t *testing.T) {
+		competitors := []racers.User{
+			{ID: racers.UserID(id.Generate())},
+			{ID: racers.UserID(id.Generate())},
+			{ID: racers.UserID(id.Generate())},
+		}
+		r := paidRace(competitors...)
+		require.NoError(r.StartPayment(competitors[0].ID, "pay_1"))
+		_, err := r.ConfirmPayment(competitors[0].ID, "pay_1", fee)
+		require.NoError(err)
+
+		require.Empty(r.ReleaseCompetitors(10), "the race is not cancelled")
+		require.NoError(r.Cancel("storm", nil, now))
+
+		first := r.ReleaseCompetitors(2)
+		require.Len(first, 2)
+		second := r.ReleaseCompetitors(2)
+		require.Len(second, 1)
+		require.Empty(r.ReleaseCompetitors(2))
+		require.Empty(r.Competitors.List())
+		require.Empty(r.Registrations)
+
+		released := make(map[racers.UserID]*racers.Registration)
+		for _, c := range append(first, second...) {
+			released[c.ID] = c.Registration
+		}
+		require.Len(released, 3)
+		require.Equal(racers.RegistrationConfirmed, released[competitors[0].ID].Status)
+		require.Equal("pay_1", released[competitors[0].ID].Payment)
+		require.Equal(racers.RegistrationPendingPayment, released[competitors[1].ID].Status)
+	})
+
+	t.Run("the transferred competitors keep their registration", func(t *testing.T) {
+		confirmed, pending := racers.User{ID: racers.UserID(id.Generate())}, racers.User{ID: racers.UserID(id.Generate())}
+		target := racers.Race{
+			ID:         racers.RaceID(id.Generate()),
+			Price:      &racers.RacePrice{Currency: "EUR", Amount: 3000},
+			Categories: racers.RaceCategories{{Name: "10K", Capacity: 2}},
+		}
+
+		err := target.CheckTransfer("", now)
+		require.True(errors.As(err, &racers.UnknownCategoryError{}))
+		require.NoError(target.CheckTransfer("10K", now))
+
+		paid := racers.Registration{Status: racers.RegistrationConfirmed, Fee: fee, Payment: "pay_1"}
+		require.NoError(target.TransferIn(confirmed, "10K", &paid, now))
+		require.Equal(paid, target.Registrations[confirmed.ID])
+
+		unpaid := racers.Registration{Status: racers.RegistrationPendingPayment, Fee: fee, Payment: "pay_2", ExpiresAt: now}
+		require.NoError(target.TransferIn(pending, "10K", &unpaid, now))
+		require.Equal(racers.Registration{
+			Status:    racers.RegistrationPendingPayment,
+			Fee:       fee,
+			ExpiresAt: now.Add(racers.PaymentWindow),
+		}, target.Registrations[pending.ID])
+
+		err = target.TransferIn(racers.User{ID: racers.UserID(id.Generate())}, "10K", nil, now)
+		require.True(errors.As(err, &racers.CategoryFullError{}))
+
+		err = target.TransferIn(confirmed, "10K", nil, now)
+		require.True(errors.As(err, &racers.CompetitorInRaceError{}))
+	})
+}
@@ -0,0 +1,220 @@
+package racers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type (
+	// CategoryName defines the name of a race category, unique in the race
+	CategoryName             string
+	InvalidCategoryNameError struct{ error }
+)
+
+func (err InvalidCategoryNameError) Error() string {
+	return fmt.Sprintf("invalid category name: %s", err.error)
+}
+
+// NewCategoryName validates the name and returns a CategoryName instance
+func NewCategoryName(s string) (CategoryName, error) {
+	if s == "" {
+		return "", InvalidCategoryNameError{errors.New("empty name")}
+	}
+
+	return CategoryName(s), nil
+}
+
+// Gender of a user, empty when it is unknown
+type Gender string
+
+const (
+	GenderFemale Gender = "female"
+	GenderMale   Gender = "male"
+)
+
+// InvalidGenderError means the gender is not one of the supported
+type InvalidGenderError struct{ Value string }
+
+func (err InvalidGenderError) Error() string {
+	return fmt.Sprintf("invalid gender: %q", err.Value)
+}
+
+// NewGender validates the gender and returns a Gender instance
+func NewGender(s string) (Gender, error) {
+	switch g := Gender(s); g {
+	case GenderFemale, GenderMale:
+		return g, nil
+	}
+
+	return "", InvalidGenderError{s}
+}
+
+// CategoryEligibility are the conditions a competitor has to meet to join a category
+type CategoryEligibility struct {
+	// MinAge is the age the competitor has to have on the race day, zero when there is no limit
+	MinAge int
+	// Gender is empty when the category is open to any gender
+	Gender Gender
+}
+
+// InvalidCategoryError means the category configuration is not valid
+type InvalidCategoryError struct {
+	Name   CategoryName
+	Reason string
+}
+
+func (err InvalidCategoryError) Error() string {
+	return fmt.Sprintf("invalid category %s: %s", err.Name, err.Reason)
+}
+
+// RaceCategory is one of the distances run in a race, with its own start and results
+type RaceCategory struct {
+	Name      CategoryName
+	Distance  Distance
+	StartTime time.Time
+	// Capacity is the max number of competitors, zero when there is no limit
+	Capacity    int
+	Eligibility CategoryEligibility
+	// Course is nil when the category has no course of its own
+	Course *Course
+	// Bibs is nil unless the race assigns the bib numbers by category range
+	Bibs *BibRange
+	// Price is nil when the category has the race price
+	Price *RacePrice
+}
+
+// NewRaceCategory validates the category configuration and returns a RaceCategory instance
+func NewRaceCategory(name CategoryName, distance Distance, start time.Time, capacity int, eligibility CategoryEligibility) (RaceCategory, error) {
+	if distance <= 0 {
+		return RaceCategory{}, InvalidCategoryError{name, fmt.Sprintf("invalid distance %d metres", distance)}
+	}
+
+	if start.IsZero() {
+		return RaceCategory{}, InvalidCategoryError{name, "empty start time"}
+	}
+
+	if capacity < 0 {
+		return RaceCategory{}, InvalidCategoryError{name, fmt.Sprintf("negative capacity %d", capacity)}
+	}
+
+	if eligibility.MinAge < 0 {
+		return RaceCategory{}, InvalidCategoryError{name, fmt.Sprintf("negative min age %d", eligibility.MinAge)}
+	}
+
+	return RaceCategory{
+		Name:        name,
+		Distance:    distance,
+		StartTime:   start,
+		Capacity:    capacity,
+		Eligibility: eligibility,
+	}, nil
+}
+
+// RaceCategories are the categories of a race, in the order they are shown
+type RaceCategories []RaceCategory
+
+// NewRaceCategories checks the categories names are unique and returns a RaceCategories instance
+func NewRaceCategories(categories ...RaceCategory) (RaceCategories, error) {
+	seen := make(map[CategoryName]struct{}, len(categories))
+	for _, c := range categories {
+		if _, ok := seen[c.Name]; ok {
+			return nil, InvalidCategoryError{c.Name, "duplicated name"}
+		}
+		seen[c.Name] = struct{}{}
+	}
+
+	return RaceCategories(categories), nil
+}
+
+// Get returns the category with the given name
+func (rc RaceCategories) Get(name CategoryName) (RaceCategory, bool) {
+	for _, c := range rc {
+		if c.Name == name {
+			return c, true
+		}
+	}
+
+	return RaceCategory{}, false
+}
+
+// RaceCategoryEntries are the category each competitor joined
+type RaceCategoryEntries map[UserID]CategoryName
+
+// count returns the number of competitors in the category
+func (e RaceCategoryEntries) count(name CategoryName) int {
+	var n int
+	for _, c := range e {
+		if c == name {
+			n++
+		}
+	}
+
+	return n
+}
+
+// UnknownCategoryError means the race has not the category, or a category is required to join it
+type UnknownCategoryError struct {
+	RaceID   RaceID
+	Category CategoryName
+}
+
+func (err UnknownCategoryError) Error() string {
+	if err.Category == "" {
+		return fmt.Sprintf("race %s requires a category to join", err.RaceID)
+	}
+
+	return fmt.Sprintf("race %s has no category %s", err.RaceID, err.Category)
+}
+
+// CategoryFullError means the category reached its capacity
+type CategoryFullError struct {
+	RaceID   RaceID
+	Category CategoryName
+	Capacity int
+}
+
+func (err CategoryFullError) Error() string {
+	return fmt.Sprintf("category %s of race %s is full, capacity %d", err.Category, err.RaceID, err.Capacity)
+}
+
+// NotEligibleError means the competitor does not meet the category conditions
+type NotEligibleError struct {
+	Category     CategoryName
+	CompetitorID UserID
+	Reason       string
+}
+
+func (err NotEligibleError) Error() string {
+	return fmt.Sprintf("competitor %s cannot join category %s: %s", err.CompetitorID, err.Category, err.Reason)
+}
+
+// checkEligibility returns NotEligibleError if the user cannot join the category of the race
+func (c RaceCategory) checkEligibility(u User, raceDate RaceDate) error {
+	if c.Eligibility.MinAge > 0 {
+		if u.BirthDate.IsZero() {
+			return NotEligibleError{c.Name, u.ID, "unknown birth date"}
+		}
+		if age := u.Age(time.Time(raceDate)); age < c.Eligibility.MinAge {
+			return NotEligibleError{c.Name, u.ID, fmt.Sprintf("min age %d, competitor age %d", c.Eligibility.MinAge, age)}
+		}
+	}
+
+	if c.Eligibility.Gender != "" && c.Eligibility.Gender != u.Gender {
+		return NotEligibleError{c.Name, u.ID, fmt.Sprintf("only %s competitors", c.Eligibility.Gender)}
+	}
+
+	return nil
+}
+
+// CategoryRanking returns the results of the competitors of the category sorted by time
+func (r Race) CategoryRanking(name CategoryName) []RaceResult {
+	results := make(RaceResults)
+	for competitor, t := range r.Results {
+		if r.CategoryEntries[competitor] == name {
+			results[competitor] = t
+		}
+	}
+
+	return results.Ranking()
+}
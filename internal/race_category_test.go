@@ -0,0 +1,154 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestCategoryName(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewCategoryName("")
+	require.True(errors.As(err, &racers.InvalidCategoryNameError{}))
+
+	name, err := racers.NewCategoryName("10K")
+	require.NoError(err)
+	require.Equal(racers.CategoryName("10K"), name)
+}
+
+func TestGender(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewGender("other")
+	require.True(errors.As(err, &racers.InvalidGenderError{}))
+
+	g, err := racers.NewGender("female")
+	require.NoError(err)
+	require.Equal(racers.GenderFemale, g)
+}
+
+func TestRaceCategory(t *testing.T) {
+	require := require.New(t)
+	start := time.Now()
+
+	t.Run("when invalid configuration returns InvalidCategoryError", func(t *testing.T) {
+		for name, c := range map[string]struct {
+			distance    racers.Distance
+			start       time.Time
+			capacity    int
+			eligibility racers.CategoryEligibility
+		}{
+			"without distance":  {start: start},
+			"without start":     {distance: 10000, capacity: 10},
+			"negative capacity": {distance: 10000, start: start, capacity: -1},
+			"negative min age":  {distance: 10000, start: start, eligibility: racers.CategoryEligibility{MinAge: -1}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := racers.NewRaceCategory("10K", c.distance, c.start, c.capacity, c.eligibility)
+				require.True(errors.As(err, &racers.InvalidCategoryError{}))
+			})
+		}
+	})
+
+	t.Run("when duplicated names returns InvalidCategoryError", func(t *testing.T) {
+		c, err := racers.NewRaceCategory("10K", 10000, start, 0, racers.CategoryEligibility{})
+		require.NoError(err)
+
+		_, err = racers.NewRaceCategories(c, c)
+		require.True(errors.As(err, &racers.InvalidCategoryError{}))
+	})
+}
+
+func TestRaceJoinCategory(t *testing.T) {
+	require := require.New(t)
+
+	categoriesRace := func() racers.Race {
+		return racers.Race{
+			ID:   raceID,
+			Date: racers.RaceDate(time.Date(2030, 6, 15, 9, 0, 0, 0, time.UTC)),
+			Categories: racers.RaceCategories{
+				{Name: "10K", Distance: 10000, Capacity: 1, Eligibility: racers.CategoryEligibility{MinAge: 18}},
+				{Name: "5K women", Distance: 5000, Eligibility: racers.CategoryEligibility{Gender: racers.GenderFemale}},
+			},
+		}
+	}
+	adult := racers.User{ID: racers.UserID(id.Generate()), BirthDate: time.Date(2012, 6, 15, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("Given a race without categories, When joins with a category, Then returns UnknownCategoryError", func(t *testing.T) {
+		r := racers.Race{ID: raceID}
+
+		err := r.Join(raceCompetitor, "10K", time.Now())
+		require.True(errors.As(err, &racers.UnknownCategoryError{}))
+	})
+
+	t.Run("Given a race with categories, When joins without category, Then returns UnknownCategoryError", func(t *testing.T) {
+		r := categoriesRace()
+
+		err := r.Join(adult, "", time.Now())
+		require.True(errors.As(err, &racers.UnknownCategoryError{}))
+	})
+
+	t.Run("When the competitor is younger than the min age, returns NotEligibleError", func(t *testing.T) {
+		r := categoriesRace()
+		young := racers.User{ID: racers.UserID(id.Generate()), BirthDate: time.Date(2012, 6, 16, 0, 0, 0, 0, time.UTC)}
+
+		err := r.Join(young, "10K", time.Now())
+		require.True(errors.As(err, &racers.NotEligibleError{}))
+	})
+
+	t.Run("When the competitor birth date is unknown and there is a min age, returns NotEligibleError", func(t *testing.T) {
+		r := categoriesRace()
+
+		err := r.Join(raceCompetitor, "10K", time.Now())
+		require.True(errors.As(err, &racers.NotEligibleError{}))
+	})
+
+	t.Run("When the competitor gender does not match, returns NotEligibleError", func(t *testing.T) {
+		r := categoriesRace()
+
+		err := r.Join(racers.User{ID: racers.UserID(id.Generate()), Gender: racers.GenderMale}, "5K women", time.Now())
+		require.True(errors.As(err, &racers.NotEligibleError{}))
+	})
+
+	t.Run("When the category is full, returns CategoryFullError", func(t *testing.T) {
+		r := categoriesRace()
+		require.NoError(r.Join(adult, "10K", time.Now()))
+
+		other := racers.User{ID: racers.UserID(id.Generate()), BirthDate: adult.BirthDate}
+		err := r.Join(other, "10K", time.Now())
+		require.True(errors.As(err, &racers.CategoryFullError{}))
+	})
+
+	t.Run("When the competitor is eligible, joins the race in the category", func(t *testing.T) {
+		r := categoriesRace()
+
+		require.NoError(r.Join(adult, "10K", time.Now()))
+		require.Equal(racers.RaceCategoryEntries{adult.ID: "10K"}, r.CategoryEntries)
+		require.Equal([]racers.UserID{adult.ID}, r.Competitors.List())
+	})
+}
+
+func TestRaceCategoryRanking(t *testing.T) {
+	require := require.New(t)
+
+	a, b, c := racers.UserID(id.Generate()), racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	r := racers.Race{
+		Categories:      racers.RaceCategories{{Name: "10K"}, {Name: "5K"}},
+		CategoryEntries: racers.RaceCategoryEntries{a: "10K", b: "5K", c: "10K"},
+		Results: racers.RaceResults{
+			a: racers.RaceTime(50 * time.Minute),
+			b: racers.RaceTime(20 * time.Minute),
+			c: racers.RaceTime(45 * time.Minute),
+		},
+	}
+
+	require.Equal([]racers.RaceResult{
+		{Position: 1, Competitor: c, Time: racers.RaceTime(45 * time.Minute)},
+		{Position: 2, Competitor: a, Time: racers.RaceTime(50 * time.Minute)},
+	}, r.CategoryRanking("10K"))
+}
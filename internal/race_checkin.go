@@ -0,0 +1,45 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// RaceCheckIns are the instants the competitors picked up their packets on race day
+type RaceCheckIns map[UserID]time.Time
+
+// CheckInClosedError means the competitors of the race can not be checked in
+type CheckInClosedError struct {
+	RaceID RaceID
+	Status RaceStatus
+}
+
+func (err CheckInClosedError) Error() string {
+	return fmt.Sprintf("check-in of race %s is closed, race is %s", err.RaceID, err.Status)
+}
+
+// CheckIn records the competitor picked up the packet, only confirmed registrations are checked in.
+// It returns false when the competitor was already checked in, keeping the first instant
+func (r *Race) CheckIn(competitor UserID, now time.Time) (bool, error) {
+	if r.Status == RaceFinished || r.Status == RaceCancelled {
+		return false, CheckInClosedError{r.ID, r.Status}
+	}
+
+	reg, ok := r.Registration(competitor)
+	if !ok {
+		return false, CompetitorNotInRaceError{r.ID, competitor}
+	}
+	if reg.Status != RegistrationConfirmed {
+		return false, InvalidRegistrationStatusError{r.ID, competitor, reg.Status}
+	}
+
+	if _, ok := r.CheckIns[competitor]; ok {
+		return false, nil
+	}
+	if r.CheckIns == nil {
+		r.CheckIns = make(RaceCheckIns)
+	}
+	r.CheckIns[competitor] = now
+
+	return true, nil
+}
@@ -0,0 +1,75 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaceCheckIn(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+	competitor := racers.UserID(id.Generate())
+	pending := racers.UserID(id.Generate())
+
+	newRace := func() racers.Race {
+		return racers.Race{
+			ID:            racers.RaceID(id.Generate()),
+			Date:          racers.RaceDate(now.Add(2 * time.Hour)),
+			Competitors:   racers.NewRaceCompetitors(competitor, pending),
+			Registrations: racers.RaceRegistrations{pending: {Status: racers.RegistrationPendingPayment}},
+		}
+	}
+
+	t.Run("when the user is not a competitor returns CompetitorNotInRaceError", func(t *testing.T) {
+		r := newRace()
+
+		_, err := r.CheckIn(racers.UserID(id.Generate()), now)
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+	})
+
+	t.Run("when the registration is not confirmed returns InvalidRegistrationStatusError", func(t *testing.T) {
+		r := newRace()
+
+		_, err := r.CheckIn(pending, now)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}))
+	})
+
+	t.Run("when the race is finished or cancelled returns CheckInClosedError", func(t *testing.T) {
+		r := newRace()
+		r.Status = racers.RaceCancelled
+
+		_, err := r.CheckIn(competitor, now)
+		require.True(errors.As(err, &racers.CheckInClosedError{}))
+	})
+
+	t.Run("keeps the first check-in", func(t *testing.T) {
+		r := newRace()
+
+		checked, err := r.CheckIn(competitor, now)
+		require.NoError(err)
+		require.True(checked)
+
+		checked, err = r.CheckIn(competitor, now.Add(time.Minute))
+		require.NoError(err)
+		require.False(checked)
+		require.Equal(now, r.CheckIns[competitor])
+	})
+
+	t.Run("refunding the competitor drops the check-in", func(t *testing.T) {
+		r := newRace()
+		r.Registrations[competitor] = racers.Registration{Status: racers.RegistrationConfirmed}
+		_, err := r.CheckIn(competitor, now)
+		require.NoError(err)
+
+		_, err = r.Refund(competitor)
+		require.NoError(err)
+		require.NotContains(r.CheckIns, competitor)
+	})
+}
@@ -0,0 +1,226 @@
+package racers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	// CheckpointName defines the name of a checkpoint, unique in the race
+	CheckpointName             string
+	InvalidCheckpointNameError struct{ error }
+)
+
+func (err InvalidCheckpointNameError) Error() string {
+	return fmt.Sprintf("invalid checkpoint name: %s", err.error)
+}
+
+// NewCheckpointName validates the name and returns a CheckpointName instance
+func NewCheckpointName(s string) (CheckpointName, error) {
+	if s == "" {
+		return "", InvalidCheckpointNameError{errors.New("empty name")}
+	}
+
+	return CheckpointName(s), nil
+}
+
+// Checkpoint is an intermediate timing point of the course
+type Checkpoint struct {
+	Name CheckpointName
+	// Distance along the course from the start
+	Distance Distance
+	// Cutoff is the max time since the start to pass the checkpoint, zero when there is no cutoff
+	Cutoff RaceTime
+}
+
+// InvalidCheckpointsError means the checkpoints of a race are not valid
+type InvalidCheckpointsError struct {
+	Checkpoint CheckpointName
+	Reason     string
+}
+
+func (err InvalidCheckpointsError) Error() string {
+	return fmt.Sprintf("invalid checkpoint %s: %s", err.Checkpoint, err.Reason)
+}
+
+// RaceCheckpoints are the checkpoints of a race in course order
+type RaceCheckpoints []Checkpoint
+
+// NewRaceCheckpoints checks the names are unique and the checkpoints are sorted along the course
+func NewRaceCheckpoints(checkpoints ...Checkpoint) (RaceCheckpoints, error) {
+	seen := make(map[CheckpointName]struct{}, len(checkpoints))
+	for i, c := range checkpoints {
+		if _, ok := seen[c.Name]; ok {
+			return nil, InvalidCheckpointsError{c.Name, "duplicated name"}
+		}
+		seen[c.Name] = struct{}{}
+
+		if c.Distance <= 0 {
+			return nil, InvalidCheckpointsError{c.Name, fmt.Sprintf("invalid distance %d", c.Distance)}
+		}
+
+		if c.Cutoff < 0 {
+			return nil, InvalidCheckpointsError{c.Name, "negative cutoff"}
+		}
+
+		if i > 0 && c.Distance <= checkpoints[i-1].Distance {
+			return nil, InvalidCheckpointsError{c.Name, fmt.Sprintf("distance %d is not after the previous checkpoint", c.Distance)}
+		}
+	}
+
+	return RaceCheckpoints(checkpoints), nil
+}
+
+// index returns the position of the checkpoint in the course, -1 if the race has not the checkpoint
+func (rc RaceCheckpoints) index(name CheckpointName) int {
+	for i, c := range rc {
+		if c.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// RacePassages are the times each competitor passed each checkpoint
+type RacePassages map[UserID]map[CheckpointName]time.Time
+
+// UnknownCheckpointError means the race has not the checkpoint
+type UnknownCheckpointError struct {
+	RaceID     RaceID
+	Checkpoint CheckpointName
+}
+
+func (err UnknownCheckpointError) Error() string {
+	return fmt.Sprintf("race %s has no checkpoint %s", err.RaceID, err.Checkpoint)
+}
+
+// PassageBeforeStartError means the passage time is before the competitor start time
+type PassageBeforeStartError struct {
+	CompetitorID UserID
+	Checkpoint   CheckpointName
+	At           time.Time
+}
+
+func (err PassageBeforeStartError) Error() string {
+	return fmt.Sprintf("passage of %s by checkpoint %s at %s is before the start", err.CompetitorID, err.Checkpoint, err.At.Format(time.RFC3339))
+}
+
+// StartTime returns when the competitor started, the start of its category or the race date otherwise
+func (r Race) StartTime(competitor UserID) time.Time {
+	if c, ok := r.Categories.Get(r.CategoryEntries[competitor]); ok {
+		return c.StartTime
+	}
+
+	return time.Time(r.Date)
+}
+
+// RecordPassage sets the time a competitor passed a checkpoint, recording it again replaces the time
+func (r *Race) RecordPassage(competitor UserID, checkpoint CheckpointName, at time.Time) error {
+	if r.Checkpoints.index(checkpoint) < 0 {
+		return UnknownCheckpointError{r.ID, checkpoint}
+	}
+
+	if !r.Competitors.is(competitor) {
+		return CompetitorNotInRaceError{r.ID, competitor}
+	}
+
+	if at.Before(r.StartTime(competitor)) {
+		return PassageBeforeStartError{competitor, checkpoint, at}
+	}
+
+	if r.Passages == nil {
+		r.Passages = make(RacePassages)
+	}
+	if r.Passages[competitor] == nil {
+		r.Passages[competitor] = make(map[CheckpointName]time.Time)
+	}
+	r.Passages[competitor][checkpoint] = at
+
+	return nil
+}
+
+// Split is the time of a competitor at a checkpoint
+type Split struct {
+	Checkpoint CheckpointName
+	At         time.Time
+	// Elapsed is the time since the competitor start
+	Elapsed RaceTime
+	// Pace is the time per kilometre since the previous passed checkpoint, or the start
+	Pace time.Duration
+	// CutoffMissed is true when the checkpoint was passed after its cutoff
+	CutoffMissed bool
+}
+
+// Splits returns the splits of the competitor in course order, checkpoints not passed are skipped
+func (r Race) Splits(competitor UserID) []Split {
+	passages := r.Passages[competitor]
+	if len(passages) == 0 {
+		return nil
+	}
+
+	start := r.StartTime(competitor)
+
+	var (
+		splits       []Split
+		prevDistance Distance
+		prevElapsed  RaceTime
+	)
+	for _, c := range r.Checkpoints {
+		at, ok := passages[c.Name]
+		if !ok {
+			continue
+		}
+
+		elapsed := RaceTime(at.Sub(start).Truncate(time.Millisecond))
+		split := Split{
+			Checkpoint:   c.Name,
+			At:           at,
+			Elapsed:      elapsed,
+			CutoffMissed: c.Cutoff > 0 && elapsed > c.Cutoff,
+		}
+		if d := c.Distance - prevDistance; d > 0 {
+			split.Pace = time.Duration(elapsed-prevElapsed) * 1000 / time.Duration(d)
+		}
+
+		splits = append(splits, split)
+		prevDistance, prevElapsed = c.Distance, elapsed
+	}
+
+	return splits
+}
+
+// CompetitorSplits are the splits of a competitor
+type CompetitorSplits struct {
+	Competitor UserID
+	Splits     []Split
+}
+
+// AllSplits returns the splits of every competitor with passages, sorted by the furthest
+// checkpoint passed and the time they passed it
+func (r Race) AllSplits() []CompetitorSplits {
+	result := make([]CompetitorSplits, 0, len(r.Passages))
+	for competitor := range r.Passages {
+		if splits := r.Splits(competitor); len(splits) > 0 {
+			result = append(result, CompetitorSplits{competitor, splits})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		si, sj := result[i].Splits, result[j].Splits
+		li, lj := si[len(si)-1], sj[len(sj)-1]
+		if pi, pj := r.Checkpoints.index(li.Checkpoint), r.Checkpoints.index(lj.Checkpoint); pi != pj {
+			return pi > pj
+		}
+
+		if li.Elapsed != lj.Elapsed {
+			return li.Elapsed < lj.Elapsed
+		}
+
+		return result[i].Competitor.String() < result[j].Competitor.String()
+	})
+
+	return result
+}
@@ -0,0 +1,135 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestCheckpointName(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewCheckpointName("")
+	require.True(errors.As(err, &racers.InvalidCheckpointNameError{}))
+
+	name, err := racers.NewCheckpointName("Aid station 1")
+	require.NoError(err)
+	require.Equal(racers.CheckpointName("Aid station 1"), name)
+}
+
+func TestRaceCheckpoints(t *testing.T) {
+	require := require.New(t)
+
+	first := racers.Checkpoint{Name: "first", Distance: 10000}
+	second := racers.Checkpoint{Name: "second", Distance: 25000, Cutoff: racers.RaceTime(4 * time.Hour)}
+
+	for name, checkpoints := range map[string][]racers.Checkpoint{
+		"duplicated name": {first, {Name: "first", Distance: 20000}},
+		"not sorted":      {second, first},
+		"no distance":     {{Name: "start"}},
+		"negative cutoff": {{Name: "first", Distance: 10000, Cutoff: -1}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := racers.NewRaceCheckpoints(checkpoints...)
+			require.True(errors.As(err, &racers.InvalidCheckpointsError{}))
+		})
+	}
+
+	checkpoints, err := racers.NewRaceCheckpoints(first, second)
+	require.NoError(err)
+	require.Equal(racers.RaceCheckpoints{first, second}, checkpoints)
+}
+
+func checkpointsRace(competitors ...racers.UserID) racers.Race {
+	return racers.Race{
+		ID:          raceID,
+		Name:        raceName,
+		Date:        raceDate,
+		Owner:       ownerID,
+		Competitors: racers.NewRaceCompetitors(competitors...),
+		Checkpoints: racers.RaceCheckpoints{
+			{Name: "first", Distance: 10000},
+			{Name: "second", Distance: 25000, Cutoff: racers.RaceTime(2 * time.Hour)},
+		},
+	}
+}
+
+func TestRaceRecordPassage(t *testing.T) {
+	require := require.New(t)
+	start := time.Time(raceDate)
+
+	t.Run("Given an unknown checkpoint, returns UnknownCheckpointError", func(t *testing.T) {
+		r := checkpointsRace(raceCompetitor.ID)
+
+		err := r.RecordPassage(raceCompetitor.ID, "finish", start.Add(time.Hour))
+		require.True(errors.As(err, &racers.UnknownCheckpointError{}))
+	})
+
+	t.Run("When the user is not a competitor, returns CompetitorNotInRaceError", func(t *testing.T) {
+		r := checkpointsRace()
+
+		err := r.RecordPassage(raceCompetitor.ID, "first", start.Add(time.Hour))
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+	})
+
+	t.Run("When the passage is before the start, returns PassageBeforeStartError", func(t *testing.T) {
+		r := checkpointsRace(raceCompetitor.ID)
+
+		err := r.RecordPassage(raceCompetitor.ID, "first", start.Add(-time.Minute))
+		require.True(errors.As(err, &racers.PassageBeforeStartError{}))
+	})
+
+	t.Run("Records the passage, replacing the previous one", func(t *testing.T) {
+		r := checkpointsRace(raceCompetitor.ID)
+
+		require.NoError(r.RecordPassage(raceCompetitor.ID, "first", start.Add(time.Hour)))
+		require.NoError(r.RecordPassage(raceCompetitor.ID, "first", start.Add(50*time.Minute)))
+		require.Equal(racers.RacePassages{
+			raceCompetitor.ID: {"first": start.Add(50 * time.Minute)},
+		}, r.Passages)
+	})
+}
+
+func TestRaceSplits(t *testing.T) {
+	require := require.New(t)
+	start := time.Time(raceDate)
+
+	fast, slow, late := racers.UserID(id.Generate()), racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	r := checkpointsRace(fast, slow, late)
+	require.NoError(r.RecordPassage(fast, "first", start.Add(50*time.Minute)))
+	require.NoError(r.RecordPassage(fast, "second", start.Add(125*time.Minute)))
+	require.NoError(r.RecordPassage(slow, "first", start.Add(55*time.Minute)))
+	require.NoError(r.RecordPassage(late, "first", start.Add(70*time.Minute)))
+	require.NoError(r.RecordPassage(late, "second", start.Add(130*time.Minute)))
+
+	t.Run("Derives the elapsed time, pace and cutoff of each checkpoint", func(t *testing.T) {
+		require.Equal([]racers.Split{
+			{
+				Checkpoint: "first",
+				At:         start.Add(50 * time.Minute),
+				Elapsed:    racers.RaceTime(50 * time.Minute),
+				Pace:       5 * time.Minute,
+			},
+			{
+				Checkpoint:   "second",
+				At:           start.Add(125 * time.Minute),
+				Elapsed:      racers.RaceTime(125 * time.Minute),
+				Pace:         5 * time.Minute,
+				CutoffMissed: true,
+			},
+		}, r.Splits(fast))
+	})
+
+	t.Run("Ranks the competitors by the furthest checkpoint passed", func(t *testing.T) {
+		all := r.AllSplits()
+
+		require.Len(all, 3)
+		require.Equal(fast, all[0].Competitor)
+		require.Equal(late, all[1].Competitor)
+		require.Equal(slow, all[2].Competitor)
+	})
+}
@@ -0,0 +1,142 @@
+package racers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Discount is what a discount code takes off the entry fee, either a percentage or a fixed amount
+type Discount struct {
+	// Percent off the fee, 100 for a free entry
+	Percent int
+	// Amount off the fee, in the minor unit of the fee currency
+	Amount int64
+}
+
+// Apply returns the fee discounted, never below zero
+func (d Discount) Apply(fee Money) Money {
+	off := d.Amount
+	if d.Percent > 0 {
+		off = fee.Amount * int64(d.Percent) / 100
+	}
+	if off > fee.Amount {
+		off = fee.Amount
+	}
+
+	return Money{Amount: fee.Amount - off, Currency: fee.Currency}
+}
+
+// InvalidDiscountCodeError means the given discount code definition is not valid
+type InvalidDiscountCodeError struct{ Reason string }
+
+func (err InvalidDiscountCodeError) Error() string {
+	return fmt.Sprintf("invalid discount code: %s", err.Reason)
+}
+
+// DiscountCodeNotApplicableError means the code can not be redeemed in the registration
+type DiscountCodeNotApplicableError struct {
+	Code   string
+	Reason string
+}
+
+func (err DiscountCodeNotApplicableError) Error() string {
+	return fmt.Sprintf("discount code %s can not be redeemed: %s", err.Code, err.Reason)
+}
+
+// DiscountCode is a code handed out by the organizer to register in a race with a discount
+type DiscountCode struct {
+	Race RaceID
+	// Code is what the competitors type, in upper case
+	Code     string
+	Discount Discount
+	// MaxRedemptions is zero for no limit
+	MaxRedemptions int
+	// ValidFrom and ValidUntil limit when the code can be redeemed, zero for no limit
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	// Category restricts the code to a category, empty for any
+	Category CategoryName
+	// Redemptions is how many registrations redeemed the code
+	Redemptions int
+}
+
+const maxDiscountCodeLength = 32
+
+// NewDiscountCode validates the definition and returns a DiscountCode instance,
+// the code is case insensitive and stored in upper case
+func NewDiscountCode(race RaceID, code string, d Discount, maxRedemptions int, validFrom, validUntil time.Time, category CategoryName) (DiscountCode, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if l := len(code); l < 3 || l > maxDiscountCodeLength {
+		return DiscountCode{}, InvalidDiscountCodeError{fmt.Sprintf("code must have between 3 and %d characters", maxDiscountCodeLength)}
+	}
+	for _, c := range code {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return DiscountCode{}, InvalidDiscountCodeError{"code must only have letters, digits, - and _"}
+		}
+	}
+
+	switch {
+	case d.Percent != 0 && d.Amount != 0:
+		return DiscountCode{}, InvalidDiscountCodeError{"discount must be a percentage or a fixed amount, not both"}
+	case d.Percent < 0 || d.Percent > 100:
+		return DiscountCode{}, InvalidDiscountCodeError{"percentage must be between 1 and 100"}
+	case d.Amount < 0:
+		return DiscountCode{}, InvalidDiscountCodeError{"negative amount"}
+	case d.Percent == 0 && d.Amount == 0:
+		return DiscountCode{}, InvalidDiscountCodeError{"missing discount"}
+	}
+
+	if maxRedemptions < 0 {
+		return DiscountCode{}, InvalidDiscountCodeError{"negative max redemptions"}
+	}
+
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return DiscountCode{}, InvalidDiscountCodeError{"validity must end after it starts"}
+	}
+
+	return DiscountCode{
+		Race:           race,
+		Code:           code,
+		Discount:       d,
+		MaxRedemptions: maxRedemptions,
+		ValidFrom:      validFrom,
+		ValidUntil:     validUntil,
+		Category:       category,
+	}, nil
+}
+
+// Exhausted returns if the code reached its max redemptions
+func (c DiscountCode) Exhausted() bool {
+	return c.MaxRedemptions > 0 && c.Redemptions >= c.MaxRedemptions
+}
+
+// check returns DiscountCodeNotApplicableError if the code can not be redeemed in the category at the given instant
+func (c DiscountCode) check(category CategoryName, at time.Time) error {
+	switch {
+	case c.Exhausted():
+		return DiscountCodeNotApplicableError{c.Code, "no redemptions left"}
+	case !c.ValidFrom.IsZero() && at.Before(c.ValidFrom):
+		return DiscountCodeNotApplicableError{c.Code, "not valid yet"}
+	case !c.ValidUntil.IsZero() && at.After(c.ValidUntil):
+		return DiscountCodeNotApplicableError{c.Code, "expired"}
+	case c.Category != "" && c.Category != category:
+		return DiscountCodeNotApplicableError{c.Code, fmt.Sprintf("only valid for category %s", c.Category)}
+	}
+
+	return nil
+}
+
+// JoinWithCode adds the user to the race competitors like Join, discounting the fee with the code.
+// The registration keeps the code, and it is confirmed without payment when nothing is left to pay
+func (r *Race) JoinWithCode(u User, category CategoryName, code DiscountCode, now time.Time) error {
+	if code.Race != r.ID {
+		return DiscountCodeNotApplicableError{code.Code, "not a code of the race"}
+	}
+
+	if err := code.check(category, now); err != nil {
+		return err
+	}
+
+	return r.join(u, category, now, &code)
+}
@@ -0,0 +1,105 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscountCode(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("when the definition is not valid returns InvalidDiscountCodeError", func(t *testing.T) {
+		for name, c := range map[string]struct {
+			code        string
+			discount    racers.Discount
+			max         int
+			from, until time.Time
+		}{
+			"short code":       {code: "AB", discount: racers.Discount{Percent: 10}},
+			"invalid chars":    {code: "SPONSOR 1", discount: racers.Discount{Percent: 10}},
+			"no discount":      {code: "SPONSOR"},
+			"both discounts":   {code: "SPONSOR", discount: racers.Discount{Percent: 10, Amount: 500}},
+			"over 100 percent": {code: "SPONSOR", discount: racers.Discount{Percent: 101}},
+			"negative amount":  {code: "SPONSOR", discount: racers.Discount{Amount: -1}},
+			"negative max":     {code: "SPONSOR", discount: racers.Discount{Percent: 10}, max: -1},
+			"empty validity":   {code: "SPONSOR", discount: racers.Discount{Percent: 10}, from: now, until: now},
+		} {
+			_, err := racers.NewDiscountCode(raceID, c.code, c.discount, c.max, c.from, c.until, "")
+			require.True(errors.As(err, &racers.InvalidDiscountCodeError{}), name)
+		}
+	})
+
+	t.Run("the code is case insensitive", func(t *testing.T) {
+		code, err := racers.NewDiscountCode(raceID, " sponsor-1 ", racers.Discount{Percent: 100}, 0, time.Time{}, time.Time{}, "")
+		require.NoError(err)
+		require.Equal("SPONSOR-1", code.Code)
+	})
+
+	t.Run("applies the discount never below zero", func(t *testing.T) {
+		fee := racers.Money{Amount: 2000, Currency: "EUR"}
+
+		require.Equal(racers.Money{Amount: 1500, Currency: "EUR"}, racers.Discount{Percent: 25}.Apply(fee))
+		require.Equal(racers.Money{Amount: 0, Currency: "EUR"}, racers.Discount{Percent: 100}.Apply(fee))
+		require.Equal(racers.Money{Amount: 1500, Currency: "EUR"}, racers.Discount{Amount: 500}.Apply(fee))
+		require.Equal(racers.Money{Amount: 0, Currency: "EUR"}, racers.Discount{Amount: 5000}.Apply(fee))
+	})
+
+	paidRace := func() racers.Race {
+		return racers.Race{
+			ID:         raceID,
+			Price:      &racers.RacePrice{Currency: "EUR", Amount: 2000},
+			Categories: racers.RaceCategories{{Name: "5K"}, {Name: "10K"}},
+		}
+	}
+
+	t.Run("a free entry code confirms the registration", func(t *testing.T) {
+		r := paidRace()
+		code := racers.DiscountCode{Race: raceID, Code: "SPONSOR", Discount: racers.Discount{Percent: 100}}
+
+		require.NoError(r.JoinWithCode(raceCompetitor, "10K", code, now))
+
+		reg, ok := r.Registration(raceCompetitor.ID)
+		require.True(ok)
+		require.Equal(racers.Registration{
+			Status: racers.RegistrationConfirmed,
+			Fee:    racers.Money{Currency: "EUR"},
+			Code:   "SPONSOR",
+		}, reg)
+	})
+
+	t.Run("a partial discount is pending the payment of the rest", func(t *testing.T) {
+		r := paidRace()
+		code := racers.DiscountCode{Race: raceID, Code: "CLUB", Discount: racers.Discount{Amount: 500}}
+
+		require.NoError(r.JoinWithCode(raceCompetitor, "10K", code, now))
+
+		reg, _ := r.Registration(raceCompetitor.ID)
+		require.Equal(racers.RegistrationPendingPayment, reg.Status)
+		require.Equal(racers.Money{Amount: 1500, Currency: "EUR"}, reg.Fee)
+		require.Equal("CLUB", reg.Code)
+	})
+
+	t.Run("when the code can not be redeemed returns DiscountCodeNotApplicableError", func(t *testing.T) {
+		valid := racers.DiscountCode{Race: raceID, Code: "CLUB", Discount: racers.Discount{Percent: 50}}
+
+		for name, code := range map[string]racers.DiscountCode{
+			"other race": {Race: racers.RaceID{}, Code: "CLUB", Discount: valid.Discount},
+			"exhausted":  {Race: raceID, Code: "CLUB", Discount: valid.Discount, MaxRedemptions: 2, Redemptions: 2},
+			"not yet":    {Race: raceID, Code: "CLUB", Discount: valid.Discount, ValidFrom: now.Add(time.Hour)},
+			"expired":    {Race: raceID, Code: "CLUB", Discount: valid.Discount, ValidUntil: now.Add(-time.Hour)},
+			"category":   {Race: raceID, Code: "CLUB", Discount: valid.Discount, Category: "5K"},
+		} {
+			r := paidRace()
+			err := r.JoinWithCode(raceCompetitor, "10K", code, now)
+			require.True(errors.As(err, &racers.DiscountCodeNotApplicableError{}), name)
+			require.False(r.HasCompetitor(raceCompetitor.ID), name)
+		}
+	})
+}
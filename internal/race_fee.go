@@ -0,0 +1,115 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+type (
+	// Currency is an ISO 4217 currency code, like EUR
+	Currency string
+	// InvalidCurrencyError means the given code is not a currency code
+	InvalidCurrencyError struct{ Value string }
+)
+
+func (err InvalidCurrencyError) Error() string {
+	return fmt.Sprintf("invalid currency: %q", err.Value)
+}
+
+// NewCurrency validates the code and returns a Currency instance
+func NewCurrency(s string) (Currency, error) {
+	if len(s) != 3 {
+		return "", InvalidCurrencyError{s}
+	}
+	for _, c := range s {
+		if c < 'A' || c > 'Z' {
+			return "", InvalidCurrencyError{s}
+		}
+	}
+
+	return Currency(s), nil
+}
+
+// Money is an amount in the minor unit of the currency, like cents
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// IsZero returns if there is nothing to pay
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+// PriceTier is an early-bird price, applied to the registrations until the given instant
+type PriceTier struct {
+	Until  time.Time
+	Amount int64
+}
+
+// InvalidRacePriceError means the entry fee of a race or category is not valid
+type InvalidRacePriceError struct{ Reason string }
+
+func (err InvalidRacePriceError) Error() string {
+	return fmt.Sprintf("invalid race price: %s", err.Reason)
+}
+
+// RacePrice is the entry fee of a race or category
+type RacePrice struct {
+	Currency Currency
+	// Amount is the price once the early-bird tiers are over
+	Amount int64
+	// Tiers are the early-bird prices, by date
+	Tiers []PriceTier
+}
+
+// NewRacePrice validates the amounts and returns a RacePrice instance, the early-bird tiers must be sorted by date
+// and never be more expensive than the later ones
+func NewRacePrice(currency Currency, amount int64, tiers ...PriceTier) (RacePrice, error) {
+	if amount < 0 {
+		return RacePrice{}, InvalidRacePriceError{"negative amount"}
+	}
+
+	for i, t := range tiers {
+		if t.Amount < 0 {
+			return RacePrice{}, InvalidRacePriceError{"negative early-bird amount"}
+		}
+		if t.Amount > amount || (i > 0 && t.Amount < tiers[i-1].Amount) {
+			return RacePrice{}, InvalidRacePriceError{"early-bird prices must not be more expensive than the later ones"}
+		}
+		if i > 0 && !t.Until.After(tiers[i-1].Until) {
+			return RacePrice{}, InvalidRacePriceError{"early-bird tiers must be sorted by date"}
+		}
+	}
+
+	return RacePrice{Currency: currency, Amount: amount, Tiers: tiers}, nil
+}
+
+// At returns the price of a registration at the given instant
+func (p RacePrice) At(t time.Time) Money {
+	for _, tier := range p.Tiers {
+		if !t.After(tier.Until) {
+			return Money{Amount: tier.Amount, Currency: p.Currency}
+		}
+	}
+
+	return Money{Amount: p.Amount, Currency: p.Currency}
+}
+
+// Fee returns the entry fee of the category at the given instant, the category price overrides the race one.
+// It is zero when the race is free
+func (r Race) Fee(category CategoryName, at time.Time) Money {
+	if c, ok := r.Categories.Get(category); ok && c.Price != nil {
+		return c.Price.At(at)
+	}
+
+	if r.Price != nil {
+		return r.Price.At(at)
+	}
+
+	return Money{}
+}
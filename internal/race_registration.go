@@ -0,0 +1,235 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// RegistrationStatus is the payment state of a registration
+type RegistrationStatus string
+
+// Registration statuses, a registration goes from pending payment to confirmed, and from confirmed to refunded
+// through the refund pending until the gateway returns the payment
+const (
+	RegistrationPendingPayment RegistrationStatus = "PENDING_PAYMENT"
+	RegistrationConfirmed      RegistrationStatus = "CONFIRMED"
+	RegistrationRefundPending  RegistrationStatus = "REFUND_PENDING"
+	RegistrationRefunded       RegistrationStatus = "REFUNDED"
+)
+
+// PaymentWindow is how long a pending registration holds the spot of the competitor
+const PaymentWindow = 30 * time.Minute
+
+// Registration is the payment state of a competitor in a paid race
+type Registration struct {
+	Status RegistrationStatus
+	Fee    Money
+	// Payment is the gateway id of the payment, empty until the checkout starts
+	Payment string
+	// ExpiresAt is when the pending registration is released, zero once confirmed
+	ExpiresAt time.Time
+	// Code is the discount code redeemed in the registration, empty without code
+	Code string
+}
+
+// RaceRegistrations are the registrations of the competitors of a race
+type RaceRegistrations map[UserID]Registration
+
+// RegistrationNotFoundError means the user has no registration in the race, or it expired
+type RegistrationNotFoundError struct {
+	RaceID       RaceID
+	CompetitorID UserID
+}
+
+func (err RegistrationNotFoundError) Error() string {
+	return fmt.Sprintf("competitor %s has no registration in race %s", err.CompetitorID, err.RaceID)
+}
+
+// InvalidRegistrationStatusError means the registration can not change from its status
+type InvalidRegistrationStatusError struct {
+	RaceID       RaceID
+	CompetitorID UserID
+	Status       RegistrationStatus
+}
+
+func (err InvalidRegistrationStatusError) Error() string {
+	return fmt.Sprintf("registration of competitor %s in race %s is %s", err.CompetitorID, err.RaceID, err.Status)
+}
+
+// PaymentMismatchError means the payment is not for the registration or its amount is not the fee
+type PaymentMismatchError struct {
+	Payment string
+	Reason  string
+}
+
+func (err PaymentMismatchError) Error() string {
+	return fmt.Sprintf("payment %s does not match the registration: %s", err.Payment, err.Reason)
+}
+
+// Registration returns the registration of the competitor, the competitors without registration are confirmed
+func (r Race) Registration(competitor UserID) (Registration, bool) {
+	if reg, ok := r.Registrations[competitor]; ok {
+		return reg, true
+	}
+
+	if !r.Competitors.is(competitor) {
+		return Registration{}, false
+	}
+
+	return Registration{Status: RegistrationConfirmed}, true
+}
+
+// requirePayment holds the spot of the competitor until the fee, discounted with the code, is paid.
+// Registrations with a code are kept even when nothing is left to pay, to record the redemption
+func (r *Race) requirePayment(competitor UserID, category CategoryName, now time.Time, code *DiscountCode) {
+	fee := r.Fee(category, now)
+	if code != nil {
+		fee = code.Discount.Apply(fee)
+	} else if fee.IsZero() {
+		return
+	}
+
+	reg := Registration{Status: RegistrationConfirmed, Fee: fee}
+	if code != nil {
+		reg.Code = code.Code
+	}
+	if !fee.IsZero() {
+		reg.Status = RegistrationPendingPayment
+		reg.ExpiresAt = now.Add(PaymentWindow)
+	}
+
+	if r.Registrations == nil {
+		r.Registrations = make(RaceRegistrations)
+	}
+	r.Registrations[competitor] = reg
+}
+
+// pending returns the pending registration of the competitor
+func (r Race) pending(competitor UserID) (Registration, error) {
+	reg, ok := r.Registrations[competitor]
+	if !ok {
+		return Registration{}, RegistrationNotFoundError{r.ID, competitor}
+	}
+
+	if reg.Status != RegistrationPendingPayment {
+		return Registration{}, InvalidRegistrationStatusError{r.ID, competitor, reg.Status}
+	}
+
+	return reg, nil
+}
+
+// StartPayment sets the gateway payment the pending registration is paid with
+func (r *Race) StartPayment(competitor UserID, payment string) error {
+	reg, err := r.pending(competitor)
+	if err != nil {
+		return err
+	}
+
+	reg.Payment = payment
+	r.Registrations[competitor] = reg
+
+	return nil
+}
+
+// ConfirmPayment confirms the pending registration paid with the payment, it returns if the registration changed:
+// confirming it again with the same payment does nothing
+func (r *Race) ConfirmPayment(competitor UserID, payment string, paid Money) (bool, error) {
+	if reg, ok := r.Registrations[competitor]; ok && reg.Status == RegistrationConfirmed && reg.Payment == payment {
+		return false, nil
+	}
+
+	reg, err := r.pending(competitor)
+	if err != nil {
+		return false, err
+	}
+
+	if reg.Payment != payment {
+		return false, PaymentMismatchError{payment, fmt.Sprintf("expected payment %s", reg.Payment)}
+	}
+	if paid != reg.Fee {
+		return false, PaymentMismatchError{payment, fmt.Sprintf("paid %s, the fee is %s", paid, reg.Fee)}
+	}
+
+	reg.Status = RegistrationConfirmed
+	reg.ExpiresAt = time.Time{}
+	r.Registrations[competitor] = reg
+
+	return true, nil
+}
+
+// Refund marks the refund of the confirmed registration pending and withdraws the competitor, releasing the spot.
+// The registration is refunded by CompleteRefund once the gateway returns the payment
+func (r *Race) Refund(competitor UserID) (Registration, error) {
+	reg, ok := r.Registrations[competitor]
+	if !ok {
+		return Registration{}, RegistrationNotFoundError{r.ID, competitor}
+	}
+
+	if reg.Status != RegistrationConfirmed {
+		return Registration{}, InvalidRegistrationStatusError{r.ID, competitor, reg.Status}
+	}
+
+	reg.Status = RegistrationRefundPending
+	r.Registrations[competitor] = reg
+	r.withdraw(competitor)
+
+	return reg, nil
+}
+
+// CompleteRefund marks the pending refund of the registration as refunded, it returns if the registration changed:
+// completing it again does nothing
+func (r *Race) CompleteRefund(competitor UserID) (Registration, bool, error) {
+	reg, ok := r.Registrations[competitor]
+	if !ok {
+		return Registration{}, false, RegistrationNotFoundError{r.ID, competitor}
+	}
+
+	switch reg.Status {
+	case RegistrationRefunded:
+		return reg, false, nil
+	case RegistrationRefundPending:
+	default:
+		return Registration{}, false, InvalidRegistrationStatusError{r.ID, competitor, reg.Status}
+	}
+
+	reg.Status = RegistrationRefunded
+	r.Registrations[competitor] = reg
+
+	return reg, true, nil
+}
+
+// PendingRefunds returns the competitors with the refund of their registration pending
+func (r Race) PendingRefunds() []UserID {
+	var pending []UserID
+	for c, reg := range r.Registrations {
+		if reg.Status == RegistrationRefundPending {
+			pending = append(pending, c)
+		}
+	}
+
+	return pending
+}
+
+// ExpireRegistrations withdraws the competitors with a pending registration expired at the given instant,
+// releasing their spots, and returns them
+func (r *Race) ExpireRegistrations(now time.Time) []UserID {
+	var expired []UserID
+	for c, reg := range r.Registrations {
+		if reg.Status == RegistrationPendingPayment && !now.Before(reg.ExpiresAt) {
+			expired = append(expired, c)
+			delete(r.Registrations, c)
+			r.withdraw(c)
+		}
+	}
+
+	return expired
+}
+
+// withdraw removes the competitor from the race with its category, bib number, transfer offer and check-in
+func (r *Race) withdraw(competitor UserID) {
+	r.Competitors.remove(competitor)
+	delete(r.CategoryEntries, competitor)
+	delete(r.BibEntries, competitor)
+	delete(r.RegistrationTransfers, competitor)
+	delete(r.CheckIns, competitor)
+}
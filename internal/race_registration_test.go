@@ -0,0 +1,185 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRacePrice(t *testing.T) {
+	require := require.New(t)
+
+	earlyBird := time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("when the currency is not valid returns InvalidCurrencyError", func(t *testing.T) {
+		for _, c := range []string{"", "eur", "EURO", "E1R"} {
+			_, err := racers.NewCurrency(c)
+			require.True(errors.As(err, &racers.InvalidCurrencyError{}), c)
+		}
+	})
+
+	t.Run("when the prices are not valid returns InvalidRacePriceError", func(t *testing.T) {
+		_, err := racers.NewRacePrice("EUR", -1)
+		require.True(errors.As(err, &racers.InvalidRacePriceError{}))
+
+		_, err = racers.NewRacePrice("EUR", 2000, racers.PriceTier{Until: earlyBird, Amount: 2500})
+		require.True(errors.As(err, &racers.InvalidRacePriceError{}))
+
+		_, err = racers.NewRacePrice("EUR", 2000,
+			racers.PriceTier{Until: earlyBird, Amount: 1500},
+			racers.PriceTier{Until: earlyBird.AddDate(0, -1, 0), Amount: 1000},
+		)
+		require.True(errors.As(err, &racers.InvalidRacePriceError{}))
+	})
+
+	t.Run("applies the early-bird tier of the date", func(t *testing.T) {
+		price, err := racers.NewRacePrice("EUR", 2000,
+			racers.PriceTier{Until: earlyBird.AddDate(0, -1, 0), Amount: 1000},
+			racers.PriceTier{Until: earlyBird, Amount: 1500},
+		)
+		require.NoError(err)
+
+		require.Equal(racers.Money{Amount: 1000, Currency: "EUR"}, price.At(earlyBird.AddDate(0, -2, 0)))
+		require.Equal(racers.Money{Amount: 1500, Currency: "EUR"}, price.At(earlyBird))
+		require.Equal(racers.Money{Amount: 2000, Currency: "EUR"}, price.At(earlyBird.Add(time.Second)))
+	})
+
+	t.Run("the category price overrides the race price", func(t *testing.T) {
+		r := racers.Race{
+			Price:      &racers.RacePrice{Currency: "EUR", Amount: 2000},
+			Categories: racers.RaceCategories{{Name: "5K", Price: &racers.RacePrice{Currency: "EUR", Amount: 1000}}, {Name: "10K"}},
+		}
+
+		require.Equal(racers.Money{Amount: 1000, Currency: "EUR"}, r.Fee("5K", earlyBird))
+		require.Equal(racers.Money{Amount: 2000, Currency: "EUR"}, r.Fee("10K", earlyBird))
+		require.True(racers.Race{}.Fee("", earlyBird).IsZero())
+	})
+}
+
+func TestRaceRegistration(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	fee := racers.Money{Amount: 2000, Currency: "EUR"}
+
+	paidRace := func() racers.Race {
+		r := racers.Race{
+			ID:         raceID,
+			Price:      &racers.RacePrice{Currency: "EUR", Amount: 2000},
+			Categories: racers.RaceCategories{{Name: "10K", Capacity: 1}},
+		}
+		require.NoError(r.Join(raceCompetitor, "10K", now))
+		require.NoError(r.StartPayment(raceCompetitor.ID, "pay_1"))
+
+		return r
+	}
+
+	t.Run("joining a free race confirms the registration", func(t *testing.T) {
+		r := racers.Race{ID: raceID}
+		require.NoError(r.Join(raceCompetitor, "", now))
+
+		reg, ok := r.Registration(raceCompetitor.ID)
+		require.True(ok)
+		require.Equal(racers.Registration{Status: racers.RegistrationConfirmed}, reg)
+	})
+
+	t.Run("joining a paid race holds the spot until the payment", func(t *testing.T) {
+		r := paidRace()
+
+		reg, ok := r.Registration(raceCompetitor.ID)
+		require.True(ok)
+		require.Equal(racers.Registration{
+			Status:    racers.RegistrationPendingPayment,
+			Fee:       fee,
+			Payment:   "pay_1",
+			ExpiresAt: now.Add(racers.PaymentWindow),
+		}, reg)
+
+		err := r.Join(racers.User{ID: racers.UserID(id.Generate())}, "10K", now)
+		require.True(errors.As(err, &racers.CategoryFullError{}))
+	})
+
+	t.Run("confirms the payment once", func(t *testing.T) {
+		r := paidRace()
+
+		_, err := r.ConfirmPayment(raceCompetitor.ID, "pay_1", racers.Money{Amount: 1000, Currency: "EUR"})
+		require.True(errors.As(err, &racers.PaymentMismatchError{}))
+
+		_, err = r.ConfirmPayment(raceCompetitor.ID, "pay_2", fee)
+		require.True(errors.As(err, &racers.PaymentMismatchError{}))
+
+		changed, err := r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.NoError(err)
+		require.True(changed)
+
+		changed, err = r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.NoError(err)
+		require.False(changed)
+
+		require.Equal(racers.RegistrationConfirmed, r.Registrations[raceCompetitor.ID].Status)
+	})
+
+	t.Run("the pending registrations expire and release the spot", func(t *testing.T) {
+		r := paidRace()
+
+		require.Empty(r.ExpireRegistrations(now.Add(racers.PaymentWindow - time.Second)))
+		require.Equal([]racers.UserID{raceCompetitor.ID}, r.ExpireRegistrations(now.Add(racers.PaymentWindow)))
+		require.False(r.HasCompetitor(raceCompetitor.ID))
+		require.Empty(r.CategoryEntries)
+
+		_, err := r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.True(errors.As(err, &racers.RegistrationNotFoundError{}))
+
+		require.NoError(r.Join(racers.User{ID: racers.UserID(id.Generate())}, "10K", now))
+	})
+
+	t.Run("refunds only the confirmed registrations", func(t *testing.T) {
+		r := paidRace()
+
+		_, err := r.Refund(raceCompetitor.ID)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}))
+
+		_, err = r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.NoError(err)
+
+		reg, err := r.Refund(raceCompetitor.ID)
+		require.NoError(err)
+		require.Equal(racers.RegistrationRefundPending, reg.Status)
+		require.False(r.HasCompetitor(raceCompetitor.ID))
+		require.Equal([]racers.UserID{raceCompetitor.ID}, r.PendingRefunds())
+
+		_, err = r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}))
+
+		_, err = r.Refund(raceCompetitor.ID)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}), "refunded once")
+	})
+
+	t.Run("completes only the pending refunds", func(t *testing.T) {
+		r := paidRace()
+
+		_, err := r.ConfirmPayment(raceCompetitor.ID, "pay_1", fee)
+		require.NoError(err)
+
+		_, _, err = r.CompleteRefund(raceCompetitor.ID)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}))
+
+		_, err = r.Refund(raceCompetitor.ID)
+		require.NoError(err)
+
+		reg, changed, err := r.CompleteRefund(raceCompetitor.ID)
+		require.NoError(err)
+		require.True(changed)
+		require.Equal(racers.RegistrationRefunded, reg.Status)
+		require.Empty(r.PendingRefunds())
+
+		_, changed, err = r.CompleteRefund(raceCompetitor.ID)
+		require.NoError(err)
+		require.False(changed, "completing it again does nothing")
+	})
+}
@@ -0,0 +1,170 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// TransferPolicy is how competitors can hand their registration over to other users
+type TransferPolicy struct {
+	// Deadline is nil when the registrations can be transferred until the race starts
+	Deadline *time.Time
+}
+
+// RegistrationTransfer is the offer of a competitor to hand the registration over to another user
+type RegistrationTransfer struct {
+	To        UserID
+	OfferedAt time.Time
+}
+
+// RegistrationTransfers are the offers pending to be accepted by the competitor making them
+type RegistrationTransfers map[UserID]RegistrationTransfer
+
+// InvalidTransferDeadlineError means the transfer deadline is not before the race
+type InvalidTransferDeadlineError struct {
+	Deadline time.Time
+}
+
+func (err InvalidTransferDeadlineError) Error() string {
+	return fmt.Sprintf("transfer deadline %s must be before the race date", err.Deadline)
+}
+
+// RegistrationTransferNotAllowedError means the race does not allow transferring the registration
+type RegistrationTransferNotAllowedError struct {
+	RaceID RaceID
+	Reason string
+}
+
+func (err RegistrationTransferNotAllowedError) Error() string {
+	return fmt.Sprintf("registrations of race %s can not be transferred: %s", err.RaceID, err.Reason)
+}
+
+// RegistrationTransferNotFoundError means the competitor did not offer the registration to the user
+type RegistrationTransferNotFoundError struct {
+	RaceID RaceID
+	From   UserID
+	To     UserID
+}
+
+func (err RegistrationTransferNotFoundError) Error() string {
+	return fmt.Sprintf("competitor %s did not offer the registration in race %s to %s", err.From, err.RaceID, err.To)
+}
+
+// AllowTransfers lets the competitors transfer their registrations until the deadline, nil to allow them
+// until the race starts. It returns an error if the deadline is not before the race
+func (r *Race) AllowTransfers(deadline *time.Time) error {
+	if deadline != nil && !deadline.Before(time.Time(r.Date)) {
+		return InvalidTransferDeadlineError{*deadline}
+	}
+	r.Transfers = &TransferPolicy{Deadline: deadline}
+
+	return nil
+}
+
+// DisallowTransfers stops the transfers of the registrations, dropping the offers not accepted yet
+func (r *Race) DisallowTransfers() {
+	r.Transfers = nil
+	r.RegistrationTransfers = nil
+}
+
+// checkTransfersAllowed returns an error if the registrations can not be transferred at the given instant
+func (r Race) checkTransfersAllowed(now time.Time) error {
+	switch {
+	case r.Transfers == nil:
+		return RegistrationTransferNotAllowedError{r.ID, "transfers are disabled"}
+	case r.Status == RaceFinished || r.Status == RaceCancelled || !now.Before(time.Time(r.Date)):
+		return RegistrationTransferNotAllowedError{r.ID, "the race is over"}
+	case r.Transfers.Deadline != nil && !now.Before(*r.Transfers.Deadline):
+		return RegistrationTransferNotAllowedError{r.ID, "the transfer deadline passed"}
+	}
+
+	return nil
+}
+
+// OfferTransfer offers the registration of the competitor to another user, replacing the previous offer.
+// Registrations pending payment can not be transferred
+func (r *Race) OfferTransfer(from, to UserID, now time.Time) error {
+	if err := r.checkTransfersAllowed(now); err != nil {
+		return err
+	}
+
+	reg, ok := r.Registration(from)
+	if !ok {
+		return CompetitorNotInRaceError{r.ID, from}
+	}
+	if reg.Status != RegistrationConfirmed {
+		return InvalidRegistrationStatusError{r.ID, from, reg.Status}
+	}
+
+	if r.Competitors.is(to) {
+		return CompetitorInRaceError{r.ID, to}
+	}
+
+	if r.RegistrationTransfers == nil {
+		r.RegistrationTransfers = make(RegistrationTransfers)
+	}
+	r.RegistrationTransfers[from] = RegistrationTransfer{To: to, OfferedAt: now}
+
+	return nil
+}
+
+// WithdrawTransfer drops the offer of the competitor
+func (r *Race) WithdrawTransfer(from UserID) error {
+	if _, ok := r.RegistrationTransfers[from]; !ok {
+		return RegistrationTransferNotFoundError{r.ID, from, UserID{}}
+	}
+	delete(r.RegistrationTransfers, from)
+
+	return nil
+}
+
+// AcceptTransfer hands the registration of the competitor over to the user it was offered to, who must be
+// eligible for the category. The category, bib number, payment and team and relay entries move to the user
+func (r *Race) AcceptTransfer(from UserID, to User, now time.Time) error {
+	offer, ok := r.RegistrationTransfers[from]
+	if !ok || offer.To != to.ID {
+		return RegistrationTransferNotFoundError{r.ID, from, to.ID}
+	}
+
+	if err := r.checkTransfersAllowed(now); err != nil {
+		return err
+	}
+	if r.Competitors.is(to.ID) {
+		return CompetitorInRaceError{r.ID, to.ID}
+	}
+
+	if category, ok := r.CategoryEntries[from]; ok {
+		c, _ := r.Categories.Get(category)
+		if err := c.checkEligibility(to, r.Date); err != nil {
+			return err
+		}
+		r.CategoryEntries[to.ID] = category
+	}
+	if bib, ok := r.BibEntries[from]; ok {
+		r.setBib(to.ID, bib)
+	}
+	if reg, ok := r.Registrations[from]; ok {
+		r.Registrations[to.ID] = reg
+		delete(r.Registrations, from)
+	}
+	for _, members := range r.TeamEntries {
+		replace(members, from, to.ID)
+	}
+	for _, entry := range r.RelayEntries {
+		replace(entry.Runners, from, to.ID)
+	}
+
+	r.withdraw(from)
+	r.Competitors.add(to.ID)
+
+	return nil
+}
+
+// replace changes the user in the list for the other
+func replace(users []UserID, from, to UserID) {
+	for i, u := range users {
+		if u == from {
+			users[i] = to
+		}
+	}
+}
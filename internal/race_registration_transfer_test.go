@@ -0,0 +1,110 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationTransfer(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+	date := now.AddDate(0, 1, 0)
+	from := racers.User{ID: racers.UserID(id.Generate()), Gender: racers.GenderFemale}
+	to := racers.User{ID: racers.UserID(id.Generate()), Gender: racers.GenderFemale}
+	team := racers.TeamID(id.Generate())
+
+	newRace := func() racers.Race {
+		r := racers.Race{
+			ID:          racers.RaceID(id.Generate()),
+			Date:        racers.RaceDate(date),
+			Categories:  racers.RaceCategories{{Name: "10K", Eligibility: racers.CategoryEligibility{Gender: racers.GenderFemale}}},
+			Bibs:        &racers.RaceBibs{Strategy: racers.BibStrategySequential, First: 100},
+			TeamEntries: racers.RaceTeamEntries{team: {from.ID}},
+		}
+		require.NoError(r.Join(from, "10K", now))
+		require.NoError(r.AllowTransfers(nil))
+
+		return r
+	}
+
+	t.Run("when the deadline is not before the race returns InvalidTransferDeadlineError", func(t *testing.T) {
+		r := newRace()
+
+		err := r.AllowTransfers(&date)
+		require.True(errors.As(err, &racers.InvalidTransferDeadlineError{}))
+	})
+
+	t.Run("when the transfers are disabled or the deadline passed returns RegistrationTransferNotAllowedError", func(t *testing.T) {
+		r := newRace()
+		r.DisallowTransfers()
+
+		err := r.OfferTransfer(from.ID, to.ID, now)
+		require.True(errors.As(err, &racers.RegistrationTransferNotAllowedError{}))
+
+		deadline := now.Add(time.Hour)
+		require.NoError(r.AllowTransfers(&deadline))
+		require.NoError(r.OfferTransfer(from.ID, to.ID, now))
+
+		err = r.AcceptTransfer(from.ID, to, deadline)
+		require.True(errors.As(err, &racers.RegistrationTransferNotAllowedError{}))
+	})
+
+	t.Run("only the competitors with a confirmed registration offer it to users not in the race", func(t *testing.T) {
+		r := newRace()
+
+		err := r.OfferTransfer(to.ID, from.ID, now)
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+
+		err = r.OfferTransfer(from.ID, from.ID, now)
+		require.True(errors.As(err, &racers.CompetitorInRaceError{}))
+
+		r.Price = &racers.RacePrice{Currency: "EUR", Amount: 2000}
+		require.NoError(r.Join(to, "10K", now))
+		err = r.OfferTransfer(to.ID, racers.UserID(id.Generate()), now)
+		require.True(errors.As(err, &racers.InvalidRegistrationStatusError{}))
+	})
+
+	t.Run("only the user offered accepts it", func(t *testing.T) {
+		r := newRace()
+		require.NoError(r.OfferTransfer(from.ID, to.ID, now))
+
+		err := r.AcceptTransfer(from.ID, racers.User{ID: racers.UserID(id.Generate())}, now)
+		require.True(errors.As(err, &racers.RegistrationTransferNotFoundError{}))
+
+		require.NoError(r.WithdrawTransfer(from.ID))
+		err = r.AcceptTransfer(from.ID, to, now)
+		require.True(errors.As(err, &racers.RegistrationTransferNotFoundError{}))
+	})
+
+	t.Run("when the user is not eligible returns NotEligibleError", func(t *testing.T) {
+		r := newRace()
+		male := racers.User{ID: racers.UserID(id.Generate()), Gender: racers.GenderMale}
+		require.NoError(r.OfferTransfer(from.ID, male.ID, now))
+
+		err := r.AcceptTransfer(from.ID, male, now)
+		require.True(errors.As(err, &racers.NotEligibleError{}))
+		require.True(r.HasCompetitor(from.ID))
+	})
+
+	t.Run("moves the category, bib and team entry to the user", func(t *testing.T) {
+		r := newRace()
+		bib := r.BibEntries[from.ID]
+		require.NoError(r.OfferTransfer(from.ID, to.ID, now))
+
+		require.NoError(r.AcceptTransfer(from.ID, to, now))
+
+		require.False(r.HasCompetitor(from.ID))
+		require.True(r.HasCompetitor(to.ID))
+		require.Equal(racers.CategoryName("10K"), r.CategoryEntries[to.ID])
+		require.Equal(bib, r.BibEntries[to.ID])
+		require.Equal([]racers.UserID{to.ID}, r.TeamEntries[team])
+		require.Empty(r.RegistrationTransfers)
+	})
+}
@@ -0,0 +1,298 @@
+package racers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	// RelayLegName defines the name of a relay leg
+	RelayLegName             string
+	InvalidRelayLegNameError struct{ error }
+)
+
+func (err InvalidRelayLegNameError) Error() string {
+	return fmt.Sprintf("invalid relay leg name: %s", err.error)
+}
+
+// NewRelayLegName validates the name and returns a RelayLegName instance
+func NewRelayLegName(s string) (RelayLegName, error) {
+	if s == "" {
+		return "", InvalidRelayLegNameError{errors.New("empty name")}
+	}
+
+	return RelayLegName(s), nil
+}
+
+// RelayLeg is one of the sections of a relay race, run by one team member
+type RelayLeg struct {
+	Name     RelayLegName
+	Distance Distance
+}
+
+// InvalidRelayLegsError means a relay does not have enough legs
+type InvalidRelayLegsError struct{ Legs int }
+
+func (err InvalidRelayLegsError) Error() string {
+	return fmt.Sprintf("a relay needs at least 2 legs, got %d", err.Legs)
+}
+
+// RaceRelay is the configuration of a relay race, legs are run in order
+type RaceRelay struct {
+	Legs []RelayLeg
+	// AllowRepeatRunners allows a member to run more than one leg
+	AllowRepeatRunners bool
+}
+
+// NewRaceRelay validates the legs of a relay and returns a RaceRelay instance
+func NewRaceRelay(legs []RelayLeg, allowRepeatRunners bool) (RaceRelay, error) {
+	if len(legs) < 2 {
+		return RaceRelay{}, InvalidRelayLegsError{len(legs)}
+	}
+
+	return RaceRelay{Legs: legs, AllowRepeatRunners: allowRepeatRunners}, nil
+}
+
+// RelayEntry is the line-up of a team in a relay, the runner and split time of each leg
+type RelayEntry struct {
+	Runners []UserID
+	// Splits are the times of each leg, zero when not recorded yet
+	Splits []RaceTime
+}
+
+// RelayEntries are the teams running a relay race
+type RelayEntries map[TeamID]RelayEntry
+
+// teamOf returns the team a competitor runs for
+func (e RelayEntries) teamOf(competitor UserID) (TeamID, bool) {
+	for team, entry := range e {
+		for _, r := range entry.Runners {
+			if r == competitor {
+				return team, true
+			}
+		}
+	}
+
+	return TeamID{}, false
+}
+
+// NotRelayRaceError means the race is not a relay
+type NotRelayRaceError struct{ RaceID RaceID }
+
+func (err NotRelayRaceError) Error() string {
+	return fmt.Sprintf("race %s is not a relay", err.RaceID)
+}
+
+// IncompleteRelayLineUpError means the line-up does not have one runner per leg
+type IncompleteRelayLineUpError struct {
+	RaceID  RaceID
+	Legs    int
+	Runners int
+}
+
+func (err IncompleteRelayLineUpError) Error() string {
+	return fmt.Sprintf("relay %s has %d legs, line-up has %d runners", err.RaceID, err.Legs, err.Runners)
+}
+
+// RunnerInSeveralLegsError means the runner is assigned to more than one leg and the relay does not allow it
+type RunnerInSeveralLegsError struct {
+	RaceID   RaceID
+	RunnerID UserID
+}
+
+func (err RunnerInSeveralLegsError) Error() string {
+	return fmt.Sprintf("runner %s cannot run more than one leg in relay %s", err.RunnerID, err.RaceID)
+}
+
+// SetRelayLineUp assigns, by the team admin, one team member to each leg of the relay in legs order.
+// Setting it again replaces the previous line-up, the split of a leg is reset when its runner changes.
+func (r *Race) SetRelayLineUp(team Team, by User, runners ...UserID) error {
+	if r.Relay == nil {
+		return NotRelayRaceError{r.ID}
+	}
+
+	if team.Admin != by.ID {
+		return NotTeamAdminError{team.ID, by.ID}
+	}
+
+	if len(runners) != len(r.Relay.Legs) {
+		return IncompleteRelayLineUpError{r.ID, len(r.Relay.Legs), len(runners)}
+	}
+
+	var seen userList
+	for _, runner := range runners {
+		if seen.is(runner) && !r.Relay.AllowRepeatRunners {
+			return RunnerInSeveralLegsError{r.ID, runner}
+		}
+		if !team.Members.is(runner) {
+			return NotTeamMemberError{team.ID, runner}
+		}
+		if !r.Competitors.is(runner) {
+			return CompetitorNotInRaceError{r.ID, runner}
+		}
+		if other, ok := r.RelayEntries.teamOf(runner); ok && other != team.ID {
+			return CompetitorInRaceTeamError{r.ID, runner, other}
+		}
+		seen.add(runner)
+	}
+
+	entry := r.RelayEntries[team.ID]
+	// the split of a leg is kept only while the same runner runs it
+	splits := make([]RaceTime, len(runners))
+	for i, runner := range runners {
+		if i < len(entry.Runners) && i < len(entry.Splits) && entry.Runners[i] == runner {
+			splits[i] = entry.Splits[i]
+		}
+	}
+	entry.Runners, entry.Splits = append([]UserID(nil), runners...), splits
+
+	if r.RelayEntries == nil {
+		r.RelayEntries = make(RelayEntries)
+	}
+	r.RelayEntries[team.ID] = entry
+
+	return nil
+}
+
+// TeamNotInRelayError means the team has not a line-up in the relay
+type TeamNotInRelayError struct {
+	RaceID RaceID
+	TeamID TeamID
+}
+
+func (err TeamNotInRelayError) Error() string {
+	return fmt.Sprintf("team %s is not running relay %s", err.TeamID, err.RaceID)
+}
+
+// UnknownRelayLegError means the leg does not exist in the relay
+type UnknownRelayLegError struct {
+	RaceID RaceID
+	Leg    int
+}
+
+func (err UnknownRelayLegError) Error() string {
+	return fmt.Sprintf("relay %s has no leg %d", err.RaceID, err.Leg)
+}
+
+// RecordLegSplit sets the time a team took to run a leg, legs are numbered from zero
+func (r *Race) RecordLegSplit(team TeamID, leg int, t RaceTime) error {
+	if r.Relay == nil {
+		return NotRelayRaceError{r.ID}
+	}
+
+	entry, ok := r.RelayEntries[team]
+	if !ok {
+		return TeamNotInRelayError{r.ID, team}
+	}
+
+	if leg < 0 || leg >= len(r.Relay.Legs) {
+		return UnknownRelayLegError{r.ID, leg}
+	}
+
+	entry.Splits[leg] = t
+
+	return nil
+}
+
+// RelayLegResult is the classification of a team in a relay leg
+type RelayLegResult struct {
+	Position int
+	Team     TeamID
+	Runner   UserID
+	Time     RaceTime
+}
+
+// RelayLegRanking returns the teams that completed the leg sorted by their split time
+func (r Race) RelayLegRanking(leg int) []RelayLegResult {
+	if r.Relay == nil || leg < 0 || leg >= len(r.Relay.Legs) {
+		return nil
+	}
+
+	var ranking []RelayLegResult
+	for team, entry := range r.RelayEntries {
+		if entry.Splits[leg] == 0 {
+			continue
+		}
+		ranking = append(ranking, RelayLegResult{Team: team, Runner: entry.Runners[leg], Time: entry.Splits[leg]})
+	}
+
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Time != ranking[j].Time {
+			return ranking[i].Time < ranking[j].Time
+		}
+
+		return ranking[i].Team.String() < ranking[j].Team.String()
+	})
+
+	for i := range ranking {
+		ranking[i].Position = i + 1
+		if i > 0 && ranking[i].Time == ranking[i-1].Time {
+			ranking[i].Position = ranking[i-1].Position
+		}
+	}
+
+	return ranking
+}
+
+// RelayStanding is the classification of a team in a relay
+type RelayStanding struct {
+	// Position is zero when the team has not completed all the legs
+	Position      int
+	Team          TeamID
+	Time          time.Duration
+	LegsCompleted int
+}
+
+// Complete returns if the team has run all the legs
+func (s RelayStanding) Complete() bool {
+	return s.Position > 0
+}
+
+// RelayStandings returns the teams sorted by their total time, teams that have not completed
+// the relay go last sorted by the legs completed
+func (r Race) RelayStandings() []RelayStanding {
+	if r.Relay == nil {
+		return nil
+	}
+
+	standings := make([]RelayStanding, 0, len(r.RelayEntries))
+	for team, entry := range r.RelayEntries {
+		s := RelayStanding{Team: team}
+		for _, split := range entry.Splits {
+			if split == 0 {
+				continue
+			}
+			s.LegsCompleted++
+			s.Time += time.Duration(split)
+		}
+		standings = append(standings, s)
+	}
+
+	legs := len(r.Relay.Legs)
+	sort.Slice(standings, func(i, j int) bool {
+		si, sj := standings[i], standings[j]
+		switch {
+		case si.LegsCompleted != sj.LegsCompleted:
+			return si.LegsCompleted > sj.LegsCompleted
+		case si.Time != sj.Time:
+			return si.Time < sj.Time
+		}
+
+		return si.Team.String() < sj.Team.String()
+	})
+
+	for i := range standings {
+		if standings[i].LegsCompleted < legs {
+			break
+		}
+
+		standings[i].Position = i + 1
+		if i > 0 && standings[i].Time == standings[i-1].Time {
+			standings[i].Position = standings[i-1].Position
+		}
+	}
+
+	return standings
+}
@@ -0,0 +1,194 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestRelayLegName(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewRelayLegName("")
+	require.True(errors.As(err, &racers.InvalidRelayLegNameError{}))
+
+	name, err := racers.NewRelayLegName("first leg")
+	require.NoError(err)
+	require.Equal(racers.RelayLegName("first leg"), name)
+}
+
+func TestRaceRelay(t *testing.T) {
+	require := require.New(t)
+	leg := racers.RelayLeg{Name: "leg", Distance: 400}
+
+	_, err := racers.NewRaceRelay([]racers.RelayLeg{leg}, false)
+	require.True(errors.As(err, &racers.InvalidRelayLegsError{}))
+
+	relay, err := racers.NewRaceRelay([]racers.RelayLeg{leg, leg}, true)
+	require.NoError(err)
+	require.Equal(racers.RaceRelay{Legs: []racers.RelayLeg{leg, leg}, AllowRepeatRunners: true}, relay)
+}
+
+func relayRace(allowRepeat bool, competitors ...racers.UserID) racers.Race {
+	return racers.Race{
+		ID:          raceID,
+		Name:        raceName,
+		Date:        raceDate,
+		Owner:       ownerID,
+		Competitors: racers.NewRaceCompetitors(competitors...),
+		Relay: &racers.RaceRelay{
+			Legs: []racers.RelayLeg{
+				{Name: "first", Distance: 5000},
+				{Name: "second", Distance: 10000},
+			},
+			AllowRepeatRunners: allowRepeat,
+		},
+	}
+}
+
+func TestRaceSetRelayLineUp(t *testing.T) {
+	require := require.New(t)
+
+	admin := racers.User{ID: teamAdminID}
+	member := racers.UserID(id.Generate())
+	team := racers.NewTeam(teamID, teamName, admin.ID, racers.TeamMembersOpt(racers.NewTeamMembers(admin.ID, member)))
+
+	t.Run("Given a race that is not a relay, returns NotRelayRaceError", func(t *testing.T) {
+		r := racers.Race{ID: raceID, Competitors: racers.NewRaceCompetitors(admin.ID, member)}
+
+		err := r.SetRelayLineUp(team, admin, admin.ID, member)
+		require.True(errors.As(err, &racers.NotRelayRaceError{}))
+	})
+
+	t.Run("When set by a user that is not the team admin, returns NotTeamAdminError", func(t *testing.T) {
+		r := relayRace(false, admin.ID, member)
+
+		err := r.SetRelayLineUp(team, racers.User{ID: member}, admin.ID, member)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When a leg has no runner, returns IncompleteRelayLineUpError", func(t *testing.T) {
+		r := relayRace(false, admin.ID, member)
+
+		err := r.SetRelayLineUp(team, admin, admin.ID)
+		require.True(errors.As(err, &racers.IncompleteRelayLineUpError{}))
+	})
+
+	t.Run("When a member runs two legs and it is not allowed, returns RunnerInSeveralLegsError", func(t *testing.T) {
+		r := relayRace(false, admin.ID, member)
+
+		err := r.SetRelayLineUp(team, admin, member, member)
+		require.True(errors.As(err, &racers.RunnerInSeveralLegsError{}))
+	})
+
+	t.Run("When a member runs two legs and it is allowed, sets the line-up", func(t *testing.T) {
+		r := relayRace(true, admin.ID, member)
+
+		require.NoError(r.SetRelayLineUp(team, admin, member, member))
+		require.Equal([]racers.UserID{member, member}, r.RelayEntries[teamID].Runners)
+	})
+
+	t.Run("When a runner is not a race competitor, returns CompetitorNotInRaceError", func(t *testing.T) {
+		r := relayRace(false, admin.ID)
+
+		err := r.SetRelayLineUp(team, admin, admin.ID, member)
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+	})
+
+	t.Run("When a runner is not a team member, returns NotTeamMemberError", func(t *testing.T) {
+		outsider := racers.UserID(id.Generate())
+		r := relayRace(false, admin.ID, outsider)
+
+		err := r.SetRelayLineUp(team, admin, admin.ID, outsider)
+		require.True(errors.As(err, &racers.NotTeamMemberError{}))
+	})
+
+	t.Run("When the line-up is set again with the same runners, keeps the splits", func(t *testing.T) {
+		r := relayRace(false, admin.ID, member)
+		require.NoError(r.SetRelayLineUp(team, admin, admin.ID, member))
+		require.NoError(r.RecordLegSplit(teamID, 0, racers.RaceTime(time.Minute)))
+
+		require.NoError(r.SetRelayLineUp(team, admin, admin.ID, member))
+
+		require.Equal(racers.RelayEntry{
+			Runners: []racers.UserID{admin.ID, member},
+			Splits:  []racers.RaceTime{racers.RaceTime(time.Minute), 0},
+		}, r.RelayEntries[teamID])
+	})
+
+	t.Run("When the runner of a leg changes, replaces the runners and resets the split of the leg", func(t *testing.T) {
+		r := relayRace(true, admin.ID, member)
+		require.NoError(r.SetRelayLineUp(team, admin, admin.ID, member))
+		require.NoError(r.RecordLegSplit(teamID, 0, racers.RaceTime(time.Minute)))
+		require.NoError(r.RecordLegSplit(teamID, 1, racers.RaceTime(2*time.Minute)))
+
+		require.NoError(r.SetRelayLineUp(team, admin, member, member))
+
+		require.Equal(racers.RelayEntry{
+			Runners: []racers.UserID{member, member},
+			Splits:  []racers.RaceTime{0, racers.RaceTime(2 * time.Minute)},
+		}, r.RelayEntries[teamID])
+	})
+}
+
+func TestRaceRecordLegSplit(t *testing.T) {
+	require := require.New(t)
+
+	runner := racers.UserID(id.Generate())
+
+	t.Run("When the team has no line-up, returns TeamNotInRelayError", func(t *testing.T) {
+		r := relayRace(true, runner)
+
+		err := r.RecordLegSplit(teamID, 0, racers.RaceTime(time.Minute))
+		require.True(errors.As(err, &racers.TeamNotInRelayError{}))
+	})
+
+	t.Run("When the leg does not exist, returns UnknownRelayLegError", func(t *testing.T) {
+		r := relayRace(true, runner)
+		r.RelayEntries = racers.RelayEntries{teamID: {Runners: []racers.UserID{runner, runner}, Splits: make([]racers.RaceTime, 2)}}
+
+		err := r.RecordLegSplit(teamID, 2, racers.RaceTime(time.Minute))
+		require.True(errors.As(err, &racers.UnknownRelayLegError{}))
+	})
+}
+
+func TestRelayRankings(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		teamA, teamB, teamC = racers.TeamID(id.Generate()), racers.TeamID(id.Generate()), racers.TeamID(id.Generate())
+		a1, a2              = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+		b1, b2              = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+		c1, c2              = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	)
+
+	r := relayRace(false, a1, a2, b1, b2, c1, c2)
+	r.RelayEntries = racers.RelayEntries{
+		teamA: {Runners: []racers.UserID{a1, a2}, Splits: []racers.RaceTime{racers.RaceTime(20 * time.Minute), racers.RaceTime(40 * time.Minute)}},
+		teamB: {Runners: []racers.UserID{b1, b2}, Splits: []racers.RaceTime{racers.RaceTime(18 * time.Minute), racers.RaceTime(38 * time.Minute)}},
+		teamC: {Runners: []racers.UserID{c1, c2}, Splits: []racers.RaceTime{racers.RaceTime(15 * time.Minute), 0}},
+	}
+
+	t.Run("leg ranking sorts the teams by split time", func(t *testing.T) {
+		require.Equal([]racers.RelayLegResult{
+			{Position: 1, Team: teamC, Runner: c1, Time: racers.RaceTime(15 * time.Minute)},
+			{Position: 2, Team: teamB, Runner: b1, Time: racers.RaceTime(18 * time.Minute)},
+			{Position: 3, Team: teamA, Runner: a1, Time: racers.RaceTime(20 * time.Minute)},
+		}, r.RelayLegRanking(0))
+
+		require.Len(r.RelayLegRanking(1), 2)
+		require.Empty(r.RelayLegRanking(5))
+	})
+
+	t.Run("standings sort by total time and leave incomplete teams unranked", func(t *testing.T) {
+		require.Equal([]racers.RelayStanding{
+			{Position: 1, Team: teamB, Time: 56 * time.Minute, LegsCompleted: 2},
+			{Position: 2, Team: teamA, Time: 60 * time.Minute, LegsCompleted: 2},
+			{Position: 0, Team: teamC, Time: 15 * time.Minute, LegsCompleted: 1},
+		}, r.RelayStandings())
+	})
+}
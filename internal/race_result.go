@@ -0,0 +1,142 @@
+package racers
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// RaceTime is the time a competitor took to finish a race
+	RaceTime time.Duration
+	// InvalidRaceTimeError means the given time is not a valid finish time
+	InvalidRaceTimeError struct{ Value string }
+)
+
+func (err InvalidRaceTimeError) Error() string {
+	return fmt.Sprintf("invalid race time: %s", err.Value)
+}
+
+// NewRaceTime validates the duration and returns a RaceTime instance
+func NewRaceTime(d time.Duration) (RaceTime, error) {
+	if d <= 0 {
+		return 0, InvalidRaceTimeError{d.String()}
+	}
+
+	return RaceTime(d.Truncate(time.Millisecond)), nil
+}
+
+// ParseRaceTime parses a time in the format used by timing systems, [[hh:]mm:]ss[.sss]
+func ParseRaceTime(s string) (RaceTime, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) > 3 {
+		return 0, InvalidRaceTimeError{s}
+	}
+
+	var (
+		d    time.Duration
+		secs int
+	)
+	for i, p := range parts {
+		if i == len(parts)-1 {
+			f, err := strconv.ParseFloat(p, 64)
+			if err != nil || f < 0 || (len(parts) > 1 && f >= 60) {
+				return 0, InvalidRaceTimeError{s}
+			}
+			d = time.Duration(secs)*time.Second + time.Duration(math.Round(f*1000))*time.Millisecond
+			break
+		}
+
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || (i > 0 && n >= 60) {
+			return 0, InvalidRaceTimeError{s}
+		}
+		secs = (secs + n) * 60
+	}
+
+	t, err := NewRaceTime(d)
+	if err != nil {
+		return 0, InvalidRaceTimeError{s}
+	}
+
+	return t, nil
+}
+
+// String formats the time as h:mm:ss.sss
+func (t RaceTime) String() string {
+	d := time.Duration(t)
+	h := d / time.Hour
+	d -= h * time.Hour
+	m := d / time.Minute
+	d -= m * time.Minute
+	s := d / time.Second
+	d -= s * time.Second
+
+	if d == 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+
+	return fmt.Sprintf("%d:%02d:%02d.%03d", h, m, s, d/time.Millisecond)
+}
+
+// RaceResults are the finish times of the competitors of a race
+type RaceResults map[UserID]RaceTime
+
+// RaceResult is the classification of a competitor in a race
+type RaceResult struct {
+	Position   int
+	Competitor UserID
+	Time       RaceTime
+}
+
+// Ranking returns the results sorted by time, competitors with the same time share the position
+func (rr RaceResults) Ranking() []RaceResult {
+	ranking := make([]RaceResult, 0, len(rr))
+	for c, t := range rr {
+		ranking = append(ranking, RaceResult{Competitor: c, Time: t})
+	}
+
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Time != ranking[j].Time {
+			return ranking[i].Time < ranking[j].Time
+		}
+
+		return ranking[i].Competitor.String() < ranking[j].Competitor.String()
+	})
+
+	for i := range ranking {
+		ranking[i].Position = i + 1
+		if i > 0 && ranking[i].Time == ranking[i-1].Time {
+			ranking[i].Position = ranking[i-1].Position
+		}
+	}
+
+	return ranking
+}
+
+// CompetitorNotInRaceError means the user is not a competitor of the race
+type CompetitorNotInRaceError struct {
+	RaceID       RaceID
+	CompetitorID UserID
+}
+
+func (err CompetitorNotInRaceError) Error() string {
+	return fmt.Sprintf("competitor %s has not joined race %s", err.CompetitorID, err.RaceID)
+}
+
+// Finish records the finish time of a competitor, recording it again overrides the previous time
+func (r *Race) Finish(competitor UserID, t RaceTime) error {
+	if !r.Competitors.is(competitor) {
+		return CompetitorNotInRaceError{r.ID, competitor}
+	}
+
+	if r.Results == nil {
+		r.Results = make(RaceResults)
+	}
+	r.Results[competitor] = t
+
+	return nil
+}
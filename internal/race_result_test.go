@@ -0,0 +1,109 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestRaceTime(t *testing.T) {
+	require := require.New(t)
+	t.Run("when New with not positive duration returns InvalidRaceTimeError error", func(t *testing.T) {
+		_, err := racers.NewRaceTime(0)
+		require.True(errors.As(err, &racers.InvalidRaceTimeError{}))
+	})
+
+	t.Run("when New with valid duration returns RaceTime truncated to milliseconds", func(t *testing.T) {
+		rt, err := racers.NewRaceTime(time.Minute + time.Millisecond + time.Microsecond)
+
+		require.NoError(err)
+		require.Equal(racers.RaceTime(time.Minute+time.Millisecond), rt)
+	})
+}
+
+func TestParseRaceTime(t *testing.T) {
+	require := require.New(t)
+	for in, expected := range map[string]time.Duration{
+		"1:02:03":     time.Hour + 2*time.Minute + 3*time.Second,
+		"02:03.25":    2*time.Minute + 3250*time.Millisecond,
+		"59.3":        59300 * time.Millisecond,
+		" 0:41:07.1 ": 41*time.Minute + 7100*time.Millisecond,
+	} {
+		t.Run(in, func(t *testing.T) {
+			rt, err := racers.ParseRaceTime(in)
+
+			require.NoError(err)
+			require.Equal(racers.RaceTime(expected), rt)
+		})
+	}
+
+	for _, in := range []string{"", "abc", "1:60:00", "1:2:3:4", "0:00", "-1"} {
+		t.Run(in, func(t *testing.T) {
+			_, err := racers.ParseRaceTime(in)
+			require.True(errors.As(err, &racers.InvalidRaceTimeError{}))
+		})
+	}
+}
+
+func TestRaceTimeString(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("1:02:03", racers.RaceTime(time.Hour+2*time.Minute+3*time.Second).String())
+	require.Equal("0:00:59.300", racers.RaceTime(59300*time.Millisecond).String())
+}
+
+func TestRaceResultsRanking(t *testing.T) {
+	require := require.New(t)
+
+	first, tied, last := racers.UserID(id.Generate()), racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	results := racers.RaceResults{
+		last:  racers.RaceTime(3 * time.Hour),
+		first: racers.RaceTime(2 * time.Hour),
+		tied:  racers.RaceTime(2 * time.Hour),
+	}
+
+	ranking := results.Ranking()
+
+	require.Len(ranking, 3)
+	require.Equal(1, ranking[0].Position)
+	require.Equal(1, ranking[1].Position)
+	require.ElementsMatch([]racers.UserID{first, tied}, []racers.UserID{ranking[0].Competitor, ranking[1].Competitor})
+	require.Equal(racers.RaceResult{Position: 3, Competitor: last, Time: racers.RaceTime(3 * time.Hour)}, ranking[2])
+}
+
+func TestRaceFinish(t *testing.T) {
+	require := require.New(t)
+
+	t.Run(`Given a race without the competitor,
+	When records the finish time,
+	Then returns CompetitorNotInRaceError error`, func(t *testing.T) {
+		r := racers.Race{ID: raceID, Name: raceName, Date: raceDate, Owner: ownerID}
+
+		err := r.Finish(raceCompetitor.ID, racers.RaceTime(time.Hour))
+
+		var notInRaceErr racers.CompetitorNotInRaceError
+		require.True(errors.As(err, &notInRaceErr))
+		require.Equal(raceCompetitor.ID, notInRaceErr.CompetitorID)
+	})
+
+	t.Run(`Given a race with the competitor,
+	When records the finish time twice,
+	Then keeps the last one`, func(t *testing.T) {
+		r := racers.Race{
+			ID:          raceID,
+			Name:        raceName,
+			Date:        raceDate,
+			Owner:       ownerID,
+			Competitors: racers.NewRaceCompetitors(raceCompetitor.ID),
+		}
+
+		require.NoError(r.Finish(raceCompetitor.ID, racers.RaceTime(time.Hour)))
+		require.NoError(r.Finish(raceCompetitor.ID, racers.RaceTime(2*time.Hour)))
+
+		require.Equal(racers.RaceResults{raceCompetitor.ID: racers.RaceTime(2 * time.Hour)}, r.Results)
+	})
+}
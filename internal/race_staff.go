@@ -0,0 +1,146 @@
+package racers
+
+import "fmt"
+
+type (
+	// StaffRole is what a user does in the organization of a race
+	StaffRole             string
+	InvalidStaffRoleError struct{ Value string }
+)
+
+const (
+	// StaffOwner is the user the race belongs to, it is not granted but transferred
+	StaffOwner StaffRole = "OWNER"
+	// StaffCoOrganizer edits the race and helps on race day
+	StaffCoOrganizer StaffRole = "CO_ORGANIZER"
+	// StaffTimekeeper enters the results and timing passages
+	StaffTimekeeper StaffRole = "TIMEKEEPER"
+	// StaffVolunteer checks the competitors in at the packet pickup
+	StaffVolunteer StaffRole = "VOLUNTEER"
+)
+
+func (err InvalidStaffRoleError) Error() string {
+	return fmt.Sprintf("invalid staff role: %s", err.Value)
+}
+
+// NewStaffRole validates the role can be granted and returns a StaffRole instance
+func NewStaffRole(s string) (StaffRole, error) {
+	switch r := StaffRole(s); r {
+	case StaffCoOrganizer, StaffTimekeeper, StaffVolunteer:
+		return r, nil
+	}
+
+	return "", InvalidStaffRoleError{s}
+}
+
+// Permission is an action on a race restricted to its staff
+type Permission string
+
+const (
+	// PermissionEdit changes the race details, schedule, course, bibs and registration policies
+	PermissionEdit Permission = "EDIT"
+	// PermissionResults records the results, passages and relay splits
+	PermissionResults Permission = "RESULTS"
+	// PermissionCheckIn checks the competitors in on race day
+	PermissionCheckIn Permission = "CHECK_IN"
+	// PermissionStaff grants and revokes the staff roles and transfers the ownership
+	PermissionStaff Permission = "STAFF"
+)
+
+// rolePermissions are the permissions of each staff role
+var rolePermissions = map[StaffRole][]Permission{
+	StaffOwner:       {PermissionEdit, PermissionResults, PermissionCheckIn, PermissionStaff},
+	StaffCoOrganizer: {PermissionEdit, PermissionResults, PermissionCheckIn},
+	StaffTimekeeper:  {PermissionResults},
+	StaffVolunteer:   {PermissionCheckIn},
+}
+
+// RaceStaff are the roles of the users besides the owner that help organizing the race
+type RaceStaff map[UserID]StaffRole
+
+// StaffInRaceError means the user already has the role in the race
+type StaffInRaceError struct {
+	RaceID RaceID
+	UserID UserID
+	Role   StaffRole
+}
+
+func (err StaffInRaceError) Error() string {
+	return fmt.Sprintf("user %s is already %s of race %s", err.UserID, err.Role, err.RaceID)
+}
+
+// StaffNotInRaceError means the user is not staff of the race
+type StaffNotInRaceError struct {
+	RaceID RaceID
+	UserID UserID
+}
+
+func (err StaffNotInRaceError) Error() string {
+	return fmt.Sprintf("user %s is not staff of race %s", err.UserID, err.RaceID)
+}
+
+// Role returns the role of the user in the race, false when the user is not staff
+func (r Race) Role(u UserID) (StaffRole, bool) {
+	if u == r.Owner {
+		return StaffOwner, true
+	}
+	role, ok := r.Staff[u]
+
+	return role, ok
+}
+
+// Can returns if the role of the user in the race has the permission
+func (r Race) Can(u UserID, p Permission) bool {
+	role, ok := r.Role(u)
+	if !ok {
+		return false
+	}
+
+	for _, rp := range rolePermissions[role] {
+		if rp == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddStaff grants the role to the user, replacing its previous role
+func (r *Race) AddStaff(u UserID, role StaffRole) error {
+	if current, ok := r.Role(u); ok && (current == role || current == StaffOwner) {
+		return StaffInRaceError{r.ID, u, current}
+	}
+	if r.Staff == nil {
+		r.Staff = make(RaceStaff)
+	}
+	r.Staff[u] = role
+
+	return nil
+}
+
+// RemoveStaff revokes the role of the user and returns it, the owner can not be removed
+func (r *Race) RemoveStaff(u UserID) (StaffRole, error) {
+	role, ok := r.Staff[u]
+	if !ok {
+		return "", StaffNotInRaceError{r.ID, u}
+	}
+	delete(r.Staff, u)
+
+	return role, nil
+}
+
+// TransferOwnership hands the race over to the user, the previous owner stays as co-organizer
+func (r *Race) TransferOwnership(to UserID) error {
+	if to == r.Owner {
+		return StaffInRaceError{r.ID, to, StaffOwner}
+	}
+
+	delete(r.Staff, to)
+	if r.Staff == nil {
+		r.Staff = make(RaceStaff)
+	}
+	r.Staff[r.Owner] = StaffCoOrganizer
+	r.Owner = to
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStaffRole(t *testing.T) {
+	require := require.New(t)
+
+	role, err := racers.NewStaffRole("TIMEKEEPER")
+	require.NoError(err)
+	require.Equal(racers.StaffTimekeeper, role)
+
+	_, err = racers.NewStaffRole("OWNER")
+	require.True(errors.As(err, &racers.InvalidStaffRoleError{}), "the ownership is transferred, not granted")
+}
+
+func TestRaceStaff(t *testing.T) {
+	owner := racers.UserID(id.Generate())
+	user := racers.UserID(id.Generate())
+
+	newRace := func() racers.Race {
+		return racers.Race{ID: racers.RaceID(id.Generate()), Owner: owner}
+	}
+
+	t.Run("the permissions depend on the role", func(t *testing.T) {
+		require := require.New(t)
+		r := newRace()
+
+		require.True(r.Can(owner, racers.PermissionStaff))
+		require.False(r.Can(user, racers.PermissionCheckIn))
+
+		require.NoError(r.AddStaff(user, racers.StaffVolunteer))
+		require.True(r.Can(user, racers.PermissionCheckIn))
+		require.False(r.Can(user, racers.PermissionResults))
+
+		require.NoError(r.AddStaff(user, racers.StaffTimekeeper))
+		require.True(r.Can(user, racers.PermissionResults))
+		require.False(r.Can(user, racers.PermissionEdit))
+
+		require.NoError(r.AddStaff(user, racers.StaffCoOrganizer))
+		require.True(r.Can(user, racers.PermissionEdit))
+		require.False(r.Can(user, racers.PermissionStaff))
+	})
+
+	t.Run("when the user already has the role returns StaffInRaceError", func(t *testing.T) {
+		require := require.New(t)
+		r := newRace()
+		require.NoError(r.AddStaff(user, racers.StaffVolunteer))
+
+		require.True(errors.As(r.AddStaff(user, racers.StaffVolunteer), &racers.StaffInRaceError{}))
+		require.True(errors.As(r.AddStaff(owner, racers.StaffVolunteer), &racers.StaffInRaceError{}))
+	})
+
+	t.Run("when the user is not staff returns StaffNotInRaceError", func(t *testing.T) {
+		require := require.New(t)
+		r := newRace()
+		require.NoError(r.AddStaff(user, racers.StaffVolunteer))
+
+		_, err := r.RemoveStaff(owner)
+		require.True(errors.As(err, &racers.StaffNotInRaceError{}))
+
+		role, err := r.RemoveStaff(user)
+		require.NoError(err)
+		require.Equal(racers.StaffVolunteer, role)
+		require.False(r.Can(user, racers.PermissionCheckIn))
+	})
+
+	t.Run("transferring the ownership keeps the previous owner as co-organizer", func(t *testing.T) {
+		require := require.New(t)
+		r := newRace()
+		require.NoError(r.AddStaff(user, racers.StaffVolunteer))
+
+		require.NoError(r.TransferOwnership(user))
+
+		require.Equal(user, r.Owner)
+		require.Equal(racers.RaceStaff{owner: racers.StaffCoOrganizer}, r.Staff)
+		require.True(errors.As(r.TransferOwnership(user), &racers.StaffInRaceError{}))
+	})
+}
@@ -0,0 +1,103 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// RaceStatus is the stage of the race lifecycle, races are open until the registration deadline
+// and finished once their day is over unless they are cancelled
+type RaceStatus string
+
+// Race statuses, the empty status is open
+const (
+	RaceOpen               RaceStatus = "OPEN"
+	RaceRegistrationClosed RaceStatus = "REGISTRATION_CLOSED"
+	RaceFinished           RaceStatus = "FINISHED"
+)
+
+// RegistrationClosedError means the race does not accept registrations anymore
+type RegistrationClosedError struct {
+	RaceID RaceID
+	Status RaceStatus
+}
+
+func (err RegistrationClosedError) Error() string {
+	return fmt.Sprintf("registration of race %s is closed", err.RaceID)
+}
+
+type InvalidRegistrationDeadlineError struct {
+	Deadline time.Time
+}
+
+func (err InvalidRegistrationDeadlineError) Error() string {
+	return fmt.Sprintf("registration deadline %s must be before the race date", err.Deadline)
+}
+
+// SetRegistrationDeadline sets when the registration closes, it must be before the race date
+func (r *Race) SetRegistrationDeadline(deadline time.Time) error {
+	if !deadline.Before(time.Time(r.Date)) {
+		return InvalidRegistrationDeadlineError{deadline}
+	}
+	r.RegistrationDeadline = &deadline
+
+	return nil
+}
+
+// RegistrationOpen returns if the race accepts registrations at the given instant
+func (r Race) RegistrationOpen(now time.Time) bool {
+	if r.Status == RaceRegistrationClosed || r.Status == RaceFinished || r.Status == RaceCancelled {
+		return false
+	}
+
+	return r.RegistrationDeadline == nil || now.Before(*r.RegistrationDeadline)
+}
+
+// CloseRegistration closes the registration once the deadline passed, it returns false when there is no
+// deadline, it did not pass or the registration was already closed
+func (r *Race) CloseRegistration(now time.Time) bool {
+	if r.Status == RaceRegistrationClosed || r.Status == RaceFinished || r.Status == RaceCancelled || r.RegistrationDeadline == nil {
+		return false
+	}
+	if now.Before(*r.RegistrationDeadline) {
+		return false
+	}
+	r.Status = RaceRegistrationClosed
+
+	return true
+}
+
+// raceDay returns the start of the race day in the race time zone
+func (r Race) raceDay() time.Time {
+	d := r.LocalDate()
+
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+}
+
+// Remind marks the race as reminded when its day started and the race did not, it returns false
+// when it is not the race day yet, the race started or the competitors were already reminded
+func (r *Race) Remind(now time.Time) bool {
+	if r.Reminded || r.Status == RaceFinished || r.Status == RaceCancelled {
+		return false
+	}
+	if now.Before(r.raceDay()) || !now.Before(time.Time(r.Date)) {
+		return false
+	}
+	r.Reminded = true
+
+	return true
+}
+
+// MarkFinished finishes the race once its day is over in the race time zone, it returns false
+// when the day is not over or the race was already finished or cancelled
+func (r *Race) MarkFinished(now time.Time) bool {
+	if r.Status == RaceFinished || r.Status == RaceCancelled {
+		return false
+	}
+	if now.Before(r.raceDay().AddDate(0, 0, 1)) {
+		return false
+	}
+	r.Status = RaceFinished
+
+	return true
+}
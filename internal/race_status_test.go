@@ -0,0 +1,78 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaceStatus(t *testing.T) {
+	require := require.New(t)
+
+	date := time.Date(2030, 6, 15, 9, 0, 0, 0, time.UTC)
+
+	t.Run("when the deadline is not before the race returns InvalidRegistrationDeadlineError", func(t *testing.T) {
+		r := racers.Race{Date: racers.RaceDate(date)}
+
+		err := r.SetRegistrationDeadline(date)
+		require.True(errors.As(err, &racers.InvalidRegistrationDeadlineError{}))
+	})
+
+	t.Run("joining after the deadline returns RegistrationClosedError", func(t *testing.T) {
+		r := racers.Race{ID: racers.RaceID(id.Generate()), Date: racers.RaceDate(date)}
+		deadline := date.AddDate(0, 0, -7)
+		require.NoError(r.SetRegistrationDeadline(deadline))
+
+		require.NoError(r.Join(racers.User{ID: racers.UserID(id.Generate())}, "", deadline.Add(-time.Second)))
+
+		err := r.Join(racers.User{ID: racers.UserID(id.Generate())}, "", deadline)
+		require.True(errors.As(err, &racers.RegistrationClosedError{}))
+	})
+
+	t.Run("closes the registration once the deadline passed", func(t *testing.T) {
+		r := racers.Race{ID: racers.RaceID(id.Generate()), Date: racers.RaceDate(date)}
+		require.False(r.CloseRegistration(date), "without deadline the registration is not closed")
+
+		deadline := date.AddDate(0, 0, -7)
+		require.NoError(r.SetRegistrationDeadline(deadline))
+
+		require.False(r.CloseRegistration(deadline.Add(-time.Second)))
+		require.True(r.CloseRegistration(deadline))
+		require.Equal(racers.RaceRegistrationClosed, r.Status)
+		require.False(r.CloseRegistration(deadline), "already closed")
+
+		err := r.Join(racers.User{ID: racers.UserID(id.Generate())}, "", deadline.AddDate(-1, 0, 0))
+		require.True(errors.As(err, &racers.RegistrationClosedError{}))
+	})
+
+	t.Run("reminds once on the race day in the race time zone", func(t *testing.T) {
+		tz, err := racers.NewTimeZone("America/New_York")
+		require.NoError(err)
+
+		// 9:00 UTC is 5:00 in New York, the race day starts at 4:00 UTC
+		r := racers.Race{Date: racers.RaceDate(date), Venue: &racers.Venue{TimeZone: tz}}
+
+		require.False(r.Remind(time.Date(2030, 6, 15, 3, 59, 0, 0, time.UTC)))
+		require.True(r.Remind(time.Date(2030, 6, 15, 4, 0, 0, 0, time.UTC)))
+		require.False(r.Remind(time.Date(2030, 6, 15, 5, 0, 0, 0, time.UTC)), "already reminded")
+
+		r.Reschedule(racers.RaceDate(date.AddDate(0, 0, 1)))
+		require.False(r.Reminded, "the reschedules remind again")
+		require.False(r.Remind(date.AddDate(0, 0, 1)), "the race started")
+	})
+
+	t.Run("finishes the race once its day is over", func(t *testing.T) {
+		r := racers.Race{Date: racers.RaceDate(date)}
+
+		require.False(r.MarkFinished(date.Add(time.Hour)))
+		require.True(r.MarkFinished(time.Date(2030, 6, 16, 0, 0, 0, 0, time.UTC)))
+		require.Equal(racers.RaceFinished, r.Status)
+		require.False(r.MarkFinished(time.Date(2030, 6, 16, 0, 0, 0, 0, time.UTC)), "already finished")
+		require.False(r.RegistrationOpen(date.AddDate(-1, 0, 0)))
+	})
+}
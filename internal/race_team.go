@@ -0,0 +1,292 @@
+package racers
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	// RaceTeamSize defines the number of members a team can enter a race with
+	RaceTeamSize struct {
+		Min int
+		Max int
+	}
+	// InvalidRaceTeamSizeError means the given sizes are not valid
+	InvalidRaceTeamSizeError struct{ Min, Max int }
+)
+
+func (err InvalidRaceTeamSizeError) Error() string {
+	return fmt.Sprintf("invalid team size: min %d max %d", err.Min, err.Max)
+}
+
+// NewRaceTeamSize validates the minimum and maximum members of a team in a race
+func NewRaceTeamSize(min, max int) (RaceTeamSize, error) {
+	if min < 1 || max < min {
+		return RaceTeamSize{}, InvalidRaceTeamSizeError{min, max}
+	}
+
+	return RaceTeamSize{Min: min, Max: max}, nil
+}
+
+type (
+	// TeamScoring defines how the team standings are computed from the members results
+	TeamScoring string
+	// InvalidTeamScoringError means the given scoring is not known
+	InvalidTeamScoringError struct{ Scoring string }
+)
+
+const (
+	// TeamScoringBestTimes ranks teams by the sum of the best times of their members, lower is better
+	TeamScoringBestTimes TeamScoring = "best_times"
+	// TeamScoringPositionPoints ranks teams by the sum of the positions of their best members, lower is better
+	TeamScoringPositionPoints TeamScoring = "position_points"
+)
+
+func (err InvalidTeamScoringError) Error() string {
+	return fmt.Sprintf("invalid team scoring: %s", err.Scoring)
+}
+
+// NewTeamScoring validates the scoring and returns a TeamScoring instance
+func NewTeamScoring(s string) (TeamScoring, error) {
+	switch sc := TeamScoring(s); sc {
+	case TeamScoringBestTimes, TeamScoringPositionPoints:
+		return sc, nil
+	}
+
+	return "", InvalidTeamScoringError{s}
+}
+
+// RaceTeams is the configuration of a race that allows teams to enter
+type RaceTeams struct {
+	Size    RaceTeamSize
+	Scoring TeamScoring
+	// Counting is the number of members results that count for the team standings
+	Counting int
+}
+
+// InvalidRaceTeamsCountingError means the number of counting results cannot be satisfied with the team size
+type InvalidRaceTeamsCountingError struct {
+	Counting int
+	Size     RaceTeamSize
+}
+
+func (err InvalidRaceTeamsCountingError) Error() string {
+	return fmt.Sprintf("counting %d members results is not possible with team min size %d", err.Counting, err.Size.Min)
+}
+
+// NewRaceTeams validates the teams configuration of a race
+func NewRaceTeams(size RaceTeamSize, scoring TeamScoring, counting int) (RaceTeams, error) {
+	if counting < 1 || counting > size.Min {
+		return RaceTeams{}, InvalidRaceTeamsCountingError{counting, size}
+	}
+
+	return RaceTeams{Size: size, Scoring: scoring, Counting: counting}, nil
+}
+
+// RaceTeamEntries are the teams entered in a race with the members that run for them
+type RaceTeamEntries map[TeamID][]UserID
+
+// teamOf returns the team a competitor runs for
+func (e RaceTeamEntries) teamOf(competitor UserID) (TeamID, bool) {
+	for team, members := range e {
+		for _, m := range members {
+			if m == competitor {
+				return team, true
+			}
+		}
+	}
+
+	return TeamID{}, false
+}
+
+// RaceWithoutTeamsError means the race does not allow teams to enter
+type RaceWithoutTeamsError struct{ RaceID RaceID }
+
+func (err RaceWithoutTeamsError) Error() string {
+	return fmt.Sprintf("race %s does not allow teams", err.RaceID)
+}
+
+// NotTeamAdminError means the user is not the admin of the team
+type NotTeamAdminError struct {
+	TeamID TeamID
+	UserID UserID
+}
+
+func (err NotTeamAdminError) Error() string {
+	return fmt.Sprintf("user %s is not the admin of team %s", err.UserID, err.TeamID)
+}
+
+// NotTeamMemberError means the user is not a member of the team
+type NotTeamMemberError struct {
+	TeamID TeamID
+	UserID UserID
+}
+
+func (err NotTeamMemberError) Error() string {
+	return fmt.Sprintf("user %s is not a member of team %s", err.UserID, err.TeamID)
+}
+
+// TeamInRaceError means the team has already entered the race
+type TeamInRaceError struct {
+	RaceID RaceID
+	TeamID TeamID
+}
+
+func (err TeamInRaceError) Error() string {
+	return fmt.Sprintf("team %s already entered race %s", err.TeamID, err.RaceID)
+}
+
+// InvalidTeamEntrySizeError means the number of members does not fit the race team size
+type InvalidTeamEntrySizeError struct {
+	RaceID  RaceID
+	Size    RaceTeamSize
+	Members int
+}
+
+func (err InvalidTeamEntrySizeError) Error() string {
+	return fmt.Sprintf("race %s requires between %d and %d team members, got %d", err.RaceID, err.Size.Min, err.Size.Max, err.Members)
+}
+
+// CompetitorInRaceTeamError means the competitor already runs for a team in the race
+type CompetitorInRaceTeamError struct {
+	RaceID       RaceID
+	CompetitorID UserID
+	TeamID       TeamID
+}
+
+func (err CompetitorInRaceTeamError) Error() string {
+	return fmt.Sprintf("competitor %s already runs for team %s in race %s", err.CompetitorID, err.TeamID, err.RaceID)
+}
+
+// EnterTeam registers a team in the race, by its admin, with the given members that must be
+// team members and race competitors
+func (r *Race) EnterTeam(team Team, by User, members ...UserID) error {
+	if r.Teams == nil {
+		return RaceWithoutTeamsError{r.ID}
+	}
+
+	if team.Admin != by.ID {
+		return NotTeamAdminError{team.ID, by.ID}
+	}
+
+	if _, ok := r.TeamEntries[team.ID]; ok {
+		return TeamInRaceError{r.ID, team.ID}
+	}
+
+	var (
+		seen  userList
+		entry []UserID
+	)
+	for _, m := range members {
+		if seen.is(m) {
+			continue
+		}
+		if !team.Members.is(m) {
+			return NotTeamMemberError{team.ID, m}
+		}
+		if !r.Competitors.is(m) {
+			return CompetitorNotInRaceError{r.ID, m}
+		}
+		if other, ok := r.TeamEntries.teamOf(m); ok {
+			return CompetitorInRaceTeamError{r.ID, m, other}
+		}
+		seen.add(m)
+		entry = append(entry, m)
+	}
+
+	if len(entry) < r.Teams.Size.Min || len(entry) > r.Teams.Size.Max {
+		return InvalidTeamEntrySizeError{r.ID, r.Teams.Size, len(entry)}
+	}
+
+	if r.TeamEntries == nil {
+		r.TeamEntries = make(RaceTeamEntries)
+	}
+	r.TeamEntries[team.ID] = entry
+
+	return nil
+}
+
+// TeamStanding is the classification of a team in a race
+type TeamStanding struct {
+	// Position is zero when the team does not have enough finishers to be ranked
+	Position  int
+	Team      TeamID
+	Time      time.Duration
+	Points    int
+	Finishers int
+}
+
+// Complete returns if the team has enough finishers to be ranked
+func (s TeamStanding) Complete() bool {
+	return s.Position > 0
+}
+
+// TeamStandings computes the team classification from the members results
+func (r Race) TeamStandings() []TeamStanding {
+	if r.Teams == nil || len(r.TeamEntries) == 0 {
+		return nil
+	}
+
+	positions := make(map[UserID]RaceResult, len(r.Results))
+	for _, res := range r.Results.Ranking() {
+		positions[res.Competitor] = res
+	}
+
+	standings := make([]TeamStanding, 0, len(r.TeamEntries))
+	for team, members := range r.TeamEntries {
+		var finished []RaceResult
+		for _, m := range members {
+			if res, ok := positions[m]; ok {
+				finished = append(finished, res)
+			}
+		}
+		sort.Slice(finished, func(i, j int) bool { return finished[i].Position < finished[j].Position })
+
+		s := TeamStanding{Team: team, Finishers: len(finished)}
+		if len(finished) > r.Teams.Counting {
+			finished = finished[:r.Teams.Counting]
+		}
+		for _, res := range finished {
+			s.Time += time.Duration(res.Time)
+			s.Points += res.Position
+		}
+
+		standings = append(standings, s)
+	}
+
+	score := func(s TeamStanding) int64 {
+		if r.Teams.Scoring == TeamScoringPositionPoints {
+			return int64(s.Points)
+		}
+
+		return int64(s.Time)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		ci, cj := standings[i].Finishers >= r.Teams.Counting, standings[j].Finishers >= r.Teams.Counting
+		switch {
+		case ci != cj:
+			return ci
+		case !ci && standings[i].Finishers != standings[j].Finishers:
+			return standings[i].Finishers > standings[j].Finishers
+		case score(standings[i]) != score(standings[j]):
+			return score(standings[i]) < score(standings[j])
+		}
+
+		return standings[i].Team.String() < standings[j].Team.String()
+	})
+
+	for i := range standings {
+		if standings[i].Finishers < r.Teams.Counting {
+			break
+		}
+
+		standings[i].Position = i + 1
+		if i > 0 && score(standings[i]) == score(standings[i-1]) {
+			standings[i].Position = standings[i-1].Position
+		}
+	}
+
+	return standings
+}
@@ -0,0 +1,192 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestRaceTeamSize(t *testing.T) {
+	require := require.New(t)
+	for name, c := range map[string][2]int{
+		"min zero":        {0, 3},
+		"max below min":   {3, 2},
+		"negative values": {-1, -1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := racers.NewRaceTeamSize(c[0], c[1])
+			require.True(errors.As(err, &racers.InvalidRaceTeamSizeError{}))
+		})
+	}
+
+	size, err := racers.NewRaceTeamSize(2, 4)
+	require.NoError(err)
+	require.Equal(racers.RaceTeamSize{Min: 2, Max: 4}, size)
+}
+
+func TestTeamScoring(t *testing.T) {
+	require := require.New(t)
+
+	_, err := racers.NewTeamScoring("fastest")
+	require.True(errors.As(err, &racers.InvalidTeamScoringError{}))
+
+	scoring, err := racers.NewTeamScoring("position_points")
+	require.NoError(err)
+	require.Equal(racers.TeamScoringPositionPoints, scoring)
+}
+
+func TestRaceTeams(t *testing.T) {
+	require := require.New(t)
+	size := racers.RaceTeamSize{Min: 3, Max: 5}
+
+	_, err := racers.NewRaceTeams(size, racers.TeamScoringBestTimes, 4)
+	require.True(errors.As(err, &racers.InvalidRaceTeamsCountingError{}))
+
+	teams, err := racers.NewRaceTeams(size, racers.TeamScoringBestTimes, 3)
+	require.NoError(err)
+	require.Equal(racers.RaceTeams{Size: size, Scoring: racers.TeamScoringBestTimes, Counting: 3}, teams)
+}
+
+func teamRace(scoring racers.TeamScoring, competitors ...racers.UserID) racers.Race {
+	return racers.Race{
+		ID:          raceID,
+		Name:        raceName,
+		Date:        raceDate,
+		Owner:       ownerID,
+		Competitors: racers.NewRaceCompetitors(competitors...),
+		Teams:       &racers.RaceTeams{Size: racers.RaceTeamSize{Min: 2, Max: 3}, Scoring: scoring, Counting: 2},
+	}
+}
+
+func TestRaceEnterTeam(t *testing.T) {
+	require := require.New(t)
+
+	admin := racers.User{ID: teamAdminID}
+	member := racers.UserID(id.Generate())
+	outsider := racers.UserID(id.Generate())
+	team := racers.NewTeam(teamID, teamName, admin.ID, racers.TeamMembersOpt(racers.NewTeamMembers(admin.ID, member)))
+
+	t.Run("Given a race without teams, returns RaceWithoutTeamsError", func(t *testing.T) {
+		r := racers.Race{ID: raceID, Competitors: racers.NewRaceCompetitors(admin.ID, member)}
+
+		err := r.EnterTeam(team, admin, admin.ID, member)
+		require.True(errors.As(err, &racers.RaceWithoutTeamsError{}))
+	})
+
+	t.Run("When entered by a user that is not the team admin, returns NotTeamAdminError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member)
+
+		err := r.EnterTeam(team, racers.User{ID: member}, admin.ID, member)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When a member is not in the team, returns NotTeamMemberError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member, outsider)
+
+		err := r.EnterTeam(team, admin, admin.ID, outsider)
+		require.True(errors.As(err, &racers.NotTeamMemberError{}))
+	})
+
+	t.Run("When a member is not a race competitor, returns CompetitorNotInRaceError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID)
+
+		err := r.EnterTeam(team, admin, admin.ID, member)
+		require.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+	})
+
+	t.Run("When there are not enough members, returns InvalidTeamEntrySizeError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member)
+
+		err := r.EnterTeam(team, admin, admin.ID, admin.ID)
+		require.True(errors.As(err, &racers.InvalidTeamEntrySizeError{}))
+	})
+
+	t.Run("When the team is already in the race, returns TeamInRaceError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member)
+		require.NoError(r.EnterTeam(team, admin, admin.ID, member))
+
+		err := r.EnterTeam(team, admin, admin.ID, member)
+		require.True(errors.As(err, &racers.TeamInRaceError{}))
+	})
+
+	t.Run("When a member already runs for other team, returns CompetitorInRaceTeamError", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member)
+		otherTeamID := racers.TeamID(id.Generate())
+		r.TeamEntries = racers.RaceTeamEntries{otherTeamID: {member}}
+
+		err := r.EnterTeam(team, admin, admin.ID, member)
+
+		var inTeamErr racers.CompetitorInRaceTeamError
+		require.True(errors.As(err, &inTeamErr))
+		require.Equal(otherTeamID, inTeamErr.TeamID)
+	})
+
+	t.Run("When valid, registers the team entry", func(t *testing.T) {
+		r := teamRace(racers.TeamScoringBestTimes, admin.ID, member)
+
+		require.NoError(r.EnterTeam(team, admin, admin.ID, member))
+		require.Equal(racers.RaceTeamEntries{teamID: {admin.ID, member}}, r.TeamEntries)
+	})
+}
+
+func TestRaceTeamStandings(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		a1, a2, a3 = racers.UserID(id.Generate()), racers.UserID(id.Generate()), racers.UserID(id.Generate())
+		b1, b2     = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+		c1, c2     = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+		teamA      = racers.TeamID(id.Generate())
+		teamB      = racers.TeamID(id.Generate())
+		teamC      = racers.TeamID(id.Generate())
+	)
+
+	newRace := func(scoring racers.TeamScoring) racers.Race {
+		r := teamRace(scoring, a1, a2, a3, b1, b2, c1, c2)
+		r.TeamEntries = racers.RaceTeamEntries{
+			teamA: {a1, a2, a3},
+			teamB: {b1, b2},
+			teamC: {c1, c2},
+		}
+		// positions: b1 1, a1 2, a2 3, a3 4, c1 5, b2 6
+		r.Results = racers.RaceResults{
+			b1: racers.RaceTime(60 * time.Minute),
+			a1: racers.RaceTime(61 * time.Minute),
+			a2: racers.RaceTime(62 * time.Minute),
+			a3: racers.RaceTime(63 * time.Minute),
+			c1: racers.RaceTime(64 * time.Minute),
+			b2: racers.RaceTime(80 * time.Minute),
+		}
+
+		return r
+	}
+
+	t.Run("Given a race without team entries, returns no standings", func(t *testing.T) {
+		require.Empty(teamRace(racers.TeamScoringBestTimes).TeamStandings())
+	})
+
+	t.Run("Given best times scoring, ranks by the sum of the counting times", func(t *testing.T) {
+		standings := newRace(racers.TeamScoringBestTimes).TeamStandings()
+
+		require.Equal([]racers.TeamStanding{
+			{Position: 1, Team: teamA, Time: 123 * time.Minute, Points: 5, Finishers: 3},
+			{Position: 2, Team: teamB, Time: 140 * time.Minute, Points: 7, Finishers: 2},
+			{Position: 0, Team: teamC, Time: 64 * time.Minute, Points: 5, Finishers: 1},
+		}, standings)
+		require.False(standings[2].Complete())
+	})
+
+	t.Run("Given position points scoring, ranks by the sum of the counting positions", func(t *testing.T) {
+		standings := newRace(racers.TeamScoringPositionPoints).TeamStandings()
+
+		require.Equal(teamA, standings[0].Team)
+		require.Equal(5, standings[0].Points)
+		require.Equal(teamB, standings[1].Team)
+		require.Equal(7, standings[1].Points)
+		require.Equal(teamC, standings[2].Team)
+	})
+}
@@ -15,7 +15,7 @@ var (
 	raceID         = racers.RaceID(id.Generate())
 	raceName       = racers.RaceName("New York Marathon")
 	raceDate       = racers.RaceDate(time.Now())
-	raceCompetitor = racers.User{racers.UserID(id.Generate())}
+	raceCompetitor = racers.User{ID: racers.UserID(id.Generate())}
 	ownerID        = racers.UserID(id.Generate())
 )
 
@@ -67,6 +67,21 @@ func TestRaceDate(t *testing.T) {
 	})
 }
 
+func TestDistance(t *testing.T) {
+	require := require.New(t)
+	t.Run("when New with not positive metres returns InvalidDistanceError error", func(t *testing.T) {
+		_, err := racers.NewDistance(0)
+		require.True(errors.As(err, &racers.InvalidDistanceError{}))
+	})
+
+	t.Run("when New with valid metres returns Distance and no error", func(t *testing.T) {
+		d, err := racers.NewDistance(42195)
+
+		require.Equal(racers.Distance(42195), d)
+		require.NoError(err)
+	})
+}
+
 func TestRaceCompetitors(t *testing.T) {
 	require := require.New(t)
 	usersIDs := []racers.UserID{
@@ -94,7 +109,7 @@ func TestRace(t *testing.T) {
 			Date:  raceDate,
 			Owner: ownerID,
 		}
-		require.NoError(r.Join(raceCompetitor))
+		require.NoError(r.Join(raceCompetitor, "", time.Now()))
 	})
 
 	t.Run(`Given a race with one competitor,
@@ -108,7 +123,7 @@ func TestRace(t *testing.T) {
 			Competitors: racers.NewRaceCompetitors(raceCompetitor.ID),
 		}
 
-		err := r.Join(raceCompetitor)
+		err := r.Join(raceCompetitor, "", time.Now())
 
 		var competirorInRaceErr racers.CompetitorInRaceError
 		require.True(errors.As(err, &competirorInRaceErr))
@@ -116,3 +131,84 @@ func TestRace(t *testing.T) {
 		require.Equal(competirorInRaceErr.CompetitorID, raceCompetitor.ID)
 	})
 }
+
+func TestRaceReschedule(t *testing.T) {
+	require := require.New(t)
+
+	start := time.Date(2030, 4, 12, 9, 0, 0, 0, time.UTC)
+	newRace := func() racers.Race {
+		return racers.Race{
+			ID:    raceID,
+			Name:  raceName,
+			Date:  racers.RaceDate(start),
+			Owner: ownerID,
+			Categories: racers.RaceCategories{
+				{Name: "10K", Distance: 10000, StartTime: start.Add(30 * time.Minute)},
+			},
+		}
+	}
+
+	t.Run(`Given a race,
+	When rescheduled to the same date,
+	Then the sequence does not change`, func(t *testing.T) {
+		r := newRace()
+		r.Reschedule(racers.RaceDate(start))
+
+		require.Equal(0, r.Sequence)
+	})
+
+	t.Run(`Given a race with categories,
+	When rescheduled,
+	Then moves the categories start and increases the sequence`, func(t *testing.T) {
+		r := newRace()
+		r.Reschedule(racers.RaceDate(start.AddDate(0, 0, 7)))
+
+		require.Equal(racers.RaceDate(start.AddDate(0, 0, 7)), r.Date)
+		require.Equal(start.AddDate(0, 0, 7).Add(30*time.Minute), r.Categories[0].StartTime)
+		require.Equal(1, r.Sequence)
+	})
+}
+
+func TestVenue(t *testing.T) {
+	require := require.New(t)
+
+	tokyo, err := racers.NewTimeZone("Asia/Tokyo")
+	require.NoError(err)
+
+	t.Run("when invalid time zone returns InvalidTimeZoneError", func(t *testing.T) {
+		for _, name := range []string{"", "Local", "Mars/Olympus"} {
+			_, err := racers.NewTimeZone(name)
+			require.True(errors.As(err, &racers.InvalidTimeZoneError{}))
+		}
+	})
+
+	t.Run("when invalid venue returns InvalidVenueError", func(t *testing.T) {
+		for name, f := range map[string]func() (racers.Venue, error){
+			"empty name":   func() (racers.Venue, error) { return racers.NewVenue("", "", 35.68, 139.76, tokyo) },
+			"latitude":     func() (racers.Venue, error) { return racers.NewVenue("Tokyo", "", 91, 139.76, tokyo) },
+			"longitude":    func() (racers.Venue, error) { return racers.NewVenue("Tokyo", "", 35.68, -181, tokyo) },
+			"no time zone": func() (racers.Venue, error) { return racers.NewVenue("Tokyo", "", 35.68, 139.76, nil) },
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := f()
+				require.True(errors.As(err, &racers.InvalidVenueError{}))
+			})
+		}
+	})
+
+	t.Run("when the race has a venue shows the date in its zone", func(t *testing.T) {
+		venue, err := racers.NewVenue("Tokyo Metropolitan Government Building", "Shinjuku", 35.68, 139.69, tokyo)
+		require.NoError(err)
+
+		r := racers.Race{Date: racers.RaceDate(time.Date(2030, 3, 2, 0, 10, 0, 0, time.UTC)), Venue: &venue}
+
+		require.Equal("2030-03-02T09:10:00+09:00", r.LocalDate().Format(time.RFC3339))
+		require.InDelta(6730, venue.DistanceTo(35.62, 139.70), 10)
+	})
+
+	t.Run("when the race has no venue shows the date in UTC", func(t *testing.T) {
+		r := racers.Race{Date: racers.RaceDate(time.Date(2030, 3, 2, 9, 10, 0, 0, tokyo))}
+
+		require.Equal("2030-03-02T00:10:00Z", r.LocalDate().Format(time.RFC3339))
+	})
+}
@@ -0,0 +1,319 @@
+package racers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is how often a recurrence repeats
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+// RecurrenceDay is a weekday of a recurrence, Ordinal selects the nth weekday of the month,
+// counting from the end when negative, and every weekday when zero
+type RecurrenceDay struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func (d RecurrenceDay) String() string {
+	for name, w := range weekdays {
+		if w == d.Weekday {
+			if d.Ordinal == 0 {
+				return name
+			}
+			return fmt.Sprintf("%d%s", d.Ordinal, name)
+		}
+	}
+
+	return ""
+}
+
+// InvalidRecurrenceError means the rule is not valid or uses parts out of the supported subset
+type InvalidRecurrenceError struct {
+	Rule   string
+	Reason string
+}
+
+func (err InvalidRecurrenceError) Error() string {
+	return fmt.Sprintf("invalid recurrence rule %q: %s", err.Rule, err.Reason)
+}
+
+// Recurrence is the subset of the RFC 5545 RRULE supported by the race series:
+// FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL
+type Recurrence struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []RecurrenceDay
+	// ByMonthDay are days of the month, counting from the end when negative
+	ByMonthDay []int
+	// Count limits the number of occurrences, zero for no limit
+	Count int
+	// Until is the last instant an occurrence can happen, zero for no limit
+	Until time.Time
+}
+
+const untilFormat = "20060102T150405Z"
+
+// ParseRecurrence parses a RRULE value, like FREQ=MONTHLY;BYDAY=1SA
+func ParseRecurrence(rule string) (Recurrence, error) {
+	invalid := func(format string, args ...interface{}) (Recurrence, error) {
+		return Recurrence{}, InvalidRecurrenceError{rule, fmt.Sprintf(format, args...)}
+	}
+
+	r := Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return invalid("malformed part %q", part)
+		}
+		name, value := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[name] {
+			return invalid("duplicated %s", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch f := Frequency(value); f {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+				r.Freq = f
+			default:
+				return invalid("unsupported frequency %s", value)
+			}
+		case "INTERVAL":
+			if r.Interval, err = strconv.Atoi(value); err != nil || r.Interval < 1 {
+				return invalid("invalid interval %s", value)
+			}
+		case "COUNT":
+			if r.Count, err = strconv.Atoi(value); err != nil || r.Count < 1 {
+				return invalid("invalid count %s", value)
+			}
+		case "UNTIL":
+			if r.Until, err = time.Parse(untilFormat, value); err != nil {
+				if r.Until, err = time.Parse("20060102", value); err != nil {
+					return invalid("invalid until %s", value)
+				}
+				// a date includes the whole day
+				r.Until = r.Until.Add(24*time.Hour - time.Second)
+			}
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				if len(d) < 2 {
+					return invalid("invalid day %s", d)
+				}
+				w, ok := weekdays[d[len(d)-2:]]
+				if !ok {
+					return invalid("invalid day %s", d)
+				}
+				day := RecurrenceDay{Weekday: w}
+				if n := d[:len(d)-2]; n != "" {
+					if day.Ordinal, err = strconv.Atoi(n); err != nil || day.Ordinal == 0 || day.Ordinal < -5 || day.Ordinal > 5 {
+						return invalid("invalid day %s", d)
+					}
+				}
+				r.ByDay = append(r.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(d)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return invalid("invalid month day %s", d)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		default:
+			return invalid("unsupported part %s", name)
+		}
+	}
+
+	if r.Freq == "" {
+		return invalid("missing FREQ")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return invalid("COUNT and UNTIL can not be combined")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != FrequencyMonthly {
+		return invalid("BYMONTHDAY requires a MONTHLY frequency")
+	}
+	if len(r.ByMonthDay) > 0 && len(r.ByDay) > 0 {
+		return invalid("BYDAY and BYMONTHDAY can not be combined")
+	}
+	for _, d := range r.ByDay {
+		if d.Ordinal != 0 && r.Freq != FrequencyMonthly {
+			return invalid("BYDAY ordinals require a MONTHLY frequency")
+		}
+	}
+
+	return r, nil
+}
+
+// String returns the rule in RRULE format
+func (r Recurrence) String() string {
+	parts := []string{fmt.Sprintf("FREQ=%s", r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, fmt.Sprintf("BYDAY=%s", strings.Join(days, ",")))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%s", strings.Join(days, ",")))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, fmt.Sprintf("UNTIL=%s", r.Until.UTC().Format(untilFormat)))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Occurrences returns the instants the recurrence happens from start up to the given instant, both included.
+// The occurrences keep the time of the day of start in its location, so they do not move with daylight saving
+func (r Recurrence) Occurrences(start, to time.Time) []time.Time {
+	var occurrences []time.Time
+	for period := 0; ; period++ {
+		first, candidates := r.period(start, period*r.Interval)
+		if first.After(to) {
+			return occurrences
+		}
+
+		for _, c := range candidates {
+			if c.Before(start) {
+				continue
+			}
+			if c.After(to) || (!r.Until.IsZero() && c.After(r.Until)) {
+				return occurrences
+			}
+
+			occurrences = append(occurrences, c)
+			if r.Count > 0 && len(occurrences) == r.Count {
+				return occurrences
+			}
+		}
+	}
+}
+
+// period returns the first instant of the nth period since start and the sorted candidates in it
+func (r Recurrence) period(start time.Time, n int) (time.Time, []time.Time) {
+	y, m, d := start.Date()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	}
+
+	var (
+		first      time.Time
+		candidates []time.Time
+	)
+	switch r.Freq {
+	case FrequencyDaily:
+		first = at(y, m, d+n)
+		if r.matchesDay(first.Weekday()) {
+			candidates = append(candidates, first)
+		}
+	case FrequencyWeekly:
+		// weeks start on monday
+		monday := d - (int(start.Weekday())+6)%7 + 7*n
+		first = at(y, m, monday)
+		for i := 0; i < 7; i++ {
+			c := at(y, m, monday+i)
+			if len(r.ByDay) == 0 && c.Weekday() == start.Weekday() || len(r.ByDay) > 0 && r.matchesDay(c.Weekday()) {
+				candidates = append(candidates, c)
+			}
+		}
+	case FrequencyMonthly:
+		first = at(y, m+time.Month(n), 1)
+		candidates = r.monthDays(first, d, at)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+
+	return first, candidates
+}
+
+func (r Recurrence) matchesDay(w time.Weekday) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, d := range r.ByDay {
+		if d.Weekday == w {
+			return true
+		}
+	}
+
+	return false
+}
+
+// monthDays returns the candidates of the month of first, startDay is the day of the month of the series start
+func (r Recurrence) monthDays(first time.Time, startDay int, at func(int, time.Month, int) time.Time) []time.Time {
+	y, m := first.Year(), first.Month()
+	days := time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	var result []time.Time
+	add := func(d int) {
+		if d >= 1 && d <= days {
+			result = append(result, at(y, m, d))
+		}
+	}
+
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = days + d + 1
+			}
+			add(d)
+		}
+	case len(r.ByDay) > 0:
+		for _, bd := range r.ByDay {
+			// day of the month of the first bd weekday
+			firstDay := 1 + (int(bd.Weekday)-int(first.Weekday())+7)%7
+			switch {
+			case bd.Ordinal > 0:
+				add(firstDay + 7*(bd.Ordinal-1))
+			case bd.Ordinal < 0:
+				last := firstDay + 7*((days-firstDay)/7)
+				add(last + 7*(bd.Ordinal+1))
+			default:
+				for d := firstDay; d <= days; d += 7 {
+					add(d)
+				}
+			}
+		}
+	default:
+		add(startDay)
+	}
+
+	return result
+}
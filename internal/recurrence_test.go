@@ -0,0 +1,113 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("when the rule is not valid returns InvalidRecurrenceError", func(t *testing.T) {
+		for _, rule := range []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=YEARLY",
+			"FREQ=WEEKLY;INTERVAL=0",
+			"FREQ=WEEKLY;BYDAY=XX",
+			"FREQ=WEEKLY;BYDAY=1SA",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=MONTHLY;BYDAY=SA;BYMONTHDAY=1",
+			"FREQ=MONTHLY;COUNT=3;UNTIL=20300101",
+			"FREQ=MONTHLY;FREQ=WEEKLY",
+			"FREQ=MONTHLY;BYSETPOS=1",
+		} {
+			_, err := racers.ParseRecurrence(rule)
+			require.True(errors.As(err, &racers.InvalidRecurrenceError{}), rule)
+		}
+	})
+
+	t.Run("parses the rule and formats it back", func(t *testing.T) {
+		r, err := racers.ParseRecurrence("RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=1SA,-1SU;UNTIL=20301231T000000Z")
+		require.NoError(err)
+
+		require.Equal(racers.Recurrence{
+			Freq:     racers.FrequencyMonthly,
+			Interval: 2,
+			ByDay:    []racers.RecurrenceDay{{Ordinal: 1, Weekday: time.Saturday}, {Ordinal: -1, Weekday: time.Sunday}},
+			Until:    time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC),
+		}, r)
+		require.Equal("FREQ=MONTHLY;INTERVAL=2;BYDAY=1SA,-1SU;UNTIL=20301231T000000Z", r.String())
+	})
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	require := require.New(t)
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(err)
+
+	occurrences := func(rule string, start, to time.Time) []time.Time {
+		r, err := racers.ParseRecurrence(rule)
+		require.NoError(err)
+
+		return r.Occurrences(start, to)
+	}
+
+	t.Run("weekly keeps the local time across daylight saving", func(t *testing.T) {
+		start := time.Date(2030, 3, 23, 9, 0, 0, 0, madrid)
+
+		require.Equal([]time.Time{
+			start,
+			time.Date(2030, 3, 30, 9, 0, 0, 0, madrid),
+			time.Date(2030, 4, 6, 9, 0, 0, 0, madrid),
+		}, occurrences("FREQ=WEEKLY;COUNT=3", start, start.AddDate(1, 0, 0)))
+	})
+
+	t.Run("weekly by day", func(t *testing.T) {
+		// a wednesday
+		start := time.Date(2030, 1, 2, 18, 0, 0, 0, time.UTC)
+
+		require.Equal([]time.Time{
+			start,
+			time.Date(2030, 1, 5, 18, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 16, 18, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 19, 18, 0, 0, 0, time.UTC),
+		}, occurrences("FREQ=WEEKLY;INTERVAL=2;BYDAY=WE,SA", start, time.Date(2030, 1, 25, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("monthly by ordinal day", func(t *testing.T) {
+		start := time.Date(2030, 1, 5, 9, 0, 0, 0, time.UTC)
+
+		require.Equal([]time.Time{
+			start,
+			time.Date(2030, 1, 27, 9, 0, 0, 0, time.UTC),
+			time.Date(2030, 2, 2, 9, 0, 0, 0, time.UTC),
+			time.Date(2030, 2, 24, 9, 0, 0, 0, time.UTC),
+		}, occurrences("FREQ=MONTHLY;BYDAY=1SA,-1SU", start, time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("monthly skips the months without the day", func(t *testing.T) {
+		start := time.Date(2030, 1, 31, 9, 0, 0, 0, time.UTC)
+
+		require.Equal([]time.Time{
+			start,
+			time.Date(2030, 3, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2030, 4, 30, 9, 0, 0, 0, time.UTC),
+		}, append(
+			occurrences("FREQ=MONTHLY", start, time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC)),
+			occurrences("FREQ=MONTHLY;BYMONTHDAY=-1", time.Date(2030, 4, 1, 9, 0, 0, 0, time.UTC), time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC))...,
+		))
+	})
+
+	t.Run("stops at until", func(t *testing.T) {
+		start := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+
+		require.Len(occurrences("FREQ=DAILY;UNTIL=20300103", start, start.AddDate(1, 0, 0)), 3)
+	})
+}
@@ -0,0 +1,207 @@
+// Package results parses the results files exported by timing systems
+package results
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Row is a result line of a timing file, values are kept as read so the importer can report them
+type Row struct {
+	// Line is the line of the file the row was read from, starting from one,
+	// assuming one record per line
+	Line     int
+	Bib      string
+	ChipTime string
+	GunTime  string
+	Status   string
+}
+
+// Parser reads the result rows of a timing file
+type Parser interface {
+	Parse(r io.Reader) ([]Row, error)
+}
+
+// Mapping are the names of the header columns that contain each value, used by the generic CSV format
+type Mapping struct {
+	Bib      string
+	ChipTime string
+	GunTime  string
+	Status   string
+}
+
+// Factory builds the parser of a format, mapping is only used by the formats that support it
+type Factory func(m Mapping) (Parser, error)
+
+const (
+	// FormatCSV is a generic CSV file with a column mapping
+	FormatCSV = "csv"
+	// FormatChip is the common bib,chip_time,gun_time,status layout
+	FormatChip = "chip"
+)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{
+		FormatCSV: func(m Mapping) (Parser, error) { return NewCSV(m) },
+		FormatChip: func(Mapping) (Parser, error) {
+			return NewCSV(Mapping{Bib: "bib", ChipTime: "chip_time", GunTime: "gun_time", Status: "status"})
+		},
+	}
+)
+
+// Register adds a parser for a format, replacing the previous one with the same name
+func Register(format string, f Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	factories[format] = f
+}
+
+// Formats returns the names of the registered formats
+func Formats() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	formats := make([]string, 0, len(factories))
+	for f := range factories {
+		formats = append(formats, f)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
+
+// UnknownFormatError means there is no parser registered for the format
+type UnknownFormatError struct{ Format string }
+
+func (err UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown results format %q, expected one of %s", err.Format, strings.Join(Formats(), ", "))
+}
+
+// NewParser returns the parser of the format
+func NewParser(format string, m Mapping) (Parser, error) {
+	mu.RLock()
+	f, ok := factories[format]
+	mu.RUnlock()
+	if !ok {
+		return nil, UnknownFormatError{format}
+	}
+
+	return f(m)
+}
+
+// InvalidMappingError means the column mapping misses required columns
+type InvalidMappingError struct{ Reason string }
+
+func (err InvalidMappingError) Error() string {
+	return fmt.Sprintf("invalid column mapping: %s", err.Reason)
+}
+
+// ParseError means the file content does not match the format
+type ParseError struct {
+	Line int
+	error
+}
+
+func (err ParseError) Error() string {
+	return fmt.Sprintf("invalid results file at line %d: %s", err.Line, err.error)
+}
+
+func (err ParseError) Unwrap() error {
+	return err.error
+}
+
+// CSV parses comma separated files with a header, reading the values from the mapped columns
+type CSV struct {
+	mapping Mapping
+}
+
+// NewCSV checks the mapping has a bib column and at least a time column
+func NewCSV(m Mapping) (CSV, error) {
+	if m.Bib == "" {
+		return CSV{}, InvalidMappingError{"missing bib column"}
+	}
+
+	if m.ChipTime == "" && m.GunTime == "" {
+		return CSV{}, InvalidMappingError{"missing chip or gun time column"}
+	}
+
+	return CSV{m}, nil
+}
+
+func (p CSV) Parse(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, ParseError{1, errors.New("empty file")}
+	}
+	if err != nil {
+		return nil, ParseError{1, err}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))] = i
+	}
+
+	index := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+
+		i, ok := columns[strings.ToLower(name)]
+		if !ok {
+			return -1, ParseError{1, fmt.Errorf("missing column %q", name)}
+		}
+
+		return i, nil
+	}
+
+	var idx [4]int
+	for i, name := range []string{p.mapping.Bib, p.mapping.ChipTime, p.mapping.GunTime, p.mapping.Status} {
+		if idx[i], err = index(name); err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []Row
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var csvErr *csv.ParseError
+			if errors.As(err, &csvErr) {
+				line = csvErr.Line
+			}
+			return nil, ParseError{line, err}
+		}
+
+		value := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		row := Row{Line: line, Bib: value(idx[0]), ChipTime: value(idx[1]), GunTime: value(idx[2]), Status: value(idx[3])}
+		if row == (Row{Line: line}) {
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
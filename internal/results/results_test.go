@@ -0,0 +1,87 @@
+package results_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/results"
+)
+
+func TestNewParser(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("when the format is not registered returns UnknownFormatError", func(t *testing.T) {
+		_, err := results.NewParser("xlsx", results.Mapping{})
+		require.True(errors.As(err, &results.UnknownFormatError{}))
+	})
+
+	t.Run("when the csv mapping misses columns returns InvalidMappingError", func(t *testing.T) {
+		for name, m := range map[string]results.Mapping{
+			"bib":  {ChipTime: "time"},
+			"time": {Bib: "dorsal"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := results.NewParser(results.FormatCSV, m)
+				require.True(errors.As(err, &results.InvalidMappingError{}))
+			})
+		}
+	})
+
+	t.Run("registers new formats", func(t *testing.T) {
+		results.Register("empty", func(results.Mapping) (results.Parser, error) { return emptyParser{}, nil })
+
+		p, err := results.NewParser("empty", results.Mapping{})
+		require.NoError(err)
+		require.Equal(emptyParser{}, p)
+		require.Contains(results.Formats(), "empty")
+	})
+}
+
+type emptyParser struct{}
+
+func (emptyParser) Parse(io.Reader) ([]results.Row, error) { return nil, nil }
+
+func TestChip(t *testing.T) {
+	require := require.New(t)
+
+	p, err := results.NewParser(results.FormatChip, results.Mapping{})
+	require.NoError(err)
+
+	t.Run("when the header misses a column returns ParseError", func(t *testing.T) {
+		_, err := p.Parse(strings.NewReader("bib,time\n1,30:00\n"))
+		require.True(errors.As(err, &results.ParseError{}))
+	})
+
+	t.Run("when empty returns ParseError", func(t *testing.T) {
+		_, err := p.Parse(strings.NewReader(""))
+		require.True(errors.As(err, &results.ParseError{}))
+	})
+
+	t.Run("returns the rows with their line", func(t *testing.T) {
+		rows, err := p.Parse(strings.NewReader("\ufeffBib,Chip_Time,Gun_Time,Status\n" +
+			"12, 41:10 ,41:15,OK\n" +
+			",,,\n" +
+			"7,,,DNF\n"))
+
+		require.NoError(err)
+		require.Equal([]results.Row{
+			{Line: 2, Bib: "12", ChipTime: "41:10", GunTime: "41:15", Status: "OK"},
+			{Line: 4, Bib: "7", Status: "DNF"},
+		}, rows)
+	})
+}
+
+func TestCSV(t *testing.T) {
+	require := require.New(t)
+
+	p, err := results.NewParser(results.FormatCSV, results.Mapping{Bib: "Dorsal", GunTime: "Tiempo"})
+	require.NoError(err)
+
+	rows, err := p.Parse(strings.NewReader("Pos,Dorsal,Nombre,Tiempo\n1,101,Kilian,1:02:03\n"))
+
+	require.NoError(err)
+	require.Equal([]results.Row{{Line: 2, Bib: "101", GunTime: "1:02:03"}}, rows)
+}
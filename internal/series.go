@@ -0,0 +1,264 @@
+package racers
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/xabi93/racers/internal/id"
+)
+
+type (
+	// SeriesID defines a unique identifier for a race series
+	SeriesID id.ID
+	// InvalidSeriesIDError means the given id is not valid
+	InvalidSeriesIDError struct{ error }
+)
+
+func (err InvalidSeriesIDError) Error() string {
+	return fmt.Sprintf("invalid series id: %s", err.error)
+}
+
+// NewSeriesID validates the id and returns a SeriesID instance
+func NewSeriesID(s string) (SeriesID, error) {
+	id, err := id.NewID(s)
+	if err != nil {
+		return SeriesID{}, InvalidSeriesIDError{err}
+	}
+
+	return SeriesID(id), nil
+}
+
+// InvalidSeriesScoringError means the points table of a series is not valid
+type InvalidSeriesScoringError struct{ Reason string }
+
+func (err InvalidSeriesScoringError) Error() string {
+	return fmt.Sprintf("invalid series scoring: %s", err.Reason)
+}
+
+// DefaultSeriesPoints is the points table of the series that do not configure one
+var DefaultSeriesPoints = []int{25, 18, 15, 12, 10, 8, 6, 4, 2, 1}
+
+// SeriesScoring is how the race results of a series add up in the standings
+type SeriesScoring struct {
+	// Points are the points given by position, the first element for the winner,
+	// positions after the table get no points
+	Points []int
+	// BestOf is the number of best races counted for each competitor, zero to count them all
+	BestOf int
+}
+
+// NewSeriesScoring validates the points table and returns a SeriesScoring instance,
+// an empty table uses DefaultSeriesPoints
+func NewSeriesScoring(points []int, bestOf int) (SeriesScoring, error) {
+	if len(points) == 0 {
+		points = DefaultSeriesPoints
+	}
+
+	for i, p := range points {
+		if p < 0 {
+			return SeriesScoring{}, InvalidSeriesScoringError{"negative points"}
+		}
+		if i > 0 && p > points[i-1] {
+			return SeriesScoring{}, InvalidSeriesScoringError{"points must not increase with the position"}
+		}
+	}
+
+	if bestOf < 0 {
+		return SeriesScoring{}, InvalidSeriesScoringError{"negative best of"}
+	}
+
+	return SeriesScoring{Points: append([]int(nil), points...), BestOf: bestOf}, nil
+}
+
+// points returns the points of the position
+func (s SeriesScoring) points(position int) int {
+	if position < 1 || position > len(s.Points) {
+		return 0
+	}
+
+	return s.Points[position-1]
+}
+
+// SeriesTemplate is the race data every instance of a series is created with
+type SeriesTemplate struct {
+	Name        RaceName
+	Description string
+	// Venue is nil when the races have no venue, the occurrences are computed in UTC
+	Venue *Venue
+}
+
+// SeriesInstance links a race to the series occurrence it was generated for
+type SeriesInstance struct {
+	Series SeriesID
+	Race   RaceID
+	// Occurrence is the instant of the recurrence the race was generated for, it does not change
+	// when the race is rescheduled on its own
+	Occurrence time.Time
+}
+
+// SeriesHorizon is how far ahead the series races are generated
+const SeriesHorizon = 90 * 24 * time.Hour
+
+// Series is a recurring race, its races are generated ahead of time from the recurrence rule
+type Series struct {
+	ID       SeriesID
+	Owner    UserID
+	Template SeriesTemplate
+	// Start is the first occurrence, the next ones keep its time of the day in the venue time zone
+	Start      time.Time
+	Recurrence Recurrence
+	Scoring    SeriesScoring
+	// Instances are the generated races, by occurrence
+	Instances []SeriesInstance
+}
+
+// location returns the time zone the occurrences are computed in
+func (s Series) location() *time.Location {
+	if s.Template.Venue == nil {
+		return time.UTC
+	}
+
+	return s.Template.Venue.TimeZone
+}
+
+// occurrences returns the occurrences after now up to the horizon
+func (s Series) occurrences(now time.Time) []time.Time {
+	var result []time.Time
+	for _, o := range s.Recurrence.Occurrences(s.Start.In(s.location()), now.Add(SeriesHorizon)) {
+		if o.After(now) {
+			result = append(result, o)
+		}
+	}
+
+	return result
+}
+
+// Pending returns the occurrences after now up to the horizon without a race yet
+func (s Series) Pending(now time.Time) []time.Time {
+	generated := make(map[int64]bool, len(s.Instances))
+	for _, i := range s.Instances {
+		generated[i.Occurrence.Unix()] = true
+	}
+
+	var pending []time.Time
+	for _, o := range s.occurrences(now) {
+		if !generated[o.Unix()] {
+			pending = append(pending, o)
+		}
+	}
+
+	return pending
+}
+
+// NewInstance returns the race of the series for the occurrence, built from the template
+func (s *Series) NewInstance(id RaceID, occurrence time.Time) Race {
+	instance := SeriesInstance{Series: s.ID, Race: id, Occurrence: occurrence}
+	s.Instances = append(s.Instances, instance)
+	sort.Slice(s.Instances, func(i, j int) bool { return s.Instances[i].Occurrence.Before(s.Instances[j].Occurrence) })
+
+	race := Race{
+		ID:     id,
+		Date:   RaceDate(occurrence),
+		Owner:  s.Owner,
+		Series: &instance,
+	}
+	race.ApplySeriesTemplate(s.Template)
+
+	return race
+}
+
+// SeriesInstanceMove is a future race of the series moved to a new occurrence by a series update
+type SeriesInstanceMove struct {
+	Race RaceID
+	From time.Time
+	To   time.Time
+}
+
+// Update changes the series, only the races after now are changed: they are matched in order with
+// the new occurrences and moved to them. It returns the future races, moved or not, and the races
+// left without an occurrence, which no longer belong to the series
+func (s *Series) Update(now time.Time, template SeriesTemplate, start time.Time, recurrence Recurrence) (kept []SeriesInstanceMove, detached []RaceID) {
+	s.Template, s.Start, s.Recurrence = template, start, recurrence
+
+	occurrences := s.occurrences(now)
+	instances := s.Instances[:0]
+	for _, i := range s.Instances {
+		if !i.Occurrence.After(now) {
+			instances = append(instances, i)
+			continue
+		}
+
+		if len(occurrences) == 0 {
+			detached = append(detached, i.Race)
+			continue
+		}
+
+		kept = append(kept, SeriesInstanceMove{Race: i.Race, From: i.Occurrence, To: occurrences[0]})
+		i.Occurrence = occurrences[0]
+		occurrences = occurrences[1:]
+		instances = append(instances, i)
+	}
+	s.Instances = instances
+
+	return kept, detached
+}
+
+// ApplySeriesTemplate sets the race data defined by the series
+func (r *Race) ApplySeriesTemplate(t SeriesTemplate) {
+	r.Name = t.Name
+	r.Description = t.Description
+	r.Venue = t.Venue
+}
+
+// SeriesStanding is the classification of a competitor in a series
+type SeriesStanding struct {
+	Position   int
+	Competitor UserID
+	Points     int
+	// Races is the number of races of the series the competitor finished
+	Races int
+}
+
+// Standings accumulates the points of the results of the series races, competitors with the same points
+// share the position
+func (s Series) Standings(races []Race) []SeriesStanding {
+	points := make(map[UserID][]int)
+	for _, r := range races {
+		for _, res := range r.Results.Ranking() {
+			points[res.Competitor] = append(points[res.Competitor], s.Scoring.points(res.Position))
+		}
+	}
+
+	standings := make([]SeriesStanding, 0, len(points))
+	for c, p := range points {
+		sort.Sort(sort.Reverse(sort.IntSlice(p)))
+		counted := p
+		if s.Scoring.BestOf > 0 && len(counted) > s.Scoring.BestOf {
+			counted = counted[:s.Scoring.BestOf]
+		}
+
+		standing := SeriesStanding{Competitor: c, Races: len(p)}
+		for _, n := range counted {
+			standing.Points += n
+		}
+		standings = append(standings, standing)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		if standings[i].Points != standings[j].Points {
+			return standings[i].Points > standings[j].Points
+		}
+
+		return standings[i].Competitor.String() < standings[j].Competitor.String()
+	})
+
+	for i := range standings {
+		standings[i].Position = i + 1
+		if i > 0 && standings[i].Points == standings[i-1].Points {
+			standings[i].Position = standings[i-1].Position
+		}
+	}
+
+	return standings
+}
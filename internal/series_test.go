@@ -0,0 +1,119 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeriesScoring(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("when the points are not valid returns InvalidSeriesScoringError", func(t *testing.T) {
+		_, err := racers.NewSeriesScoring([]int{10, 12}, 0)
+		require.True(errors.As(err, &racers.InvalidSeriesScoringError{}))
+
+		_, err = racers.NewSeriesScoring([]int{10, -1}, 0)
+		require.True(errors.As(err, &racers.InvalidSeriesScoringError{}))
+
+		_, err = racers.NewSeriesScoring(nil, -1)
+		require.True(errors.As(err, &racers.InvalidSeriesScoringError{}))
+	})
+
+	t.Run("when no points uses the default table", func(t *testing.T) {
+		scoring, err := racers.NewSeriesScoring(nil, 3)
+		require.NoError(err)
+
+		require.Equal(racers.SeriesScoring{Points: racers.DefaultSeriesPoints, BestOf: 3}, scoring)
+	})
+}
+
+func newWeeklySeries(t *testing.T, start time.Time) racers.Series {
+	recurrence, err := racers.ParseRecurrence("FREQ=WEEKLY")
+	require.NoError(t, err)
+
+	return racers.Series{
+		ID:         racers.SeriesID(id.Generate()),
+		Owner:      ownerID,
+		Template:   racers.SeriesTemplate{Name: raceName, Description: "parkrun"},
+		Start:      start,
+		Recurrence: recurrence,
+	}
+}
+
+func TestSeriesInstances(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("generates the pending occurrences up to the horizon", func(t *testing.T) {
+		series := newWeeklySeries(t, now.Add(-24*time.Hour))
+
+		pending := series.Pending(now)
+		require.Len(pending, 13)
+		require.Equal(now.AddDate(0, 0, 6), pending[0])
+
+		race := series.NewInstance(raceID, pending[0])
+		require.Equal(racers.Race{
+			ID:          raceID,
+			Name:        raceName,
+			Description: "parkrun",
+			Date:        racers.RaceDate(pending[0]),
+			Owner:       ownerID,
+			Series:      &racers.SeriesInstance{Series: series.ID, Race: raceID, Occurrence: pending[0]},
+		}, race)
+
+		require.Equal(pending[1:], series.Pending(now))
+	})
+
+	t.Run("updates only the future instances", func(t *testing.T) {
+		series := newWeeklySeries(t, now.AddDate(0, 0, -7))
+		past := racers.RaceID(id.Generate())
+		series.NewInstance(past, series.Start)
+		first, second := racers.RaceID(id.Generate()), racers.RaceID(id.Generate())
+		series.NewInstance(first, now.AddDate(0, 0, 7))
+		series.NewInstance(second, now.AddDate(0, 0, 14))
+
+		recurrence, err := racers.ParseRecurrence("FREQ=WEEKLY;COUNT=1")
+		require.NoError(err)
+		template := racers.SeriesTemplate{Name: "Sunday parkrun"}
+		start := now.AddDate(0, 0, 8)
+
+		kept, detached := series.Update(now, template, start, recurrence)
+
+		require.Equal([]racers.SeriesInstanceMove{{Race: first, From: now.AddDate(0, 0, 7), To: start}}, kept)
+		require.Equal([]racers.RaceID{second}, detached)
+		require.Equal([]racers.SeriesInstance{
+			{Series: series.ID, Race: past, Occurrence: now.AddDate(0, 0, -7)},
+			{Series: series.ID, Race: first, Occurrence: start},
+		}, series.Instances)
+		require.Equal(template, series.Template)
+	})
+}
+
+func TestSeriesStandings(t *testing.T) {
+	require := require.New(t)
+
+	a, b, c := racers.UserID(id.Generate()), racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	series := racers.Series{Scoring: racers.SeriesScoring{Points: []int{10, 6, 4}, BestOf: 2}}
+
+	races := []racers.Race{
+		{Results: racers.RaceResults{a: racers.RaceTime(time.Hour), b: racers.RaceTime(2 * time.Hour), c: racers.RaceTime(3 * time.Hour)}},
+		{Results: racers.RaceResults{b: racers.RaceTime(time.Hour), a: racers.RaceTime(2 * time.Hour)}},
+		{Results: racers.RaceResults{c: racers.RaceTime(time.Hour), a: racers.RaceTime(time.Hour)}},
+		{},
+	}
+
+	standings := series.Standings(races)
+
+	require.Equal([]racers.SeriesStanding{
+		{Position: 1, Competitor: a, Points: 20, Races: 3},
+		{Position: 2, Competitor: b, Points: 16, Races: 2},
+		{Position: 3, Competitor: c, Points: 14, Races: 2},
+	}, standings)
+}
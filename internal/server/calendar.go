@@ -0,0 +1,118 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/ical"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/service"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// RaceCalendarEndpoint is the calendar feed of a race
+	RaceCalendarEndpoint = "/races/{id}/calendar.ics"
+	// UpcomingCalendarEndpoint is the public calendar feed of the races not run yet
+	UpcomingCalendarEndpoint = "/calendar.ics"
+	// UserCalendarEndpoint is the personal calendar feed of the races a user joined,
+	// authenticated by the calendar token secret
+	UserCalendarEndpoint = "/calendar/{token}.ics"
+)
+
+// calendarFeeds serves the races as iCalendar feeds, linking them from the public url
+type calendarFeeds struct {
+	calendars service.Calendars
+	publicURL string
+	logger    log.Logger
+}
+
+func (f calendarFeeds) event(r racers.Race) ical.Event {
+	raceID := id.ID(r.ID).String()
+
+	e := ical.Event{
+		UID:      fmt.Sprintf("%s@racers", raceID),
+		Sequence: r.Sequence,
+		Start:    r.LocalDate(),
+		Summary:  string(r.Name),
+		URL:      fmt.Sprintf("%s/races/%s", strings.TrimSuffix(f.publicURL, "/"), raceID),
+	}
+	if v := r.Venue; v != nil {
+		e.Location = v.Name
+		if v.Address != "" {
+			e.Location = fmt.Sprintf("%s, %s", v.Name, v.Address)
+		}
+	}
+
+	return e
+}
+
+func (f calendarFeeds) write(w http.ResponseWriter, r *http.Request, name string, races []racers.Race) {
+	cal := ical.Calendar{Name: name, Events: make([]ical.Event, len(races))}
+	for i, race := range races {
+		cal.Events[i] = f.event(race)
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	if err := cal.Write(w, time.Now()); err != nil {
+		f.logger.Error(r.Context(), err, nil)
+	}
+}
+
+func (f calendarFeeds) race() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		race, err := f.calendars.RaceFeed(r.Context(), service.GetRace{ID: mux.Vars(r)["id"]})
+
+		var invalidID racers.InvalidRaceIDError
+		switch {
+		case errorsx.As(err, &invalidID):
+			http.Error(w, invalidID.Error(), http.StatusBadRequest)
+			return
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		case err != nil:
+			f.logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		f.write(w, r, string(race.Name), []racers.Race{race})
+	}
+}
+
+func (f calendarFeeds) upcoming() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		races, err := f.calendars.UpcomingFeed(r.Context())
+		if err != nil {
+			f.logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		f.write(w, r, "Upcoming races", races)
+	}
+}
+
+func (f calendarFeeds) user() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		races, err := f.calendars.UserFeed(r.Context(), service.UserFeed{Secret: mux.Vars(r)["token"]})
+		switch {
+		case errorsx.Is(err, service.ErrCalendarTokenNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		case err != nil:
+			f.logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		f.write(w, r, "My races", races)
+	}
+}
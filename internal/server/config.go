@@ -1,18 +1,53 @@
 package server
 
 import (
+	"fmt"
+
+	"github.com/xabi93/racers/internal/notifications"
 	"github.com/xabi93/racers/internal/storage/postgres"
 
 	"github.com/caarlos0/env/v6"
 )
 
 type Conf struct {
-	Port     string `env:"PORT" envDefault:"8080"`
+	Port string `env:"PORT" envDefault:"8080"`
+	// PublicURL is the base url the races are linked from, in the calendar feeds
+	PublicURL string `env:"PUBLIC_URL" envDefault:"http://localhost:8080"`
+	// PaymentSecret signs the callbacks of the payment gateway
+	PaymentSecret string `env:"PAYMENT_SECRET"`
+	// NotificationSecret signs the unsubscribe links of the emails
+	NotificationSecret string `env:"NOTIFICATION_SECRET"`
+	// CheckInSecret signs the check-in codes of the confirmation emails
+	CheckInSecret string `env:"CHECK_IN_SECRET"`
+	// TenantDomain is the domain the tenants are served as subdomains of, they are only named by header when empty
+	TenantDomain string `env:"TENANT_DOMAIN"`
+	// SMTP is the server the emails are sent through, when no host is set they are written to MailDir
+	SMTP     notifications.SMTPConfig
+	MailDir  string `env:"MAIL_DIR" envDefault:"mail"`
 	Postgres postgres.Config
 }
 
 func LoadConf() (Conf, error) {
 	var c Conf
+	if err := env.Parse(&c); err != nil {
+		return Conf{}, err
+	}
+
+	return c, nil
+}
+
+// checkSecrets fails when a secret of the server is not set, anyone could sign with an empty key. Only the server
+// uses them, the other commands load the configuration without them
+func (c Conf) checkSecrets() error {
+	for _, s := range []struct{ name, value string }{
+		{"PAYMENT_SECRET", c.PaymentSecret},
+		{"NOTIFICATION_SECRET", c.NotificationSecret},
+		{"CHECK_IN_SECRET", c.CheckInSecret},
+	} {
+		if s.value == "" {
+			return fmt.Errorf("env: secret %s is empty", s.name)
+		}
+	}
 
-	return c, env.Parse(&c)
+	return nil
 }
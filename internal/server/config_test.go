@@ -0,0 +1,50 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConf_Secrets(t *testing.T) {
+	secrets := []string{"PAYMENT_SECRET", "NOTIFICATION_SECRET", "CHECK_IN_SECRET"}
+	setSecrets := func() {
+		for _, s := range secrets {
+			os.Setenv(s, "secret")
+		}
+	}
+	defer func() {
+		for _, s := range secrets {
+			os.Unsetenv(s)
+		}
+	}()
+
+	for _, s := range secrets {
+		t.Run(s+" not set", func(t *testing.T) {
+			setSecrets()
+			os.Unsetenv(s)
+
+			conf, err := LoadConf()
+			require.NoError(t, err, "the commands not serving the API do not need it")
+			require.Error(t, conf.checkSecrets())
+		})
+
+		t.Run(s+" empty", func(t *testing.T) {
+			setSecrets()
+			os.Setenv(s, "")
+
+			conf, err := LoadConf()
+			require.NoError(t, err)
+			require.Error(t, conf.checkSecrets())
+		})
+	}
+
+	t.Run("all set", func(t *testing.T) {
+		setSecrets()
+
+		conf, err := LoadConf()
+		require.NoError(t, err)
+		require.NoError(t, conf.checkSecrets())
+	})
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/service"
+
+	"github.com/gorilla/mux"
+)
+
+// CourseEndpoint downloads the original course file of a race, or of a category with the category query param
+const CourseEndpoint = "/races/{id}/course"
+
+func courseFileHandler(races service.Races, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		category := r.URL.Query().Get("category")
+		file, err := races.CourseFile(r.Context(), service.GetCourseFile{
+			RaceID:   mux.Vars(r)["id"],
+			Category: category,
+		})
+
+		var invalidID racers.InvalidRaceIDError
+		switch {
+		case errorsx.As(err, &invalidID):
+			http.Error(w, invalidID.Error(), http.StatusBadRequest)
+			return
+		case errorsx.Is(err, service.ErrCourseNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		case err != nil:
+			logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		name := "course"
+		if category != "" {
+			name = fmt.Sprintf("course-%s", category)
+		}
+
+		w.Header().Set("Content-Type", file.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s", name, file.Format)))
+		_, _ = w.Write(file.Data)
+	}
+}
@@ -0,0 +1,304 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"strconv"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/service"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// StartListEndpoint exports the start list of a race, in the format of the format query param
+	StartListEndpoint = "/races/{id}/start-list"
+	// ResultsEndpoint exports the results of a race, in the format of the format query param
+	ResultsEndpoint = "/races/{id}/results"
+)
+
+const (
+	exportCSV    = "csv"
+	exportNDJSON = "ndjson"
+	exportHTML   = "html"
+)
+
+// sheet is a table exported row by row
+type sheet struct {
+	Title   string
+	Columns []string
+}
+
+// sheetWriter writes a sheet in an export format, cells are used by tabular formats and record by the others
+type sheetWriter interface {
+	contentType() string
+	begin(s sheet) error
+	row(cells []string, record interface{}) error
+	end() error
+}
+
+func newSheetWriter(format string, w io.Writer) (sheetWriter, error) {
+	switch format {
+	case "", exportCSV:
+		return &csvSheet{w: csv.NewWriter(w)}, nil
+	case exportNDJSON:
+		return ndjsonSheet{json.NewEncoder(w)}, nil
+	case exportHTML:
+		return htmlSheet{w}, nil
+	}
+
+	return nil, fmt.Errorf("unknown export format %q, expected one of %s, %s, %s", format, exportCSV, exportNDJSON, exportHTML)
+}
+
+type csvSheet struct{ w *csv.Writer }
+
+func (csvSheet) contentType() string { return "text/csv; charset=utf-8" }
+
+func (s *csvSheet) begin(sh sheet) error {
+	return s.write(sh.Columns)
+}
+
+func (s *csvSheet) row(cells []string, _ interface{}) error {
+	return s.write(cells)
+}
+
+func (s *csvSheet) write(cells []string) error {
+	if err := s.w.Write(cells); err != nil {
+		return err
+	}
+	s.w.Flush()
+
+	return s.w.Error()
+}
+
+func (csvSheet) end() error { return nil }
+
+type ndjsonSheet struct{ enc *json.Encoder }
+
+func (ndjsonSheet) contentType() string { return "application/x-ndjson" }
+
+func (ndjsonSheet) begin(sheet) error { return nil }
+
+func (s ndjsonSheet) row(_ []string, record interface{}) error {
+	return s.enc.Encode(record)
+}
+
+func (ndjsonSheet) end() error { return nil }
+
+var sheetTemplate = template.Must(template.New("sheet").Parse(`
+{{- define "begin" -}}
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: sans-serif; font-size: 11pt; margin: 1cm; }
+h1 { font-size: 16pt; }
+table { width: 100%; border-collapse: collapse; }
+th, td { padding: 2pt 6pt; border-bottom: 1px solid #ccc; text-align: left; }
+th { border-bottom: 2px solid #000; }
+tr:nth-child(even) td { background: #f4f4f4; }
+@page { size: A4; margin: 1cm; }
+@media print {
+	body { margin: 0; }
+	thead { display: table-header-group; }
+	tr { page-break-inside: avoid; }
+}
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+<table>
+<thead><tr>{{ range .Columns }}<th>{{ . }}</th>{{ end }}</tr></thead>
+<tbody>
+{{ end -}}
+{{- define "row" }}<tr>{{ range . }}<td>{{ . }}</td>{{ end }}</tr>
+{{ end -}}
+{{- define "end" -}}
+</tbody>
+</table>
+</body>
+</html>
+{{ end -}}
+`))
+
+type htmlSheet struct{ w io.Writer }
+
+func (htmlSheet) contentType() string { return "text/html; charset=utf-8" }
+
+func (s htmlSheet) begin(sh sheet) error {
+	return sheetTemplate.ExecuteTemplate(s.w, "begin", sh)
+}
+
+func (s htmlSheet) row(cells []string, _ interface{}) error {
+	return sheetTemplate.ExecuteTemplate(s.w, "row", cells)
+}
+
+func (s htmlSheet) end() error {
+	return sheetTemplate.ExecuteTemplate(s.w, "end", nil)
+}
+
+// exportStream starts the response with the first row, so errors found before streaming
+// still get a proper status code
+type exportStream struct {
+	w       http.ResponseWriter
+	sheet   sheet
+	name    string
+	writer  sheetWriter
+	started bool
+}
+
+func (s *exportStream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+
+	s.w.Header().Set("Content-Type", s.writer.contentType())
+	if _, ok := s.writer.(htmlSheet); !ok {
+		s.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.name))
+	}
+
+	return s.writer.begin(s.sheet)
+}
+
+func (s *exportStream) row(cells []string, record interface{}) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	if err := s.writer.row(cells, record); err != nil {
+		return err
+	}
+
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return nil
+}
+
+func (s *exportStream) end() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+
+	return s.writer.end()
+}
+
+type exportFunc func(r *http.Request, raceID string, row func(cells []string, record interface{}) error) error
+
+func exportHandler(sh sheet, name string, export exportFunc, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		format := r.URL.Query().Get("format")
+		writer, err := newSheetWriter(format, w)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if format == "" {
+			format = exportCSV
+		}
+
+		stream := &exportStream{w: w, sheet: sh, name: fmt.Sprintf("%s.%s", name, format), writer: writer}
+
+		err = export(r, mux.Vars(r)["id"], stream.row)
+		if err == nil {
+			err = stream.end()
+		}
+
+		if err == nil {
+			return
+		}
+
+		if stream.started {
+			// the status is already sent, the client gets a truncated export
+			logger.Error(r.Context(), err, nil)
+			return
+		}
+
+		var invalidID racers.InvalidRaceIDError
+		switch {
+		case errorsx.As(err, &invalidID):
+			http.Error(w, invalidID.Error(), http.StatusBadRequest)
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+	}
+}
+
+type startListRecord struct {
+	Bib      int    `json:"bib,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Category string `json:"category,omitempty"`
+	Team     string `json:"team,omitempty"`
+}
+
+func startListHandler(races service.Races, logger log.Logger) http.HandlerFunc {
+	sh := sheet{Title: "Start list", Columns: []string{"bib", "name", "category", "team"}}
+
+	return exportHandler(sh, "start-list", func(r *http.Request, raceID string, row func([]string, interface{}) error) error {
+		return races.StartList(r.Context(), service.ExportRace{RaceID: raceID}, func(e service.StartListEntry) error {
+			return row(
+				[]string{bibCell(e.Bib), e.Name, string(e.Category), string(e.Team)},
+				startListRecord{int(e.Bib), e.Name, string(e.Category), string(e.Team)},
+			)
+		})
+	}, logger)
+}
+
+type resultRecord struct {
+	Position         int    `json:"position"`
+	Bib              int    `json:"bib,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Category         string `json:"category,omitempty"`
+	CategoryPosition int    `json:"categoryPosition"`
+	Time             string `json:"time"`
+	Gap              string `json:"gap"`
+}
+
+func resultsHandler(races service.Races, logger log.Logger) http.HandlerFunc {
+	sh := sheet{Title: "Results", Columns: []string{"position", "bib", "name", "category", "category position", "time", "gap"}}
+
+	return exportHandler(sh, "results", func(r *http.Request, raceID string, row func([]string, interface{}) error) error {
+		return races.ResultSheet(r.Context(), service.ExportRace{RaceID: raceID}, func(e service.ResultSheetEntry) error {
+			record := resultRecord{
+				Position:         e.Position,
+				Bib:              int(e.Bib),
+				Name:             e.Name,
+				Category:         string(e.Category),
+				CategoryPosition: e.CategoryPosition,
+				Time:             e.Time.String(),
+				Gap:              e.Gap.String(),
+			}
+
+			return row(
+				[]string{
+					strconv.Itoa(record.Position), bibCell(e.Bib), record.Name, record.Category,
+					strconv.Itoa(record.CategoryPosition), record.Time, record.Gap,
+				},
+				record,
+			)
+		})
+	}, logger)
+}
+
+// bibCell formats the bib number, empty when the competitor has none
+func bibCell(b racers.Bib) string {
+	if b == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(int(b))
+}
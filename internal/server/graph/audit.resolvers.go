@@ -0,0 +1,55 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *queryResolver) AuditLog(ctx context.Context, filter *models.AuditLogFilter, first *int, after *string) (models.AuditLogResult, error) {
+	req := service.AuditLog{}
+	if filter != nil {
+		req.UserID = stringValue(filter.UserID)
+		req.RaceID = stringValue(filter.RaceID)
+		req.OrganizationID = stringValue(filter.OrganizationID)
+		req.Type = stringValue(filter.Type)
+		req.From = filter.From
+		req.To = filter.To
+	}
+	if first != nil {
+		req.First = *first
+	}
+	req.After = stringValue(after)
+
+	result, err := r.audit.Log(ctx, req)
+
+	var (
+		invalidUserID racers.InvalidUserIDError
+		invalidRaceID racers.InvalidRaceIDError
+		invalidOrgID  racers.InvalidOrganizationIDError
+	)
+	if err != nil {
+		switch {
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrInvalidCursor):
+			return models.InvalidCursorError{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidOrgID):
+			return models.InvalidIDError{Message: invalidOrgID.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewAuditLog(result), nil
+}
@@ -0,0 +1,54 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) AssignBib(ctx context.Context, bib models.BibInput) (models.AssignBibResult, error) {
+	race, err := r.racers.AssignBib(ctx, service.AssignBib{
+		RaceID: bib.RaceID,
+		UserID: bib.UserID,
+		Bib:    bib.Bib,
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		invalidBib    racers.InvalidBibError
+		notEnabled    racers.BibsNotEnabledError
+		taken         racers.BibTakenError
+		notInRace     racers.CompetitorNotInRaceError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.CompetitorNotInRaceError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &invalidBib):
+			return models.InvalidBibError{Message: invalidBib.Error()}, nil
+		case errorsx.As(err, &notEnabled):
+			return models.InvalidBibError{Message: notEnabled.Error()}, nil
+		case errorsx.As(err, &taken):
+			return models.InvalidBibError{Message: taken.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
@@ -0,0 +1,63 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) RescheduleRace(ctx context.Context, race models.RescheduleRaceInput) (models.RescheduleRaceResult, error) {
+	result, err := r.racers.Reschedule(ctx, service.RescheduleRace{RaceID: race.RaceID, Date: race.Date})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidDate   racers.InvalidRaceDateError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidDate):
+			return models.InvalidRaceDateError{Message: invalidDate.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(result), nil
+}
+
+func (r *mutationResolver) CreateCalendarToken(ctx context.Context) (models.CreateCalendarTokenResult, error) {
+	token, err := r.calendars.CreateToken(ctx)
+	if err != nil {
+		if errorsx.Is(err, service.ErrForbidden) {
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewCalendarToken(token), nil
+}
+
+func (r *mutationResolver) RevokeCalendarToken(ctx context.Context) (models.RevokeCalendarTokenResult, error) {
+	if err := r.calendars.RevokeToken(ctx); err != nil {
+		if errorsx.Is(err, service.ErrForbidden) {
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.CalendarTokenRevoked{Revoked: true}, nil
+}
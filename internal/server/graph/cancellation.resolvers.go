@@ -0,0 +1,52 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) CancelRace(ctx context.Context, race models.CancelRaceInput) (models.CancelRaceResult, error) {
+	cancel := service.CancelRace{RaceID: race.RaceID, Reason: race.Reason}
+	if t := race.TransferTo; t != nil {
+		cancel.TransferTo = &service.CancelRaceTransfer{RaceID: t.RaceID, Category: stringValue(t.Category)}
+	}
+
+	result, err := r.cancels.Cancel(ctx, cancel)
+
+	var (
+		invalidRaceID   racers.InvalidRaceIDError
+		invalidReason   racers.InvalidCancellationReasonError
+		notCancellable  racers.RaceNotCancellableError
+		invalidTransfer racers.InvalidTransferError
+		unknownCategory racers.UnknownCategoryError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidReason):
+			return models.CancellationError{Message: invalidReason.Error()}, nil
+		case errorsx.As(err, &notCancellable):
+			return models.CancellationError{Message: notCancellable.Error()}, nil
+		case errorsx.As(err, &invalidTransfer):
+			return models.CancellationError{Message: invalidTransfer.Error()}, nil
+		case errorsx.As(err, &unknownCategory):
+			return models.CancellationError{Message: unknownCategory.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(result), nil
+}
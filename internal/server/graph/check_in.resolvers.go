@@ -0,0 +1,60 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) CheckIn(ctx context.Context, checkIn models.CheckInInput) (models.CheckInResult, error) {
+	entry, err := r.checkIns.CheckIn(ctx, service.FindCompetitor{
+		RaceID: checkIn.RaceID,
+		Token:  stringValue(checkIn.Qr),
+		UserID: stringValue(checkIn.UserID),
+		Bib:    intValue(checkIn.Bib),
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		notInRace     racers.CompetitorNotInRaceError
+		invalidBib    racers.InvalidBibError
+		unknownBib    racers.UnknownBibError
+		invalidStatus racers.InvalidRegistrationStatusError
+		closed        racers.CheckInClosedError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrInvalidCheckInToken):
+			return models.InvalidIDError{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.CompetitorNotInRaceError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &invalidBib):
+			return models.InvalidBibError{Message: invalidBib.Error()}, nil
+		case errorsx.As(err, &unknownBib):
+			return models.InvalidBibError{Message: unknownBib.Error()}, nil
+		case errorsx.As(err, &invalidStatus):
+			return models.CheckInError{Message: invalidStatus.Error()}, nil
+		case errorsx.As(err, &closed):
+			return models.CheckInError{Message: closed.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewCompetitorCheckIn(entry), nil
+}
@@ -0,0 +1,43 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) RecordPassages(ctx context.Context, passages models.PassagesInput) (models.RecordPassagesResult, error) {
+	req := service.RecordPassages{RaceID: passages.RaceID, Passages: make([]service.Passage, len(passages.Passages))}
+	for i, p := range passages.Passages {
+		req.Passages[i] = service.Passage{UserID: stringValue(p.UserID), Bib: intValue(p.Bib), Checkpoint: p.Checkpoint, At: p.At}
+	}
+
+	result, err := r.racers.RecordPassages(ctx, req)
+
+	var invalidRaceID racers.InvalidRaceIDError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	rejected := make([]*models.RejectedPassage, len(result.Rejected))
+	for i, p := range result.Rejected {
+		rejected[i] = &models.RejectedPassage{Index: p.Index, Message: p.Err.Error()}
+	}
+
+	return models.PassagesRecorded{Race: models.NewRace(result.Race), Rejected: rejected}, nil
+}
@@ -0,0 +1,51 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/track"
+)
+
+func (r *mutationResolver) UploadCourse(ctx context.Context, course models.CourseUploadInput) (models.UploadCourseResult, error) {
+	race, err := r.racers.UploadCourse(ctx, service.UploadCourse{
+		RaceID:   course.RaceID,
+		Category: stringValue(course.Category),
+		File:     course.File.File,
+	})
+
+	var (
+		invalidRaceID   racers.InvalidRaceIDError
+		invalidCourse   racers.InvalidCourseError
+		unknownCategory racers.UnknownCategoryError
+		parseErr        track.ParseError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidCourse):
+			return models.InvalidCourseError{Message: invalidCourse.Error()}, nil
+		case errorsx.As(err, &unknownCategory):
+			return models.InvalidCourseError{Message: unknownCategory.Error()}, nil
+		case errorsx.As(err, &parseErr):
+			return models.InvalidCourseError{Message: parseErr.Error()}, nil
+		case errorsx.Is(err, track.ErrUnknownFormat), errorsx.Is(err, service.ErrCourseFileTooLarge):
+			return models.InvalidCourseError{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
@@ -0,0 +1,70 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) CreateDiscountCode(ctx context.Context, code models.DiscountCodeInput) (models.CreateDiscountCodeResult, error) {
+	result, err := r.codes.Create(ctx, service.CreateDiscountCode{
+		RaceID:         code.RaceID,
+		Code:           code.Code,
+		Percent:        intValue(code.Percent),
+		Amount:         int64(intValue(code.Amount)),
+		MaxRedemptions: intValue(code.MaxRedemptions),
+		ValidFrom:      timeValue(code.ValidFrom),
+		ValidUntil:     timeValue(code.ValidUntil),
+		Category:       stringValue(code.Category),
+	})
+
+	var (
+		invalidID       racers.InvalidRaceIDError
+		invalidCode     racers.InvalidDiscountCodeError
+		unknownCategory racers.UnknownCategoryError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidCode):
+			return models.InvalidDiscountCodeError{Message: invalidCode.Error()}, nil
+		case errorsx.As(err, &unknownCategory):
+			return models.InvalidDiscountCodeError{Message: unknownCategory.Error()}, nil
+		case errorsx.Is(err, service.ErrDiscountCodeAlreadyExists):
+			return models.DiscountCodeAlreadyExists{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewDiscountCode(result), nil
+}
+
+func (r *queryResolver) DiscountCodes(ctx context.Context, raceID string) (models.DiscountCodesResult, error) {
+	codes, err := r.codes.List(ctx, service.GetRace{ID: raceID})
+
+	var invalidID racers.InvalidRaceIDError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewDiscountCodes(codes), nil
+}
@@ -39,6 +39,7 @@ type Config struct {
 type ResolverRoot interface {
 	Mutation() MutationResolver
 	Query() QueryResolver
+	Series() SeriesResolver
 }
 
 type DirectiveRoot struct {
@@ -46,2848 +47,28057 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
-	InvalidIDError struct {
-		Message func(childComplexity int) int
+	AuditLog struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
 	}
 
-	InvalidRaceDateError struct {
-		Message func(childComplexity int) int
+	AuditLogEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
-	InvalidRaceNameError struct {
-		Message func(childComplexity int) int
+	AuditLogEntry struct {
+		Actor          func(childComplexity int) int
+		ID             func(childComplexity int) int
+		OccurredAt     func(childComplexity int) int
+		OrganizationID func(childComplexity int) int
+		Payload        func(childComplexity int) int
+		RaceID         func(childComplexity int) int
+		Type           func(childComplexity int) int
 	}
 
-	Mutation struct {
-		CreateRace func(childComplexity int, race models.RaceInput) int
+	BibRange struct {
+		From func(childComplexity int) int
+		To   func(childComplexity int) int
 	}
 
-	Query struct {
-		Race  func(childComplexity int, id string) int
-		Races func(childComplexity int) int
+	CalendarToken struct {
+		CreatedAt func(childComplexity int) int
+		Feed      func(childComplexity int) int
+		Secret    func(childComplexity int) int
 	}
 
-	Race struct {
-		Competitors func(childComplexity int) int
-		Date        func(childComplexity int) int
-		ID          func(childComplexity int) int
-		Name        func(childComplexity int) int
+	CalendarTokenRevoked struct {
+		Revoked func(childComplexity int) int
 	}
 
-	RaceAlreadyExists struct {
+	CancellationError struct {
 		Message func(childComplexity int) int
 	}
 
-	RaceNotFound struct {
+	CheckIn struct {
+		At         func(childComplexity int) int
+		Competitor func(childComplexity int) int
+	}
+
+	CheckInError struct {
 		Message func(childComplexity int) int
 	}
 
-	Races struct {
-		Races func(childComplexity int) int
+	Checkout struct {
+		PaymentID func(childComplexity int) int
+		URL       func(childComplexity int) int
 	}
 
-	User struct {
-		ID    func(childComplexity int) int
-		Name  func(childComplexity int) int
-		Races func(childComplexity int) int
+	Checkpoint struct {
+		Cutoff   func(childComplexity int) int
+		Distance func(childComplexity int) int
+		Name     func(childComplexity int) int
 	}
-}
 
-type MutationResolver interface {
-	CreateRace(ctx context.Context, race models.RaceInput) (models.CreateRaceResult, error)
-}
-type QueryResolver interface {
-	Race(ctx context.Context, id string) (models.RaceResult, error)
-	Races(ctx context.Context) (*models.Races, error)
-}
+	CompetitorBib struct {
+		Bib        func(childComplexity int) int
+		Competitor func(childComplexity int) int
+	}
 
-type executableSchema struct {
-	resolvers  ResolverRoot
-	directives DirectiveRoot
-	complexity ComplexityRoot
-}
+	CompetitorCheckIn struct {
+		Bib         func(childComplexity int) int
+		Category    func(childComplexity int) int
+		CheckedInAt func(childComplexity int) int
+		Competitor  func(childComplexity int) int
+		RaceID      func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
 
-func (e *executableSchema) Schema() *ast.Schema {
-	return parsedSchema
-}
+	CompetitorNotInRaceError struct {
+		Message func(childComplexity int) int
+	}
 
-func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
-	ec := executionContext{nil, e}
-	_ = ec
-	switch typeName + "." + field {
+	CompetitorResult struct {
+		Competitor func(childComplexity int) int
+		Position   func(childComplexity int) int
+		Time       func(childComplexity int) int
+	}
 
-	case "InvalidIDError.message":
-		if e.complexity.InvalidIDError.Message == nil {
-			break
-		}
+	CompetitorSplits struct {
+		Competitor func(childComplexity int) int
+		Splits     func(childComplexity int) int
+	}
 
-		return e.complexity.InvalidIDError.Message(childComplexity), true
+	Course struct {
+		Distance      func(childComplexity int) int
+		ElevationGain func(childComplexity int) int
+		ElevationLoss func(childComplexity int) int
+		Points        func(childComplexity int) int
+		Polyline      func(childComplexity int) int
+	}
 
-	case "InvalidRaceDateError.message":
-		if e.complexity.InvalidRaceDateError.Message == nil {
-			break
-		}
+	CoursePoint struct {
+		Elevation func(childComplexity int) int
+		Lat       func(childComplexity int) int
+		Lon       func(childComplexity int) int
+	}
 
-		return e.complexity.InvalidRaceDateError.Message(childComplexity), true
+	DataExport struct {
+		ContentType func(childComplexity int) int
+		Data        func(childComplexity int) int
+		FileName    func(childComplexity int) int
+	}
 
-	case "InvalidRaceNameError.message":
-		if e.complexity.InvalidRaceNameError.Message == nil {
-			break
-		}
+	DiscountCode struct {
+		Amount         func(childComplexity int) int
+		Category       func(childComplexity int) int
+		Code           func(childComplexity int) int
+		MaxRedemptions func(childComplexity int) int
+		Percent        func(childComplexity int) int
+		RaceID         func(childComplexity int) int
+		Redemptions    func(childComplexity int) int
+		ValidFrom      func(childComplexity int) int
+		ValidUntil     func(childComplexity int) int
+	}
 
-		return e.complexity.InvalidRaceNameError.Message(childComplexity), true
+	DiscountCodeAlreadyExists struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Mutation.createRace":
-		if e.complexity.Mutation.CreateRace == nil {
-			break
-		}
+	DiscountCodeNotApplicableError struct {
+		Message func(childComplexity int) int
+	}
 
-		args, err := ec.field_Mutation_createRace_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
+	DiscountCodeNotFound struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Mutation.CreateRace(childComplexity, args["race"].(models.RaceInput)), true
+	DiscountCodes struct {
+		Codes func(childComplexity int) int
+	}
 
-	case "Query.race":
-		if e.complexity.Query.Race == nil {
-			break
-		}
+	Erasure struct {
+		FinishedAt  func(childComplexity int) int
+		ID          func(childComplexity int) int
+		RequestedAt func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
 
-		args, err := ec.field_Query_race_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
+	ErasureNotFound struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Query.Race(childComplexity, args["id"].(string)), true
+	Forbidden struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Query.races":
-		if e.complexity.Query.Races == nil {
-			break
-		}
+	ImportIssue struct {
+		Bib     func(childComplexity int) int
+		Kind    func(childComplexity int) int
+		Line    func(childComplexity int) int
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Query.Races(childComplexity), true
+	ImportedResult struct {
+		Bib        func(childComplexity int) int
+		Competitor func(childComplexity int) int
+		Line       func(childComplexity int) int
+		Time       func(childComplexity int) int
+	}
 
-	case "Race.competitors":
-		if e.complexity.Race.Competitors == nil {
-			break
-		}
+	InvalidBibError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Race.Competitors(childComplexity), true
+	InvalidCourseError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Race.date":
-		if e.complexity.Race.Date == nil {
-			break
-		}
+	InvalidCursorError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Race.Date(childComplexity), true
+	InvalidDiscountCodeError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Race.id":
-		if e.complexity.Race.ID == nil {
-			break
-		}
+	InvalidIDError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Race.ID(childComplexity), true
+	InvalidLegSplitError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Race.name":
-		if e.complexity.Race.Name == nil {
-			break
-		}
+	InvalidOrganizationNameError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Race.Name(childComplexity), true
+	InvalidRaceBibsError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "RaceAlreadyExists.message":
-		if e.complexity.RaceAlreadyExists.Message == nil {
-			break
-		}
+	InvalidRaceCategoryError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.RaceAlreadyExists.Message(childComplexity), true
+	InvalidRaceCheckpointsError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "RaceNotFound.message":
-		if e.complexity.RaceNotFound.Message == nil {
-			break
-		}
+	InvalidRaceDateError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.RaceNotFound.Message(childComplexity), true
+	InvalidRaceNameError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "Races.races":
-		if e.complexity.Races.Races == nil {
-			break
-		}
+	InvalidRacePriceError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.Races.Races(childComplexity), true
+	InvalidRaceRelayError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
-			break
-		}
+	InvalidRaceSearchError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.User.ID(childComplexity), true
+	InvalidRaceTeamsError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "User.name":
-		if e.complexity.User.Name == nil {
-			break
-		}
+	InvalidRaceTimeError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.User.Name(childComplexity), true
+	InvalidRacesNearError struct {
+		Message func(childComplexity int) int
+	}
 
-	case "User.races":
-		if e.complexity.User.Races == nil {
-			break
-		}
+	InvalidRelayLineUpError struct {
+		Message func(childComplexity int) int
+	}
 
-		return e.complexity.User.Races(childComplexity), true
+	InvalidResultsFileError struct {
+		Message func(childComplexity int) int
+	}
 
+	InvalidSeriesError struct {
+		Message func(childComplexity int) int
 	}
-	return 0, false
-}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	first := true
+	InvalidTeamEntryError struct {
+		Message func(childComplexity int) int
+	}
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._queryMiddleware(ctx, rc.Operation, func(ctx context.Context) (interface{}, error) {
-				return ec._Query(ctx, rc.Operation.SelectionSet), nil
-			})
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	InvalidTransferDeadlineError struct {
+		Message func(childComplexity int) int
+	}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
-		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._mutationMiddleware(ctx, rc.Operation, func(ctx context.Context) (interface{}, error) {
-				return ec._Mutation(ctx, rc.Operation.SelectionSet), nil
-			})
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	InvalidVenueError struct {
+		Message func(childComplexity int) int
+	}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
-		}
+	Money struct {
+		Amount   func(childComplexity int) int
+		Currency func(childComplexity int) int
+	}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	Mutation struct {
+		AcceptRegistration            func(childComplexity int, transfer models.AcceptRegistrationInput) int
+		AcceptTeamInvitation          func(childComplexity int, teamID string) int
+		AddOrganizationMember         func(childComplexity int, member models.OrganizationMemberInput) int
+		AddRaceStaff                  func(childComplexity int, staff models.RaceStaffInput) int
+		ApproveJoinRequest            func(childComplexity int, request models.TeamUserInput) int
+		AssignBib                     func(childComplexity int, bib models.BibInput) int
+		AssignRaceToOrganization      func(childComplexity int, assignment models.RaceOrganizationInput) int
+		CancelRace                    func(childComplexity int, race models.CancelRaceInput) int
+		CheckIn                       func(childComplexity int, checkIn models.CheckInInput) int
+		Checkout                      func(childComplexity int, raceID string) int
+		CreateCalendarToken           func(childComplexity int) int
+		CreateDiscountCode            func(childComplexity int, code models.DiscountCodeInput) int
+		CreateOrganization            func(childComplexity int, organization models.OrganizationInput) int
+		CreateRace                    func(childComplexity int, race models.RaceInput) int
+		CreateSeries                  func(childComplexity int, series models.SeriesInput) int
+		DeclineTeamInvitation         func(childComplexity int, teamID string) int
+		EnterTeam                     func(childComplexity int, entry models.TeamEntryInput) int
+		EraseMyData                   func(childComplexity int) int
+		GenerateSeriesRaces           func(childComplexity int, id string) int
+		ImportResults                 func(childComplexity int, results models.ResultsImportInput) int
+		InviteToTeam                  func(childComplexity int, invitation models.TeamUserInput) int
+		JoinRace                      func(childComplexity int, registration models.JoinRaceInput) int
+		LeaveTeam                     func(childComplexity int, teamID string) int
+		OfferRegistration             func(childComplexity int, transfer models.OfferRegistrationInput) int
+		RecordLegSplit                func(childComplexity int, split models.LegSplitInput) int
+		RecordPassages                func(childComplexity int, passages models.PassagesInput) int
+		RecordResult                  func(childComplexity int, result models.RaceResultInput) int
+		RefundRegistration            func(childComplexity int, registration models.RefundRegistrationInput) int
+		RejectJoinRequest             func(childComplexity int, request models.TeamUserInput) int
+		RemoveMember                  func(childComplexity int, member models.TeamUserInput) int
+		RemoveOrganizationMember      func(childComplexity int, member models.RemoveOrganizationMemberInput) int
+		RemoveRaceStaff               func(childComplexity int, staff models.RemoveRaceStaffInput) int
+		RequestToJoinTeam             func(childComplexity int, teamID string) int
+		RescheduleRace                func(childComplexity int, race models.RescheduleRaceInput) int
+		RevokeCalendarToken           func(childComplexity int) int
+		SetRegistrationTransfers      func(childComplexity int, race models.RegistrationTransfersInput) int
+		SetRelayLineUp                func(childComplexity int, lineUp models.RelayLineUpInput) int
+		TransferAdmin                 func(childComplexity int, to models.TeamUserInput) int
+		TransferRaceOwnership         func(childComplexity int, owner models.RaceOwnerInput) int
+		UpdateNotificationPreferences func(childComplexity int, preferences models.NotificationPreferencesInput) int
+		UpdateSeries                  func(childComplexity int, series models.SeriesInput) int
+		UploadCourse                  func(childComplexity int, course models.CourseUploadInput) int
+		WithdrawRegistrationOffer     func(childComplexity int, raceID string) int
 	}
-}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-}
+	NotificationPreferences struct {
+		Disabled func(childComplexity int) int
+		Locale   func(childComplexity int) int
+	}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
+	Organization struct {
+		Dashboard func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Members   func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Races     func(childComplexity int) int
 	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
+	OrganizationAlreadyExists struct {
+		Message func(childComplexity int) int
 	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
-}
 
-var sources = []*ast.Source{
-	{Name: "../../../api/race.graphql", Input: `type Race {
-    id: ID!
-    name: String!
-    date: DateTime!
-    competitors: [User!]!
-}
+	OrganizationDashboard struct {
+		Races   func(childComplexity int) int
+		Revenue func(childComplexity int) int
+	}
 
-type Races {
-    races: [Race!]!
-}
-`, BuiltIn: false},
-	{Name: "../../../api/schema.graphql", Input: `
-directive @logged on MUTATION | QUERY | FIELD
+	OrganizationMember struct {
+		Role func(childComplexity int) int
+		User func(childComplexity int) int
+	}
 
-type Query {
-  race(id: ID!): RaceResult!
-  races: Races!
-}
+	OrganizationMemberError struct {
+		Message func(childComplexity int) int
+	}
 
-union RaceResult = Race | InvalidIDError | RaceNotFound
+	OrganizationNotFound struct {
+		Message func(childComplexity int) int
+	}
 
-type Mutation {
-  createRace(race: RaceInput!): CreateRaceResult! @logged
-}
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
 
-input RaceInput {
-    id: ID!
-    name: String!
-    date: DateTime!
-}
+	PassagesRecorded struct {
+		Race     func(childComplexity int) int
+		Rejected func(childComplexity int) int
+	}
 
-union CreateRaceResult = Race | InvalidIDError | InvalidRaceNameError | InvalidRaceDateError | RaceAlreadyExists
+	PriceTier struct {
+		Amount func(childComplexity int) int
+		Until  func(childComplexity int) int
+	}
 
-scalar DateTime
+	Query struct {
+		AuditLog                func(childComplexity int, filter *models.AuditLogFilter, first *int, after *string) int
+		DiscountCodes           func(childComplexity int, raceID string) int
+		Erasure                 func(childComplexity int, id string) int
+		ExportMyData            func(childComplexity int) int
+		NotificationPreferences func(childComplexity int) int
+		Organization            func(childComplexity int, id string) int
+		Race                    func(childComplexity int, id string) int
+		Races                   func(childComplexity int) int
+		RacesNear               func(childComplexity int, lat float64, lon float64, radiusKm float64) int
+		SearchRaces             func(childComplexity int, query string, filter *models.RaceSearchFilter, first *int) int
+		Series                  func(childComplexity int, id string) int
+	}
 
-interface Error {
-    message: String!
-}
+	Race struct {
+		Bibs                  func(childComplexity int) int
+		Cancellation          func(childComplexity int) int
+		Categories            func(childComplexity int) int
+		CheckIns              func(childComplexity int) int
+		Checkpoints           func(childComplexity int) int
+		Competitors           func(childComplexity int) int
+		Course                func(childComplexity int) int
+		Date                  func(childComplexity int) int
+		Description           func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		Name                  func(childComplexity int) int
+		OrganizationID        func(childComplexity int) int
+		Owner                 func(childComplexity int) int
+		Price                 func(childComplexity int) int
+		RegistrationDeadline  func(childComplexity int) int
+		RegistrationOffers    func(childComplexity int) int
+		RegistrationTransfers func(childComplexity int) int
+		Relay                 func(childComplexity int) int
+		Results               func(childComplexity int) int
+		Sequence              func(childComplexity int) int
+		SeriesID              func(childComplexity int) int
+		Splits                func(childComplexity int) int
+		Staff                 func(childComplexity int) int
+		Status                func(childComplexity int) int
+		TeamStandings         func(childComplexity int) int
+		Teams                 func(childComplexity int) int
+		Venue                 func(childComplexity int) int
+	}
 
-type RaceNotFound implements Error {
-    message: String!
-}
+	RaceAlreadyExists struct {
+		Message func(childComplexity int) int
+	}
 
-type InvalidIDError implements Error {
-    message: String!
-}
+	RaceBibs struct {
+		Entries  func(childComplexity int) int
+		First    func(childComplexity int) int
+		Strategy func(childComplexity int) int
+	}
 
-type InvalidRaceNameError implements Error {
-    message: String!
-}
+	RaceCancellation struct {
+		CancelledAt func(childComplexity int) int
+		Reason      func(childComplexity int) int
+		TransferTo  func(childComplexity int) int
+	}
 
-type InvalidRaceDateError implements Error {
-    message: String!
-}
+	RaceCategory struct {
+		BibRange    func(childComplexity int) int
+		Capacity    func(childComplexity int) int
+		Competitors func(childComplexity int) int
+		Course      func(childComplexity int) int
+		Distance    func(childComplexity int) int
+		Gender      func(childComplexity int) int
+		MinAge      func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Price       func(childComplexity int) int
+		Results     func(childComplexity int) int
+		StartTime   func(childComplexity int) int
+	}
 
-type RaceAlreadyExists implements Error {
-    message: String!
-}
-`, BuiltIn: false},
-	{Name: "../../../api/user.graphql", Input: `type User {
-    id: ID!
-    name: String!
-    races: [Race!]!
-}
-`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+	RaceDashboard struct {
+		CheckedIn    func(childComplexity int) int
+		Confirmed    func(childComplexity int) int
+		Participants func(childComplexity int) int
+		RaceID       func(childComplexity int) int
+		Revenue      func(childComplexity int) int
+	}
 
-// endregion ************************** generated!.gotpl **************************
+	RaceMatch struct {
+		Race    func(childComplexity int) int
+		Rank    func(childComplexity int) int
+		Snippet func(childComplexity int) int
+	}
 
-// region    ***************************** args.gotpl *****************************
+	RaceNotFound struct {
+		Message func(childComplexity int) int
+	}
 
-func (ec *executionContext) field_Mutation_createRace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 models.RaceInput
-	if tmp, ok := rawArgs["race"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("race"))
-		arg0, err = ec.unmarshalNRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceInput(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	RacePrice struct {
+		Amount   func(childComplexity int) int
+		Currency func(childComplexity int) int
+		Tiers    func(childComplexity int) int
 	}
-	args["race"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	RaceRelay struct {
+		AllowRepeatRunners func(childComplexity int) int
+		Legs               func(childComplexity int) int
+		Standings          func(childComplexity int) int
 	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_race_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	RaceSearch struct {
+		Matches func(childComplexity int) int
 	}
-	args["id"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	RaceStaffMember struct {
+		Role func(childComplexity int) int
+		User func(childComplexity int) int
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
-		}
+	RaceTeams struct {
+		Counting   func(childComplexity int) int
+		MaxMembers func(childComplexity int) int
+		MinMembers func(childComplexity int) int
+		Scoring    func(childComplexity int) int
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-// endregion ***************************** args.gotpl *****************************
+	RaceTransfer struct {
+		Category func(childComplexity int) int
+		RaceID   func(childComplexity int) int
+	}
 
-// region    ************************** directives.gotpl **************************
+	Races struct {
+		Races func(childComplexity int) int
+	}
 
-func (ec *executionContext) _queryMiddleware(ctx context.Context, obj *ast.OperationDefinition, next func(ctx context.Context) (interface{}, error)) graphql.Marshaler {
+	Registration struct {
+		Code       func(childComplexity int) int
+		Competitor func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		Fee        func(childComplexity int) int
+		RaceID     func(childComplexity int) int
+		Status     func(childComplexity int) int
+	}
 
-	for _, d := range obj.Directives {
-		switch d.Name {
-		case "logged":
-			n := next
-			next = func(ctx context.Context) (interface{}, error) {
-				if ec.directives.Logged == nil {
-					return nil, errors.New("directive logged is not implemented")
-				}
-				return ec.directives.Logged(ctx, obj, n)
-			}
-		}
+	RegistrationError struct {
+		Message func(childComplexity int) int
 	}
-	tmp, err := next(ctx)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	RegistrationOffer struct {
+		From      func(childComplexity int) int
+		OfferedAt func(childComplexity int) int
+		RaceID    func(childComplexity int) int
+		To        func(childComplexity int) int
 	}
-	if data, ok := tmp.(graphql.Marshaler); ok {
-		return data
+
+	RegistrationOfferWithdrawn struct {
+		RaceID func(childComplexity int) int
 	}
-	ec.Errorf(ctx, `unexpected type %T from directive, should be graphql.Marshaler`, tmp)
-	return graphql.Null
 
-}
+	RegistrationTransferError struct {
+		Message func(childComplexity int) int
+	}
 
-func (ec *executionContext) _mutationMiddleware(ctx context.Context, obj *ast.OperationDefinition, next func(ctx context.Context) (interface{}, error)) graphql.Marshaler {
+	RegistrationTransferPolicy struct {
+		Deadline func(childComplexity int) int
+	}
 
-	for _, d := range obj.Directives {
-		switch d.Name {
-		case "logged":
-			n := next
-			next = func(ctx context.Context) (interface{}, error) {
-				if ec.directives.Logged == nil {
-					return nil, errors.New("directive logged is not implemented")
-				}
-				return ec.directives.Logged(ctx, obj, n)
-			}
-		}
+	RejectedPassage struct {
+		Index   func(childComplexity int) int
+		Message func(childComplexity int) int
 	}
-	tmp, err := next(ctx)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	RelayLeg struct {
+		Distance func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Position func(childComplexity int) int
+		Ranking  func(childComplexity int) int
 	}
-	if data, ok := tmp.(graphql.Marshaler); ok {
-		return data
+
+	RelayLegResult struct {
+		Position func(childComplexity int) int
+		Runner   func(childComplexity int) int
+		TeamID   func(childComplexity int) int
+		Time     func(childComplexity int) int
 	}
-	ec.Errorf(ctx, `unexpected type %T from directive, should be graphql.Marshaler`, tmp)
-	return graphql.Null
 
-}
+	RelayStanding struct {
+		Complete      func(childComplexity int) int
+		LegsCompleted func(childComplexity int) int
+		Position      func(childComplexity int) int
+		TeamID        func(childComplexity int) int
+		Time          func(childComplexity int) int
+	}
 
-func (ec *executionContext) _fieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) interface{} {
-	fc := graphql.GetFieldContext(ctx)
-	for _, d := range fc.Field.Directives {
-		switch d.Name {
-		case "logged":
-			n := next
-			next = func(ctx context.Context) (interface{}, error) {
-				if ec.directives.Logged == nil {
-					return nil, errors.New("directive logged is not implemented")
-				}
-				return ec.directives.Logged(ctx, obj, n)
-			}
-		}
+	ResultsImport struct {
+		Committed func(childComplexity int) int
+		Issues    func(childComplexity int) int
+		Race      func(childComplexity int) int
+		Results   func(childComplexity int) int
+		Skipped   func(childComplexity int) int
 	}
-	res, err := ec.ResolverMiddleware(ctx, next)
-	if err != nil {
-		ec.Error(ctx, err)
-		return nil
+
+	Series struct {
+		BestOf      func(childComplexity int) int
+		Description func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Points      func(childComplexity int) int
+		Races       func(childComplexity int) int
+		Rule        func(childComplexity int) int
+		Standings   func(childComplexity int) int
+		Start       func(childComplexity int) int
+		Venue       func(childComplexity int) int
 	}
-	return res
-}
 
-// endregion ************************** directives.gotpl **************************
+	SeriesAlreadyExists struct {
+		Message func(childComplexity int) int
+	}
 
-// region    **************************** field.gotpl *****************************
+	SeriesNotFound struct {
+		Message func(childComplexity int) int
+	}
 
-func (ec *executionContext) _InvalidIDError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidIDError) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "InvalidIDError",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+	SeriesRace struct {
+		Occurrence func(childComplexity int) int
+		RaceID     func(childComplexity int) int
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
+	SeriesRaces struct {
+		Races func(childComplexity int) int
+	}
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	SeriesStanding struct {
+		Competitor func(childComplexity int) int
+		Points     func(childComplexity int) int
+		Position   func(childComplexity int) int
+		Races      func(childComplexity int) int
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _InvalidRaceDateError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceDateError) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "InvalidRaceDateError",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+	Split struct {
+		At           func(childComplexity int) int
+		Checkpoint   func(childComplexity int) int
+		CutoffMissed func(childComplexity int) int
+		Elapsed      func(childComplexity int) int
+		Pace         func(childComplexity int) int
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
+	StaffError struct {
+		Message func(childComplexity int) int
+	}
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	Team struct {
+		Admin        func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Invitations  func(childComplexity int) int
+		JoinRequests func(childComplexity int) int
+		Members      func(childComplexity int) int
+		Name         func(childComplexity int) int
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _InvalidRaceNameError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceNameError) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "InvalidRaceNameError",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+	TeamAlreadyEntered struct {
+		Message func(childComplexity int) int
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
+	TeamInvitation struct {
+		ExpiresAt func(childComplexity int) int
+		User      func(childComplexity int) int
+	}
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	TeamMembershipError struct {
+		Message func(childComplexity int) int
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Mutation_createRace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+	TeamNotFound struct {
+		Message func(childComplexity int) int
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_createRace_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	TeamStanding struct {
+		Complete  func(childComplexity int) int
+		Finishers func(childComplexity int) int
+		Points    func(childComplexity int) int
+		Position  func(childComplexity int) int
+		TeamID    func(childComplexity int) int
+		Time      func(childComplexity int) int
 	}
-	fc.Args = args
-	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateRace(rctx, args["race"].(models.RaceInput))
-	})
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	UnsupportedLocaleError struct {
+		Message func(childComplexity int) int
 	}
-	res := resTmp.(models.CreateRaceResult)
-	fc.Result = res
-	return ec.marshalNCreateRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateRaceResult(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Query_race(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+	User struct {
+		ID    func(childComplexity int) int
+		Name  func(childComplexity int) int
+		Races func(childComplexity int) int
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_race_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+	UserNotFound struct {
+		Message func(childComplexity int) int
 	}
-	fc.Args = args
-	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Race(rctx, args["id"].(string))
-	})
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	Venue struct {
+		Address  func(childComplexity int) int
+		Lat      func(childComplexity int) int
+		Lon      func(childComplexity int) int
+		Name     func(childComplexity int) int
+		TimeZone func(childComplexity int) int
 	}
-	res := resTmp.(models.RaceResult)
-	fc.Result = res
-	return ec.marshalNRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_races(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+type MutationResolver interface {
+	CreateRace(ctx context.Context, race models.RaceInput) (models.CreateRaceResult, error)
+	RecordResult(ctx context.Context, result models.RaceResultInput) (models.RecordResultResult, error)
+	AssignBib(ctx context.Context, bib models.BibInput) (models.AssignBibResult, error)
+	RescheduleRace(ctx context.Context, race models.RescheduleRaceInput) (models.RescheduleRaceResult, error)
+	CreateCalendarToken(ctx context.Context) (models.CreateCalendarTokenResult, error)
+	RevokeCalendarToken(ctx context.Context) (models.RevokeCalendarTokenResult, error)
+	CancelRace(ctx context.Context, race models.CancelRaceInput) (models.CancelRaceResult, error)
+	CheckIn(ctx context.Context, checkIn models.CheckInInput) (models.CheckInResult, error)
+	RecordPassages(ctx context.Context, passages models.PassagesInput) (models.RecordPassagesResult, error)
+	UploadCourse(ctx context.Context, course models.CourseUploadInput) (models.UploadCourseResult, error)
+	CreateDiscountCode(ctx context.Context, code models.DiscountCodeInput) (models.CreateDiscountCodeResult, error)
+	UpdateNotificationPreferences(ctx context.Context, preferences models.NotificationPreferencesInput) (models.UpdateNotificationPreferencesResult, error)
+	CreateOrganization(ctx context.Context, organization models.OrganizationInput) (models.CreateOrganizationResult, error)
+	AddOrganizationMember(ctx context.Context, member models.OrganizationMemberInput) (models.OrganizationMemberResult, error)
+	RemoveOrganizationMember(ctx context.Context, member models.RemoveOrganizationMemberInput) (models.OrganizationMemberResult, error)
+	AssignRaceToOrganization(ctx context.Context, assignment models.RaceOrganizationInput) (models.AssignRaceToOrganizationResult, error)
+	EraseMyData(ctx context.Context) (models.EraseMyDataResult, error)
+	JoinRace(ctx context.Context, registration models.JoinRaceInput) (models.JoinRaceResult, error)
+	Checkout(ctx context.Context, raceID string) (models.CheckoutResult, error)
+	RefundRegistration(ctx context.Context, registration models.RefundRegistrationInput) (models.RefundRegistrationResult, error)
+	SetRegistrationTransfers(ctx context.Context, race models.RegistrationTransfersInput) (models.SetRegistrationTransfersResult, error)
+	OfferRegistration(ctx context.Context, transfer models.OfferRegistrationInput) (models.OfferRegistrationResult, error)
+	WithdrawRegistrationOffer(ctx context.Context, raceID string) (models.WithdrawRegistrationOfferResult, error)
+	AcceptRegistration(ctx context.Context, transfer models.AcceptRegistrationInput) (models.AcceptRegistrationResult, error)
+	SetRelayLineUp(ctx context.Context, lineUp models.RelayLineUpInput) (models.SetRelayLineUpResult, error)
+	RecordLegSplit(ctx context.Context, split models.LegSplitInput) (models.RecordLegSplitResult, error)
+	ImportResults(ctx context.Context, results models.ResultsImportInput) (models.ImportResultsResult, error)
+	CreateSeries(ctx context.Context, series models.SeriesInput) (models.CreateSeriesResult, error)
+	UpdateSeries(ctx context.Context, series models.SeriesInput) (models.UpdateSeriesResult, error)
+	GenerateSeriesRaces(ctx context.Context, id string) (models.GenerateSeriesRacesResult, error)
+	AddRaceStaff(ctx context.Context, staff models.RaceStaffInput) (models.RaceStaffResult, error)
+	RemoveRaceStaff(ctx context.Context, staff models.RemoveRaceStaffInput) (models.RaceStaffResult, error)
+	TransferRaceOwnership(ctx context.Context, owner models.RaceOwnerInput) (models.RaceStaffResult, error)
+	EnterTeam(ctx context.Context, entry models.TeamEntryInput) (models.EnterTeamResult, error)
+	InviteToTeam(ctx context.Context, invitation models.TeamUserInput) (models.TeamResult, error)
+	AcceptTeamInvitation(ctx context.Context, teamID string) (models.TeamResult, error)
+	DeclineTeamInvitation(ctx context.Context, teamID string) (models.TeamResult, error)
+	RequestToJoinTeam(ctx context.Context, teamID string) (models.TeamResult, error)
+	ApproveJoinRequest(ctx context.Context, request models.TeamUserInput) (models.TeamResult, error)
+	RejectJoinRequest(ctx context.Context, request models.TeamUserInput) (models.TeamResult, error)
+	LeaveTeam(ctx context.Context, teamID string) (models.TeamResult, error)
+	RemoveMember(ctx context.Context, member models.TeamUserInput) (models.TeamResult, error)
+	TransferAdmin(ctx context.Context, to models.TeamUserInput) (models.TeamResult, error)
+}
+type QueryResolver interface {
+	Race(ctx context.Context, id string) (models.RaceResult, error)
+	Races(ctx context.Context) (*models.Races, error)
+	RacesNear(ctx context.Context, lat float64, lon float64, radiusKm float64) (models.RacesNearResult, error)
+	AuditLog(ctx context.Context, filter *models.AuditLogFilter, first *int, after *string) (models.AuditLogResult, error)
+	DiscountCodes(ctx context.Context, raceID string) (models.DiscountCodesResult, error)
+	NotificationPreferences(ctx context.Context) (models.NotificationPreferencesResult, error)
+	Organization(ctx context.Context, id string) (models.OrganizationResult, error)
+	ExportMyData(ctx context.Context) (models.ExportMyDataResult, error)
+	Erasure(ctx context.Context, id string) (models.ErasureResult, error)
+	SearchRaces(ctx context.Context, query string, filter *models.RaceSearchFilter, first *int) (models.SearchRacesResult, error)
+	Series(ctx context.Context, id string) (models.SeriesResult, error)
+}
+type SeriesResolver interface {
+	Standings(ctx context.Context, obj *models.Series) ([]*models.SeriesStanding, error)
+}
+
+type executableSchema struct {
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(typeName, field string, childComplexity int, rawArgs map[string]interface{}) (int, bool) {
+	ec := executionContext{nil, e}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "AuditLog.edges":
+		if e.complexity.AuditLog.Edges == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Races(rctx)
-	})
+		return e.complexity.AuditLog.Edges(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "AuditLog.pageInfo":
+		if e.complexity.AuditLog.PageInfo == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*models.Races)
-	fc.Result = res
-	return ec.marshalNRaces2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.AuditLog.PageInfo(childComplexity), true
+
+	case "AuditLogEdge.cursor":
+		if e.complexity.AuditLogEdge.Cursor == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
-	})
+		return e.complexity.AuditLogEdge.Cursor(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+	case "AuditLogEdge.node":
+		if e.complexity.AuditLogEdge.Node == nil {
+			break
+		}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.AuditLogEdge.Node(childComplexity), true
+
+	case "AuditLogEntry.actor":
+		if e.complexity.AuditLogEntry.Actor == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
-	})
+		return e.complexity.AuditLogEntry.Actor(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Schema)
-	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
-}
+	case "AuditLogEntry.id":
+		if e.complexity.AuditLogEntry.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _Race_id(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.AuditLogEntry.ID(childComplexity), true
+
+	case "AuditLogEntry.occurredAt":
+		if e.complexity.AuditLogEntry.OccurredAt == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Race",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
+		return e.complexity.AuditLogEntry.OccurredAt(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "AuditLogEntry.organizationId":
+		if e.complexity.AuditLogEntry.OrganizationID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Race_name(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.AuditLogEntry.OrganizationID(childComplexity), true
+
+	case "AuditLogEntry.payload":
+		if e.complexity.AuditLogEntry.Payload == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Race",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.AuditLogEntry.Payload(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "AuditLogEntry.raceId":
+		if e.complexity.AuditLogEntry.RaceID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Race_date(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.AuditLogEntry.RaceID(childComplexity), true
+
+	case "AuditLogEntry.type":
+		if e.complexity.AuditLogEntry.Type == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Race",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Date, nil
-	})
+		return e.complexity.AuditLogEntry.Type(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "BibRange.from":
+		if e.complexity.BibRange.From == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Race_competitors(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.BibRange.From(childComplexity), true
+
+	case "BibRange.to":
+		if e.complexity.BibRange.To == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Race",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Competitors, nil
-	})
+		return e.complexity.BibRange.To(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CalendarToken.createdAt":
+		if e.complexity.CalendarToken.CreatedAt == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*models.User)
-	fc.Result = res
-	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _RaceAlreadyExists_message(ctx context.Context, field graphql.CollectedField, obj *models.RaceAlreadyExists) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CalendarToken.CreatedAt(childComplexity), true
+
+	case "CalendarToken.feed":
+		if e.complexity.CalendarToken.Feed == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "RaceAlreadyExists",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
+		return e.complexity.CalendarToken.Feed(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CalendarToken.secret":
+		if e.complexity.CalendarToken.Secret == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _RaceNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.RaceNotFound) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CalendarToken.Secret(childComplexity), true
+
+	case "CalendarTokenRevoked.revoked":
+		if e.complexity.CalendarTokenRevoked.Revoked == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "RaceNotFound",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
+		return e.complexity.CalendarTokenRevoked.Revoked(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CancellationError.message":
+		if e.complexity.CancellationError.Message == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Races_races(ctx context.Context, field graphql.CollectedField, obj *models.Races) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CancellationError.Message(childComplexity), true
+
+	case "CheckIn.at":
+		if e.complexity.CheckIn.At == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Races",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Races, nil
-	})
+		return e.complexity.CheckIn.At(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CheckIn.competitor":
+		if e.complexity.CheckIn.Competitor == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*models.Race)
-	fc.Result = res
-	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CheckIn.Competitor(childComplexity), true
+
+	case "CheckInError.message":
+		if e.complexity.CheckInError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
+		return e.complexity.CheckInError.Message(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Checkout.paymentId":
+		if e.complexity.Checkout.PaymentID == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Checkout.PaymentID(childComplexity), true
+
+	case "Checkout.url":
+		if e.complexity.Checkout.URL == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.Checkout.URL(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Checkpoint.cutoff":
+		if e.complexity.Checkpoint.Cutoff == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _User_races(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Checkpoint.Cutoff(childComplexity), true
+
+	case "Checkpoint.distance":
+		if e.complexity.Checkpoint.Distance == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "User",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Races, nil
-	})
+		return e.complexity.Checkpoint.Distance(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Checkpoint.name":
+		if e.complexity.Checkpoint.Name == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]*models.Race)
-	fc.Result = res
-	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Checkpoint.Name(childComplexity), true
+
+	case "CompetitorBib.bib":
+		if e.complexity.CompetitorBib.Bib == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.CompetitorBib.Bib(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CompetitorBib.competitor":
+		if e.complexity.CompetitorBib.Competitor == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorBib.Competitor(childComplexity), true
+
+	case "CompetitorCheckIn.bib":
+		if e.complexity.CompetitorCheckIn.Bib == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
+		return e.complexity.CompetitorCheckIn.Bib(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+	case "CompetitorCheckIn.category":
+		if e.complexity.CompetitorCheckIn.Category == nil {
+			break
+		}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorCheckIn.Category(childComplexity), true
+
+	case "CompetitorCheckIn.checkedInAt":
+		if e.complexity.CompetitorCheckIn.CheckedInAt == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
-	})
+		return e.complexity.CompetitorCheckIn.CheckedInAt(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CompetitorCheckIn.competitor":
+		if e.complexity.CompetitorCheckIn.Competitor == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]string)
-	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorCheckIn.Competitor(childComplexity), true
+
+	case "CompetitorCheckIn.raceId":
+		if e.complexity.CompetitorCheckIn.RaceID == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
+		return e.complexity.CompetitorCheckIn.RaceID(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CompetitorCheckIn.status":
+		if e.complexity.CompetitorCheckIn.Status == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorCheckIn.Status(childComplexity), true
+
+	case "CompetitorNotInRaceError.message":
+		if e.complexity.CompetitorNotInRaceError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.CompetitorNotInRaceError.Message(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CompetitorResult.competitor":
+		if e.complexity.CompetitorResult.Competitor == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorResult.Competitor(childComplexity), true
+
+	case "CompetitorResult.position":
+		if e.complexity.CompetitorResult.Position == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
+		return e.complexity.CompetitorResult.Position(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+	case "CompetitorResult.time":
+		if e.complexity.CompetitorResult.Time == nil {
+			break
+		}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CompetitorResult.Time(childComplexity), true
+
+	case "CompetitorSplits.competitor":
+		if e.complexity.CompetitorSplits.Competitor == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
+		return e.complexity.CompetitorSplits.Competitor(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CompetitorSplits.splits":
+		if e.complexity.CompetitorSplits.Splits == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+		return e.complexity.CompetitorSplits.Splits(childComplexity), true
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
+	case "Course.distance":
+		if e.complexity.Course.Distance == nil {
+			break
+		}
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+		return e.complexity.Course.Distance(childComplexity), true
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Course.elevationGain":
+		if e.complexity.Course.ElevationGain == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.Course.ElevationGain(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Course.elevationLoss":
+		if e.complexity.Course.ElevationLoss == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Course.ElevationLoss(childComplexity), true
+
+	case "Course.points":
+		if e.complexity.Course.Points == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
+		return e.complexity.Course.Points(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+	case "Course.polyline":
+		if e.complexity.Course.Polyline == nil {
+			break
+		}
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Course.Polyline(childComplexity), true
+
+	case "CoursePoint.elevation":
+		if e.complexity.CoursePoint.Elevation == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
+		return e.complexity.CoursePoint.Elevation(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "CoursePoint.lat":
+		if e.complexity.CoursePoint.Lat == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.CoursePoint.Lat(childComplexity), true
+
+	case "CoursePoint.lon":
+		if e.complexity.CoursePoint.Lon == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
+		return e.complexity.CoursePoint.Lon(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DataExport.contentType":
+		if e.complexity.DataExport.ContentType == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DataExport.ContentType(childComplexity), true
+
+	case "DataExport.data":
+		if e.complexity.DataExport.Data == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
+		return e.complexity.DataExport.Data(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DataExport.fileName":
+		if e.complexity.DataExport.FileName == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DataExport.FileName(childComplexity), true
+
+	case "DiscountCode.amount":
+		if e.complexity.DiscountCode.Amount == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
+		return e.complexity.DiscountCode.Amount(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+	case "DiscountCode.category":
+		if e.complexity.DiscountCode.Category == nil {
+			break
+		}
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCode.Category(childComplexity), true
+
+	case "DiscountCode.code":
+		if e.complexity.DiscountCode.Code == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
+		return e.complexity.DiscountCode.Code(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DiscountCode.maxRedemptions":
+		if e.complexity.DiscountCode.MaxRedemptions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCode.MaxRedemptions(childComplexity), true
+
+	case "DiscountCode.percent":
+		if e.complexity.DiscountCode.Percent == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+		return e.complexity.DiscountCode.Percent(childComplexity), true
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "DiscountCode.raceId":
+		if e.complexity.DiscountCode.RaceID == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
+		return e.complexity.DiscountCode.RaceID(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DiscountCode.redemptions":
+		if e.complexity.DiscountCode.Redemptions == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCode.Redemptions(childComplexity), true
+
+	case "DiscountCode.validFrom":
+		if e.complexity.DiscountCode.ValidFrom == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
-	})
+		return e.complexity.DiscountCode.ValidFrom(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+	case "DiscountCode.validUntil":
+		if e.complexity.DiscountCode.ValidUntil == nil {
+			break
+		}
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCode.ValidUntil(childComplexity), true
+
+	case "DiscountCodeAlreadyExists.message":
+		if e.complexity.DiscountCodeAlreadyExists.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
-	})
+		return e.complexity.DiscountCodeAlreadyExists.Message(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DiscountCodeNotApplicableError.message":
+		if e.complexity.DiscountCodeNotApplicableError.Message == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCodeNotApplicableError.Message(childComplexity), true
+
+	case "DiscountCodeNotFound.message":
+		if e.complexity.DiscountCodeNotFound.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
-	})
+		return e.complexity.DiscountCodeNotFound.Message(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "DiscountCodes.codes":
+		if e.complexity.DiscountCodes.Codes == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.DiscountCodes.Codes(childComplexity), true
+
+	case "Erasure.finishedAt":
+		if e.complexity.Erasure.FinishedAt == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
-	})
+		return e.complexity.Erasure.FinishedAt(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+	case "Erasure.id":
+		if e.complexity.Erasure.ID == nil {
+			break
+		}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Erasure.ID(childComplexity), true
+
+	case "Erasure.requestedAt":
+		if e.complexity.Erasure.RequestedAt == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
-	})
+		return e.complexity.Erasure.RequestedAt(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+	case "Erasure.status":
+		if e.complexity.Erasure.Status == nil {
+			break
+		}
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Erasure.Status(childComplexity), true
+
+	case "ErasureNotFound.message":
+		if e.complexity.ErasureNotFound.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
-	})
+		return e.complexity.ErasureNotFound.Message(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Forbidden.message":
+		if e.complexity.Forbidden.Message == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Directive)
-	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Forbidden.Message(childComplexity), true
+
+	case "ImportIssue.bib":
+		if e.complexity.ImportIssue.Bib == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
-	})
+		return e.complexity.ImportIssue.Bib(childComplexity), true
 
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "ImportIssue.kind":
+		if e.complexity.ImportIssue.Kind == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ImportIssue.Kind(childComplexity), true
+
+	case "ImportIssue.line":
+		if e.complexity.ImportIssue.Line == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+		return e.complexity.ImportIssue.Line(childComplexity), true
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "ImportIssue.message":
+		if e.complexity.ImportIssue.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
+		return e.complexity.ImportIssue.Message(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+	case "ImportedResult.bib":
+		if e.complexity.ImportedResult.Bib == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ImportedResult.Bib(childComplexity), true
+
+	case "ImportedResult.competitor":
+		if e.complexity.ImportedResult.Competitor == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
-	})
+		return e.complexity.ImportedResult.Competitor(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Field)
-	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
-}
+	case "ImportedResult.line":
+		if e.complexity.ImportedResult.Line == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ImportedResult.Line(childComplexity), true
+
+	case "ImportedResult.time":
+		if e.complexity.ImportedResult.Time == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
-	})
+		return e.complexity.ImportedResult.Time(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
-}
+	case "InvalidBibError.message":
+		if e.complexity.InvalidBibError.Message == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.InvalidBibError.Message(childComplexity), true
+
+	case "InvalidCourseError.message":
+		if e.complexity.InvalidCourseError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
-	})
+		return e.complexity.InvalidCourseError.Message(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
-}
+	case "InvalidCursorError.message":
+		if e.complexity.InvalidCursorError.Message == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.InvalidCursorError.Message(childComplexity), true
+
+	case "InvalidDiscountCodeError.message":
+		if e.complexity.InvalidDiscountCodeError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
-	})
+		return e.complexity.InvalidDiscountCodeError.Message(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.EnumValue)
-	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
-}
+	case "InvalidIDError.message":
+		if e.complexity.InvalidIDError.Message == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.InvalidIDError.Message(childComplexity), true
+
+	case "InvalidLegSplitError.message":
+		if e.complexity.InvalidLegSplitError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
-	})
+		return e.complexity.InvalidLegSplitError.Message(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
-}
+	case "InvalidOrganizationNameError.message":
+		if e.complexity.InvalidOrganizationNameError.Message == nil {
+			break
+		}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.InvalidOrganizationNameError.Message(childComplexity), true
+
+	case "InvalidRaceBibsError.message":
+		if e.complexity.InvalidRaceBibsError.Message == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
-	})
+		return e.complexity.InvalidRaceBibsError.Message(childComplexity), true
 
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+	case "InvalidRaceCategoryError.message":
+		if e.complexity.InvalidRaceCategoryError.Message == nil {
+			break
+		}
 
-// endregion **************************** field.gotpl *****************************
+		return e.complexity.InvalidRaceCategoryError.Message(childComplexity), true
 
-// region    **************************** input.gotpl *****************************
+	case "InvalidRaceCheckpointsError.message":
+		if e.complexity.InvalidRaceCheckpointsError.Message == nil {
+			break
+		}
 
-func (ec *executionContext) unmarshalInputRaceInput(ctx context.Context, obj interface{}) (models.RaceInput, error) {
-	var it models.RaceInput
-	var asMap = obj.(map[string]interface{})
+		return e.complexity.InvalidRaceCheckpointsError.Message(childComplexity), true
 
-	for k, v := range asMap {
-		switch k {
-		case "id":
-			var err error
+	case "InvalidRaceDateError.message":
+		if e.complexity.InvalidRaceDateError.Message == nil {
+			break
+		}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			it.ID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "name":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "date":
-			var err error
+		return e.complexity.InvalidRaceDateError.Message(childComplexity), true
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
-			it.Date, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
+	case "InvalidRaceNameError.message":
+		if e.complexity.InvalidRaceNameError.Message == nil {
+			break
 		}
-	}
 
-	return it, nil
-}
+		return e.complexity.InvalidRaceNameError.Message(childComplexity), true
 
-// endregion **************************** input.gotpl *****************************
+	case "InvalidRacePriceError.message":
+		if e.complexity.InvalidRacePriceError.Message == nil {
+			break
+		}
 
-// region    ************************** interface.gotpl ***************************
+		return e.complexity.InvalidRacePriceError.Message(childComplexity), true
 
-func (ec *executionContext) _CreateRaceResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateRaceResult) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case models.Race:
-		return ec._Race(ctx, sel, &obj)
-	case *models.Race:
-		if obj == nil {
-			return graphql.Null
+	case "InvalidRaceRelayError.message":
+		if e.complexity.InvalidRaceRelayError.Message == nil {
+			break
 		}
-		return ec._Race(ctx, sel, obj)
-	case models.InvalidIDError:
-		return ec._InvalidIDError(ctx, sel, &obj)
-	case *models.InvalidIDError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidRaceRelayError.Message(childComplexity), true
+
+	case "InvalidRaceSearchError.message":
+		if e.complexity.InvalidRaceSearchError.Message == nil {
+			break
 		}
-		return ec._InvalidIDError(ctx, sel, obj)
-	case models.InvalidRaceNameError:
-		return ec._InvalidRaceNameError(ctx, sel, &obj)
-	case *models.InvalidRaceNameError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidRaceSearchError.Message(childComplexity), true
+
+	case "InvalidRaceTeamsError.message":
+		if e.complexity.InvalidRaceTeamsError.Message == nil {
+			break
 		}
-		return ec._InvalidRaceNameError(ctx, sel, obj)
-	case models.InvalidRaceDateError:
-		return ec._InvalidRaceDateError(ctx, sel, &obj)
-	case *models.InvalidRaceDateError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidRaceTeamsError.Message(childComplexity), true
+
+	case "InvalidRaceTimeError.message":
+		if e.complexity.InvalidRaceTimeError.Message == nil {
+			break
 		}
-		return ec._InvalidRaceDateError(ctx, sel, obj)
-	case models.RaceAlreadyExists:
-		return ec._RaceAlreadyExists(ctx, sel, &obj)
-	case *models.RaceAlreadyExists:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidRaceTimeError.Message(childComplexity), true
+
+	case "InvalidRacesNearError.message":
+		if e.complexity.InvalidRacesNearError.Message == nil {
+			break
 		}
-		return ec._RaceAlreadyExists(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
-}
 
-func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, obj models.Error) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case models.RaceNotFound:
-		return ec._RaceNotFound(ctx, sel, &obj)
-	case *models.RaceNotFound:
-		if obj == nil {
-			return graphql.Null
+		return e.complexity.InvalidRacesNearError.Message(childComplexity), true
+
+	case "InvalidRelayLineUpError.message":
+		if e.complexity.InvalidRelayLineUpError.Message == nil {
+			break
 		}
-		return ec._RaceNotFound(ctx, sel, obj)
-	case models.InvalidIDError:
-		return ec._InvalidIDError(ctx, sel, &obj)
-	case *models.InvalidIDError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidRelayLineUpError.Message(childComplexity), true
+
+	case "InvalidResultsFileError.message":
+		if e.complexity.InvalidResultsFileError.Message == nil {
+			break
 		}
-		return ec._InvalidIDError(ctx, sel, obj)
-	case models.InvalidRaceNameError:
-		return ec._InvalidRaceNameError(ctx, sel, &obj)
-	case *models.InvalidRaceNameError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidResultsFileError.Message(childComplexity), true
+
+	case "InvalidSeriesError.message":
+		if e.complexity.InvalidSeriesError.Message == nil {
+			break
 		}
-		return ec._InvalidRaceNameError(ctx, sel, obj)
-	case models.InvalidRaceDateError:
-		return ec._InvalidRaceDateError(ctx, sel, &obj)
-	case *models.InvalidRaceDateError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidSeriesError.Message(childComplexity), true
+
+	case "InvalidTeamEntryError.message":
+		if e.complexity.InvalidTeamEntryError.Message == nil {
+			break
 		}
-		return ec._InvalidRaceDateError(ctx, sel, obj)
-	case models.RaceAlreadyExists:
-		return ec._RaceAlreadyExists(ctx, sel, &obj)
-	case *models.RaceAlreadyExists:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidTeamEntryError.Message(childComplexity), true
+
+	case "InvalidTransferDeadlineError.message":
+		if e.complexity.InvalidTransferDeadlineError.Message == nil {
+			break
 		}
-		return ec._RaceAlreadyExists(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
-}
 
-func (ec *executionContext) _RaceResult(ctx context.Context, sel ast.SelectionSet, obj models.RaceResult) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case models.Race:
-		return ec._Race(ctx, sel, &obj)
-	case *models.Race:
-		if obj == nil {
-			return graphql.Null
+		return e.complexity.InvalidTransferDeadlineError.Message(childComplexity), true
+
+	case "InvalidVenueError.message":
+		if e.complexity.InvalidVenueError.Message == nil {
+			break
 		}
-		return ec._Race(ctx, sel, obj)
-	case models.InvalidIDError:
-		return ec._InvalidIDError(ctx, sel, &obj)
-	case *models.InvalidIDError:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.InvalidVenueError.Message(childComplexity), true
+
+	case "Money.amount":
+		if e.complexity.Money.Amount == nil {
+			break
 		}
-		return ec._InvalidIDError(ctx, sel, obj)
-	case models.RaceNotFound:
-		return ec._RaceNotFound(ctx, sel, &obj)
-	case *models.RaceNotFound:
-		if obj == nil {
-			return graphql.Null
+
+		return e.complexity.Money.Amount(childComplexity), true
+
+	case "Money.currency":
+		if e.complexity.Money.Currency == nil {
+			break
 		}
-		return ec._RaceNotFound(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
-	}
-}
 
-// endregion ************************** interface.gotpl ***************************
+		return e.complexity.Money.Currency(childComplexity), true
 
-// region    **************************** object.gotpl ****************************
+	case "Mutation.acceptRegistration":
+		if e.complexity.Mutation.AcceptRegistration == nil {
+			break
+		}
 
-var invalidIDErrorImplementors = []string{"InvalidIDError", "RaceResult", "CreateRaceResult", "Error"}
+		args, err := ec.field_Mutation_acceptRegistration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _InvalidIDError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidIDError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidIDErrorImplementors)
+		return e.complexity.Mutation.AcceptRegistration(childComplexity, args["transfer"].(models.AcceptRegistrationInput)), true
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidIDError")
-		case "message":
-			out.Values[i] = ec._InvalidIDError_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	case "Mutation.acceptTeamInvitation":
+		if e.complexity.Mutation.AcceptTeamInvitation == nil {
+			break
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var invalidRaceDateErrorImplementors = []string{"InvalidRaceDateError", "CreateRaceResult", "Error"}
+		args, err := ec.field_Mutation_acceptTeamInvitation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _InvalidRaceDateError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceDateError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceDateErrorImplementors)
+		return e.complexity.Mutation.AcceptTeamInvitation(childComplexity, args["teamId"].(string)), true
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidRaceDateError")
-		case "message":
-			out.Values[i] = ec._InvalidRaceDateError_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	case "Mutation.addOrganizationMember":
+		if e.complexity.Mutation.AddOrganizationMember == nil {
+			break
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var invalidRaceNameErrorImplementors = []string{"InvalidRaceNameError", "CreateRaceResult", "Error"}
+		args, err := ec.field_Mutation_addOrganizationMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _InvalidRaceNameError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceNameError) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceNameErrorImplementors)
+		return e.complexity.Mutation.AddOrganizationMember(childComplexity, args["member"].(models.OrganizationMemberInput)), true
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("InvalidRaceNameError")
-		case "message":
-			out.Values[i] = ec._InvalidRaceNameError_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	case "Mutation.addRaceStaff":
+		if e.complexity.Mutation.AddRaceStaff == nil {
+			break
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var mutationImplementors = []string{"Mutation"}
+		args, err := ec.field_Mutation_addRaceStaff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+		return e.complexity.Mutation.AddRaceStaff(childComplexity, args["staff"].(models.RaceStaffInput)), true
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+	case "Mutation.approveJoinRequest":
+		if e.complexity.Mutation.ApproveJoinRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveJoinRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveJoinRequest(childComplexity, args["request"].(models.TeamUserInput)), true
+
+	case "Mutation.assignBib":
+		if e.complexity.Mutation.AssignBib == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_assignBib_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AssignBib(childComplexity, args["bib"].(models.BibInput)), true
+
+	case "Mutation.assignRaceToOrganization":
+		if e.complexity.Mutation.AssignRaceToOrganization == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_assignRaceToOrganization_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AssignRaceToOrganization(childComplexity, args["assignment"].(models.RaceOrganizationInput)), true
+
+	case "Mutation.cancelRace":
+		if e.complexity.Mutation.CancelRace == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelRace_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelRace(childComplexity, args["race"].(models.CancelRaceInput)), true
+
+	case "Mutation.checkIn":
+		if e.complexity.Mutation.CheckIn == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_checkIn_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CheckIn(childComplexity, args["checkIn"].(models.CheckInInput)), true
+
+	case "Mutation.checkout":
+		if e.complexity.Mutation.Checkout == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_checkout_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Checkout(childComplexity, args["raceId"].(string)), true
+
+	case "Mutation.createCalendarToken":
+		if e.complexity.Mutation.CreateCalendarToken == nil {
+			break
+		}
+
+		return e.complexity.Mutation.CreateCalendarToken(childComplexity), true
+
+	case "Mutation.createDiscountCode":
+		if e.complexity.Mutation.CreateDiscountCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createDiscountCode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateDiscountCode(childComplexity, args["code"].(models.DiscountCodeInput)), true
+
+	case "Mutation.createOrganization":
+		if e.complexity.Mutation.CreateOrganization == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createOrganization_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateOrganization(childComplexity, args["organization"].(models.OrganizationInput)), true
+
+	case "Mutation.createRace":
+		if e.complexity.Mutation.CreateRace == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createRace_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateRace(childComplexity, args["race"].(models.RaceInput)), true
+
+	case "Mutation.createSeries":
+		if e.complexity.Mutation.CreateSeries == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createSeries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateSeries(childComplexity, args["series"].(models.SeriesInput)), true
+
+	case "Mutation.declineTeamInvitation":
+		if e.complexity.Mutation.DeclineTeamInvitation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_declineTeamInvitation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeclineTeamInvitation(childComplexity, args["teamId"].(string)), true
+
+	case "Mutation.enterTeam":
+		if e.complexity.Mutation.EnterTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_enterTeam_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.EnterTeam(childComplexity, args["entry"].(models.TeamEntryInput)), true
+
+	case "Mutation.eraseMyData":
+		if e.complexity.Mutation.EraseMyData == nil {
+			break
+		}
+
+		return e.complexity.Mutation.EraseMyData(childComplexity), true
+
+	case "Mutation.generateSeriesRaces":
+		if e.complexity.Mutation.GenerateSeriesRaces == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_generateSeriesRaces_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.GenerateSeriesRaces(childComplexity, args["id"].(string)), true
+
+	case "Mutation.importResults":
+		if e.complexity.Mutation.ImportResults == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_importResults_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ImportResults(childComplexity, args["results"].(models.ResultsImportInput)), true
+
+	case "Mutation.inviteToTeam":
+		if e.complexity.Mutation.InviteToTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_inviteToTeam_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.InviteToTeam(childComplexity, args["invitation"].(models.TeamUserInput)), true
+
+	case "Mutation.joinRace":
+		if e.complexity.Mutation.JoinRace == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_joinRace_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.JoinRace(childComplexity, args["registration"].(models.JoinRaceInput)), true
+
+	case "Mutation.leaveTeam":
+		if e.complexity.Mutation.LeaveTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_leaveTeam_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LeaveTeam(childComplexity, args["teamId"].(string)), true
+
+	case "Mutation.offerRegistration":
+		if e.complexity.Mutation.OfferRegistration == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_offerRegistration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.OfferRegistration(childComplexity, args["transfer"].(models.OfferRegistrationInput)), true
+
+	case "Mutation.recordLegSplit":
+		if e.complexity.Mutation.RecordLegSplit == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordLegSplit_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordLegSplit(childComplexity, args["split"].(models.LegSplitInput)), true
+
+	case "Mutation.recordPassages":
+		if e.complexity.Mutation.RecordPassages == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordPassages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordPassages(childComplexity, args["passages"].(models.PassagesInput)), true
+
+	case "Mutation.recordResult":
+		if e.complexity.Mutation.RecordResult == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordResult_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordResult(childComplexity, args["result"].(models.RaceResultInput)), true
+
+	case "Mutation.refundRegistration":
+		if e.complexity.Mutation.RefundRegistration == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_refundRegistration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RefundRegistration(childComplexity, args["registration"].(models.RefundRegistrationInput)), true
+
+	case "Mutation.rejectJoinRequest":
+		if e.complexity.Mutation.RejectJoinRequest == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectJoinRequest_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectJoinRequest(childComplexity, args["request"].(models.TeamUserInput)), true
+
+	case "Mutation.removeMember":
+		if e.complexity.Mutation.RemoveMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveMember(childComplexity, args["member"].(models.TeamUserInput)), true
+
+	case "Mutation.removeOrganizationMember":
+		if e.complexity.Mutation.RemoveOrganizationMember == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeOrganizationMember_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveOrganizationMember(childComplexity, args["member"].(models.RemoveOrganizationMemberInput)), true
+
+	case "Mutation.removeRaceStaff":
+		if e.complexity.Mutation.RemoveRaceStaff == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeRaceStaff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveRaceStaff(childComplexity, args["staff"].(models.RemoveRaceStaffInput)), true
+
+	case "Mutation.requestToJoinTeam":
+		if e.complexity.Mutation.RequestToJoinTeam == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestToJoinTeam_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestToJoinTeam(childComplexity, args["teamId"].(string)), true
+
+	case "Mutation.rescheduleRace":
+		if e.complexity.Mutation.RescheduleRace == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rescheduleRace_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RescheduleRace(childComplexity, args["race"].(models.RescheduleRaceInput)), true
+
+	case "Mutation.revokeCalendarToken":
+		if e.complexity.Mutation.RevokeCalendarToken == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RevokeCalendarToken(childComplexity), true
+
+	case "Mutation.setRegistrationTransfers":
+		if e.complexity.Mutation.SetRegistrationTransfers == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setRegistrationTransfers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetRegistrationTransfers(childComplexity, args["race"].(models.RegistrationTransfersInput)), true
+
+	case "Mutation.setRelayLineUp":
+		if e.complexity.Mutation.SetRelayLineUp == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setRelayLineUp_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetRelayLineUp(childComplexity, args["lineUp"].(models.RelayLineUpInput)), true
+
+	case "Mutation.transferAdmin":
+		if e.complexity.Mutation.TransferAdmin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transferAdmin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TransferAdmin(childComplexity, args["to"].(models.TeamUserInput)), true
+
+	case "Mutation.transferRaceOwnership":
+		if e.complexity.Mutation.TransferRaceOwnership == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_transferRaceOwnership_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TransferRaceOwnership(childComplexity, args["owner"].(models.RaceOwnerInput)), true
+
+	case "Mutation.updateNotificationPreferences":
+		if e.complexity.Mutation.UpdateNotificationPreferences == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateNotificationPreferences_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateNotificationPreferences(childComplexity, args["preferences"].(models.NotificationPreferencesInput)), true
+
+	case "Mutation.updateSeries":
+		if e.complexity.Mutation.UpdateSeries == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSeries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSeries(childComplexity, args["series"].(models.SeriesInput)), true
+
+	case "Mutation.uploadCourse":
+		if e.complexity.Mutation.UploadCourse == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadCourse_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadCourse(childComplexity, args["course"].(models.CourseUploadInput)), true
+
+	case "Mutation.withdrawRegistrationOffer":
+		if e.complexity.Mutation.WithdrawRegistrationOffer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_withdrawRegistrationOffer_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.WithdrawRegistrationOffer(childComplexity, args["raceId"].(string)), true
+
+	case "NotificationPreferences.disabled":
+		if e.complexity.NotificationPreferences.Disabled == nil {
+			break
+		}
+
+		return e.complexity.NotificationPreferences.Disabled(childComplexity), true
+
+	case "NotificationPreferences.locale":
+		if e.complexity.NotificationPreferences.Locale == nil {
+			break
+		}
+
+		return e.complexity.NotificationPreferences.Locale(childComplexity), true
+
+	case "Organization.dashboard":
+		if e.complexity.Organization.Dashboard == nil {
+			break
+		}
+
+		return e.complexity.Organization.Dashboard(childComplexity), true
+
+	case "Organization.id":
+		if e.complexity.Organization.ID == nil {
+			break
+		}
+
+		return e.complexity.Organization.ID(childComplexity), true
+
+	case "Organization.members":
+		if e.complexity.Organization.Members == nil {
+			break
+		}
+
+		return e.complexity.Organization.Members(childComplexity), true
+
+	case "Organization.name":
+		if e.complexity.Organization.Name == nil {
+			break
+		}
+
+		return e.complexity.Organization.Name(childComplexity), true
+
+	case "Organization.races":
+		if e.complexity.Organization.Races == nil {
+			break
+		}
+
+		return e.complexity.Organization.Races(childComplexity), true
+
+	case "OrganizationAlreadyExists.message":
+		if e.complexity.OrganizationAlreadyExists.Message == nil {
+			break
+		}
+
+		return e.complexity.OrganizationAlreadyExists.Message(childComplexity), true
+
+	case "OrganizationDashboard.races":
+		if e.complexity.OrganizationDashboard.Races == nil {
+			break
+		}
+
+		return e.complexity.OrganizationDashboard.Races(childComplexity), true
+
+	case "OrganizationDashboard.revenue":
+		if e.complexity.OrganizationDashboard.Revenue == nil {
+			break
+		}
+
+		return e.complexity.OrganizationDashboard.Revenue(childComplexity), true
+
+	case "OrganizationMember.role":
+		if e.complexity.OrganizationMember.Role == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMember.Role(childComplexity), true
+
+	case "OrganizationMember.user":
+		if e.complexity.OrganizationMember.User == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMember.User(childComplexity), true
+
+	case "OrganizationMemberError.message":
+		if e.complexity.OrganizationMemberError.Message == nil {
+			break
+		}
+
+		return e.complexity.OrganizationMemberError.Message(childComplexity), true
+
+	case "OrganizationNotFound.message":
+		if e.complexity.OrganizationNotFound.Message == nil {
+			break
+		}
+
+		return e.complexity.OrganizationNotFound.Message(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PassagesRecorded.race":
+		if e.complexity.PassagesRecorded.Race == nil {
+			break
+		}
+
+		return e.complexity.PassagesRecorded.Race(childComplexity), true
+
+	case "PassagesRecorded.rejected":
+		if e.complexity.PassagesRecorded.Rejected == nil {
+			break
+		}
+
+		return e.complexity.PassagesRecorded.Rejected(childComplexity), true
+
+	case "PriceTier.amount":
+		if e.complexity.PriceTier.Amount == nil {
+			break
+		}
+
+		return e.complexity.PriceTier.Amount(childComplexity), true
+
+	case "PriceTier.until":
+		if e.complexity.PriceTier.Until == nil {
+			break
+		}
+
+		return e.complexity.PriceTier.Until(childComplexity), true
+
+	case "Query.auditLog":
+		if e.complexity.Query.AuditLog == nil {
+			break
+		}
+
+		args, err := ec.field_Query_auditLog_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AuditLog(childComplexity, args["filter"].(*models.AuditLogFilter), args["first"].(*int), args["after"].(*string)), true
+
+	case "Query.discountCodes":
+		if e.complexity.Query.DiscountCodes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_discountCodes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DiscountCodes(childComplexity, args["raceId"].(string)), true
+
+	case "Query.erasure":
+		if e.complexity.Query.Erasure == nil {
+			break
+		}
+
+		args, err := ec.field_Query_erasure_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Erasure(childComplexity, args["id"].(string)), true
+
+	case "Query.exportMyData":
+		if e.complexity.Query.ExportMyData == nil {
+			break
+		}
+
+		return e.complexity.Query.ExportMyData(childComplexity), true
+
+	case "Query.notificationPreferences":
+		if e.complexity.Query.NotificationPreferences == nil {
+			break
+		}
+
+		return e.complexity.Query.NotificationPreferences(childComplexity), true
+
+	case "Query.organization":
+		if e.complexity.Query.Organization == nil {
+			break
+		}
+
+		args, err := ec.field_Query_organization_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Organization(childComplexity, args["id"].(string)), true
+
+	case "Query.race":
+		if e.complexity.Query.Race == nil {
+			break
+		}
+
+		args, err := ec.field_Query_race_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Race(childComplexity, args["id"].(string)), true
+
+	case "Query.races":
+		if e.complexity.Query.Races == nil {
+			break
+		}
+
+		return e.complexity.Query.Races(childComplexity), true
+
+	case "Query.racesNear":
+		if e.complexity.Query.RacesNear == nil {
+			break
+		}
+
+		args, err := ec.field_Query_racesNear_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RacesNear(childComplexity, args["lat"].(float64), args["lon"].(float64), args["radiusKm"].(float64)), true
+
+	case "Query.searchRaces":
+		if e.complexity.Query.SearchRaces == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchRaces_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchRaces(childComplexity, args["query"].(string), args["filter"].(*models.RaceSearchFilter), args["first"].(*int)), true
+
+	case "Query.series":
+		if e.complexity.Query.Series == nil {
+			break
+		}
+
+		args, err := ec.field_Query_series_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Series(childComplexity, args["id"].(string)), true
+
+	case "Race.bibs":
+		if e.complexity.Race.Bibs == nil {
+			break
+		}
+
+		return e.complexity.Race.Bibs(childComplexity), true
+
+	case "Race.cancellation":
+		if e.complexity.Race.Cancellation == nil {
+			break
+		}
+
+		return e.complexity.Race.Cancellation(childComplexity), true
+
+	case "Race.categories":
+		if e.complexity.Race.Categories == nil {
+			break
+		}
+
+		return e.complexity.Race.Categories(childComplexity), true
+
+	case "Race.checkIns":
+		if e.complexity.Race.CheckIns == nil {
+			break
+		}
+
+		return e.complexity.Race.CheckIns(childComplexity), true
+
+	case "Race.checkpoints":
+		if e.complexity.Race.Checkpoints == nil {
+			break
+		}
+
+		return e.complexity.Race.Checkpoints(childComplexity), true
+
+	case "Race.competitors":
+		if e.complexity.Race.Competitors == nil {
+			break
+		}
+
+		return e.complexity.Race.Competitors(childComplexity), true
+
+	case "Race.course":
+		if e.complexity.Race.Course == nil {
+			break
+		}
+
+		return e.complexity.Race.Course(childComplexity), true
+
+	case "Race.date":
+		if e.complexity.Race.Date == nil {
+			break
+		}
+
+		return e.complexity.Race.Date(childComplexity), true
+
+	case "Race.description":
+		if e.complexity.Race.Description == nil {
+			break
+		}
+
+		return e.complexity.Race.Description(childComplexity), true
+
+	case "Race.id":
+		if e.complexity.Race.ID == nil {
+			break
+		}
+
+		return e.complexity.Race.ID(childComplexity), true
+
+	case "Race.name":
+		if e.complexity.Race.Name == nil {
+			break
+		}
+
+		return e.complexity.Race.Name(childComplexity), true
+
+	case "Race.organizationId":
+		if e.complexity.Race.OrganizationID == nil {
+			break
+		}
+
+		return e.complexity.Race.OrganizationID(childComplexity), true
+
+	case "Race.owner":
+		if e.complexity.Race.Owner == nil {
+			break
+		}
+
+		return e.complexity.Race.Owner(childComplexity), true
+
+	case "Race.price":
+		if e.complexity.Race.Price == nil {
+			break
+		}
+
+		return e.complexity.Race.Price(childComplexity), true
+
+	case "Race.registrationDeadline":
+		if e.complexity.Race.RegistrationDeadline == nil {
+			break
+		}
+
+		return e.complexity.Race.RegistrationDeadline(childComplexity), true
+
+	case "Race.registrationOffers":
+		if e.complexity.Race.RegistrationOffers == nil {
+			break
+		}
+
+		return e.complexity.Race.RegistrationOffers(childComplexity), true
+
+	case "Race.registrationTransfers":
+		if e.complexity.Race.RegistrationTransfers == nil {
+			break
+		}
+
+		return e.complexity.Race.RegistrationTransfers(childComplexity), true
+
+	case "Race.relay":
+		if e.complexity.Race.Relay == nil {
+			break
+		}
+
+		return e.complexity.Race.Relay(childComplexity), true
+
+	case "Race.results":
+		if e.complexity.Race.Results == nil {
+			break
+		}
+
+		return e.complexity.Race.Results(childComplexity), true
+
+	case "Race.sequence":
+		if e.complexity.Race.Sequence == nil {
+			break
+		}
+
+		return e.complexity.Race.Sequence(childComplexity), true
+
+	case "Race.seriesId":
+		if e.complexity.Race.SeriesID == nil {
+			break
+		}
+
+		return e.complexity.Race.SeriesID(childComplexity), true
+
+	case "Race.splits":
+		if e.complexity.Race.Splits == nil {
+			break
+		}
+
+		return e.complexity.Race.Splits(childComplexity), true
+
+	case "Race.staff":
+		if e.complexity.Race.Staff == nil {
+			break
+		}
+
+		return e.complexity.Race.Staff(childComplexity), true
+
+	case "Race.status":
+		if e.complexity.Race.Status == nil {
+			break
+		}
+
+		return e.complexity.Race.Status(childComplexity), true
+
+	case "Race.teamStandings":
+		if e.complexity.Race.TeamStandings == nil {
+			break
+		}
+
+		return e.complexity.Race.TeamStandings(childComplexity), true
+
+	case "Race.teams":
+		if e.complexity.Race.Teams == nil {
+			break
+		}
+
+		return e.complexity.Race.Teams(childComplexity), true
+
+	case "Race.venue":
+		if e.complexity.Race.Venue == nil {
+			break
+		}
+
+		return e.complexity.Race.Venue(childComplexity), true
+
+	case "RaceAlreadyExists.message":
+		if e.complexity.RaceAlreadyExists.Message == nil {
+			break
+		}
+
+		return e.complexity.RaceAlreadyExists.Message(childComplexity), true
+
+	case "RaceBibs.entries":
+		if e.complexity.RaceBibs.Entries == nil {
+			break
+		}
+
+		return e.complexity.RaceBibs.Entries(childComplexity), true
+
+	case "RaceBibs.first":
+		if e.complexity.RaceBibs.First == nil {
+			break
+		}
+
+		return e.complexity.RaceBibs.First(childComplexity), true
+
+	case "RaceBibs.strategy":
+		if e.complexity.RaceBibs.Strategy == nil {
+			break
+		}
+
+		return e.complexity.RaceBibs.Strategy(childComplexity), true
+
+	case "RaceCancellation.cancelledAt":
+		if e.complexity.RaceCancellation.CancelledAt == nil {
+			break
+		}
+
+		return e.complexity.RaceCancellation.CancelledAt(childComplexity), true
+
+	case "RaceCancellation.reason":
+		if e.complexity.RaceCancellation.Reason == nil {
+			break
+		}
+
+		return e.complexity.RaceCancellation.Reason(childComplexity), true
+
+	case "RaceCancellation.transferTo":
+		if e.complexity.RaceCancellation.TransferTo == nil {
+			break
+		}
+
+		return e.complexity.RaceCancellation.TransferTo(childComplexity), true
+
+	case "RaceCategory.bibRange":
+		if e.complexity.RaceCategory.BibRange == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.BibRange(childComplexity), true
+
+	case "RaceCategory.capacity":
+		if e.complexity.RaceCategory.Capacity == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Capacity(childComplexity), true
+
+	case "RaceCategory.competitors":
+		if e.complexity.RaceCategory.Competitors == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Competitors(childComplexity), true
+
+	case "RaceCategory.course":
+		if e.complexity.RaceCategory.Course == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Course(childComplexity), true
+
+	case "RaceCategory.distance":
+		if e.complexity.RaceCategory.Distance == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Distance(childComplexity), true
+
+	case "RaceCategory.gender":
+		if e.complexity.RaceCategory.Gender == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Gender(childComplexity), true
+
+	case "RaceCategory.minAge":
+		if e.complexity.RaceCategory.MinAge == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.MinAge(childComplexity), true
+
+	case "RaceCategory.name":
+		if e.complexity.RaceCategory.Name == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Name(childComplexity), true
+
+	case "RaceCategory.price":
+		if e.complexity.RaceCategory.Price == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Price(childComplexity), true
+
+	case "RaceCategory.results":
+		if e.complexity.RaceCategory.Results == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.Results(childComplexity), true
+
+	case "RaceCategory.startTime":
+		if e.complexity.RaceCategory.StartTime == nil {
+			break
+		}
+
+		return e.complexity.RaceCategory.StartTime(childComplexity), true
+
+	case "RaceDashboard.checkedIn":
+		if e.complexity.RaceDashboard.CheckedIn == nil {
+			break
+		}
+
+		return e.complexity.RaceDashboard.CheckedIn(childComplexity), true
+
+	case "RaceDashboard.confirmed":
+		if e.complexity.RaceDashboard.Confirmed == nil {
+			break
+		}
+
+		return e.complexity.RaceDashboard.Confirmed(childComplexity), true
+
+	case "RaceDashboard.participants":
+		if e.complexity.RaceDashboard.Participants == nil {
+			break
+		}
+
+		return e.complexity.RaceDashboard.Participants(childComplexity), true
+
+	case "RaceDashboard.raceId":
+		if e.complexity.RaceDashboard.RaceID == nil {
+			break
+		}
+
+		return e.complexity.RaceDashboard.RaceID(childComplexity), true
+
+	case "RaceDashboard.revenue":
+		if e.complexity.RaceDashboard.Revenue == nil {
+			break
+		}
+
+		return e.complexity.RaceDashboard.Revenue(childComplexity), true
+
+	case "RaceMatch.race":
+		if e.complexity.RaceMatch.Race == nil {
+			break
+		}
+
+		return e.complexity.RaceMatch.Race(childComplexity), true
+
+	case "RaceMatch.rank":
+		if e.complexity.RaceMatch.Rank == nil {
+			break
+		}
+
+		return e.complexity.RaceMatch.Rank(childComplexity), true
+
+	case "RaceMatch.snippet":
+		if e.complexity.RaceMatch.Snippet == nil {
+			break
+		}
+
+		return e.complexity.RaceMatch.Snippet(childComplexity), true
+
+	case "RaceNotFound.message":
+		if e.complexity.RaceNotFound.Message == nil {
+			break
+		}
+
+		return e.complexity.RaceNotFound.Message(childComplexity), true
+
+	case "RacePrice.amount":
+		if e.complexity.RacePrice.Amount == nil {
+			break
+		}
+
+		return e.complexity.RacePrice.Amount(childComplexity), true
+
+	case "RacePrice.currency":
+		if e.complexity.RacePrice.Currency == nil {
+			break
+		}
+
+		return e.complexity.RacePrice.Currency(childComplexity), true
+
+	case "RacePrice.tiers":
+		if e.complexity.RacePrice.Tiers == nil {
+			break
+		}
+
+		return e.complexity.RacePrice.Tiers(childComplexity), true
+
+	case "RaceRelay.allowRepeatRunners":
+		if e.complexity.RaceRelay.AllowRepeatRunners == nil {
+			break
+		}
+
+		return e.complexity.RaceRelay.AllowRepeatRunners(childComplexity), true
+
+	case "RaceRelay.legs":
+		if e.complexity.RaceRelay.Legs == nil {
+			break
+		}
+
+		return e.complexity.RaceRelay.Legs(childComplexity), true
+
+	case "RaceRelay.standings":
+		if e.complexity.RaceRelay.Standings == nil {
+			break
+		}
+
+		return e.complexity.RaceRelay.Standings(childComplexity), true
+
+	case "RaceSearch.matches":
+		if e.complexity.RaceSearch.Matches == nil {
+			break
+		}
+
+		return e.complexity.RaceSearch.Matches(childComplexity), true
+
+	case "RaceStaffMember.role":
+		if e.complexity.RaceStaffMember.Role == nil {
+			break
+		}
+
+		return e.complexity.RaceStaffMember.Role(childComplexity), true
+
+	case "RaceStaffMember.user":
+		if e.complexity.RaceStaffMember.User == nil {
+			break
+		}
+
+		return e.complexity.RaceStaffMember.User(childComplexity), true
+
+	case "RaceTeams.counting":
+		if e.complexity.RaceTeams.Counting == nil {
+			break
+		}
+
+		return e.complexity.RaceTeams.Counting(childComplexity), true
+
+	case "RaceTeams.maxMembers":
+		if e.complexity.RaceTeams.MaxMembers == nil {
+			break
+		}
+
+		return e.complexity.RaceTeams.MaxMembers(childComplexity), true
+
+	case "RaceTeams.minMembers":
+		if e.complexity.RaceTeams.MinMembers == nil {
+			break
+		}
+
+		return e.complexity.RaceTeams.MinMembers(childComplexity), true
+
+	case "RaceTeams.scoring":
+		if e.complexity.RaceTeams.Scoring == nil {
+			break
+		}
+
+		return e.complexity.RaceTeams.Scoring(childComplexity), true
+
+	case "RaceTransfer.category":
+		if e.complexity.RaceTransfer.Category == nil {
+			break
+		}
+
+		return e.complexity.RaceTransfer.Category(childComplexity), true
+
+	case "RaceTransfer.raceId":
+		if e.complexity.RaceTransfer.RaceID == nil {
+			break
+		}
+
+		return e.complexity.RaceTransfer.RaceID(childComplexity), true
+
+	case "Races.races":
+		if e.complexity.Races.Races == nil {
+			break
+		}
+
+		return e.complexity.Races.Races(childComplexity), true
+
+	case "Registration.code":
+		if e.complexity.Registration.Code == nil {
+			break
+		}
+
+		return e.complexity.Registration.Code(childComplexity), true
+
+	case "Registration.competitor":
+		if e.complexity.Registration.Competitor == nil {
+			break
+		}
+
+		return e.complexity.Registration.Competitor(childComplexity), true
+
+	case "Registration.expiresAt":
+		if e.complexity.Registration.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.Registration.ExpiresAt(childComplexity), true
+
+	case "Registration.fee":
+		if e.complexity.Registration.Fee == nil {
+			break
+		}
+
+		return e.complexity.Registration.Fee(childComplexity), true
+
+	case "Registration.raceId":
+		if e.complexity.Registration.RaceID == nil {
+			break
+		}
+
+		return e.complexity.Registration.RaceID(childComplexity), true
+
+	case "Registration.status":
+		if e.complexity.Registration.Status == nil {
+			break
+		}
+
+		return e.complexity.Registration.Status(childComplexity), true
+
+	case "RegistrationError.message":
+		if e.complexity.RegistrationError.Message == nil {
+			break
+		}
+
+		return e.complexity.RegistrationError.Message(childComplexity), true
+
+	case "RegistrationOffer.from":
+		if e.complexity.RegistrationOffer.From == nil {
+			break
+		}
+
+		return e.complexity.RegistrationOffer.From(childComplexity), true
+
+	case "RegistrationOffer.offeredAt":
+		if e.complexity.RegistrationOffer.OfferedAt == nil {
+			break
+		}
+
+		return e.complexity.RegistrationOffer.OfferedAt(childComplexity), true
+
+	case "RegistrationOffer.raceId":
+		if e.complexity.RegistrationOffer.RaceID == nil {
+			break
+		}
+
+		return e.complexity.RegistrationOffer.RaceID(childComplexity), true
+
+	case "RegistrationOffer.to":
+		if e.complexity.RegistrationOffer.To == nil {
+			break
+		}
+
+		return e.complexity.RegistrationOffer.To(childComplexity), true
+
+	case "RegistrationOfferWithdrawn.raceId":
+		if e.complexity.RegistrationOfferWithdrawn.RaceID == nil {
+			break
+		}
+
+		return e.complexity.RegistrationOfferWithdrawn.RaceID(childComplexity), true
+
+	case "RegistrationTransferError.message":
+		if e.complexity.RegistrationTransferError.Message == nil {
+			break
+		}
+
+		return e.complexity.RegistrationTransferError.Message(childComplexity), true
+
+	case "RegistrationTransferPolicy.deadline":
+		if e.complexity.RegistrationTransferPolicy.Deadline == nil {
+			break
+		}
+
+		return e.complexity.RegistrationTransferPolicy.Deadline(childComplexity), true
+
+	case "RejectedPassage.index":
+		if e.complexity.RejectedPassage.Index == nil {
+			break
+		}
+
+		return e.complexity.RejectedPassage.Index(childComplexity), true
+
+	case "RejectedPassage.message":
+		if e.complexity.RejectedPassage.Message == nil {
+			break
+		}
+
+		return e.complexity.RejectedPassage.Message(childComplexity), true
+
+	case "RelayLeg.distance":
+		if e.complexity.RelayLeg.Distance == nil {
+			break
+		}
+
+		return e.complexity.RelayLeg.Distance(childComplexity), true
+
+	case "RelayLeg.name":
+		if e.complexity.RelayLeg.Name == nil {
+			break
+		}
+
+		return e.complexity.RelayLeg.Name(childComplexity), true
+
+	case "RelayLeg.position":
+		if e.complexity.RelayLeg.Position == nil {
+			break
+		}
+
+		return e.complexity.RelayLeg.Position(childComplexity), true
+
+	case "RelayLeg.ranking":
+		if e.complexity.RelayLeg.Ranking == nil {
+			break
+		}
+
+		return e.complexity.RelayLeg.Ranking(childComplexity), true
+
+	case "RelayLegResult.position":
+		if e.complexity.RelayLegResult.Position == nil {
+			break
+		}
+
+		return e.complexity.RelayLegResult.Position(childComplexity), true
+
+	case "RelayLegResult.runner":
+		if e.complexity.RelayLegResult.Runner == nil {
+			break
+		}
+
+		return e.complexity.RelayLegResult.Runner(childComplexity), true
+
+	case "RelayLegResult.teamId":
+		if e.complexity.RelayLegResult.TeamID == nil {
+			break
+		}
+
+		return e.complexity.RelayLegResult.TeamID(childComplexity), true
+
+	case "RelayLegResult.time":
+		if e.complexity.RelayLegResult.Time == nil {
+			break
+		}
+
+		return e.complexity.RelayLegResult.Time(childComplexity), true
+
+	case "RelayStanding.complete":
+		if e.complexity.RelayStanding.Complete == nil {
+			break
+		}
+
+		return e.complexity.RelayStanding.Complete(childComplexity), true
+
+	case "RelayStanding.legsCompleted":
+		if e.complexity.RelayStanding.LegsCompleted == nil {
+			break
+		}
+
+		return e.complexity.RelayStanding.LegsCompleted(childComplexity), true
+
+	case "RelayStanding.position":
+		if e.complexity.RelayStanding.Position == nil {
+			break
+		}
+
+		return e.complexity.RelayStanding.Position(childComplexity), true
+
+	case "RelayStanding.teamId":
+		if e.complexity.RelayStanding.TeamID == nil {
+			break
+		}
+
+		return e.complexity.RelayStanding.TeamID(childComplexity), true
+
+	case "RelayStanding.time":
+		if e.complexity.RelayStanding.Time == nil {
+			break
+		}
+
+		return e.complexity.RelayStanding.Time(childComplexity), true
+
+	case "ResultsImport.committed":
+		if e.complexity.ResultsImport.Committed == nil {
+			break
+		}
+
+		return e.complexity.ResultsImport.Committed(childComplexity), true
+
+	case "ResultsImport.issues":
+		if e.complexity.ResultsImport.Issues == nil {
+			break
+		}
+
+		return e.complexity.ResultsImport.Issues(childComplexity), true
+
+	case "ResultsImport.race":
+		if e.complexity.ResultsImport.Race == nil {
+			break
+		}
+
+		return e.complexity.ResultsImport.Race(childComplexity), true
+
+	case "ResultsImport.results":
+		if e.complexity.ResultsImport.Results == nil {
+			break
+		}
+
+		return e.complexity.ResultsImport.Results(childComplexity), true
+
+	case "ResultsImport.skipped":
+		if e.complexity.ResultsImport.Skipped == nil {
+			break
+		}
+
+		return e.complexity.ResultsImport.Skipped(childComplexity), true
+
+	case "Series.bestOf":
+		if e.complexity.Series.BestOf == nil {
+			break
+		}
+
+		return e.complexity.Series.BestOf(childComplexity), true
+
+	case "Series.description":
+		if e.complexity.Series.Description == nil {
+			break
+		}
+
+		return e.complexity.Series.Description(childComplexity), true
+
+	case "Series.id":
+		if e.complexity.Series.ID == nil {
+			break
+		}
+
+		return e.complexity.Series.ID(childComplexity), true
+
+	case "Series.name":
+		if e.complexity.Series.Name == nil {
+			break
+		}
+
+		return e.complexity.Series.Name(childComplexity), true
+
+	case "Series.points":
+		if e.complexity.Series.Points == nil {
+			break
+		}
+
+		return e.complexity.Series.Points(childComplexity), true
+
+	case "Series.races":
+		if e.complexity.Series.Races == nil {
+			break
+		}
+
+		return e.complexity.Series.Races(childComplexity), true
+
+	case "Series.rule":
+		if e.complexity.Series.Rule == nil {
+			break
+		}
+
+		return e.complexity.Series.Rule(childComplexity), true
+
+	case "Series.standings":
+		if e.complexity.Series.Standings == nil {
+			break
+		}
+
+		return e.complexity.Series.Standings(childComplexity), true
+
+	case "Series.start":
+		if e.complexity.Series.Start == nil {
+			break
+		}
+
+		return e.complexity.Series.Start(childComplexity), true
+
+	case "Series.venue":
+		if e.complexity.Series.Venue == nil {
+			break
+		}
+
+		return e.complexity.Series.Venue(childComplexity), true
+
+	case "SeriesAlreadyExists.message":
+		if e.complexity.SeriesAlreadyExists.Message == nil {
+			break
+		}
+
+		return e.complexity.SeriesAlreadyExists.Message(childComplexity), true
+
+	case "SeriesNotFound.message":
+		if e.complexity.SeriesNotFound.Message == nil {
+			break
+		}
+
+		return e.complexity.SeriesNotFound.Message(childComplexity), true
+
+	case "SeriesRace.occurrence":
+		if e.complexity.SeriesRace.Occurrence == nil {
+			break
+		}
+
+		return e.complexity.SeriesRace.Occurrence(childComplexity), true
+
+	case "SeriesRace.raceId":
+		if e.complexity.SeriesRace.RaceID == nil {
+			break
+		}
+
+		return e.complexity.SeriesRace.RaceID(childComplexity), true
+
+	case "SeriesRaces.races":
+		if e.complexity.SeriesRaces.Races == nil {
+			break
+		}
+
+		return e.complexity.SeriesRaces.Races(childComplexity), true
+
+	case "SeriesStanding.competitor":
+		if e.complexity.SeriesStanding.Competitor == nil {
+			break
+		}
+
+		return e.complexity.SeriesStanding.Competitor(childComplexity), true
+
+	case "SeriesStanding.points":
+		if e.complexity.SeriesStanding.Points == nil {
+			break
+		}
+
+		return e.complexity.SeriesStanding.Points(childComplexity), true
+
+	case "SeriesStanding.position":
+		if e.complexity.SeriesStanding.Position == nil {
+			break
+		}
+
+		return e.complexity.SeriesStanding.Position(childComplexity), true
+
+	case "SeriesStanding.races":
+		if e.complexity.SeriesStanding.Races == nil {
+			break
+		}
+
+		return e.complexity.SeriesStanding.Races(childComplexity), true
+
+	case "Split.at":
+		if e.complexity.Split.At == nil {
+			break
+		}
+
+		return e.complexity.Split.At(childComplexity), true
+
+	case "Split.checkpoint":
+		if e.complexity.Split.Checkpoint == nil {
+			break
+		}
+
+		return e.complexity.Split.Checkpoint(childComplexity), true
+
+	case "Split.cutoffMissed":
+		if e.complexity.Split.CutoffMissed == nil {
+			break
+		}
+
+		return e.complexity.Split.CutoffMissed(childComplexity), true
+
+	case "Split.elapsed":
+		if e.complexity.Split.Elapsed == nil {
+			break
+		}
+
+		return e.complexity.Split.Elapsed(childComplexity), true
+
+	case "Split.pace":
+		if e.complexity.Split.Pace == nil {
+			break
+		}
+
+		return e.complexity.Split.Pace(childComplexity), true
+
+	case "StaffError.message":
+		if e.complexity.StaffError.Message == nil {
+			break
+		}
+
+		return e.complexity.StaffError.Message(childComplexity), true
+
+	case "Team.admin":
+		if e.complexity.Team.Admin == nil {
+			break
+		}
+
+		return e.complexity.Team.Admin(childComplexity), true
+
+	case "Team.id":
+		if e.complexity.Team.ID == nil {
+			break
+		}
+
+		return e.complexity.Team.ID(childComplexity), true
+
+	case "Team.invitations":
+		if e.complexity.Team.Invitations == nil {
+			break
+		}
+
+		return e.complexity.Team.Invitations(childComplexity), true
+
+	case "Team.joinRequests":
+		if e.complexity.Team.JoinRequests == nil {
+			break
+		}
+
+		return e.complexity.Team.JoinRequests(childComplexity), true
+
+	case "Team.members":
+		if e.complexity.Team.Members == nil {
+			break
+		}
+
+		return e.complexity.Team.Members(childComplexity), true
+
+	case "Team.name":
+		if e.complexity.Team.Name == nil {
+			break
+		}
+
+		return e.complexity.Team.Name(childComplexity), true
+
+	case "TeamAlreadyEntered.message":
+		if e.complexity.TeamAlreadyEntered.Message == nil {
+			break
+		}
+
+		return e.complexity.TeamAlreadyEntered.Message(childComplexity), true
+
+	case "TeamInvitation.expiresAt":
+		if e.complexity.TeamInvitation.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.TeamInvitation.ExpiresAt(childComplexity), true
+
+	case "TeamInvitation.user":
+		if e.complexity.TeamInvitation.User == nil {
+			break
+		}
+
+		return e.complexity.TeamInvitation.User(childComplexity), true
+
+	case "TeamMembershipError.message":
+		if e.complexity.TeamMembershipError.Message == nil {
+			break
+		}
+
+		return e.complexity.TeamMembershipError.Message(childComplexity), true
+
+	case "TeamNotFound.message":
+		if e.complexity.TeamNotFound.Message == nil {
+			break
+		}
+
+		return e.complexity.TeamNotFound.Message(childComplexity), true
+
+	case "TeamStanding.complete":
+		if e.complexity.TeamStanding.Complete == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.Complete(childComplexity), true
+
+	case "TeamStanding.finishers":
+		if e.complexity.TeamStanding.Finishers == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.Finishers(childComplexity), true
+
+	case "TeamStanding.points":
+		if e.complexity.TeamStanding.Points == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.Points(childComplexity), true
+
+	case "TeamStanding.position":
+		if e.complexity.TeamStanding.Position == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.Position(childComplexity), true
+
+	case "TeamStanding.teamId":
+		if e.complexity.TeamStanding.TeamID == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.TeamID(childComplexity), true
+
+	case "TeamStanding.time":
+		if e.complexity.TeamStanding.Time == nil {
+			break
+		}
+
+		return e.complexity.TeamStanding.Time(childComplexity), true
+
+	case "UnsupportedLocaleError.message":
+		if e.complexity.UnsupportedLocaleError.Message == nil {
+			break
+		}
+
+		return e.complexity.UnsupportedLocaleError.Message(childComplexity), true
+
+	case "User.id":
+		if e.complexity.User.ID == nil {
+			break
+		}
+
+		return e.complexity.User.ID(childComplexity), true
+
+	case "User.name":
+		if e.complexity.User.Name == nil {
+			break
+		}
+
+		return e.complexity.User.Name(childComplexity), true
+
+	case "User.races":
+		if e.complexity.User.Races == nil {
+			break
+		}
+
+		return e.complexity.User.Races(childComplexity), true
+
+	case "UserNotFound.message":
+		if e.complexity.UserNotFound.Message == nil {
+			break
+		}
+
+		return e.complexity.UserNotFound.Message(childComplexity), true
+
+	case "Venue.address":
+		if e.complexity.Venue.Address == nil {
+			break
+		}
+
+		return e.complexity.Venue.Address(childComplexity), true
+
+	case "Venue.lat":
+		if e.complexity.Venue.Lat == nil {
+			break
+		}
+
+		return e.complexity.Venue.Lat(childComplexity), true
+
+	case "Venue.lon":
+		if e.complexity.Venue.Lon == nil {
+			break
+		}
+
+		return e.complexity.Venue.Lon(childComplexity), true
+
+	case "Venue.name":
+		if e.complexity.Venue.Name == nil {
+			break
+		}
+
+		return e.complexity.Venue.Name(childComplexity), true
+
+	case "Venue.timeZone":
+		if e.complexity.Venue.TimeZone == nil {
+			break
+		}
+
+		return e.complexity.Venue.TimeZone(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._queryMiddleware(ctx, rc.Operation, func(ctx context.Context) (interface{}, error) {
+				return ec._Query(ctx, rc.Operation.SelectionSet), nil
+			})
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._mutationMiddleware(ctx, rc.Operation, func(ctx context.Context) (interface{}, error) {
+				return ec._Mutation(ctx, rc.Operation.SelectionSet), nil
+			})
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(parsedSchema), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "../../../api/audit.graphql", Input: `extend type Query {
+  auditLog(filter: AuditLogFilter, first: Int = 20, after: String): AuditLogResult!
+}
+
+input AuditLogFilter {
+    userId: ID
+    raceId: ID
+    "required unless the current user is a service administrator"
+    organizationId: ID
+    type: String
+    from: DateTime
+    to: DateTime
+}
+
+type AuditLog {
+    edges: [AuditLogEdge!]!
+    pageInfo: PageInfo!
+}
+
+type AuditLogEdge {
+    cursor: String!
+    node: AuditLogEntry!
+}
+
+type AuditLogEntry {
+    id: ID!
+    type: String!
+    actor: User!
+    raceId: ID
+    organizationId: ID
+    occurredAt: DateTime!
+    payload: JSON!
+}
+
+type InvalidCursorError implements Error {
+    message: String!
+}
+
+union AuditLogResult = AuditLog | Forbidden | InvalidIDError | InvalidCursorError
+`, BuiltIn: false},
+	{Name: "../../../api/bib.graphql", Input: `extend type Mutation {
+  assignBib(bib: BibInput!): AssignBibResult! @logged
+}
+
+enum BibStrategy {
+    "the race owner assigns every number"
+    MANUAL
+    "the next number of the race in registration order"
+    SEQUENTIAL
+    "the next number of the competitor category range"
+    CATEGORY_RANGE
+}
+
+type RaceBibs {
+    strategy: BibStrategy!
+    "number the sequential strategy starts from"
+    first: Int
+    entries: [CompetitorBib!]!
+}
+
+type CompetitorBib {
+    bib: Int!
+    competitor: User!
+}
+
+type BibRange {
+    from: Int!
+    to: Int!
+}
+
+input RaceBibsInput {
+    strategy: BibStrategy!
+    "number the sequential strategy starts from"
+    first: Int = 1
+}
+
+input BibRangeInput {
+    from: Int!
+    to: Int!
+}
+
+input BibInput {
+    raceId: ID!
+    userId: ID!
+    bib: Int!
+}
+
+type InvalidRaceBibsError implements Error {
+    message: String!
+}
+
+type InvalidBibError implements Error {
+    message: String!
+}
+
+union AssignBibResult = Race | InvalidIDError | RaceNotFound | Forbidden | CompetitorNotInRaceError | InvalidBibError
+`, BuiltIn: false},
+	{Name: "../../../api/calendar.graphql", Input: `extend type Mutation {
+  rescheduleRace(race: RescheduleRaceInput!): RescheduleRaceResult! @logged
+  "creates the secret of the personal calendar feed, revoking the previous one"
+  createCalendarToken: CreateCalendarTokenResult! @logged
+  revokeCalendarToken: RevokeCalendarTokenResult! @logged
+}
+
+input RescheduleRaceInput {
+    raceId: ID!
+    date: DateTime!
+}
+
+union RescheduleRaceResult = Race | InvalidIDError | RaceNotFound | Forbidden | InvalidRaceDateError
+
+type CalendarToken {
+    "only returned once, create a new token if it is lost"
+    secret: String!
+    "path of the personal feed, /calendar/{secret}.ics"
+    feed: String!
+    createdAt: DateTime!
+}
+
+union CreateCalendarTokenResult = CalendarToken | Forbidden
+
+type CalendarTokenRevoked {
+    revoked: Boolean!
+}
+
+union RevokeCalendarTokenResult = CalendarTokenRevoked | Forbidden
+`, BuiltIn: false},
+	{Name: "../../../api/cancellation.graphql", Input: `extend type Mutation {
+  "calls the race off, its competitors are transferred to another race or released with their fees refunded"
+  cancelRace(race: CancelRaceInput!): CancelRaceResult! @logged
+}
+
+input CancelRaceInput {
+    raceId: ID!
+    "told to the competitors"
+    reason: String!
+    "the competitors are released when missing"
+    transferTo: RaceTransferInput
+}
+
+input RaceTransferInput {
+    "an open race of the same owner"
+    raceId: ID!
+    "required when the race has categories"
+    category: String
+}
+
+type RaceCancellation {
+    reason: String!
+    cancelledAt: DateTime!
+    "the competitors are released when missing"
+    transferTo: RaceTransfer
+}
+
+type RaceTransfer {
+    raceId: ID!
+    category: String
+}
+
+type CancellationError implements Error {
+    message: String!
+}
+
+union CancelRaceResult = Race | InvalidIDError | RaceNotFound | Forbidden | CancellationError
+`, BuiltIn: false},
+	{Name: "../../../api/check_in.graphql", Input: `extend type Mutation {
+  "records the competitor picked up the packet, only for the race owner, co-organizers and volunteers. Checking in again keeps the first check-in"
+  checkIn(checkIn: CheckInInput!): CheckInResult! @logged
+}
+
+input CheckInInput {
+    raceId: ID!
+    "the competitor is identified by the QR payload of the confirmation email, the user id or the bib number"
+    qr: String
+    userId: ID
+    bib: Int
+}
+
+type CheckIn {
+    competitor: User!
+    at: DateTime!
+}
+
+type CompetitorCheckIn {
+    raceId: ID!
+    competitor: User!
+    category: String
+    bib: Int
+    status: RegistrationStatus!
+    "missing until the competitor is checked in"
+    checkedInAt: DateTime
+}
+
+type CheckInError implements Error {
+    message: String!
+}
+
+union CheckInResult = CompetitorCheckIn | InvalidIDError | RaceNotFound | Forbidden | CompetitorNotInRaceError | InvalidBibError | CheckInError
+`, BuiltIn: false},
+	{Name: "../../../api/checkpoint.graphql", Input: `extend type Mutation {
+  recordPassages(passages: PassagesInput!): RecordPassagesResult! @logged
+}
+
+type Checkpoint {
+    name: String!
+    "distance along the course in metres"
+    distance: Int!
+    "max time since the start to pass the checkpoint, no cutoff when missing"
+    cutoff: String
+}
+
+type CompetitorSplits {
+    competitor: User!
+    "splits in course order, checkpoints not passed yet are missing"
+    splits: [Split!]!
+}
+
+type Split {
+    checkpoint: String!
+    at: DateTime!
+    "time since the competitor start"
+    elapsed: String!
+    "time per kilometre since the previous passed checkpoint"
+    pace: String!
+    cutoffMissed: Boolean!
+}
+
+input CheckpointInput {
+    name: String!
+    "distance along the course in metres"
+    distance: Int!
+    "max time since the start in [[hh:]mm:]ss format, no cutoff when missing"
+    cutoff: String
+}
+
+input PassagesInput {
+    raceId: ID!
+    passages: [PassageInput!]!
+}
+
+input PassageInput {
+    "the competitor is identified by the user id or the bib number"
+    userId: ID
+    bib: Int
+    checkpoint: String!
+    at: DateTime!
+}
+
+type PassagesRecorded {
+    race: Race!
+    "passages of the batch that were not recorded, the rest are"
+    rejected: [RejectedPassage!]!
+}
+
+type RejectedPassage {
+    "position of the passage in the input"
+    index: Int!
+    message: String!
+}
+
+type InvalidRaceCheckpointsError implements Error {
+    message: String!
+}
+
+union RecordPassagesResult = PassagesRecorded | InvalidIDError | RaceNotFound | Forbidden
+`, BuiltIn: false},
+	{Name: "../../../api/course.graphql", Input: `scalar Upload
+
+extend type Mutation {
+  uploadCourse(course: CourseUploadInput!): UploadCourseResult! @logged
+}
+
+input CourseUploadInput {
+    raceId: ID!
+    "category the course belongs to, the race course when missing"
+    category: String
+    "GPX or KML file"
+    file: Upload!
+}
+
+type Course {
+    "distance in metres"
+    distance: Int!
+    "elevation gain in metres"
+    elevationGain: Int!
+    "elevation loss in metres"
+    elevationLoss: Int!
+    "simplified course in encoded polyline format"
+    polyline: String!
+    points: [CoursePoint!]!
+}
+
+type CoursePoint {
+    lat: Float!
+    lon: Float!
+    "elevation in metres"
+    elevation: Float!
+}
+
+type InvalidCourseError implements Error {
+    message: String!
+}
+
+union UploadCourseResult = Race | InvalidIDError | RaceNotFound | Forbidden | InvalidCourseError
+`, BuiltIn: false},
+	{Name: "../../../api/discount_code.graphql", Input: `extend type Query {
+  "the discount codes of the race, only for the race owner and co-organizers"
+  discountCodes(raceId: ID!): DiscountCodesResult! @logged
+}
+
+extend type Mutation {
+  "adds a discount code to the race, only for the race owner and co-organizers"
+  createDiscountCode(code: DiscountCodeInput!): CreateDiscountCodeResult! @logged
+}
+
+input DiscountCodeInput {
+    raceId: ID!
+    "letters, digits, - and _, case insensitive"
+    code: String!
+    "percentage off the fee, 100 for a free entry, required when amount is missing"
+    percent: Int
+    "amount off the fee in the minor unit of its currency, required when percent is missing"
+    amount: Int
+    "no limit when missing"
+    maxRedemptions: Int
+    validFrom: DateTime
+    validUntil: DateTime
+    "valid for any category when missing"
+    category: String
+}
+
+type DiscountCode {
+    raceId: ID!
+    code: String!
+    percent: Int
+    amount: Int
+    maxRedemptions: Int
+    validFrom: DateTime
+    validUntil: DateTime
+    category: String
+    redemptions: Int!
+}
+
+type DiscountCodes {
+    codes: [DiscountCode!]!
+}
+
+type DiscountCodeNotFound implements Error {
+    message: String!
+}
+
+type DiscountCodeAlreadyExists implements Error {
+    message: String!
+}
+
+type InvalidDiscountCodeError implements Error {
+    message: String!
+}
+
+"the code exists but can not be redeemed in the registration"
+type DiscountCodeNotApplicableError implements Error {
+    message: String!
+}
+
+union DiscountCodesResult = DiscountCodes | InvalidIDError | RaceNotFound | Forbidden
+
+union CreateDiscountCodeResult = DiscountCode | InvalidIDError | RaceNotFound | Forbidden | InvalidDiscountCodeError | DiscountCodeAlreadyExists
+`, BuiltIn: false},
+	{Name: "../../../api/notification.graphql", Input: `extend type Query {
+  "the notification preferences of the current user"
+  notificationPreferences: NotificationPreferencesResult! @logged
+}
+
+extend type Mutation {
+  "replaces the notification preferences of the current user"
+  updateNotificationPreferences(preferences: NotificationPreferencesInput!): UpdateNotificationPreferencesResult! @logged
+}
+
+enum NotificationKind {
+    "the changes of the user registrations"
+    REGISTRATIONS
+    "the reschedules, race day reminders and cancellations of the races the user joined"
+    RACE_UPDATES
+    "the notifications of the races the user organizes"
+    ORGANIZER
+}
+
+input NotificationPreferencesInput {
+    "the language of the emails, en or es"
+    locale: String!
+    "the kinds of notifications the user opts out of"
+    disabled: [NotificationKind!]!
+}
+
+type NotificationPreferences {
+    locale: String!
+    disabled: [NotificationKind!]!
+}
+
+type UnsupportedLocaleError implements Error {
+    message: String!
+}
+
+union NotificationPreferencesResult = NotificationPreferences | Forbidden
+
+union UpdateNotificationPreferencesResult = NotificationPreferences | Forbidden | UnsupportedLocaleError
+`, BuiltIn: false},
+	{Name: "../../../api/organization.graphql", Input: `extend type Query {
+  organization(id: ID!): OrganizationResult!
+}
+
+extend type Mutation {
+  "creates an organization with the current user as its admin"
+  createOrganization(organization: OrganizationInput!): CreateOrganizationResult! @logged
+  "adds a user to the organization, or changes its role, only for the organization admins"
+  addOrganizationMember(member: OrganizationMemberInput!): OrganizationMemberResult! @logged
+  "removes a user from the organization, only for the organization admins"
+  removeOrganizationMember(member: RemoveOrganizationMemberInput!): OrganizationMemberResult! @logged
+  "hands the management of the race to the organization, only for the race owner being an admin of the organization"
+  assignRaceToOrganization(assignment: RaceOrganizationInput!): AssignRaceToOrganizationResult! @logged
+}
+
+enum OrganizationRole {
+    "manages the members and all the races of the organization"
+    ADMIN
+    MEMBER
+}
+
+input OrganizationInput {
+    id: ID!
+    name: String!
+}
+
+input OrganizationMemberInput {
+    organizationId: ID!
+    userId: ID!
+    role: OrganizationRole!
+}
+
+input RemoveOrganizationMemberInput {
+    organizationId: ID!
+    userId: ID!
+}
+
+input RaceOrganizationInput {
+    organizationId: ID!
+    raceId: ID!
+}
+
+type Organization {
+    id: ID!
+    name: String!
+    members: [OrganizationMember!]!
+    "sorted by date"
+    races: [Race!]!
+    "null unless the current user is an admin of the organization"
+    dashboard: OrganizationDashboard
+}
+
+type OrganizationMember {
+    user: User!
+    role: OrganizationRole!
+}
+
+type OrganizationDashboard {
+    races: [RaceDashboard!]!
+    "confirmed registration fees of all the races, one amount per currency"
+    revenue: [Money!]!
+}
+
+type RaceDashboard {
+    raceId: ID!
+    participants: Int!
+    "participants with a confirmed registration, all of them in free races"
+    confirmed: Int!
+    checkedIn: Int!
+    "confirmed registration fees, one amount per currency, empty in free races"
+    revenue: [Money!]!
+}
+
+type OrganizationNotFound implements Error {
+    message: String!
+}
+
+type OrganizationAlreadyExists implements Error {
+    message: String!
+}
+
+type InvalidOrganizationNameError implements Error {
+    message: String!
+}
+
+type OrganizationMemberError implements Error {
+    message: String!
+}
+
+union OrganizationResult = Organization | InvalidIDError | OrganizationNotFound
+
+union CreateOrganizationResult = Organization | InvalidIDError | InvalidOrganizationNameError | OrganizationAlreadyExists
+
+union OrganizationMemberResult = Organization | InvalidIDError | OrganizationNotFound | UserNotFound | Forbidden | OrganizationMemberError
+
+union AssignRaceToOrganizationResult = Race | InvalidIDError | OrganizationNotFound | RaceNotFound | Forbidden
+`, BuiltIn: false},
+	{Name: "../../../api/privacy.graphql", Input: `extend type Query {
+  "a zip of JSON files with all the personal data of the current user"
+  exportMyData: ExportMyDataResult! @logged
+  "an erasure requested by the current user, the admins can read any"
+  erasure(id: ID!): ErasureResult! @logged
+}
+
+extend type Mutation {
+  """
+  requests the anonymization of the current user across the races, results, teams and the event log,
+  it is done asynchronously, follow its status with the erasure query
+  """
+  eraseMyData: EraseMyDataResult! @logged
+}
+
+type DataExport {
+    fileName: String!
+    contentType: String!
+    "the zip file encoded in base64"
+    data: String!
+}
+
+enum ErasureStatus {
+    PENDING
+    RUNNING
+    DONE
+}
+
+type Erasure {
+    id: ID!
+    status: ErasureStatus!
+    requestedAt: DateTime!
+    finishedAt: DateTime
+}
+
+type ErasureNotFound implements Error {
+    message: String!
+}
+
+union ExportMyDataResult = DataExport | Forbidden
+
+union EraseMyDataResult = Erasure | Forbidden
+
+union ErasureResult = Erasure | InvalidIDError | ErasureNotFound | Forbidden
+`, BuiltIn: false},
+	{Name: "../../../api/race.graphql", Input: `type Race {
+    id: ID!
+    name: String!
+    "in the venue time zone, UTC when the race has no venue"
+    date: DateTime!
+    description: String!
+    venue: Venue
+    competitors: [User!]!
+    teams: RaceTeams
+    results: [CompetitorResult!]!
+    teamStandings: [TeamStanding!]!
+    relay: RaceRelay
+    categories: [RaceCategory!]!
+    "download the original file at /races/{id}/course"
+    course: Course
+    checkpoints: [Checkpoint!]!
+    "splits of the competitors, the furthest ahead first"
+    splits: [CompetitorSplits!]!
+    "no bib numbers when missing"
+    bibs: RaceBibs
+    "revision of the race schedule, increased every time it is rescheduled"
+    sequence: Int!
+    "no series when the race was created on its own"
+    seriesId: ID
+    "free when missing"
+    price: RacePrice
+    status: RaceStatus!
+    "the registration is open until the race is finished when missing"
+    registrationDeadline: DateTime
+    "missing unless the race is cancelled"
+    cancellation: RaceCancellation
+    "the competitors can not transfer their registrations when missing"
+    registrationTransfers: RegistrationTransferPolicy
+    "registrations offered by the competitors and not accepted yet"
+    registrationOffers: [RegistrationOffer!]!
+    owner: User!
+    "null when the race is not owned by an organization, its admins manage the race"
+    organizationId: ID
+    "the owner first, then the users helping in the race by role"
+    staff: [RaceStaffMember!]!
+    checkIns: [CheckIn!]!
+}
+
+enum RaceStatus {
+    "accepting registrations"
+    OPEN
+    "the registration deadline passed"
+    REGISTRATION_CLOSED
+    "the race day is over"
+    FINISHED
+    "the race was called off"
+    CANCELLED
+}
+
+type Races {
+    races: [Race!]!
+}
+
+type Venue {
+    name: String!
+    address: String!
+    lat: Float!
+    lon: Float!
+    "IANA name of the zone the race times are shown in, like Europe/Madrid"
+    timeZone: String!
+}
+
+enum TeamScoring {
+    BEST_TIMES
+    POSITION_POINTS
+}
+
+type RaceTeams {
+    minMembers: Int!
+    maxMembers: Int!
+    scoring: TeamScoring!
+    counting: Int!
+}
+
+enum Gender {
+    FEMALE
+    MALE
+}
+
+type RaceCategory {
+    name: String!
+    "distance in metres"
+    distance: Int!
+    "in the venue time zone, UTC when the race has no venue"
+    startTime: DateTime!
+    capacity: Int
+    minAge: Int
+    gender: Gender
+    competitors: [User!]!
+    results: [CompetitorResult!]!
+    "download the original file at /races/{id}/course?category={name}"
+    course: Course
+    bibRange: BibRange
+    "overrides the race price"
+    price: RacePrice
+}
+
+type CompetitorResult {
+    position: Int!
+    competitor: User!
+    time: String!
+}
+
+type TeamStanding {
+    position: Int
+    teamId: ID!
+    time: String!
+    points: Int!
+    finishers: Int!
+    complete: Boolean!
+}
+
+type RaceNotFound implements Error {
+    message: String!
+}
+
+type InvalidRaceNameError implements Error {
+    message: String!
+}
+
+type InvalidRaceDateError implements Error {
+    message: String!
+}
+
+type InvalidRaceTeamsError implements Error {
+    message: String!
+}
+
+type InvalidRaceCategoryError implements Error {
+    message: String!
+}
+
+type RaceAlreadyExists implements Error {
+    message: String!
+}
+
+type InvalidRaceTimeError implements Error {
+    message: String!
+}
+
+type CompetitorNotInRaceError implements Error {
+    message: String!
+}
+
+type InvalidVenueError implements Error {
+    message: String!
+}
+
+type InvalidRacesNearError implements Error {
+    message: String!
+}
+`, BuiltIn: false},
+	{Name: "../../../api/registration.graphql", Input: `extend type Mutation {
+  "joins the user to the race, the spot of a paid race is held until the payment window closes"
+  joinRace(registration: JoinRaceInput!): JoinRaceResult! @logged
+  "starts the payment of the pending registration of the current user"
+  checkout(raceId: ID!): CheckoutResult! @logged
+  "refunds the fee of a confirmed registration and withdraws the competitor, only for the race owner and co-organizers"
+  refundRegistration(registration: RefundRegistrationInput!): RefundRegistrationResult! @logged
+}
+
+input JoinRaceInput {
+    raceId: ID!
+    userId: ID!
+    "required when the race has categories"
+    category: String
+    "discount code of the race"
+    code: String
+}
+
+input RefundRegistrationInput {
+    raceId: ID!
+    userId: ID!
+}
+
+type Money {
+    "in the minor unit of the currency, like cents"
+    amount: Int!
+    "ISO 4217 code, like EUR"
+    currency: String!
+}
+
+type RacePrice {
+    currency: String!
+    "price once the early-bird tiers are over"
+    amount: Int!
+    tiers: [PriceTier!]!
+}
+
+type PriceTier {
+    until: DateTime!
+    amount: Int!
+}
+
+enum RegistrationStatus {
+    PENDING_PAYMENT
+    CONFIRMED
+    REFUND_PENDING
+    REFUNDED
+}
+
+type Registration {
+    raceId: ID!
+    competitor: User!
+    status: RegistrationStatus!
+    "missing when the race is free"
+    fee: Money
+    "the pending registration is released when not paid before"
+    expiresAt: DateTime
+    "the discount code redeemed"
+    code: String
+}
+
+type Checkout {
+    paymentId: ID!
+    "the page the competitor pays at"
+    url: String!
+}
+
+type InvalidRacePriceError implements Error {
+    message: String!
+}
+
+type RegistrationError implements Error {
+    message: String!
+}
+
+union JoinRaceResult = Registration | InvalidIDError | RaceNotFound | RegistrationError | DiscountCodeNotFound | DiscountCodeNotApplicableError
+
+union CheckoutResult = Checkout | InvalidIDError | RaceNotFound | RegistrationError
+
+union RefundRegistrationResult = Registration | InvalidIDError | RaceNotFound | Forbidden | RegistrationError
+`, BuiltIn: false},
+	{Name: "../../../api/registration_transfer.graphql", Input: `extend type Mutation {
+  "allows or disallows the competitors to transfer their registrations, only for the race owner"
+  setRegistrationTransfers(race: RegistrationTransfersInput!): SetRegistrationTransfersResult! @logged
+  "offers the registration of the current user to another user, replacing the previous offer"
+  offerRegistration(transfer: OfferRegistrationInput!): OfferRegistrationResult! @logged
+  "drops the offer of the registration of the current user"
+  withdrawRegistrationOffer(raceId: ID!): WithdrawRegistrationOfferResult! @logged
+  "takes the registration offered to the current user, with its category, bib number and team entry"
+  acceptRegistration(transfer: AcceptRegistrationInput!): AcceptRegistrationResult! @logged
+}
+
+input RegistrationTransfersInput {
+    raceId: ID!
+    allowed: Boolean!
+    "the registrations can be transferred until the race starts when missing"
+    deadline: DateTime
+}
+
+input OfferRegistrationInput {
+    raceId: ID!
+    "the user the registration is offered to"
+    userId: ID!
+}
+
+input AcceptRegistrationInput {
+    raceId: ID!
+    "the competitor that offered the registration"
+    userId: ID!
+}
+
+type RegistrationTransferPolicy {
+    "the registrations can be transferred until the race starts when missing"
+    deadline: DateTime
+}
+
+type RegistrationOffer {
+    raceId: ID!
+    from: User!
+    to: User!
+    offeredAt: DateTime!
+}
+
+type RegistrationOfferWithdrawn {
+    raceId: ID!
+}
+
+type InvalidTransferDeadlineError implements Error {
+    message: String!
+}
+
+type RegistrationTransferError implements Error {
+    message: String!
+}
+
+union SetRegistrationTransfersResult = Race | InvalidIDError | RaceNotFound | Forbidden | InvalidTransferDeadlineError
+
+union OfferRegistrationResult = RegistrationOffer | InvalidIDError | RaceNotFound | UserNotFound | RegistrationTransferError
+
+union WithdrawRegistrationOfferResult = RegistrationOfferWithdrawn | InvalidIDError | RaceNotFound | RegistrationTransferError
+
+union AcceptRegistrationResult = Registration | InvalidIDError | RaceNotFound | RegistrationTransferError | RegistrationError
+`, BuiltIn: false},
+	{Name: "../../../api/relay.graphql", Input: `extend type Mutation {
+  setRelayLineUp(lineUp: RelayLineUpInput!): SetRelayLineUpResult! @logged
+  recordLegSplit(split: LegSplitInput!): RecordLegSplitResult! @logged
+}
+
+type RaceRelay {
+    legs: [RelayLeg!]!
+    allowRepeatRunners: Boolean!
+    standings: [RelayStanding!]!
+}
+
+type RelayLeg {
+    "position of the leg in the relay, starting from zero"
+    position: Int!
+    name: String!
+    "distance in metres"
+    distance: Int!
+    ranking: [RelayLegResult!]!
+}
+
+type RelayLegResult {
+    position: Int!
+    teamId: ID!
+    runner: User!
+    time: String!
+}
+
+type RelayStanding {
+    position: Int
+    teamId: ID!
+    time: String!
+    legsCompleted: Int!
+    complete: Boolean!
+}
+
+input RaceRelayInput {
+    legs: [RelayLegInput!]!
+    allowRepeatRunners: Boolean!
+}
+
+input RelayLegInput {
+    name: String!
+    "distance in metres"
+    distance: Int!
+}
+
+input RelayLineUpInput {
+    raceId: ID!
+    teamId: ID!
+    "runner of each leg, in legs order"
+    runners: [ID!]!
+}
+
+input LegSplitInput {
+    raceId: ID!
+    teamId: ID!
+    leg: Int!
+    "split time in [[hh:]mm:]ss[.sss] format"
+    time: String!
+}
+
+type InvalidRaceRelayError implements Error {
+    message: String!
+}
+
+type InvalidRelayLineUpError implements Error {
+    message: String!
+}
+
+type InvalidLegSplitError implements Error {
+    message: String!
+}
+
+union SetRelayLineUpResult = Race | InvalidIDError | RaceNotFound | TeamNotFound | Forbidden | InvalidRelayLineUpError
+
+union RecordLegSplitResult = Race | InvalidIDError | RaceNotFound | Forbidden | InvalidRaceTimeError | InvalidLegSplitError
+`, BuiltIn: false},
+	{Name: "../../../api/results_import.graphql", Input: `extend type Mutation {
+  importResults(results: ResultsImportInput!): ImportResultsResult! @logged
+}
+
+input ResultsImportInput {
+    raceId: ID!
+    "registered parser of the file, csv or chip"
+    format: String!
+    "columns of each value, required by the csv format"
+    mapping: ResultsColumnMappingInput
+    file: Upload!
+    "report what would be imported without recording the results"
+    dryRun: Boolean! = false
+}
+
+"header names of the columns"
+input ResultsColumnMappingInput {
+    bib: String!
+    chipTime: String
+    gunTime: String
+    status: String
+}
+
+type ResultsImport {
+    race: Race!
+    results: [ImportedResult!]!
+    "rows of competitors that did not finish"
+    skipped: Int!
+    issues: [ImportIssue!]!
+    "false on a dry run or when there is nothing to record"
+    committed: Boolean!
+}
+
+type ImportedResult {
+    line: Int!
+    bib: Int!
+    competitor: User!
+    time: String!
+}
+
+enum ImportIssueKind {
+    BAD_BIB
+    UNMATCHED_BIB
+    DUPLICATE_BIB
+    BAD_TIME
+}
+
+type ImportIssue {
+    line: Int!
+    bib: String!
+    kind: ImportIssueKind!
+    message: String!
+}
+
+type InvalidResultsFileError implements Error {
+    message: String!
+}
+
+union ImportResultsResult = ResultsImport | InvalidIDError | RaceNotFound | Forbidden | InvalidResultsFileError
+`, BuiltIn: false},
+	{Name: "../../../api/schema.graphql", Input: `
+directive @logged on MUTATION | QUERY | FIELD
+
+type Query {
+  race(id: ID!): RaceResult!
+  races: Races!
+  "races with a venue within the radius of the point, the closest first"
+  racesNear(lat: Float!, lon: Float!, radiusKm: Float!): RacesNearResult!
+}
+
+union RaceResult = Race | InvalidIDError | RaceNotFound
+
+union RacesNearResult = Races | InvalidRacesNearError
+
+type Mutation {
+  createRace(race: RaceInput!): CreateRaceResult! @logged
+  recordResult(result: RaceResultInput!): RecordResultResult! @logged
+}
+
+input RaceInput {
+    id: ID!
+    name: String!
+    date: DateTime!
+    description: String
+    teams: RaceTeamsInput
+    relay: RaceRelayInput
+    categories: [RaceCategoryInput!]
+    "intermediate timing points in course order"
+    checkpoints: [CheckpointInput!]
+    "no bib numbers when missing"
+    bibs: RaceBibsInput
+    "times are shown in UTC when missing"
+    venue: VenueInput
+    "free when missing"
+    price: RacePriceInput
+    "the registration is open until the race is finished when missing"
+    registrationDeadline: DateTime
+}
+
+input RacePriceInput {
+    "ISO 4217 code, like EUR"
+    currency: String!
+    "in the minor unit of the currency, like cents"
+    amount: Int!
+    "early-bird prices, by date"
+    tiers: [PriceTierInput!]
+}
+
+input PriceTierInput {
+    "the tier applies to the registrations until this date"
+    until: DateTime!
+    amount: Int!
+}
+
+input VenueInput {
+    name: String!
+    address: String
+    lat: Float!
+    lon: Float!
+    "IANA name of the zone, like Europe/Madrid"
+    timeZone: String!
+}
+
+input RaceCategoryInput {
+    name: String!
+    "distance in metres"
+    distance: Int!
+    startTime: DateTime!
+    "max number of competitors, no limit when missing"
+    capacity: Int
+    "age required on the race day, no limit when missing"
+    minAge: Int
+    "open to any gender when missing"
+    gender: Gender
+    "numbers reserved for the category, required by the category range bib strategy"
+    bibRange: BibRangeInput
+    "the race price when missing"
+    price: RacePriceInput
+}
+
+input RaceTeamsInput {
+    minMembers: Int!
+    maxMembers: Int!
+    scoring: TeamScoring!
+    counting: Int!
+}
+
+union CreateRaceResult = Race | InvalidIDError | InvalidRaceNameError | InvalidRaceDateError | InvalidRaceTeamsError | InvalidRaceRelayError | InvalidRaceCategoryError | InvalidRaceCheckpointsError | InvalidRaceBibsError | InvalidVenueError | InvalidRacePriceError | RaceAlreadyExists
+
+input RaceResultInput {
+    raceId: ID!
+    "the competitor is identified by the user id or the bib number"
+    userId: ID
+    bib: Int
+    "finish time in [[hh:]mm:]ss[.sss] format"
+    time: String!
+}
+
+union RecordResultResult = Race | InvalidIDError | RaceNotFound | Forbidden | InvalidRaceTimeError | CompetitorNotInRaceError | InvalidBibError
+
+scalar DateTime
+
+interface Error {
+    message: String!
+}
+
+type InvalidIDError implements Error {
+    message: String!
+}
+
+type Forbidden implements Error {
+    message: String!
+}
+
+type PageInfo {
+    endCursor: String
+    hasNextPage: Boolean!
+}
+
+scalar JSON
+`, BuiltIn: false},
+	{Name: "../../../api/search.graphql", Input: `extend type Query {
+  "races by name, venue and description, tolerating typos in the name, the most relevant first"
+  searchRaces(query: String!, filter: RaceSearchFilter, first: Int = 20): SearchRacesResult!
+}
+
+input RaceSearchFilter {
+    from: DateTime
+    to: DateTime
+    near: NearInput
+}
+
+input NearInput {
+    lat: Float!
+    lon: Float!
+    radiusKm: Float!
+}
+
+type RaceSearch {
+    matches: [RaceMatch!]!
+}
+
+type RaceMatch {
+    race: Race!
+    "relevance for the query, higher first"
+    rank: Float!
+    "HTML escaped text that matched, with the matching words in <mark> tags"
+    snippet: String!
+}
+
+type InvalidRaceSearchError implements Error {
+    message: String!
+}
+
+union SearchRacesResult = RaceSearch | InvalidRaceSearchError
+`, BuiltIn: false},
+	{Name: "../../../api/series.graphql", Input: `extend type Query {
+  series(id: ID!): SeriesResult!
+}
+
+extend type Mutation {
+  "creates the series and its races up to 90 days ahead"
+  createSeries(series: SeriesInput!): CreateSeriesResult! @logged
+  "changes the series, only the races not run yet are changed"
+  updateSeries(series: SeriesInput!): UpdateSeriesResult! @logged
+  "creates the races of the series up to 90 days ahead that were not created yet"
+  generateSeriesRaces(id: ID!): GenerateSeriesRacesResult! @logged
+}
+
+input SeriesInput {
+    id: ID!
+    name: String!
+    description: String
+    "times are shown in UTC when missing"
+    venue: VenueInput
+    "date of the first race, the next ones keep its time of the day in the venue time zone"
+    start: DateTime!
+    "RFC 5545 recurrence rule with FREQ DAILY, WEEKLY or MONTHLY, INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL"
+    rule: String!
+    "standings points by position, the first for the winner, 25, 18, 15, 12, 10, 8, 6, 4, 2, 1 when missing"
+    points: [Int!]
+    "number of best races counted for each competitor, all of them when missing"
+    bestOf: Int
+}
+
+type Series {
+    id: ID!
+    name: String!
+    description: String!
+    venue: Venue
+    "in the venue time zone, UTC when the series has no venue"
+    start: DateTime!
+    rule: String!
+    points: [Int!]!
+    bestOf: Int!
+    "the races generated for the series, by date"
+    races: [SeriesRace!]!
+    standings: [SeriesStanding!]!
+}
+
+type SeriesRace {
+    raceId: ID!
+    "the occurrence of the rule the race was created for"
+    occurrence: DateTime!
+}
+
+type SeriesStanding {
+    position: Int!
+    competitor: User!
+    points: Int!
+    "races of the series the competitor finished"
+    races: Int!
+}
+
+type SeriesNotFound implements Error {
+    message: String!
+}
+
+type SeriesAlreadyExists implements Error {
+    message: String!
+}
+
+type InvalidSeriesError implements Error {
+    message: String!
+}
+
+type SeriesRaces {
+    races: [Race!]!
+}
+
+union SeriesResult = Series | InvalidIDError | SeriesNotFound
+
+union CreateSeriesResult = Series | InvalidIDError | InvalidRaceNameError | InvalidRaceDateError | InvalidVenueError | InvalidSeriesError | SeriesAlreadyExists
+
+union UpdateSeriesResult = Series | InvalidIDError | InvalidRaceNameError | InvalidVenueError | InvalidSeriesError | SeriesNotFound | Forbidden
+
+union GenerateSeriesRacesResult = SeriesRaces | InvalidIDError | SeriesNotFound | Forbidden
+`, BuiltIn: false},
+	{Name: "../../../api/staff.graphql", Input: `extend type Mutation {
+  "grants a user a role in the race, replacing its previous role, only for the race owner"
+  addRaceStaff(staff: RaceStaffInput!): RaceStaffResult! @logged
+  "revokes the role of a user in the race, only for the race owner"
+  removeRaceStaff(staff: RemoveRaceStaffInput!): RaceStaffResult! @logged
+  "hands the race over to another user, the current owner stays as co-organizer"
+  transferRaceOwnership(owner: RaceOwnerInput!): RaceStaffResult! @logged
+}
+
+enum StaffRole {
+    "the race belongs to the user, transferred with transferRaceOwnership"
+    OWNER
+    "edits the race, enters the results and checks the competitors in"
+    CO_ORGANIZER
+    "enters the results and timing passages"
+    TIMEKEEPER
+    "checks the competitors in at the packet pickup"
+    VOLUNTEER
+}
+
+input RaceStaffInput {
+    raceId: ID!
+    userId: ID!
+    "any role but OWNER"
+    role: StaffRole!
+}
+
+input RemoveRaceStaffInput {
+    raceId: ID!
+    userId: ID!
+}
+
+input RaceOwnerInput {
+    raceId: ID!
+    userId: ID!
+}
+
+type RaceStaffMember {
+    user: User!
+    role: StaffRole!
+}
+
+type StaffError implements Error {
+    message: String!
+}
+
+union RaceStaffResult = Race | InvalidIDError | RaceNotFound | UserNotFound | Forbidden | StaffError
+`, BuiltIn: false},
+	{Name: "../../../api/team.graphql", Input: `extend type Mutation {
+  enterTeam(entry: TeamEntryInput!): EnterTeamResult! @logged
+  inviteToTeam(invitation: TeamUserInput!): TeamResult! @logged
+  acceptTeamInvitation(teamId: ID!): TeamResult! @logged
+  declineTeamInvitation(teamId: ID!): TeamResult! @logged
+  requestToJoinTeam(teamId: ID!): TeamResult! @logged
+  approveJoinRequest(request: TeamUserInput!): TeamResult! @logged
+  rejectJoinRequest(request: TeamUserInput!): TeamResult! @logged
+  leaveTeam(teamId: ID!): TeamResult! @logged
+  removeMember(member: TeamUserInput!): TeamResult! @logged
+  transferAdmin(to: TeamUserInput!): TeamResult! @logged
+}
+
+type Team {
+    id: ID!
+    name: String!
+    admin: User!
+    members: [User!]!
+    invitations: [TeamInvitation!]!
+    joinRequests: [User!]!
+}
+
+type TeamInvitation {
+    user: User!
+    expiresAt: DateTime!
+}
+
+input TeamUserInput {
+    teamId: ID!
+    userId: ID!
+}
+
+type TeamMembershipError implements Error {
+    message: String!
+}
+
+union TeamResult = Team | InvalidIDError | TeamNotFound | UserNotFound | Forbidden | TeamMembershipError
+
+input TeamEntryInput {
+    raceId: ID!
+    teamId: ID!
+    members: [ID!]!
+}
+
+type TeamNotFound implements Error {
+    message: String!
+}
+
+type TeamAlreadyEntered implements Error {
+    message: String!
+}
+
+type InvalidTeamEntryError implements Error {
+    message: String!
+}
+
+union EnterTeamResult = Race | InvalidIDError | RaceNotFound | TeamNotFound | Forbidden | TeamAlreadyEntered | InvalidTeamEntryError
+`, BuiltIn: false},
+	{Name: "../../../api/user.graphql", Input: `type User {
+    id: ID!
+    name: String!
+    races: [Race!]!
+}
+
+type UserNotFound implements Error {
+    message: String!
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_acceptRegistration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.AcceptRegistrationInput
+	if tmp, ok := rawArgs["transfer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("transfer"))
+		arg0, err = ec.unmarshalNAcceptRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAcceptRegistrationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["transfer"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acceptTeamInvitation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["teamId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["teamId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addOrganizationMember_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.OrganizationMemberInput
+	if tmp, ok := rawArgs["member"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("member"))
+		arg0, err = ec.unmarshalNOrganizationMemberInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["member"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addRaceStaff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RaceStaffInput
+	if tmp, ok := rawArgs["staff"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("staff"))
+		arg0, err = ec.unmarshalNRaceStaffInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["staff"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_approveJoinRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamUserInput
+	if tmp, ok := rawArgs["request"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("request"))
+		arg0, err = ec.unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_assignBib_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.BibInput
+	if tmp, ok := rawArgs["bib"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+		arg0, err = ec.unmarshalNBibInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["bib"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_assignRaceToOrganization_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RaceOrganizationInput
+	if tmp, ok := rawArgs["assignment"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("assignment"))
+		arg0, err = ec.unmarshalNRaceOrganizationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceOrganizationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["assignment"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelRace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.CancelRaceInput
+	if tmp, ok := rawArgs["race"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("race"))
+		arg0, err = ec.unmarshalNCancelRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCancelRaceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["race"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_checkIn_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.CheckInInput
+	if tmp, ok := rawArgs["checkIn"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("checkIn"))
+		arg0, err = ec.unmarshalNCheckInInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["checkIn"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_checkout_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["raceId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["raceId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createDiscountCode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.DiscountCodeInput
+	if tmp, ok := rawArgs["code"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+		arg0, err = ec.unmarshalNDiscountCodeInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodeInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["code"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createOrganization_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.OrganizationInput
+	if tmp, ok := rawArgs["organization"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organization"))
+		arg0, err = ec.unmarshalNOrganizationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["organization"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createRace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RaceInput
+	if tmp, ok := rawArgs["race"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("race"))
+		arg0, err = ec.unmarshalNRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["race"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_createSeries_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.SeriesInput
+	if tmp, ok := rawArgs["series"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("series"))
+		arg0, err = ec.unmarshalNSeriesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["series"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_declineTeamInvitation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["teamId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["teamId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_enterTeam_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamEntryInput
+	if tmp, ok := rawArgs["entry"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entry"))
+		arg0, err = ec.unmarshalNTeamEntryInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamEntryInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entry"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_generateSeriesRaces_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_importResults_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.ResultsImportInput
+	if tmp, ok := rawArgs["results"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("results"))
+		arg0, err = ec.unmarshalNResultsImportInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐResultsImportInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["results"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_inviteToTeam_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamUserInput
+	if tmp, ok := rawArgs["invitation"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("invitation"))
+		arg0, err = ec.unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["invitation"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_joinRace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.JoinRaceInput
+	if tmp, ok := rawArgs["registration"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("registration"))
+		arg0, err = ec.unmarshalNJoinRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJoinRaceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["registration"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_leaveTeam_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["teamId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["teamId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_offerRegistration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.OfferRegistrationInput
+	if tmp, ok := rawArgs["transfer"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("transfer"))
+		arg0, err = ec.unmarshalNOfferRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOfferRegistrationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["transfer"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordLegSplit_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.LegSplitInput
+	if tmp, ok := rawArgs["split"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("split"))
+		arg0, err = ec.unmarshalNLegSplitInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐLegSplitInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["split"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordPassages_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.PassagesInput
+	if tmp, ok := rawArgs["passages"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passages"))
+		arg0, err = ec.unmarshalNPassagesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassagesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["passages"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordResult_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RaceResultInput
+	if tmp, ok := rawArgs["result"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("result"))
+		arg0, err = ec.unmarshalNRaceResultInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResultInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["result"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_refundRegistration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RefundRegistrationInput
+	if tmp, ok := rawArgs["registration"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("registration"))
+		arg0, err = ec.unmarshalNRefundRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRefundRegistrationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["registration"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rejectJoinRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamUserInput
+	if tmp, ok := rawArgs["request"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("request"))
+		arg0, err = ec.unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["request"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeMember_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamUserInput
+	if tmp, ok := rawArgs["member"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("member"))
+		arg0, err = ec.unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["member"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeOrganizationMember_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RemoveOrganizationMemberInput
+	if tmp, ok := rawArgs["member"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("member"))
+		arg0, err = ec.unmarshalNRemoveOrganizationMemberInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRemoveOrganizationMemberInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["member"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeRaceStaff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RemoveRaceStaffInput
+	if tmp, ok := rawArgs["staff"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("staff"))
+		arg0, err = ec.unmarshalNRemoveRaceStaffInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRemoveRaceStaffInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["staff"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_requestToJoinTeam_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["teamId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["teamId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rescheduleRace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RescheduleRaceInput
+	if tmp, ok := rawArgs["race"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("race"))
+		arg0, err = ec.unmarshalNRescheduleRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRescheduleRaceInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["race"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setRegistrationTransfers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RegistrationTransfersInput
+	if tmp, ok := rawArgs["race"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("race"))
+		arg0, err = ec.unmarshalNRegistrationTransfersInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationTransfersInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["race"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setRelayLineUp_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RelayLineUpInput
+	if tmp, ok := rawArgs["lineUp"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lineUp"))
+		arg0, err = ec.unmarshalNRelayLineUpInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLineUpInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["lineUp"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_transferAdmin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.TeamUserInput
+	if tmp, ok := rawArgs["to"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+		arg0, err = ec.unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["to"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_transferRaceOwnership_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.RaceOwnerInput
+	if tmp, ok := rawArgs["owner"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("owner"))
+		arg0, err = ec.unmarshalNRaceOwnerInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceOwnerInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["owner"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateNotificationPreferences_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.NotificationPreferencesInput
+	if tmp, ok := rawArgs["preferences"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("preferences"))
+		arg0, err = ec.unmarshalNNotificationPreferencesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationPreferencesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["preferences"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateSeries_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.SeriesInput
+	if tmp, ok := rawArgs["series"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("series"))
+		arg0, err = ec.unmarshalNSeriesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["series"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_uploadCourse_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.CourseUploadInput
+	if tmp, ok := rawArgs["course"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("course"))
+		arg0, err = ec.unmarshalNCourseUploadInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCourseUploadInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["course"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_withdrawRegistrationOffer_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["raceId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["raceId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_auditLog_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *models.AuditLogFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalOAuditLogFilter2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_discountCodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["raceId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["raceId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_erasure_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_organization_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_race_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_racesNear_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 float64
+	if tmp, ok := rawArgs["lat"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lat"))
+		arg0, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["lat"] = arg0
+	var arg1 float64
+	if tmp, ok := rawArgs["lon"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lon"))
+		arg1, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["lon"] = arg1
+	var arg2 float64
+	if tmp, ok := rawArgs["radiusKm"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("radiusKm"))
+		arg2, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["radiusKm"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_searchRaces_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg0
+	var arg1 *models.RaceSearchFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg1, err = ec.unmarshalORaceSearchFilter2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceSearchFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_series_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+func (ec *executionContext) _queryMiddleware(ctx context.Context, obj *ast.OperationDefinition, next func(ctx context.Context) (interface{}, error)) graphql.Marshaler {
+
+	for _, d := range obj.Directives {
+		switch d.Name {
+		case "logged":
+			n := next
+			next = func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Logged == nil {
+					return nil, errors.New("directive logged is not implemented")
+				}
+				return ec.directives.Logged(ctx, obj, n)
+			}
+		}
+	}
+	tmp, err := next(ctx)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if data, ok := tmp.(graphql.Marshaler); ok {
+		return data
+	}
+	ec.Errorf(ctx, `unexpected type %T from directive, should be graphql.Marshaler`, tmp)
+	return graphql.Null
+
+}
+
+func (ec *executionContext) _mutationMiddleware(ctx context.Context, obj *ast.OperationDefinition, next func(ctx context.Context) (interface{}, error)) graphql.Marshaler {
+
+	for _, d := range obj.Directives {
+		switch d.Name {
+		case "logged":
+			n := next
+			next = func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Logged == nil {
+					return nil, errors.New("directive logged is not implemented")
+				}
+				return ec.directives.Logged(ctx, obj, n)
+			}
+		}
+	}
+	tmp, err := next(ctx)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if data, ok := tmp.(graphql.Marshaler); ok {
+		return data
+	}
+	ec.Errorf(ctx, `unexpected type %T from directive, should be graphql.Marshaler`, tmp)
+	return graphql.Null
+
+}
+
+func (ec *executionContext) _fieldMiddleware(ctx context.Context, obj interface{}, next graphql.Resolver) interface{} {
+	fc := graphql.GetFieldContext(ctx)
+	for _, d := range fc.Field.Directives {
+		switch d.Name {
+		case "logged":
+			n := next
+			next = func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Logged == nil {
+					return nil, errors.New("directive logged is not implemented")
+				}
+				return ec.directives.Logged(ctx, obj, n)
+			}
+		}
+	}
+	res, err := ec.ResolverMiddleware(ctx, next)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	return res
+}
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AuditLog_edges(ctx context.Context, field graphql.CollectedField, obj *models.AuditLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLog",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.AuditLogEdge)
+	fc.Result = res
+	return ec.marshalNAuditLogEdge2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLog_pageInfo(ctx context.Context, field graphql.CollectedField, obj *models.AuditLog) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLog",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEdge",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEdge_node(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEdge",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.AuditLogEntry)
+	fc.Result = res
+	return ec.marshalNAuditLogEntry2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_id(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_type(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_actor(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_raceId(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_organizationId(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrganizationID, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_occurredAt(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OccurredAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AuditLogEntry_payload(ctx context.Context, field graphql.CollectedField, obj *models.AuditLogEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AuditLogEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.JSON)
+	fc.Result = res
+	return ec.marshalNJSON2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJSON(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BibRange_from(ctx context.Context, field graphql.CollectedField, obj *models.BibRange) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BibRange",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _BibRange_to(ctx context.Context, field graphql.CollectedField, obj *models.BibRange) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "BibRange",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CalendarToken_secret(ctx context.Context, field graphql.CollectedField, obj *models.CalendarToken) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CalendarToken",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CalendarToken_feed(ctx context.Context, field graphql.CollectedField, obj *models.CalendarToken) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CalendarToken",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Feed, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CalendarToken_createdAt(ctx context.Context, field graphql.CollectedField, obj *models.CalendarToken) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CalendarToken",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CalendarTokenRevoked_revoked(ctx context.Context, field graphql.CollectedField, obj *models.CalendarTokenRevoked) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CalendarTokenRevoked",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revoked, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CancellationError_message(ctx context.Context, field graphql.CollectedField, obj *models.CancellationError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CancellationError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CheckIn_competitor(ctx context.Context, field graphql.CollectedField, obj *models.CheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CheckIn_at(ctx context.Context, field graphql.CollectedField, obj *models.CheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.At, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CheckInError_message(ctx context.Context, field graphql.CollectedField, obj *models.CheckInError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CheckInError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Checkout_paymentId(ctx context.Context, field graphql.CollectedField, obj *models.Checkout) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Checkout",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PaymentID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Checkout_url(ctx context.Context, field graphql.CollectedField, obj *models.Checkout) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Checkout",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Checkpoint_name(ctx context.Context, field graphql.CollectedField, obj *models.Checkpoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Checkpoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Checkpoint_distance(ctx context.Context, field graphql.CollectedField, obj *models.Checkpoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Checkpoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Checkpoint_cutoff(ctx context.Context, field graphql.CollectedField, obj *models.Checkpoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Checkpoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cutoff, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorBib_bib(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorBib) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorBib",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bib, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorBib_competitor(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorBib) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorBib",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_raceId(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_competitor(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_category(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_bib(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bib, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_status(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RegistrationStatus)
+	fc.Result = res
+	return ec.marshalNRegistrationStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorCheckIn_checkedInAt(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorCheckIn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorCheckIn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CheckedInAt, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorNotInRaceError_message(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorNotInRaceError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorNotInRaceError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorResult_position(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorResult_competitor(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorResult_time(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorSplits_competitor(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorSplits) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorSplits",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CompetitorSplits_splits(ctx context.Context, field graphql.CollectedField, obj *models.CompetitorSplits) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CompetitorSplits",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Splits, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Split)
+	fc.Result = res
+	return ec.marshalNSplit2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSplitᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Course_distance(ctx context.Context, field graphql.CollectedField, obj *models.Course) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Course",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Course_elevationGain(ctx context.Context, field graphql.CollectedField, obj *models.Course) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Course",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ElevationGain, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Course_elevationLoss(ctx context.Context, field graphql.CollectedField, obj *models.Course) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Course",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ElevationLoss, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Course_polyline(ctx context.Context, field graphql.CollectedField, obj *models.Course) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Course",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Polyline, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Course_points(ctx context.Context, field graphql.CollectedField, obj *models.Course) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Course",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Points, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CoursePoint)
+	fc.Result = res
+	return ec.marshalNCoursePoint2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCoursePointᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CoursePoint_lat(ctx context.Context, field graphql.CollectedField, obj *models.CoursePoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CoursePoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lat, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CoursePoint_lon(ctx context.Context, field graphql.CollectedField, obj *models.CoursePoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CoursePoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lon, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CoursePoint_elevation(ctx context.Context, field graphql.CollectedField, obj *models.CoursePoint) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "CoursePoint",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Elevation, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DataExport_fileName(ctx context.Context, field graphql.CollectedField, obj *models.DataExport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DataExport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FileName, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DataExport_contentType(ctx context.Context, field graphql.CollectedField, obj *models.DataExport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DataExport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContentType, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DataExport_data(ctx context.Context, field graphql.CollectedField, obj *models.DataExport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DataExport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_raceId(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_code(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_percent(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Percent, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_amount(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_maxRedemptions(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxRedemptions, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_validFrom(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ValidFrom, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_validUntil(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ValidUntil, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_category(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCode_redemptions(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCode) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCode",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Redemptions, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCodeAlreadyExists_message(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCodeAlreadyExists) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCodeAlreadyExists",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCodeNotApplicableError_message(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCodeNotApplicableError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCodeNotApplicableError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCodeNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCodeNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCodeNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DiscountCodes_codes(ctx context.Context, field graphql.CollectedField, obj *models.DiscountCodes) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DiscountCodes",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Codes, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.DiscountCode)
+	fc.Result = res
+	return ec.marshalNDiscountCode2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Erasure_id(ctx context.Context, field graphql.CollectedField, obj *models.Erasure) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Erasure",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Erasure_status(ctx context.Context, field graphql.CollectedField, obj *models.Erasure) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Erasure",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.ErasureStatus)
+	fc.Result = res
+	return ec.marshalNErasureStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐErasureStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Erasure_requestedAt(ctx context.Context, field graphql.CollectedField, obj *models.Erasure) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Erasure",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestedAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Erasure_finishedAt(ctx context.Context, field graphql.CollectedField, obj *models.Erasure) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Erasure",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FinishedAt, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ErasureNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.ErasureNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ErasureNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Forbidden_message(ctx context.Context, field graphql.CollectedField, obj *models.Forbidden) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Forbidden",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportIssue_line(ctx context.Context, field graphql.CollectedField, obj *models.ImportIssue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportIssue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportIssue_bib(ctx context.Context, field graphql.CollectedField, obj *models.ImportIssue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportIssue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bib, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportIssue_kind(ctx context.Context, field graphql.CollectedField, obj *models.ImportIssue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportIssue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.ImportIssueKind)
+	fc.Result = res
+	return ec.marshalNImportIssueKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssueKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportIssue_message(ctx context.Context, field graphql.CollectedField, obj *models.ImportIssue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportIssue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportedResult_line(ctx context.Context, field graphql.CollectedField, obj *models.ImportedResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportedResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportedResult_bib(ctx context.Context, field graphql.CollectedField, obj *models.ImportedResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportedResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bib, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportedResult_competitor(ctx context.Context, field graphql.CollectedField, obj *models.ImportedResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportedResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ImportedResult_time(ctx context.Context, field graphql.CollectedField, obj *models.ImportedResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ImportedResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidBibError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidBibError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidBibError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidCourseError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidCourseError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidCourseError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidCursorError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidCursorError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidCursorError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidDiscountCodeError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidDiscountCodeError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidDiscountCodeError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidIDError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidIDError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidIDError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidLegSplitError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidLegSplitError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidLegSplitError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidOrganizationNameError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidOrganizationNameError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidOrganizationNameError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceBibsError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceBibsError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceBibsError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceCategoryError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceCategoryError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceCategoryError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceCheckpointsError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceCheckpointsError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceCheckpointsError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceDateError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceDateError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceDateError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceNameError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceNameError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceNameError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRacePriceError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRacePriceError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRacePriceError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceRelayError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceRelayError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceRelayError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceSearchError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceSearchError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceSearchError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceTeamsError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceTeamsError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceTeamsError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRaceTimeError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRaceTimeError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRaceTimeError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRacesNearError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRacesNearError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRacesNearError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidRelayLineUpError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidRelayLineUpError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidRelayLineUpError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidResultsFileError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidResultsFileError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidResultsFileError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidSeriesError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidSeriesError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidSeriesError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidTeamEntryError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidTeamEntryError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidTeamEntryError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidTransferDeadlineError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidTransferDeadlineError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidTransferDeadlineError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InvalidVenueError_message(ctx context.Context, field graphql.CollectedField, obj *models.InvalidVenueError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InvalidVenueError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Money_amount(ctx context.Context, field graphql.CollectedField, obj *models.Money) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Money",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Money_currency(ctx context.Context, field graphql.CollectedField, obj *models.Money) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Money",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Currency, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createRace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createRace_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateRace(rctx, args["race"].(models.RaceInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CreateRaceResult)
+	fc.Result = res
+	return ec.marshalNCreateRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateRaceResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_recordResult(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_recordResult_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RecordResult(rctx, args["result"].(models.RaceResultInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RecordResultResult)
+	fc.Result = res
+	return ec.marshalNRecordResultResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordResultResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_assignBib(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_assignBib_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AssignBib(rctx, args["bib"].(models.BibInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.AssignBibResult)
+	fc.Result = res
+	return ec.marshalNAssignBibResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAssignBibResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_rescheduleRace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_rescheduleRace_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RescheduleRace(rctx, args["race"].(models.RescheduleRaceInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RescheduleRaceResult)
+	fc.Result = res
+	return ec.marshalNRescheduleRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRescheduleRaceResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createCalendarToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateCalendarToken(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CreateCalendarTokenResult)
+	fc.Result = res
+	return ec.marshalNCreateCalendarTokenResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateCalendarTokenResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_revokeCalendarToken(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeCalendarToken(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RevokeCalendarTokenResult)
+	fc.Result = res
+	return ec.marshalNRevokeCalendarTokenResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRevokeCalendarTokenResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_cancelRace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_cancelRace_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CancelRace(rctx, args["race"].(models.CancelRaceInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CancelRaceResult)
+	fc.Result = res
+	return ec.marshalNCancelRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCancelRaceResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_checkIn(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_checkIn_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CheckIn(rctx, args["checkIn"].(models.CheckInInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CheckInResult)
+	fc.Result = res
+	return ec.marshalNCheckInResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_recordPassages(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_recordPassages_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RecordPassages(rctx, args["passages"].(models.PassagesInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RecordPassagesResult)
+	fc.Result = res
+	return ec.marshalNRecordPassagesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordPassagesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_uploadCourse(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_uploadCourse_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UploadCourse(rctx, args["course"].(models.CourseUploadInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.UploadCourseResult)
+	fc.Result = res
+	return ec.marshalNUploadCourseResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUploadCourseResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createDiscountCode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createDiscountCode_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateDiscountCode(rctx, args["code"].(models.DiscountCodeInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CreateDiscountCodeResult)
+	fc.Result = res
+	return ec.marshalNCreateDiscountCodeResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateDiscountCodeResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateNotificationPreferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateNotificationPreferences_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateNotificationPreferences(rctx, args["preferences"].(models.NotificationPreferencesInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.UpdateNotificationPreferencesResult)
+	fc.Result = res
+	return ec.marshalNUpdateNotificationPreferencesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUpdateNotificationPreferencesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createOrganization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createOrganization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateOrganization(rctx, args["organization"].(models.OrganizationInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CreateOrganizationResult)
+	fc.Result = res
+	return ec.marshalNCreateOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateOrganizationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_addOrganizationMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addOrganizationMember_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddOrganizationMember(rctx, args["member"].(models.OrganizationMemberInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.OrganizationMemberResult)
+	fc.Result = res
+	return ec.marshalNOrganizationMemberResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_removeOrganizationMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeOrganizationMember_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveOrganizationMember(rctx, args["member"].(models.RemoveOrganizationMemberInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.OrganizationMemberResult)
+	fc.Result = res
+	return ec.marshalNOrganizationMemberResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_assignRaceToOrganization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_assignRaceToOrganization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AssignRaceToOrganization(rctx, args["assignment"].(models.RaceOrganizationInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.AssignRaceToOrganizationResult)
+	fc.Result = res
+	return ec.marshalNAssignRaceToOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAssignRaceToOrganizationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_eraseMyData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EraseMyData(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.EraseMyDataResult)
+	fc.Result = res
+	return ec.marshalNEraseMyDataResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐEraseMyDataResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_joinRace(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_joinRace_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().JoinRace(rctx, args["registration"].(models.JoinRaceInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.JoinRaceResult)
+	fc.Result = res
+	return ec.marshalNJoinRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJoinRaceResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_checkout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_checkout_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Checkout(rctx, args["raceId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CheckoutResult)
+	fc.Result = res
+	return ec.marshalNCheckoutResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckoutResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_refundRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_refundRegistration_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RefundRegistration(rctx, args["registration"].(models.RefundRegistrationInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RefundRegistrationResult)
+	fc.Result = res
+	return ec.marshalNRefundRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRefundRegistrationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_setRegistrationTransfers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setRegistrationTransfers_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetRegistrationTransfers(rctx, args["race"].(models.RegistrationTransfersInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.SetRegistrationTransfersResult)
+	fc.Result = res
+	return ec.marshalNSetRegistrationTransfersResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSetRegistrationTransfersResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_offerRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_offerRegistration_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().OfferRegistration(rctx, args["transfer"].(models.OfferRegistrationInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.OfferRegistrationResult)
+	fc.Result = res
+	return ec.marshalNOfferRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOfferRegistrationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_withdrawRegistrationOffer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_withdrawRegistrationOffer_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().WithdrawRegistrationOffer(rctx, args["raceId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.WithdrawRegistrationOfferResult)
+	fc.Result = res
+	return ec.marshalNWithdrawRegistrationOfferResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐWithdrawRegistrationOfferResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_acceptRegistration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_acceptRegistration_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AcceptRegistration(rctx, args["transfer"].(models.AcceptRegistrationInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.AcceptRegistrationResult)
+	fc.Result = res
+	return ec.marshalNAcceptRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAcceptRegistrationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_setRelayLineUp(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_setRelayLineUp_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SetRelayLineUp(rctx, args["lineUp"].(models.RelayLineUpInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.SetRelayLineUpResult)
+	fc.Result = res
+	return ec.marshalNSetRelayLineUpResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSetRelayLineUpResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_recordLegSplit(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_recordLegSplit_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RecordLegSplit(rctx, args["split"].(models.LegSplitInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RecordLegSplitResult)
+	fc.Result = res
+	return ec.marshalNRecordLegSplitResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordLegSplitResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_importResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_importResults_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ImportResults(rctx, args["results"].(models.ResultsImportInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.ImportResultsResult)
+	fc.Result = res
+	return ec.marshalNImportResultsResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportResultsResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createSeries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_createSeries_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateSeries(rctx, args["series"].(models.SeriesInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.CreateSeriesResult)
+	fc.Result = res
+	return ec.marshalNCreateSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateSeriesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateSeries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateSeries_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSeries(rctx, args["series"].(models.SeriesInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.UpdateSeriesResult)
+	fc.Result = res
+	return ec.marshalNUpdateSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUpdateSeriesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_generateSeriesRaces(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_generateSeriesRaces_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().GenerateSeriesRaces(rctx, args["id"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.GenerateSeriesRacesResult)
+	fc.Result = res
+	return ec.marshalNGenerateSeriesRacesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGenerateSeriesRacesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_addRaceStaff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_addRaceStaff_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AddRaceStaff(rctx, args["staff"].(models.RaceStaffInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RaceStaffResult)
+	fc.Result = res
+	return ec.marshalNRaceStaffResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_removeRaceStaff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeRaceStaff_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveRaceStaff(rctx, args["staff"].(models.RemoveRaceStaffInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RaceStaffResult)
+	fc.Result = res
+	return ec.marshalNRaceStaffResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_transferRaceOwnership(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_transferRaceOwnership_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TransferRaceOwnership(rctx, args["owner"].(models.RaceOwnerInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RaceStaffResult)
+	fc.Result = res
+	return ec.marshalNRaceStaffResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_enterTeam(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_enterTeam_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EnterTeam(rctx, args["entry"].(models.TeamEntryInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.EnterTeamResult)
+	fc.Result = res
+	return ec.marshalNEnterTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐEnterTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_inviteToTeam(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_inviteToTeam_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().InviteToTeam(rctx, args["invitation"].(models.TeamUserInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_acceptTeamInvitation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_acceptTeamInvitation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AcceptTeamInvitation(rctx, args["teamId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_declineTeamInvitation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_declineTeamInvitation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeclineTeamInvitation(rctx, args["teamId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_requestToJoinTeam(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_requestToJoinTeam_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RequestToJoinTeam(rctx, args["teamId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_approveJoinRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_approveJoinRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ApproveJoinRequest(rctx, args["request"].(models.TeamUserInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_rejectJoinRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_rejectJoinRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RejectJoinRequest(rctx, args["request"].(models.TeamUserInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_leaveTeam(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_leaveTeam_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().LeaveTeam(rctx, args["teamId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_removeMember(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeMember_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveMember(rctx, args["member"].(models.TeamUserInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_transferAdmin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_transferAdmin_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TransferAdmin(rctx, args["to"].(models.TeamUserInput))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamResult)
+	fc.Result = res
+	return ec.marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NotificationPreferences_locale(ctx context.Context, field graphql.CollectedField, obj *models.NotificationPreferences) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "NotificationPreferences",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locale, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _NotificationPreferences_disabled(ctx context.Context, field graphql.CollectedField, obj *models.NotificationPreferences) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "NotificationPreferences",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Disabled, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]models.NotificationKind)
+	fc.Result = res
+	return ec.marshalNNotificationKind2ᚕgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKindᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Organization_id(ctx context.Context, field graphql.CollectedField, obj *models.Organization) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Organization_name(ctx context.Context, field graphql.CollectedField, obj *models.Organization) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Organization_members(ctx context.Context, field graphql.CollectedField, obj *models.Organization) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Members, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.OrganizationMember)
+	fc.Result = res
+	return ec.marshalNOrganizationMember2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Organization_races(ctx context.Context, field graphql.CollectedField, obj *models.Organization) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Organization_dashboard(ctx context.Context, field graphql.CollectedField, obj *models.Organization) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Organization",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dashboard, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.OrganizationDashboard)
+	fc.Result = res
+	return ec.marshalOOrganizationDashboard2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationDashboard(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationAlreadyExists_message(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationAlreadyExists) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationAlreadyExists",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationDashboard_races(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RaceDashboard)
+	fc.Result = res
+	return ec.marshalNRaceDashboard2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceDashboardᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationDashboard_revenue(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revenue, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Money)
+	fc.Result = res
+	return ec.marshalNMoney2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoneyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationMember_user(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationMember) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationMember",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationMember_role(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationMember) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationMember",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Role, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.OrganizationRole)
+	fc.Result = res
+	return ec.marshalNOrganizationRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationRole(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationMemberError_message(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationMemberError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationMemberError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _OrganizationNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.OrganizationNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "OrganizationNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EndCursor, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *models.PageInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PassagesRecorded_race(ctx context.Context, field graphql.CollectedField, obj *models.PassagesRecorded) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PassagesRecorded",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Race, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PassagesRecorded_rejected(ctx context.Context, field graphql.CollectedField, obj *models.PassagesRecorded) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PassagesRecorded",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rejected, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RejectedPassage)
+	fc.Result = res
+	return ec.marshalNRejectedPassage2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRejectedPassageᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PriceTier_until(ctx context.Context, field graphql.CollectedField, obj *models.PriceTier) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PriceTier",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Until, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PriceTier_amount(ctx context.Context, field graphql.CollectedField, obj *models.PriceTier) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PriceTier",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_race(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_race_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Race(rctx, args["id"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RaceResult)
+	fc.Result = res
+	return ec.marshalNRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_races(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Races(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Races)
+	fc.Result = res
+	return ec.marshalNRaces2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_racesNear(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_racesNear_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RacesNear(rctx, args["lat"].(float64), args["lon"].(float64), args["radiusKm"].(float64))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RacesNearResult)
+	fc.Result = res
+	return ec.marshalNRacesNearResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacesNearResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_auditLog(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_auditLog_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AuditLog(rctx, args["filter"].(*models.AuditLogFilter), args["first"].(*int), args["after"].(*string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.AuditLogResult)
+	fc.Result = res
+	return ec.marshalNAuditLogResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_discountCodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_discountCodes_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DiscountCodes(rctx, args["raceId"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.DiscountCodesResult)
+	fc.Result = res
+	return ec.marshalNDiscountCodesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_notificationPreferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().NotificationPreferences(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.NotificationPreferencesResult)
+	fc.Result = res
+	return ec.marshalNNotificationPreferencesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationPreferencesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_organization(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_organization_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Organization(rctx, args["id"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.OrganizationResult)
+	fc.Result = res
+	return ec.marshalNOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_exportMyData(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ExportMyData(rctx)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.ExportMyDataResult)
+	fc.Result = res
+	return ec.marshalNExportMyDataResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐExportMyDataResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_erasure(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_erasure_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Erasure(rctx, args["id"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.ErasureResult)
+	fc.Result = res
+	return ec.marshalNErasureResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐErasureResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_searchRaces(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_searchRaces_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SearchRaces(rctx, args["query"].(string), args["filter"].(*models.RaceSearchFilter), args["first"].(*int))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.SearchRacesResult)
+	fc.Result = res
+	return ec.marshalNSearchRacesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSearchRacesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_series(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_series_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Series(rctx, args["id"].(string))
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.SeriesResult)
+	fc.Result = res
+	return ec.marshalNSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, nil, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_id(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_name(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_date(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Date, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_description(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_venue(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Venue, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Venue)
+	fc.Result = res
+	return ec.marshalOVenue2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_competitors(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitors, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_teams(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Teams, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RaceTeams)
+	fc.Result = res
+	return ec.marshalORaceTeams2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTeams(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_results(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CompetitorResult)
+	fc.Result = res
+	return ec.marshalNCompetitorResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_teamStandings(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamStandings, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.TeamStanding)
+	fc.Result = res
+	return ec.marshalNTeamStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamStandingᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_relay(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Relay, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RaceRelay)
+	fc.Result = res
+	return ec.marshalORaceRelay2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceRelay(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_categories(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Categories, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RaceCategory)
+	fc.Result = res
+	return ec.marshalNRaceCategory2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_course(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Course, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Course)
+	fc.Result = res
+	return ec.marshalOCourse2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCourse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_checkpoints(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Checkpoints, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Checkpoint)
+	fc.Result = res
+	return ec.marshalNCheckpoint2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_splits(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Splits, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CompetitorSplits)
+	fc.Result = res
+	return ec.marshalNCompetitorSplits2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorSplitsᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_bibs(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bibs, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RaceBibs)
+	fc.Result = res
+	return ec.marshalORaceBibs2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceBibs(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_sequence(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sequence, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_seriesId(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SeriesID, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_price(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Price, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RacePrice)
+	fc.Result = res
+	return ec.marshalORacePrice2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePrice(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_status(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RaceStatus)
+	fc.Result = res
+	return ec.marshalNRaceStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_registrationDeadline(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RegistrationDeadline, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_cancellation(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cancellation, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RaceCancellation)
+	fc.Result = res
+	return ec.marshalORaceCancellation2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCancellation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_registrationTransfers(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RegistrationTransfers, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RegistrationTransferPolicy)
+	fc.Result = res
+	return ec.marshalORegistrationTransferPolicy2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationTransferPolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_registrationOffers(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RegistrationOffers, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RegistrationOffer)
+	fc.Result = res
+	return ec.marshalNRegistrationOffer2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationOfferᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_owner(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Owner, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_organizationId(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OrganizationID, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_staff(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Staff, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RaceStaffMember)
+	fc.Result = res
+	return ec.marshalNRaceStaffMember2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffMemberᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Race_checkIns(ctx context.Context, field graphql.CollectedField, obj *models.Race) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Race",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CheckIns, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CheckIn)
+	fc.Result = res
+	return ec.marshalNCheckIn2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceAlreadyExists_message(ctx context.Context, field graphql.CollectedField, obj *models.RaceAlreadyExists) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceAlreadyExists",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceBibs_strategy(ctx context.Context, field graphql.CollectedField, obj *models.RaceBibs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceBibs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Strategy, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.BibStrategy)
+	fc.Result = res
+	return ec.marshalNBibStrategy2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibStrategy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceBibs_first(ctx context.Context, field graphql.CollectedField, obj *models.RaceBibs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceBibs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.First, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceBibs_entries(ctx context.Context, field graphql.CollectedField, obj *models.RaceBibs) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceBibs",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entries, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CompetitorBib)
+	fc.Result = res
+	return ec.marshalNCompetitorBib2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorBibᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCancellation_reason(ctx context.Context, field graphql.CollectedField, obj *models.RaceCancellation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCancellation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCancellation_cancelledAt(ctx context.Context, field graphql.CollectedField, obj *models.RaceCancellation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCancellation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CancelledAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCancellation_transferTo(ctx context.Context, field graphql.CollectedField, obj *models.RaceCancellation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCancellation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TransferTo, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RaceTransfer)
+	fc.Result = res
+	return ec.marshalORaceTransfer2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTransfer(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_name(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_distance(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_startTime(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartTime, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_capacity(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Capacity, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_minAge(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinAge, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_gender(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gender, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Gender)
+	fc.Result = res
+	return ec.marshalOGender2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGender(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_competitors(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitors, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_results(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.CompetitorResult)
+	fc.Result = res
+	return ec.marshalNCompetitorResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_course(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Course, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Course)
+	fc.Result = res
+	return ec.marshalOCourse2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCourse(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_bibRange(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BibRange, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.BibRange)
+	fc.Result = res
+	return ec.marshalOBibRange2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibRange(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceCategory_price(ctx context.Context, field graphql.CollectedField, obj *models.RaceCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Price, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.RacePrice)
+	fc.Result = res
+	return ec.marshalORacePrice2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePrice(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceDashboard_raceId(ctx context.Context, field graphql.CollectedField, obj *models.RaceDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceDashboard_participants(ctx context.Context, field graphql.CollectedField, obj *models.RaceDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Participants, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceDashboard_confirmed(ctx context.Context, field graphql.CollectedField, obj *models.RaceDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Confirmed, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceDashboard_checkedIn(ctx context.Context, field graphql.CollectedField, obj *models.RaceDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CheckedIn, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceDashboard_revenue(ctx context.Context, field graphql.CollectedField, obj *models.RaceDashboard) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceDashboard",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revenue, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Money)
+	fc.Result = res
+	return ec.marshalNMoney2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoneyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceMatch_race(ctx context.Context, field graphql.CollectedField, obj *models.RaceMatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceMatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Race, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceMatch_rank(ctx context.Context, field graphql.CollectedField, obj *models.RaceMatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceMatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rank, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceMatch_snippet(ctx context.Context, field graphql.CollectedField, obj *models.RaceMatch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceMatch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Snippet, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.RaceNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RacePrice_currency(ctx context.Context, field graphql.CollectedField, obj *models.RacePrice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RacePrice",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Currency, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RacePrice_amount(ctx context.Context, field graphql.CollectedField, obj *models.RacePrice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RacePrice",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Amount, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RacePrice_tiers(ctx context.Context, field graphql.CollectedField, obj *models.RacePrice) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RacePrice",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tiers, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.PriceTier)
+	fc.Result = res
+	return ec.marshalNPriceTier2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceRelay_legs(ctx context.Context, field graphql.CollectedField, obj *models.RaceRelay) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceRelay",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Legs, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RelayLeg)
+	fc.Result = res
+	return ec.marshalNRelayLeg2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceRelay_allowRepeatRunners(ctx context.Context, field graphql.CollectedField, obj *models.RaceRelay) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceRelay",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowRepeatRunners, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceRelay_standings(ctx context.Context, field graphql.CollectedField, obj *models.RaceRelay) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceRelay",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Standings, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RelayStanding)
+	fc.Result = res
+	return ec.marshalNRelayStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayStandingᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceSearch_matches(ctx context.Context, field graphql.CollectedField, obj *models.RaceSearch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceSearch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matches, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RaceMatch)
+	fc.Result = res
+	return ec.marshalNRaceMatch2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceMatchᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceStaffMember_user(ctx context.Context, field graphql.CollectedField, obj *models.RaceStaffMember) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceStaffMember",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceStaffMember_role(ctx context.Context, field graphql.CollectedField, obj *models.RaceStaffMember) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceStaffMember",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Role, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.StaffRole)
+	fc.Result = res
+	return ec.marshalNStaffRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐStaffRole(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTeams_minMembers(ctx context.Context, field graphql.CollectedField, obj *models.RaceTeams) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTeams",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinMembers, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTeams_maxMembers(ctx context.Context, field graphql.CollectedField, obj *models.RaceTeams) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTeams",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxMembers, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTeams_scoring(ctx context.Context, field graphql.CollectedField, obj *models.RaceTeams) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTeams",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Scoring, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.TeamScoring)
+	fc.Result = res
+	return ec.marshalNTeamScoring2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamScoring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTeams_counting(ctx context.Context, field graphql.CollectedField, obj *models.RaceTeams) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTeams",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Counting, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTransfer_raceId(ctx context.Context, field graphql.CollectedField, obj *models.RaceTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RaceTransfer_category(ctx context.Context, field graphql.CollectedField, obj *models.RaceTransfer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RaceTransfer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Category, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Races_races(ctx context.Context, field graphql.CollectedField, obj *models.Races) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Races",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_raceId(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_competitor(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_status(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(models.RegistrationStatus)
+	fc.Result = res
+	return ec.marshalNRegistrationStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_fee(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fee, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Money)
+	fc.Result = res
+	return ec.marshalOMoney2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoney(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_expiresAt(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Registration_code(ctx context.Context, field graphql.CollectedField, obj *models.Registration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Registration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationError_message(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationOffer_raceId(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationOffer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationOffer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationOffer_from(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationOffer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationOffer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationOffer_to(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationOffer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationOffer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationOffer_offeredAt(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationOffer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationOffer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfferedAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationOfferWithdrawn_raceId(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationOfferWithdrawn) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationOfferWithdrawn",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationTransferError_message(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationTransferError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationTransferError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RegistrationTransferPolicy_deadline(ctx context.Context, field graphql.CollectedField, obj *models.RegistrationTransferPolicy) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RegistrationTransferPolicy",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deadline, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedPassage_index(ctx context.Context, field graphql.CollectedField, obj *models.RejectedPassage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RejectedPassage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Index, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RejectedPassage_message(ctx context.Context, field graphql.CollectedField, obj *models.RejectedPassage) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RejectedPassage",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLeg_position(ctx context.Context, field graphql.CollectedField, obj *models.RelayLeg) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLeg",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLeg_name(ctx context.Context, field graphql.CollectedField, obj *models.RelayLeg) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLeg",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLeg_distance(ctx context.Context, field graphql.CollectedField, obj *models.RelayLeg) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLeg",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Distance, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLeg_ranking(ctx context.Context, field graphql.CollectedField, obj *models.RelayLeg) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLeg",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Ranking, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.RelayLegResult)
+	fc.Result = res
+	return ec.marshalNRelayLegResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLegResult_position(ctx context.Context, field graphql.CollectedField, obj *models.RelayLegResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLegResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLegResult_teamId(ctx context.Context, field graphql.CollectedField, obj *models.RelayLegResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLegResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLegResult_runner(ctx context.Context, field graphql.CollectedField, obj *models.RelayLegResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLegResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Runner, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayLegResult_time(ctx context.Context, field graphql.CollectedField, obj *models.RelayLegResult) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayLegResult",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayStanding_position(ctx context.Context, field graphql.CollectedField, obj *models.RelayStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayStanding_teamId(ctx context.Context, field graphql.CollectedField, obj *models.RelayStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayStanding_time(ctx context.Context, field graphql.CollectedField, obj *models.RelayStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayStanding_legsCompleted(ctx context.Context, field graphql.CollectedField, obj *models.RelayStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LegsCompleted, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelayStanding_complete(ctx context.Context, field graphql.CollectedField, obj *models.RelayStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelayStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Complete, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ResultsImport_race(ctx context.Context, field graphql.CollectedField, obj *models.ResultsImport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ResultsImport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Race, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ResultsImport_results(ctx context.Context, field graphql.CollectedField, obj *models.ResultsImport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ResultsImport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ImportedResult)
+	fc.Result = res
+	return ec.marshalNImportedResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportedResultᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ResultsImport_skipped(ctx context.Context, field graphql.CollectedField, obj *models.ResultsImport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ResultsImport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Skipped, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ResultsImport_issues(ctx context.Context, field graphql.CollectedField, obj *models.ResultsImport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ResultsImport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Issues, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.ImportIssue)
+	fc.Result = res
+	return ec.marshalNImportIssue2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ResultsImport_committed(ctx context.Context, field graphql.CollectedField, obj *models.ResultsImport) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ResultsImport",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Committed, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_id(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_name(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_description(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_venue(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Venue, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*models.Venue)
+	fc.Result = res
+	return ec.marshalOVenue2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenue(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_start(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Start, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_rule(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rule, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_points(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Points, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]int)
+	fc.Result = res
+	return ec.marshalNInt2ᚕintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_bestOf(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BestOf, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_races(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.SeriesRace)
+	fc.Result = res
+	return ec.marshalNSeriesRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesRaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Series_standings(ctx context.Context, field graphql.CollectedField, obj *models.Series) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Series",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Series().Standings(rctx, obj)
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.SeriesStanding)
+	fc.Result = res
+	return ec.marshalNSeriesStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesStandingᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesAlreadyExists_message(ctx context.Context, field graphql.CollectedField, obj *models.SeriesAlreadyExists) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesAlreadyExists",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.SeriesNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesRace_raceId(ctx context.Context, field graphql.CollectedField, obj *models.SeriesRace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesRace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RaceID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesRace_occurrence(ctx context.Context, field graphql.CollectedField, obj *models.SeriesRace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesRace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Occurrence, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesRaces_races(ctx context.Context, field graphql.CollectedField, obj *models.SeriesRaces) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesRaces",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesStanding_position(ctx context.Context, field graphql.CollectedField, obj *models.SeriesStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesStanding_competitor(ctx context.Context, field graphql.CollectedField, obj *models.SeriesStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Competitor, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesStanding_points(ctx context.Context, field graphql.CollectedField, obj *models.SeriesStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Points, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SeriesStanding_races(ctx context.Context, field graphql.CollectedField, obj *models.SeriesStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SeriesStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Split_checkpoint(ctx context.Context, field graphql.CollectedField, obj *models.Split) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Split",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Checkpoint, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Split_at(ctx context.Context, field graphql.CollectedField, obj *models.Split) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Split",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.At, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Split_elapsed(ctx context.Context, field graphql.CollectedField, obj *models.Split) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Split",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Elapsed, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Split_pace(ctx context.Context, field graphql.CollectedField, obj *models.Split) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Split",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pace, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Split_cutoffMissed(ctx context.Context, field graphql.CollectedField, obj *models.Split) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Split",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CutoffMissed, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _StaffError_message(ctx context.Context, field graphql.CollectedField, obj *models.StaffError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "StaffError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_id(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_name(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_admin(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Admin, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_members(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Members, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_invitations(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Invitations, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.TeamInvitation)
+	fc.Result = res
+	return ec.marshalNTeamInvitation2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamInvitationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Team_joinRequests(ctx context.Context, field graphql.CollectedField, obj *models.Team) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.JoinRequests, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamAlreadyEntered_message(ctx context.Context, field graphql.CollectedField, obj *models.TeamAlreadyEntered) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamAlreadyEntered",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamInvitation_user(ctx context.Context, field graphql.CollectedField, obj *models.TeamInvitation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamInvitation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.User, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*models.User)
+	fc.Result = res
+	return ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamInvitation_expiresAt(ctx context.Context, field graphql.CollectedField, obj *models.TeamInvitation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamInvitation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExpiresAt, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNDateTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamMembershipError_message(ctx context.Context, field graphql.CollectedField, obj *models.TeamMembershipError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamMembershipError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.TeamNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_position(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Position, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_teamId(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TeamID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_time(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_points(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Points, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_finishers(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Finishers, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TeamStanding_complete(ctx context.Context, field graphql.CollectedField, obj *models.TeamStanding) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TeamStanding",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Complete, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UnsupportedLocaleError_message(ctx context.Context, field graphql.CollectedField, obj *models.UnsupportedLocaleError) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UnsupportedLocaleError",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_id(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_name(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _User_races(ctx context.Context, field graphql.CollectedField, obj *models.User) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "User",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Races, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*models.Race)
+	fc.Result = res
+	return ec.marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _UserNotFound_message(ctx context.Context, field graphql.CollectedField, obj *models.UserNotFound) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "UserNotFound",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Venue_name(ctx context.Context, field graphql.CollectedField, obj *models.Venue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Venue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Venue_address(ctx context.Context, field graphql.CollectedField, obj *models.Venue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Venue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Venue_lat(ctx context.Context, field graphql.CollectedField, obj *models.Venue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Venue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lat, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Venue_lon(ctx context.Context, field graphql.CollectedField, obj *models.Venue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Venue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lon, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Venue_timeZone(ctx context.Context, field graphql.CollectedField, obj *models.Venue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Venue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeZone, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp := ec._fieldMiddleware(ctx, obj, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAcceptRegistrationInput(ctx context.Context, obj interface{}) (models.AcceptRegistrationInput, error) {
+	var it models.AcceptRegistrationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAuditLogFilter(ctx context.Context, obj interface{}) (models.AuditLogFilter, error) {
+	var it models.AuditLogFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "organizationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationId"))
+			it.OrganizationID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "type":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			it.Type, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputBibInput(ctx context.Context, obj interface{}) (models.BibInput, error) {
+	var it models.BibInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bib":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+			it.Bib, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputBibRangeInput(ctx context.Context, obj interface{}) (models.BibRangeInput, error) {
+	var it models.BibRangeInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCancelRaceInput(ctx context.Context, obj interface{}) (models.CancelRaceInput, error) {
+	var it models.CancelRaceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "reason":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			it.Reason, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "transferTo":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("transferTo"))
+			it.TransferTo, err = ec.unmarshalORaceTransferInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTransferInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCheckInInput(ctx context.Context, obj interface{}) (models.CheckInInput, error) {
+	var it models.CheckInInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "qr":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("qr"))
+			it.Qr, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bib":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+			it.Bib, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCheckpointInput(ctx context.Context, obj interface{}) (models.CheckpointInput, error) {
+	var it models.CheckpointInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "distance":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distance"))
+			it.Distance, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "cutoff":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cutoff"))
+			it.Cutoff, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCourseUploadInput(ctx context.Context, obj interface{}) (models.CourseUploadInput, error) {
+	var it models.CourseUploadInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "category":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			it.Category, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "file":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file"))
+			it.File, err = ec.unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDiscountCodeInput(ctx context.Context, obj interface{}) (models.DiscountCodeInput, error) {
+	var it models.DiscountCodeInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "code":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			it.Code, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "percent":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("percent"))
+			it.Percent, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			it.Amount, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxRedemptions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxRedemptions"))
+			it.MaxRedemptions, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "validFrom":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validFrom"))
+			it.ValidFrom, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "validUntil":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("validUntil"))
+			it.ValidUntil, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "category":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			it.Category, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputJoinRaceInput(ctx context.Context, obj interface{}) (models.JoinRaceInput, error) {
+	var it models.JoinRaceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "category":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			it.Category, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "code":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			it.Code, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputLegSplitInput(ctx context.Context, obj interface{}) (models.LegSplitInput, error) {
+	var it models.LegSplitInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "teamId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+			it.TeamID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "leg":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("leg"))
+			it.Leg, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "time":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("time"))
+			it.Time, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNearInput(ctx context.Context, obj interface{}) (models.NearInput, error) {
+	var it models.NearInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "lat":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lat"))
+			it.Lat, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lon":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lon"))
+			it.Lon, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "radiusKm":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("radiusKm"))
+			it.RadiusKm, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputNotificationPreferencesInput(ctx context.Context, obj interface{}) (models.NotificationPreferencesInput, error) {
+	var it models.NotificationPreferencesInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "locale":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("locale"))
+			it.Locale, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "disabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("disabled"))
+			it.Disabled, err = ec.unmarshalNNotificationKind2ᚕgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKindᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOfferRegistrationInput(ctx context.Context, obj interface{}) (models.OfferRegistrationInput, error) {
+	var it models.OfferRegistrationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrganizationInput(ctx context.Context, obj interface{}) (models.OrganizationInput, error) {
+	var it models.OrganizationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrganizationMemberInput(ctx context.Context, obj interface{}) (models.OrganizationMemberInput, error) {
+	var it models.OrganizationMemberInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "organizationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationId"))
+			it.OrganizationID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "role":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("role"))
+			it.Role, err = ec.unmarshalNOrganizationRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationRole(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPassageInput(ctx context.Context, obj interface{}) (models.PassageInput, error) {
+	var it models.PassageInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bib":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+			it.Bib, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "checkpoint":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("checkpoint"))
+			it.Checkpoint, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "at":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("at"))
+			it.At, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPassagesInput(ctx context.Context, obj interface{}) (models.PassagesInput, error) {
+	var it models.PassagesInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "passages":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("passages"))
+			it.Passages, err = ec.unmarshalNPassageInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassageInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPriceTierInput(ctx context.Context, obj interface{}) (models.PriceTierInput, error) {
+	var it models.PriceTierInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "until":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			it.Until, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			it.Amount, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceBibsInput(ctx context.Context, obj interface{}) (models.RaceBibsInput, error) {
+	var it models.RaceBibsInput
+	var asMap = obj.(map[string]interface{})
+
+	if _, present := asMap["first"]; !present {
+		asMap["first"] = 1
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "strategy":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("strategy"))
+			it.Strategy, err = ec.unmarshalNBibStrategy2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibStrategy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "first":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+			it.First, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceCategoryInput(ctx context.Context, obj interface{}) (models.RaceCategoryInput, error) {
+	var it models.RaceCategoryInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "distance":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distance"))
+			it.Distance, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "startTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startTime"))
+			it.StartTime, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "capacity":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("capacity"))
+			it.Capacity, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minAge":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minAge"))
+			it.MinAge, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gender":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gender"))
+			it.Gender, err = ec.unmarshalOGender2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGender(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bibRange":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bibRange"))
+			it.BibRange, err = ec.unmarshalOBibRangeInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibRangeInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "price":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("price"))
+			it.Price, err = ec.unmarshalORacePriceInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePriceInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceInput(ctx context.Context, obj interface{}) (models.RaceInput, error) {
+	var it models.RaceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "date":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
+			it.Date, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "teams":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teams"))
+			it.Teams, err = ec.unmarshalORaceTeamsInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTeamsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "relay":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("relay"))
+			it.Relay, err = ec.unmarshalORaceRelayInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceRelayInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "categories":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categories"))
+			it.Categories, err = ec.unmarshalORaceCategoryInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "checkpoints":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("checkpoints"))
+			it.Checkpoints, err = ec.unmarshalOCheckpointInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bibs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bibs"))
+			it.Bibs, err = ec.unmarshalORaceBibsInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceBibsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "venue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("venue"))
+			it.Venue, err = ec.unmarshalOVenueInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenueInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "price":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("price"))
+			it.Price, err = ec.unmarshalORacePriceInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePriceInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "registrationDeadline":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("registrationDeadline"))
+			it.RegistrationDeadline, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceOrganizationInput(ctx context.Context, obj interface{}) (models.RaceOrganizationInput, error) {
+	var it models.RaceOrganizationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "organizationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationId"))
+			it.OrganizationID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceOwnerInput(ctx context.Context, obj interface{}) (models.RaceOwnerInput, error) {
+	var it models.RaceOwnerInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRacePriceInput(ctx context.Context, obj interface{}) (models.RacePriceInput, error) {
+	var it models.RacePriceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "currency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("currency"))
+			it.Currency, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "amount":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			it.Amount, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tiers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tiers"))
+			it.Tiers, err = ec.unmarshalOPriceTierInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceRelayInput(ctx context.Context, obj interface{}) (models.RaceRelayInput, error) {
+	var it models.RaceRelayInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "legs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("legs"))
+			it.Legs, err = ec.unmarshalNRelayLegInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allowRepeatRunners":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allowRepeatRunners"))
+			it.AllowRepeatRunners, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceResultInput(ctx context.Context, obj interface{}) (models.RaceResultInput, error) {
+	var it models.RaceResultInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bib":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+			it.Bib, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "time":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("time"))
+			it.Time, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceSearchFilter(ctx context.Context, obj interface{}) (models.RaceSearchFilter, error) {
+	var it models.RaceSearchFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "near":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("near"))
+			it.Near, err = ec.unmarshalONearInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNearInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceStaffInput(ctx context.Context, obj interface{}) (models.RaceStaffInput, error) {
+	var it models.RaceStaffInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "role":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("role"))
+			it.Role, err = ec.unmarshalNStaffRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐStaffRole(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceTeamsInput(ctx context.Context, obj interface{}) (models.RaceTeamsInput, error) {
+	var it models.RaceTeamsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "minMembers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minMembers"))
+			it.MinMembers, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxMembers":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxMembers"))
+			it.MaxMembers, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "scoring":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scoring"))
+			it.Scoring, err = ec.unmarshalNTeamScoring2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamScoring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "counting":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("counting"))
+			it.Counting, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRaceTransferInput(ctx context.Context, obj interface{}) (models.RaceTransferInput, error) {
+	var it models.RaceTransferInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "category":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+			it.Category, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRefundRegistrationInput(ctx context.Context, obj interface{}) (models.RefundRegistrationInput, error) {
+	var it models.RefundRegistrationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRegistrationTransfersInput(ctx context.Context, obj interface{}) (models.RegistrationTransfersInput, error) {
+	var it models.RegistrationTransfersInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allowed":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allowed"))
+			it.Allowed, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "deadline":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deadline"))
+			it.Deadline, err = ec.unmarshalODateTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRelayLegInput(ctx context.Context, obj interface{}) (models.RelayLegInput, error) {
+	var it models.RelayLegInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "distance":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("distance"))
+			it.Distance, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRelayLineUpInput(ctx context.Context, obj interface{}) (models.RelayLineUpInput, error) {
+	var it models.RelayLineUpInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "teamId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+			it.TeamID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "runners":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("runners"))
+			it.Runners, err = ec.unmarshalNID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRemoveOrganizationMemberInput(ctx context.Context, obj interface{}) (models.RemoveOrganizationMemberInput, error) {
+	var it models.RemoveOrganizationMemberInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "organizationId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("organizationId"))
+			it.OrganizationID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRemoveRaceStaffInput(ctx context.Context, obj interface{}) (models.RemoveRaceStaffInput, error) {
+	var it models.RemoveRaceStaffInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRescheduleRaceInput(ctx context.Context, obj interface{}) (models.RescheduleRaceInput, error) {
+	var it models.RescheduleRaceInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "date":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("date"))
+			it.Date, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputResultsColumnMappingInput(ctx context.Context, obj interface{}) (models.ResultsColumnMappingInput, error) {
+	var it models.ResultsColumnMappingInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "bib":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bib"))
+			it.Bib, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "chipTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chipTime"))
+			it.ChipTime, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gunTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gunTime"))
+			it.GunTime, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "status":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			it.Status, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputResultsImportInput(ctx context.Context, obj interface{}) (models.ResultsImportInput, error) {
+	var it models.ResultsImportInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "format":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+			it.Format, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mapping":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mapping"))
+			it.Mapping, err = ec.unmarshalOResultsColumnMappingInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐResultsColumnMappingInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "file":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("file"))
+			it.File, err = ec.unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "dryRun":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+			it.DryRun, err = ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSeriesInput(ctx context.Context, obj interface{}) (models.SeriesInput, error) {
+	var it models.SeriesInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "description":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("description"))
+			it.Description, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "venue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("venue"))
+			it.Venue, err = ec.unmarshalOVenueInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenueInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "start":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("start"))
+			it.Start, err = ec.unmarshalNDateTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "rule":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rule"))
+			it.Rule, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "points":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("points"))
+			it.Points, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bestOf":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bestOf"))
+			it.BestOf, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTeamEntryInput(ctx context.Context, obj interface{}) (models.TeamEntryInput, error) {
+	var it models.TeamEntryInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "raceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("raceId"))
+			it.RaceID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "teamId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+			it.TeamID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "members":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("members"))
+			it.Members, err = ec.unmarshalNID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTeamUserInput(ctx context.Context, obj interface{}) (models.TeamUserInput, error) {
+	var it models.TeamUserInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "teamId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teamId"))
+			it.TeamID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "userId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			it.UserID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputVenueInput(ctx context.Context, obj interface{}) (models.VenueInput, error) {
+	var it models.VenueInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "address":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			it.Address, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lat":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lat"))
+			it.Lat, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lon":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lon"))
+			it.Lon, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "timeZone":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timeZone"))
+			it.TimeZone, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _AcceptRegistrationResult(ctx context.Context, sel ast.SelectionSet, obj models.AcceptRegistrationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Registration:
+		return ec._Registration(ctx, sel, &obj)
+	case *models.Registration:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Registration(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.RegistrationTransferError:
+		return ec._RegistrationTransferError(ctx, sel, &obj)
+	case *models.RegistrationTransferError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationTransferError(ctx, sel, obj)
+	case models.RegistrationError:
+		return ec._RegistrationError(ctx, sel, &obj)
+	case *models.RegistrationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _AssignBibResult(ctx context.Context, sel ast.SelectionSet, obj models.AssignBibResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.CompetitorNotInRaceError:
+		return ec._CompetitorNotInRaceError(ctx, sel, &obj)
+	case *models.CompetitorNotInRaceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CompetitorNotInRaceError(ctx, sel, obj)
+	case models.InvalidBibError:
+		return ec._InvalidBibError(ctx, sel, &obj)
+	case *models.InvalidBibError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidBibError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _AssignRaceToOrganizationResult(ctx context.Context, sel ast.SelectionSet, obj models.AssignRaceToOrganizationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.OrganizationNotFound:
+		return ec._OrganizationNotFound(ctx, sel, &obj)
+	case *models.OrganizationNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationNotFound(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _AuditLogResult(ctx context.Context, sel ast.SelectionSet, obj models.AuditLogResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.AuditLog:
+		return ec._AuditLog(ctx, sel, &obj)
+	case *models.AuditLog:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AuditLog(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.InvalidCursorError:
+		return ec._InvalidCursorError(ctx, sel, &obj)
+	case *models.InvalidCursorError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCursorError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CancelRaceResult(ctx context.Context, sel ast.SelectionSet, obj models.CancelRaceResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.CancellationError:
+		return ec._CancellationError(ctx, sel, &obj)
+	case *models.CancellationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CancellationError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CheckInResult(ctx context.Context, sel ast.SelectionSet, obj models.CheckInResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.CompetitorCheckIn:
+		return ec._CompetitorCheckIn(ctx, sel, &obj)
+	case *models.CompetitorCheckIn:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CompetitorCheckIn(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.CompetitorNotInRaceError:
+		return ec._CompetitorNotInRaceError(ctx, sel, &obj)
+	case *models.CompetitorNotInRaceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CompetitorNotInRaceError(ctx, sel, obj)
+	case models.InvalidBibError:
+		return ec._InvalidBibError(ctx, sel, &obj)
+	case *models.InvalidBibError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidBibError(ctx, sel, obj)
+	case models.CheckInError:
+		return ec._CheckInError(ctx, sel, &obj)
+	case *models.CheckInError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CheckInError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CheckoutResult(ctx context.Context, sel ast.SelectionSet, obj models.CheckoutResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Checkout:
+		return ec._Checkout(ctx, sel, &obj)
+	case *models.Checkout:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Checkout(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.RegistrationError:
+		return ec._RegistrationError(ctx, sel, &obj)
+	case *models.RegistrationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateCalendarTokenResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateCalendarTokenResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.CalendarToken:
+		return ec._CalendarToken(ctx, sel, &obj)
+	case *models.CalendarToken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CalendarToken(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateDiscountCodeResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateDiscountCodeResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.DiscountCode:
+		return ec._DiscountCode(ctx, sel, &obj)
+	case *models.DiscountCode:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCode(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidDiscountCodeError:
+		return ec._InvalidDiscountCodeError(ctx, sel, &obj)
+	case *models.InvalidDiscountCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidDiscountCodeError(ctx, sel, obj)
+	case models.DiscountCodeAlreadyExists:
+		return ec._DiscountCodeAlreadyExists(ctx, sel, &obj)
+	case *models.DiscountCodeAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeAlreadyExists(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateOrganizationResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateOrganizationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Organization:
+		return ec._Organization(ctx, sel, &obj)
+	case *models.Organization:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Organization(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.InvalidOrganizationNameError:
+		return ec._InvalidOrganizationNameError(ctx, sel, &obj)
+	case *models.InvalidOrganizationNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidOrganizationNameError(ctx, sel, obj)
+	case models.OrganizationAlreadyExists:
+		return ec._OrganizationAlreadyExists(ctx, sel, &obj)
+	case *models.OrganizationAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationAlreadyExists(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateRaceResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateRaceResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.InvalidRaceNameError:
+		return ec._InvalidRaceNameError(ctx, sel, &obj)
+	case *models.InvalidRaceNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceNameError(ctx, sel, obj)
+	case models.InvalidRaceDateError:
+		return ec._InvalidRaceDateError(ctx, sel, &obj)
+	case *models.InvalidRaceDateError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceDateError(ctx, sel, obj)
+	case models.InvalidRaceTeamsError:
+		return ec._InvalidRaceTeamsError(ctx, sel, &obj)
+	case *models.InvalidRaceTeamsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceTeamsError(ctx, sel, obj)
+	case models.InvalidRaceRelayError:
+		return ec._InvalidRaceRelayError(ctx, sel, &obj)
+	case *models.InvalidRaceRelayError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceRelayError(ctx, sel, obj)
+	case models.InvalidRaceCategoryError:
+		return ec._InvalidRaceCategoryError(ctx, sel, &obj)
+	case *models.InvalidRaceCategoryError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceCategoryError(ctx, sel, obj)
+	case models.InvalidRaceCheckpointsError:
+		return ec._InvalidRaceCheckpointsError(ctx, sel, &obj)
+	case *models.InvalidRaceCheckpointsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceCheckpointsError(ctx, sel, obj)
+	case models.InvalidRaceBibsError:
+		return ec._InvalidRaceBibsError(ctx, sel, &obj)
+	case *models.InvalidRaceBibsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceBibsError(ctx, sel, obj)
+	case models.InvalidVenueError:
+		return ec._InvalidVenueError(ctx, sel, &obj)
+	case *models.InvalidVenueError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidVenueError(ctx, sel, obj)
+	case models.InvalidRacePriceError:
+		return ec._InvalidRacePriceError(ctx, sel, &obj)
+	case *models.InvalidRacePriceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRacePriceError(ctx, sel, obj)
+	case models.RaceAlreadyExists:
+		return ec._RaceAlreadyExists(ctx, sel, &obj)
+	case *models.RaceAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceAlreadyExists(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _CreateSeriesResult(ctx context.Context, sel ast.SelectionSet, obj models.CreateSeriesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Series:
+		return ec._Series(ctx, sel, &obj)
+	case *models.Series:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Series(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.InvalidRaceNameError:
+		return ec._InvalidRaceNameError(ctx, sel, &obj)
+	case *models.InvalidRaceNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceNameError(ctx, sel, obj)
+	case models.InvalidRaceDateError:
+		return ec._InvalidRaceDateError(ctx, sel, &obj)
+	case *models.InvalidRaceDateError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceDateError(ctx, sel, obj)
+	case models.InvalidVenueError:
+		return ec._InvalidVenueError(ctx, sel, &obj)
+	case *models.InvalidVenueError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidVenueError(ctx, sel, obj)
+	case models.InvalidSeriesError:
+		return ec._InvalidSeriesError(ctx, sel, &obj)
+	case *models.InvalidSeriesError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSeriesError(ctx, sel, obj)
+	case models.SeriesAlreadyExists:
+		return ec._SeriesAlreadyExists(ctx, sel, &obj)
+	case *models.SeriesAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesAlreadyExists(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _DiscountCodesResult(ctx context.Context, sel ast.SelectionSet, obj models.DiscountCodesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.DiscountCodes:
+		return ec._DiscountCodes(ctx, sel, &obj)
+	case *models.DiscountCodes:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodes(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _EnterTeamResult(ctx context.Context, sel ast.SelectionSet, obj models.EnterTeamResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.TeamNotFound:
+		return ec._TeamNotFound(ctx, sel, &obj)
+	case *models.TeamNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.TeamAlreadyEntered:
+		return ec._TeamAlreadyEntered(ctx, sel, &obj)
+	case *models.TeamAlreadyEntered:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamAlreadyEntered(ctx, sel, obj)
+	case models.InvalidTeamEntryError:
+		return ec._InvalidTeamEntryError(ctx, sel, &obj)
+	case *models.InvalidTeamEntryError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidTeamEntryError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _EraseMyDataResult(ctx context.Context, sel ast.SelectionSet, obj models.EraseMyDataResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Erasure:
+		return ec._Erasure(ctx, sel, &obj)
+	case *models.Erasure:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Erasure(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _ErasureResult(ctx context.Context, sel ast.SelectionSet, obj models.ErasureResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Erasure:
+		return ec._Erasure(ctx, sel, &obj)
+	case *models.Erasure:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Erasure(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.ErasureNotFound:
+		return ec._ErasureNotFound(ctx, sel, &obj)
+	case *models.ErasureNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ErasureNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _Error(ctx context.Context, sel ast.SelectionSet, obj models.Error) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.InvalidCursorError:
+		return ec._InvalidCursorError(ctx, sel, &obj)
+	case *models.InvalidCursorError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCursorError(ctx, sel, obj)
+	case models.InvalidRaceBibsError:
+		return ec._InvalidRaceBibsError(ctx, sel, &obj)
+	case *models.InvalidRaceBibsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceBibsError(ctx, sel, obj)
+	case models.InvalidBibError:
+		return ec._InvalidBibError(ctx, sel, &obj)
+	case *models.InvalidBibError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidBibError(ctx, sel, obj)
+	case models.CancellationError:
+		return ec._CancellationError(ctx, sel, &obj)
+	case *models.CancellationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CancellationError(ctx, sel, obj)
+	case models.CheckInError:
+		return ec._CheckInError(ctx, sel, &obj)
+	case *models.CheckInError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CheckInError(ctx, sel, obj)
+	case models.InvalidRaceCheckpointsError:
+		return ec._InvalidRaceCheckpointsError(ctx, sel, &obj)
+	case *models.InvalidRaceCheckpointsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceCheckpointsError(ctx, sel, obj)
+	case models.InvalidCourseError:
+		return ec._InvalidCourseError(ctx, sel, &obj)
+	case *models.InvalidCourseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCourseError(ctx, sel, obj)
+	case models.DiscountCodeNotFound:
+		return ec._DiscountCodeNotFound(ctx, sel, &obj)
+	case *models.DiscountCodeNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeNotFound(ctx, sel, obj)
+	case models.DiscountCodeAlreadyExists:
+		return ec._DiscountCodeAlreadyExists(ctx, sel, &obj)
+	case *models.DiscountCodeAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeAlreadyExists(ctx, sel, obj)
+	case models.InvalidDiscountCodeError:
+		return ec._InvalidDiscountCodeError(ctx, sel, &obj)
+	case *models.InvalidDiscountCodeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidDiscountCodeError(ctx, sel, obj)
+	case models.DiscountCodeNotApplicableError:
+		return ec._DiscountCodeNotApplicableError(ctx, sel, &obj)
+	case *models.DiscountCodeNotApplicableError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeNotApplicableError(ctx, sel, obj)
+	case models.UnsupportedLocaleError:
+		return ec._UnsupportedLocaleError(ctx, sel, &obj)
+	case *models.UnsupportedLocaleError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UnsupportedLocaleError(ctx, sel, obj)
+	case models.OrganizationNotFound:
+		return ec._OrganizationNotFound(ctx, sel, &obj)
+	case *models.OrganizationNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationNotFound(ctx, sel, obj)
+	case models.OrganizationAlreadyExists:
+		return ec._OrganizationAlreadyExists(ctx, sel, &obj)
+	case *models.OrganizationAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationAlreadyExists(ctx, sel, obj)
+	case models.InvalidOrganizationNameError:
+		return ec._InvalidOrganizationNameError(ctx, sel, &obj)
+	case *models.InvalidOrganizationNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidOrganizationNameError(ctx, sel, obj)
+	case models.OrganizationMemberError:
+		return ec._OrganizationMemberError(ctx, sel, &obj)
+	case *models.OrganizationMemberError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationMemberError(ctx, sel, obj)
+	case models.ErasureNotFound:
+		return ec._ErasureNotFound(ctx, sel, &obj)
+	case *models.ErasureNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ErasureNotFound(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.InvalidRaceNameError:
+		return ec._InvalidRaceNameError(ctx, sel, &obj)
+	case *models.InvalidRaceNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceNameError(ctx, sel, obj)
+	case models.InvalidRaceDateError:
+		return ec._InvalidRaceDateError(ctx, sel, &obj)
+	case *models.InvalidRaceDateError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceDateError(ctx, sel, obj)
+	case models.InvalidRaceTeamsError:
+		return ec._InvalidRaceTeamsError(ctx, sel, &obj)
+	case *models.InvalidRaceTeamsError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceTeamsError(ctx, sel, obj)
+	case models.InvalidRaceCategoryError:
+		return ec._InvalidRaceCategoryError(ctx, sel, &obj)
+	case *models.InvalidRaceCategoryError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceCategoryError(ctx, sel, obj)
+	case models.RaceAlreadyExists:
+		return ec._RaceAlreadyExists(ctx, sel, &obj)
+	case *models.RaceAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceAlreadyExists(ctx, sel, obj)
+	case models.InvalidRaceTimeError:
+		return ec._InvalidRaceTimeError(ctx, sel, &obj)
+	case *models.InvalidRaceTimeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceTimeError(ctx, sel, obj)
+	case models.CompetitorNotInRaceError:
+		return ec._CompetitorNotInRaceError(ctx, sel, &obj)
+	case *models.CompetitorNotInRaceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CompetitorNotInRaceError(ctx, sel, obj)
+	case models.InvalidVenueError:
+		return ec._InvalidVenueError(ctx, sel, &obj)
+	case *models.InvalidVenueError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidVenueError(ctx, sel, obj)
+	case models.InvalidRacesNearError:
+		return ec._InvalidRacesNearError(ctx, sel, &obj)
+	case *models.InvalidRacesNearError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRacesNearError(ctx, sel, obj)
+	case models.InvalidRacePriceError:
+		return ec._InvalidRacePriceError(ctx, sel, &obj)
+	case *models.InvalidRacePriceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRacePriceError(ctx, sel, obj)
+	case models.RegistrationError:
+		return ec._RegistrationError(ctx, sel, &obj)
+	case *models.RegistrationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationError(ctx, sel, obj)
+	case models.InvalidTransferDeadlineError:
+		return ec._InvalidTransferDeadlineError(ctx, sel, &obj)
+	case *models.InvalidTransferDeadlineError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidTransferDeadlineError(ctx, sel, obj)
+	case models.RegistrationTransferError:
+		return ec._RegistrationTransferError(ctx, sel, &obj)
+	case *models.RegistrationTransferError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationTransferError(ctx, sel, obj)
+	case models.InvalidRaceRelayError:
+		return ec._InvalidRaceRelayError(ctx, sel, &obj)
+	case *models.InvalidRaceRelayError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceRelayError(ctx, sel, obj)
+	case models.InvalidRelayLineUpError:
+		return ec._InvalidRelayLineUpError(ctx, sel, &obj)
+	case *models.InvalidRelayLineUpError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRelayLineUpError(ctx, sel, obj)
+	case models.InvalidLegSplitError:
+		return ec._InvalidLegSplitError(ctx, sel, &obj)
+	case *models.InvalidLegSplitError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidLegSplitError(ctx, sel, obj)
+	case models.InvalidResultsFileError:
+		return ec._InvalidResultsFileError(ctx, sel, &obj)
+	case *models.InvalidResultsFileError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidResultsFileError(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidRaceSearchError:
+		return ec._InvalidRaceSearchError(ctx, sel, &obj)
+	case *models.InvalidRaceSearchError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceSearchError(ctx, sel, obj)
+	case models.SeriesNotFound:
+		return ec._SeriesNotFound(ctx, sel, &obj)
+	case *models.SeriesNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesNotFound(ctx, sel, obj)
+	case models.SeriesAlreadyExists:
+		return ec._SeriesAlreadyExists(ctx, sel, &obj)
+	case *models.SeriesAlreadyExists:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesAlreadyExists(ctx, sel, obj)
+	case models.InvalidSeriesError:
+		return ec._InvalidSeriesError(ctx, sel, &obj)
+	case *models.InvalidSeriesError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSeriesError(ctx, sel, obj)
+	case models.StaffError:
+		return ec._StaffError(ctx, sel, &obj)
+	case *models.StaffError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._StaffError(ctx, sel, obj)
+	case models.TeamMembershipError:
+		return ec._TeamMembershipError(ctx, sel, &obj)
+	case *models.TeamMembershipError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamMembershipError(ctx, sel, obj)
+	case models.TeamNotFound:
+		return ec._TeamNotFound(ctx, sel, &obj)
+	case *models.TeamNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamNotFound(ctx, sel, obj)
+	case models.TeamAlreadyEntered:
+		return ec._TeamAlreadyEntered(ctx, sel, &obj)
+	case *models.TeamAlreadyEntered:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamAlreadyEntered(ctx, sel, obj)
+	case models.InvalidTeamEntryError:
+		return ec._InvalidTeamEntryError(ctx, sel, &obj)
+	case *models.InvalidTeamEntryError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidTeamEntryError(ctx, sel, obj)
+	case models.UserNotFound:
+		return ec._UserNotFound(ctx, sel, &obj)
+	case *models.UserNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UserNotFound(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _ExportMyDataResult(ctx context.Context, sel ast.SelectionSet, obj models.ExportMyDataResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.DataExport:
+		return ec._DataExport(ctx, sel, &obj)
+	case *models.DataExport:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DataExport(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _GenerateSeriesRacesResult(ctx context.Context, sel ast.SelectionSet, obj models.GenerateSeriesRacesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.SeriesRaces:
+		return ec._SeriesRaces(ctx, sel, &obj)
+	case *models.SeriesRaces:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesRaces(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.SeriesNotFound:
+		return ec._SeriesNotFound(ctx, sel, &obj)
+	case *models.SeriesNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _ImportResultsResult(ctx context.Context, sel ast.SelectionSet, obj models.ImportResultsResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.ResultsImport:
+		return ec._ResultsImport(ctx, sel, &obj)
+	case *models.ResultsImport:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ResultsImport(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidResultsFileError:
+		return ec._InvalidResultsFileError(ctx, sel, &obj)
+	case *models.InvalidResultsFileError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidResultsFileError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _JoinRaceResult(ctx context.Context, sel ast.SelectionSet, obj models.JoinRaceResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Registration:
+		return ec._Registration(ctx, sel, &obj)
+	case *models.Registration:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Registration(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.RegistrationError:
+		return ec._RegistrationError(ctx, sel, &obj)
+	case *models.RegistrationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationError(ctx, sel, obj)
+	case models.DiscountCodeNotFound:
+		return ec._DiscountCodeNotFound(ctx, sel, &obj)
+	case *models.DiscountCodeNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeNotFound(ctx, sel, obj)
+	case models.DiscountCodeNotApplicableError:
+		return ec._DiscountCodeNotApplicableError(ctx, sel, &obj)
+	case *models.DiscountCodeNotApplicableError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscountCodeNotApplicableError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _NotificationPreferencesResult(ctx context.Context, sel ast.SelectionSet, obj models.NotificationPreferencesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.NotificationPreferences:
+		return ec._NotificationPreferences(ctx, sel, &obj)
+	case *models.NotificationPreferences:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._NotificationPreferences(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _OfferRegistrationResult(ctx context.Context, sel ast.SelectionSet, obj models.OfferRegistrationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.RegistrationOffer:
+		return ec._RegistrationOffer(ctx, sel, &obj)
+	case *models.RegistrationOffer:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationOffer(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.UserNotFound:
+		return ec._UserNotFound(ctx, sel, &obj)
+	case *models.UserNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UserNotFound(ctx, sel, obj)
+	case models.RegistrationTransferError:
+		return ec._RegistrationTransferError(ctx, sel, &obj)
+	case *models.RegistrationTransferError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationTransferError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _OrganizationMemberResult(ctx context.Context, sel ast.SelectionSet, obj models.OrganizationMemberResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Organization:
+		return ec._Organization(ctx, sel, &obj)
+	case *models.Organization:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Organization(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.OrganizationNotFound:
+		return ec._OrganizationNotFound(ctx, sel, &obj)
+	case *models.OrganizationNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationNotFound(ctx, sel, obj)
+	case models.UserNotFound:
+		return ec._UserNotFound(ctx, sel, &obj)
+	case *models.UserNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UserNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.OrganizationMemberError:
+		return ec._OrganizationMemberError(ctx, sel, &obj)
+	case *models.OrganizationMemberError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationMemberError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _OrganizationResult(ctx context.Context, sel ast.SelectionSet, obj models.OrganizationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Organization:
+		return ec._Organization(ctx, sel, &obj)
+	case *models.Organization:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Organization(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.OrganizationNotFound:
+		return ec._OrganizationNotFound(ctx, sel, &obj)
+	case *models.OrganizationNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._OrganizationNotFound(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RaceResult(ctx context.Context, sel ast.SelectionSet, obj models.RaceResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RaceStaffResult(ctx context.Context, sel ast.SelectionSet, obj models.RaceStaffResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.UserNotFound:
+		return ec._UserNotFound(ctx, sel, &obj)
+	case *models.UserNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UserNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.StaffError:
+		return ec._StaffError(ctx, sel, &obj)
+	case *models.StaffError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._StaffError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RacesNearResult(ctx context.Context, sel ast.SelectionSet, obj models.RacesNearResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Races:
+		return ec._Races(ctx, sel, &obj)
+	case *models.Races:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Races(ctx, sel, obj)
+	case models.InvalidRacesNearError:
+		return ec._InvalidRacesNearError(ctx, sel, &obj)
+	case *models.InvalidRacesNearError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRacesNearError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RecordLegSplitResult(ctx context.Context, sel ast.SelectionSet, obj models.RecordLegSplitResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidRaceTimeError:
+		return ec._InvalidRaceTimeError(ctx, sel, &obj)
+	case *models.InvalidRaceTimeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceTimeError(ctx, sel, obj)
+	case models.InvalidLegSplitError:
+		return ec._InvalidLegSplitError(ctx, sel, &obj)
+	case *models.InvalidLegSplitError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidLegSplitError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RecordPassagesResult(ctx context.Context, sel ast.SelectionSet, obj models.RecordPassagesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.PassagesRecorded:
+		return ec._PassagesRecorded(ctx, sel, &obj)
+	case *models.PassagesRecorded:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PassagesRecorded(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RecordResultResult(ctx context.Context, sel ast.SelectionSet, obj models.RecordResultResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidRaceTimeError:
+		return ec._InvalidRaceTimeError(ctx, sel, &obj)
+	case *models.InvalidRaceTimeError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceTimeError(ctx, sel, obj)
+	case models.CompetitorNotInRaceError:
+		return ec._CompetitorNotInRaceError(ctx, sel, &obj)
+	case *models.CompetitorNotInRaceError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CompetitorNotInRaceError(ctx, sel, obj)
+	case models.InvalidBibError:
+		return ec._InvalidBibError(ctx, sel, &obj)
+	case *models.InvalidBibError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidBibError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RefundRegistrationResult(ctx context.Context, sel ast.SelectionSet, obj models.RefundRegistrationResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Registration:
+		return ec._Registration(ctx, sel, &obj)
+	case *models.Registration:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Registration(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.RegistrationError:
+		return ec._RegistrationError(ctx, sel, &obj)
+	case *models.RegistrationError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RescheduleRaceResult(ctx context.Context, sel ast.SelectionSet, obj models.RescheduleRaceResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidRaceDateError:
+		return ec._InvalidRaceDateError(ctx, sel, &obj)
+	case *models.InvalidRaceDateError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceDateError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _RevokeCalendarTokenResult(ctx context.Context, sel ast.SelectionSet, obj models.RevokeCalendarTokenResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.CalendarTokenRevoked:
+		return ec._CalendarTokenRevoked(ctx, sel, &obj)
+	case *models.CalendarTokenRevoked:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._CalendarTokenRevoked(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _SearchRacesResult(ctx context.Context, sel ast.SelectionSet, obj models.SearchRacesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.RaceSearch:
+		return ec._RaceSearch(ctx, sel, &obj)
+	case *models.RaceSearch:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceSearch(ctx, sel, obj)
+	case models.InvalidRaceSearchError:
+		return ec._InvalidRaceSearchError(ctx, sel, &obj)
+	case *models.InvalidRaceSearchError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceSearchError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _SeriesResult(ctx context.Context, sel ast.SelectionSet, obj models.SeriesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Series:
+		return ec._Series(ctx, sel, &obj)
+	case *models.Series:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Series(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.SeriesNotFound:
+		return ec._SeriesNotFound(ctx, sel, &obj)
+	case *models.SeriesNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesNotFound(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _SetRegistrationTransfersResult(ctx context.Context, sel ast.SelectionSet, obj models.SetRegistrationTransfersResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidTransferDeadlineError:
+		return ec._InvalidTransferDeadlineError(ctx, sel, &obj)
+	case *models.InvalidTransferDeadlineError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidTransferDeadlineError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _SetRelayLineUpResult(ctx context.Context, sel ast.SelectionSet, obj models.SetRelayLineUpResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.TeamNotFound:
+		return ec._TeamNotFound(ctx, sel, &obj)
+	case *models.TeamNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidRelayLineUpError:
+		return ec._InvalidRelayLineUpError(ctx, sel, &obj)
+	case *models.InvalidRelayLineUpError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRelayLineUpError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _TeamResult(ctx context.Context, sel ast.SelectionSet, obj models.TeamResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Team:
+		return ec._Team(ctx, sel, &obj)
+	case *models.Team:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Team(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.TeamNotFound:
+		return ec._TeamNotFound(ctx, sel, &obj)
+	case *models.TeamNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamNotFound(ctx, sel, obj)
+	case models.UserNotFound:
+		return ec._UserNotFound(ctx, sel, &obj)
+	case *models.UserNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UserNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.TeamMembershipError:
+		return ec._TeamMembershipError(ctx, sel, &obj)
+	case *models.TeamMembershipError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._TeamMembershipError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdateNotificationPreferencesResult(ctx context.Context, sel ast.SelectionSet, obj models.UpdateNotificationPreferencesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.NotificationPreferences:
+		return ec._NotificationPreferences(ctx, sel, &obj)
+	case *models.NotificationPreferences:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._NotificationPreferences(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.UnsupportedLocaleError:
+		return ec._UnsupportedLocaleError(ctx, sel, &obj)
+	case *models.UnsupportedLocaleError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._UnsupportedLocaleError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UpdateSeriesResult(ctx context.Context, sel ast.SelectionSet, obj models.UpdateSeriesResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Series:
+		return ec._Series(ctx, sel, &obj)
+	case *models.Series:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Series(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.InvalidRaceNameError:
+		return ec._InvalidRaceNameError(ctx, sel, &obj)
+	case *models.InvalidRaceNameError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidRaceNameError(ctx, sel, obj)
+	case models.InvalidVenueError:
+		return ec._InvalidVenueError(ctx, sel, &obj)
+	case *models.InvalidVenueError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidVenueError(ctx, sel, obj)
+	case models.InvalidSeriesError:
+		return ec._InvalidSeriesError(ctx, sel, &obj)
+	case *models.InvalidSeriesError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidSeriesError(ctx, sel, obj)
+	case models.SeriesNotFound:
+		return ec._SeriesNotFound(ctx, sel, &obj)
+	case *models.SeriesNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SeriesNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _UploadCourseResult(ctx context.Context, sel ast.SelectionSet, obj models.UploadCourseResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.Race:
+		return ec._Race(ctx, sel, &obj)
+	case *models.Race:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Race(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.Forbidden:
+		return ec._Forbidden(ctx, sel, &obj)
+	case *models.Forbidden:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Forbidden(ctx, sel, obj)
+	case models.InvalidCourseError:
+		return ec._InvalidCourseError(ctx, sel, &obj)
+	case *models.InvalidCourseError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidCourseError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _WithdrawRegistrationOfferResult(ctx context.Context, sel ast.SelectionSet, obj models.WithdrawRegistrationOfferResult) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case models.RegistrationOfferWithdrawn:
+		return ec._RegistrationOfferWithdrawn(ctx, sel, &obj)
+	case *models.RegistrationOfferWithdrawn:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationOfferWithdrawn(ctx, sel, obj)
+	case models.InvalidIDError:
+		return ec._InvalidIDError(ctx, sel, &obj)
+	case *models.InvalidIDError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._InvalidIDError(ctx, sel, obj)
+	case models.RaceNotFound:
+		return ec._RaceNotFound(ctx, sel, &obj)
+	case *models.RaceNotFound:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RaceNotFound(ctx, sel, obj)
+	case models.RegistrationTransferError:
+		return ec._RegistrationTransferError(ctx, sel, &obj)
+	case *models.RegistrationTransferError:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RegistrationTransferError(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var auditLogImplementors = []string{"AuditLog", "AuditLogResult"}
+
+func (ec *executionContext) _AuditLog(ctx context.Context, sel ast.SelectionSet, obj *models.AuditLog) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditLogImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditLog")
+		case "edges":
+			out.Values[i] = ec._AuditLog_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._AuditLog_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var auditLogEdgeImplementors = []string{"AuditLogEdge"}
+
+func (ec *executionContext) _AuditLogEdge(ctx context.Context, sel ast.SelectionSet, obj *models.AuditLogEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditLogEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditLogEdge")
+		case "cursor":
+			out.Values[i] = ec._AuditLogEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+			out.Values[i] = ec._AuditLogEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var auditLogEntryImplementors = []string{"AuditLogEntry"}
+
+func (ec *executionContext) _AuditLogEntry(ctx context.Context, sel ast.SelectionSet, obj *models.AuditLogEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, auditLogEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AuditLogEntry")
+		case "id":
+			out.Values[i] = ec._AuditLogEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec._AuditLogEntry_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "actor":
+			out.Values[i] = ec._AuditLogEntry_actor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "raceId":
+			out.Values[i] = ec._AuditLogEntry_raceId(ctx, field, obj)
+		case "organizationId":
+			out.Values[i] = ec._AuditLogEntry_organizationId(ctx, field, obj)
+		case "occurredAt":
+			out.Values[i] = ec._AuditLogEntry_occurredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "payload":
+			out.Values[i] = ec._AuditLogEntry_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var bibRangeImplementors = []string{"BibRange"}
+
+func (ec *executionContext) _BibRange(ctx context.Context, sel ast.SelectionSet, obj *models.BibRange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, bibRangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BibRange")
+		case "from":
+			out.Values[i] = ec._BibRange_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "to":
+			out.Values[i] = ec._BibRange_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var calendarTokenImplementors = []string{"CalendarToken", "CreateCalendarTokenResult"}
+
+func (ec *executionContext) _CalendarToken(ctx context.Context, sel ast.SelectionSet, obj *models.CalendarToken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, calendarTokenImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CalendarToken")
+		case "secret":
+			out.Values[i] = ec._CalendarToken_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feed":
+			out.Values[i] = ec._CalendarToken_feed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._CalendarToken_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var calendarTokenRevokedImplementors = []string{"CalendarTokenRevoked", "RevokeCalendarTokenResult"}
+
+func (ec *executionContext) _CalendarTokenRevoked(ctx context.Context, sel ast.SelectionSet, obj *models.CalendarTokenRevoked) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, calendarTokenRevokedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CalendarTokenRevoked")
+		case "revoked":
+			out.Values[i] = ec._CalendarTokenRevoked_revoked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var cancellationErrorImplementors = []string{"CancellationError", "Error", "CancelRaceResult"}
+
+func (ec *executionContext) _CancellationError(ctx context.Context, sel ast.SelectionSet, obj *models.CancellationError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cancellationErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CancellationError")
+		case "message":
+			out.Values[i] = ec._CancellationError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var checkInImplementors = []string{"CheckIn"}
+
+func (ec *executionContext) _CheckIn(ctx context.Context, sel ast.SelectionSet, obj *models.CheckIn) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkInImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckIn")
+		case "competitor":
+			out.Values[i] = ec._CheckIn_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "at":
+			out.Values[i] = ec._CheckIn_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var checkInErrorImplementors = []string{"CheckInError", "Error", "CheckInResult"}
+
+func (ec *executionContext) _CheckInError(ctx context.Context, sel ast.SelectionSet, obj *models.CheckInError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkInErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckInError")
+		case "message":
+			out.Values[i] = ec._CheckInError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var checkoutImplementors = []string{"Checkout", "CheckoutResult"}
+
+func (ec *executionContext) _Checkout(ctx context.Context, sel ast.SelectionSet, obj *models.Checkout) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Checkout")
+		case "paymentId":
+			out.Values[i] = ec._Checkout_paymentId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "url":
+			out.Values[i] = ec._Checkout_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var checkpointImplementors = []string{"Checkpoint"}
+
+func (ec *executionContext) _Checkpoint(ctx context.Context, sel ast.SelectionSet, obj *models.Checkpoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkpointImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Checkpoint")
+		case "name":
+			out.Values[i] = ec._Checkpoint_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "distance":
+			out.Values[i] = ec._Checkpoint_distance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cutoff":
+			out.Values[i] = ec._Checkpoint_cutoff(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var competitorBibImplementors = []string{"CompetitorBib"}
+
+func (ec *executionContext) _CompetitorBib(ctx context.Context, sel ast.SelectionSet, obj *models.CompetitorBib) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, competitorBibImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompetitorBib")
+		case "bib":
+			out.Values[i] = ec._CompetitorBib_bib(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._CompetitorBib_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var competitorCheckInImplementors = []string{"CompetitorCheckIn", "CheckInResult"}
+
+func (ec *executionContext) _CompetitorCheckIn(ctx context.Context, sel ast.SelectionSet, obj *models.CompetitorCheckIn) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, competitorCheckInImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompetitorCheckIn")
+		case "raceId":
+			out.Values[i] = ec._CompetitorCheckIn_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._CompetitorCheckIn_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "category":
+			out.Values[i] = ec._CompetitorCheckIn_category(ctx, field, obj)
+		case "bib":
+			out.Values[i] = ec._CompetitorCheckIn_bib(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._CompetitorCheckIn_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checkedInAt":
+			out.Values[i] = ec._CompetitorCheckIn_checkedInAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var competitorNotInRaceErrorImplementors = []string{"CompetitorNotInRaceError", "AssignBibResult", "CheckInResult", "Error", "RecordResultResult"}
+
+func (ec *executionContext) _CompetitorNotInRaceError(ctx context.Context, sel ast.SelectionSet, obj *models.CompetitorNotInRaceError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, competitorNotInRaceErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompetitorNotInRaceError")
+		case "message":
+			out.Values[i] = ec._CompetitorNotInRaceError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var competitorResultImplementors = []string{"CompetitorResult"}
+
+func (ec *executionContext) _CompetitorResult(ctx context.Context, sel ast.SelectionSet, obj *models.CompetitorResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, competitorResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompetitorResult")
+		case "position":
+			out.Values[i] = ec._CompetitorResult_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._CompetitorResult_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._CompetitorResult_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var competitorSplitsImplementors = []string{"CompetitorSplits"}
+
+func (ec *executionContext) _CompetitorSplits(ctx context.Context, sel ast.SelectionSet, obj *models.CompetitorSplits) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, competitorSplitsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CompetitorSplits")
+		case "competitor":
+			out.Values[i] = ec._CompetitorSplits_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "splits":
+			out.Values[i] = ec._CompetitorSplits_splits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var courseImplementors = []string{"Course"}
+
+func (ec *executionContext) _Course(ctx context.Context, sel ast.SelectionSet, obj *models.Course) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, courseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Course")
+		case "distance":
+			out.Values[i] = ec._Course_distance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "elevationGain":
+			out.Values[i] = ec._Course_elevationGain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "elevationLoss":
+			out.Values[i] = ec._Course_elevationLoss(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "polyline":
+			out.Values[i] = ec._Course_polyline(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "points":
+			out.Values[i] = ec._Course_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var coursePointImplementors = []string{"CoursePoint"}
+
+func (ec *executionContext) _CoursePoint(ctx context.Context, sel ast.SelectionSet, obj *models.CoursePoint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, coursePointImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CoursePoint")
+		case "lat":
+			out.Values[i] = ec._CoursePoint_lat(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lon":
+			out.Values[i] = ec._CoursePoint_lon(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "elevation":
+			out.Values[i] = ec._CoursePoint_elevation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var dataExportImplementors = []string{"DataExport", "ExportMyDataResult"}
+
+func (ec *executionContext) _DataExport(ctx context.Context, sel ast.SelectionSet, obj *models.DataExport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataExportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataExport")
+		case "fileName":
+			out.Values[i] = ec._DataExport_fileName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "contentType":
+			out.Values[i] = ec._DataExport_contentType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "data":
+			out.Values[i] = ec._DataExport_data(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discountCodeImplementors = []string{"DiscountCode", "CreateDiscountCodeResult"}
+
+func (ec *executionContext) _DiscountCode(ctx context.Context, sel ast.SelectionSet, obj *models.DiscountCode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discountCodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscountCode")
+		case "raceId":
+			out.Values[i] = ec._DiscountCode_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "code":
+			out.Values[i] = ec._DiscountCode_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "percent":
+			out.Values[i] = ec._DiscountCode_percent(ctx, field, obj)
+		case "amount":
+			out.Values[i] = ec._DiscountCode_amount(ctx, field, obj)
+		case "maxRedemptions":
+			out.Values[i] = ec._DiscountCode_maxRedemptions(ctx, field, obj)
+		case "validFrom":
+			out.Values[i] = ec._DiscountCode_validFrom(ctx, field, obj)
+		case "validUntil":
+			out.Values[i] = ec._DiscountCode_validUntil(ctx, field, obj)
+		case "category":
+			out.Values[i] = ec._DiscountCode_category(ctx, field, obj)
+		case "redemptions":
+			out.Values[i] = ec._DiscountCode_redemptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discountCodeAlreadyExistsImplementors = []string{"DiscountCodeAlreadyExists", "Error", "CreateDiscountCodeResult"}
+
+func (ec *executionContext) _DiscountCodeAlreadyExists(ctx context.Context, sel ast.SelectionSet, obj *models.DiscountCodeAlreadyExists) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discountCodeAlreadyExistsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscountCodeAlreadyExists")
+		case "message":
+			out.Values[i] = ec._DiscountCodeAlreadyExists_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discountCodeNotApplicableErrorImplementors = []string{"DiscountCodeNotApplicableError", "Error", "JoinRaceResult"}
+
+func (ec *executionContext) _DiscountCodeNotApplicableError(ctx context.Context, sel ast.SelectionSet, obj *models.DiscountCodeNotApplicableError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discountCodeNotApplicableErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscountCodeNotApplicableError")
+		case "message":
+			out.Values[i] = ec._DiscountCodeNotApplicableError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discountCodeNotFoundImplementors = []string{"DiscountCodeNotFound", "Error", "JoinRaceResult"}
+
+func (ec *executionContext) _DiscountCodeNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.DiscountCodeNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discountCodeNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscountCodeNotFound")
+		case "message":
+			out.Values[i] = ec._DiscountCodeNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var discountCodesImplementors = []string{"DiscountCodes", "DiscountCodesResult"}
+
+func (ec *executionContext) _DiscountCodes(ctx context.Context, sel ast.SelectionSet, obj *models.DiscountCodes) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discountCodesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscountCodes")
+		case "codes":
+			out.Values[i] = ec._DiscountCodes_codes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var erasureImplementors = []string{"Erasure", "EraseMyDataResult", "ErasureResult"}
+
+func (ec *executionContext) _Erasure(ctx context.Context, sel ast.SelectionSet, obj *models.Erasure) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, erasureImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Erasure")
+		case "id":
+			out.Values[i] = ec._Erasure_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Erasure_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requestedAt":
+			out.Values[i] = ec._Erasure_requestedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "finishedAt":
+			out.Values[i] = ec._Erasure_finishedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var erasureNotFoundImplementors = []string{"ErasureNotFound", "Error", "ErasureResult"}
+
+func (ec *executionContext) _ErasureNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.ErasureNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, erasureNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ErasureNotFound")
+		case "message":
+			out.Values[i] = ec._ErasureNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var forbiddenImplementors = []string{"Forbidden", "AuditLogResult", "AssignBibResult", "RescheduleRaceResult", "CreateCalendarTokenResult", "RevokeCalendarTokenResult", "CancelRaceResult", "CheckInResult", "RecordPassagesResult", "UploadCourseResult", "DiscountCodesResult", "CreateDiscountCodeResult", "NotificationPreferencesResult", "UpdateNotificationPreferencesResult", "OrganizationMemberResult", "AssignRaceToOrganizationResult", "ExportMyDataResult", "EraseMyDataResult", "ErasureResult", "RefundRegistrationResult", "SetRegistrationTransfersResult", "SetRelayLineUpResult", "RecordLegSplitResult", "ImportResultsResult", "RecordResultResult", "Error", "UpdateSeriesResult", "GenerateSeriesRacesResult", "RaceStaffResult", "TeamResult", "EnterTeamResult"}
+
+func (ec *executionContext) _Forbidden(ctx context.Context, sel ast.SelectionSet, obj *models.Forbidden) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, forbiddenImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Forbidden")
+		case "message":
+			out.Values[i] = ec._Forbidden_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var importIssueImplementors = []string{"ImportIssue"}
+
+func (ec *executionContext) _ImportIssue(ctx context.Context, sel ast.SelectionSet, obj *models.ImportIssue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, importIssueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImportIssue")
+		case "line":
+			out.Values[i] = ec._ImportIssue_line(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bib":
+			out.Values[i] = ec._ImportIssue_bib(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._ImportIssue_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ImportIssue_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var importedResultImplementors = []string{"ImportedResult"}
+
+func (ec *executionContext) _ImportedResult(ctx context.Context, sel ast.SelectionSet, obj *models.ImportedResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, importedResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ImportedResult")
+		case "line":
+			out.Values[i] = ec._ImportedResult_line(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bib":
+			out.Values[i] = ec._ImportedResult_bib(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._ImportedResult_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._ImportedResult_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidBibErrorImplementors = []string{"InvalidBibError", "Error", "AssignBibResult", "CheckInResult", "RecordResultResult"}
+
+func (ec *executionContext) _InvalidBibError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidBibError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidBibErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidBibError")
+		case "message":
+			out.Values[i] = ec._InvalidBibError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidCourseErrorImplementors = []string{"InvalidCourseError", "Error", "UploadCourseResult"}
+
+func (ec *executionContext) _InvalidCourseError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidCourseError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidCourseErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidCourseError")
+		case "message":
+			out.Values[i] = ec._InvalidCourseError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidCursorErrorImplementors = []string{"InvalidCursorError", "Error", "AuditLogResult"}
+
+func (ec *executionContext) _InvalidCursorError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidCursorError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidCursorErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidCursorError")
+		case "message":
+			out.Values[i] = ec._InvalidCursorError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidDiscountCodeErrorImplementors = []string{"InvalidDiscountCodeError", "Error", "CreateDiscountCodeResult"}
+
+func (ec *executionContext) _InvalidDiscountCodeError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidDiscountCodeError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidDiscountCodeErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidDiscountCodeError")
+		case "message":
+			out.Values[i] = ec._InvalidDiscountCodeError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidIDErrorImplementors = []string{"InvalidIDError", "AuditLogResult", "AssignBibResult", "RescheduleRaceResult", "CancelRaceResult", "CheckInResult", "RecordPassagesResult", "UploadCourseResult", "DiscountCodesResult", "CreateDiscountCodeResult", "OrganizationResult", "CreateOrganizationResult", "OrganizationMemberResult", "AssignRaceToOrganizationResult", "ErasureResult", "JoinRaceResult", "CheckoutResult", "RefundRegistrationResult", "SetRegistrationTransfersResult", "OfferRegistrationResult", "WithdrawRegistrationOfferResult", "AcceptRegistrationResult", "SetRelayLineUpResult", "RecordLegSplitResult", "ImportResultsResult", "RaceResult", "CreateRaceResult", "RecordResultResult", "Error", "SeriesResult", "CreateSeriesResult", "UpdateSeriesResult", "GenerateSeriesRacesResult", "RaceStaffResult", "TeamResult", "EnterTeamResult"}
+
+func (ec *executionContext) _InvalidIDError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidIDError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidIDErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidIDError")
+		case "message":
+			out.Values[i] = ec._InvalidIDError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidLegSplitErrorImplementors = []string{"InvalidLegSplitError", "Error", "RecordLegSplitResult"}
+
+func (ec *executionContext) _InvalidLegSplitError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidLegSplitError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidLegSplitErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidLegSplitError")
+		case "message":
+			out.Values[i] = ec._InvalidLegSplitError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidOrganizationNameErrorImplementors = []string{"InvalidOrganizationNameError", "Error", "CreateOrganizationResult"}
+
+func (ec *executionContext) _InvalidOrganizationNameError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidOrganizationNameError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidOrganizationNameErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidOrganizationNameError")
+		case "message":
+			out.Values[i] = ec._InvalidOrganizationNameError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceBibsErrorImplementors = []string{"InvalidRaceBibsError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRaceBibsError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceBibsError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceBibsErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceBibsError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceBibsError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceCategoryErrorImplementors = []string{"InvalidRaceCategoryError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRaceCategoryError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceCategoryError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceCategoryErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceCategoryError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceCategoryError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceCheckpointsErrorImplementors = []string{"InvalidRaceCheckpointsError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRaceCheckpointsError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceCheckpointsError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceCheckpointsErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceCheckpointsError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceCheckpointsError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceDateErrorImplementors = []string{"InvalidRaceDateError", "RescheduleRaceResult", "Error", "CreateRaceResult", "CreateSeriesResult"}
+
+func (ec *executionContext) _InvalidRaceDateError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceDateError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceDateErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceDateError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceDateError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceNameErrorImplementors = []string{"InvalidRaceNameError", "Error", "CreateRaceResult", "CreateSeriesResult", "UpdateSeriesResult"}
+
+func (ec *executionContext) _InvalidRaceNameError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceNameError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceNameErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceNameError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceNameError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRacePriceErrorImplementors = []string{"InvalidRacePriceError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRacePriceError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRacePriceError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRacePriceErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRacePriceError")
+		case "message":
+			out.Values[i] = ec._InvalidRacePriceError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceRelayErrorImplementors = []string{"InvalidRaceRelayError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRaceRelayError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceRelayError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceRelayErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceRelayError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceRelayError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceSearchErrorImplementors = []string{"InvalidRaceSearchError", "Error", "SearchRacesResult"}
+
+func (ec *executionContext) _InvalidRaceSearchError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceSearchError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceSearchErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceSearchError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceSearchError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceTeamsErrorImplementors = []string{"InvalidRaceTeamsError", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _InvalidRaceTeamsError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceTeamsError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceTeamsErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceTeamsError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceTeamsError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRaceTimeErrorImplementors = []string{"InvalidRaceTimeError", "Error", "RecordLegSplitResult", "RecordResultResult"}
+
+func (ec *executionContext) _InvalidRaceTimeError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRaceTimeError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRaceTimeErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRaceTimeError")
+		case "message":
+			out.Values[i] = ec._InvalidRaceTimeError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRacesNearErrorImplementors = []string{"InvalidRacesNearError", "Error", "RacesNearResult"}
+
+func (ec *executionContext) _InvalidRacesNearError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRacesNearError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRacesNearErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRacesNearError")
+		case "message":
+			out.Values[i] = ec._InvalidRacesNearError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidRelayLineUpErrorImplementors = []string{"InvalidRelayLineUpError", "Error", "SetRelayLineUpResult"}
+
+func (ec *executionContext) _InvalidRelayLineUpError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidRelayLineUpError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidRelayLineUpErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidRelayLineUpError")
+		case "message":
+			out.Values[i] = ec._InvalidRelayLineUpError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidResultsFileErrorImplementors = []string{"InvalidResultsFileError", "Error", "ImportResultsResult"}
+
+func (ec *executionContext) _InvalidResultsFileError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidResultsFileError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidResultsFileErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidResultsFileError")
+		case "message":
+			out.Values[i] = ec._InvalidResultsFileError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidSeriesErrorImplementors = []string{"InvalidSeriesError", "Error", "CreateSeriesResult", "UpdateSeriesResult"}
+
+func (ec *executionContext) _InvalidSeriesError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidSeriesError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidSeriesErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidSeriesError")
+		case "message":
+			out.Values[i] = ec._InvalidSeriesError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidTeamEntryErrorImplementors = []string{"InvalidTeamEntryError", "Error", "EnterTeamResult"}
+
+func (ec *executionContext) _InvalidTeamEntryError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidTeamEntryError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidTeamEntryErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidTeamEntryError")
+		case "message":
+			out.Values[i] = ec._InvalidTeamEntryError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidTransferDeadlineErrorImplementors = []string{"InvalidTransferDeadlineError", "Error", "SetRegistrationTransfersResult"}
+
+func (ec *executionContext) _InvalidTransferDeadlineError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidTransferDeadlineError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidTransferDeadlineErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidTransferDeadlineError")
+		case "message":
+			out.Values[i] = ec._InvalidTransferDeadlineError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var invalidVenueErrorImplementors = []string{"InvalidVenueError", "Error", "CreateRaceResult", "CreateSeriesResult", "UpdateSeriesResult"}
+
+func (ec *executionContext) _InvalidVenueError(ctx context.Context, sel ast.SelectionSet, obj *models.InvalidVenueError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, invalidVenueErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InvalidVenueError")
+		case "message":
+			out.Values[i] = ec._InvalidVenueError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var moneyImplementors = []string{"Money"}
+
+func (ec *executionContext) _Money(ctx context.Context, sel ast.SelectionSet, obj *models.Money) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, moneyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Money")
+		case "amount":
+			out.Values[i] = ec._Money_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "currency":
+			out.Values[i] = ec._Money_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
 		Object: "Mutation",
 	})
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createRace":
-			out.Values[i] = ec._Mutation_createRace(ctx, field)
-			if out.Values[i] == graphql.Null {
-				invalids++
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createRace":
+			out.Values[i] = ec._Mutation_createRace(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "recordResult":
+			out.Values[i] = ec._Mutation_recordResult(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "assignBib":
+			out.Values[i] = ec._Mutation_assignBib(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rescheduleRace":
+			out.Values[i] = ec._Mutation_rescheduleRace(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createCalendarToken":
+			out.Values[i] = ec._Mutation_createCalendarToken(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokeCalendarToken":
+			out.Values[i] = ec._Mutation_revokeCalendarToken(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cancelRace":
+			out.Values[i] = ec._Mutation_cancelRace(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checkIn":
+			out.Values[i] = ec._Mutation_checkIn(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "recordPassages":
+			out.Values[i] = ec._Mutation_recordPassages(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "uploadCourse":
+			out.Values[i] = ec._Mutation_uploadCourse(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createDiscountCode":
+			out.Values[i] = ec._Mutation_createDiscountCode(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateNotificationPreferences":
+			out.Values[i] = ec._Mutation_updateNotificationPreferences(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createOrganization":
+			out.Values[i] = ec._Mutation_createOrganization(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addOrganizationMember":
+			out.Values[i] = ec._Mutation_addOrganizationMember(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeOrganizationMember":
+			out.Values[i] = ec._Mutation_removeOrganizationMember(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "assignRaceToOrganization":
+			out.Values[i] = ec._Mutation_assignRaceToOrganization(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eraseMyData":
+			out.Values[i] = ec._Mutation_eraseMyData(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "joinRace":
+			out.Values[i] = ec._Mutation_joinRace(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checkout":
+			out.Values[i] = ec._Mutation_checkout(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "refundRegistration":
+			out.Values[i] = ec._Mutation_refundRegistration(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setRegistrationTransfers":
+			out.Values[i] = ec._Mutation_setRegistrationTransfers(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offerRegistration":
+			out.Values[i] = ec._Mutation_offerRegistration(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "withdrawRegistrationOffer":
+			out.Values[i] = ec._Mutation_withdrawRegistrationOffer(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "acceptRegistration":
+			out.Values[i] = ec._Mutation_acceptRegistration(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setRelayLineUp":
+			out.Values[i] = ec._Mutation_setRelayLineUp(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "recordLegSplit":
+			out.Values[i] = ec._Mutation_recordLegSplit(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "importResults":
+			out.Values[i] = ec._Mutation_importResults(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "createSeries":
+			out.Values[i] = ec._Mutation_createSeries(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "updateSeries":
+			out.Values[i] = ec._Mutation_updateSeries(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "generateSeriesRaces":
+			out.Values[i] = ec._Mutation_generateSeriesRaces(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "addRaceStaff":
+			out.Values[i] = ec._Mutation_addRaceStaff(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeRaceStaff":
+			out.Values[i] = ec._Mutation_removeRaceStaff(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "transferRaceOwnership":
+			out.Values[i] = ec._Mutation_transferRaceOwnership(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "enterTeam":
+			out.Values[i] = ec._Mutation_enterTeam(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "inviteToTeam":
+			out.Values[i] = ec._Mutation_inviteToTeam(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "acceptTeamInvitation":
+			out.Values[i] = ec._Mutation_acceptTeamInvitation(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "declineTeamInvitation":
+			out.Values[i] = ec._Mutation_declineTeamInvitation(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requestToJoinTeam":
+			out.Values[i] = ec._Mutation_requestToJoinTeam(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "approveJoinRequest":
+			out.Values[i] = ec._Mutation_approveJoinRequest(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejectJoinRequest":
+			out.Values[i] = ec._Mutation_rejectJoinRequest(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "leaveTeam":
+			out.Values[i] = ec._Mutation_leaveTeam(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "removeMember":
+			out.Values[i] = ec._Mutation_removeMember(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "transferAdmin":
+			out.Values[i] = ec._Mutation_transferAdmin(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var notificationPreferencesImplementors = []string{"NotificationPreferences", "NotificationPreferencesResult", "UpdateNotificationPreferencesResult"}
+
+func (ec *executionContext) _NotificationPreferences(ctx context.Context, sel ast.SelectionSet, obj *models.NotificationPreferences) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, notificationPreferencesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NotificationPreferences")
+		case "locale":
+			out.Values[i] = ec._NotificationPreferences_locale(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "disabled":
+			out.Values[i] = ec._NotificationPreferences_disabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationImplementors = []string{"Organization", "OrganizationResult", "CreateOrganizationResult", "OrganizationMemberResult"}
+
+func (ec *executionContext) _Organization(ctx context.Context, sel ast.SelectionSet, obj *models.Organization) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Organization")
+		case "id":
+			out.Values[i] = ec._Organization_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Organization_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "members":
+			out.Values[i] = ec._Organization_members(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "races":
+			out.Values[i] = ec._Organization_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "dashboard":
+			out.Values[i] = ec._Organization_dashboard(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationAlreadyExistsImplementors = []string{"OrganizationAlreadyExists", "Error", "CreateOrganizationResult"}
+
+func (ec *executionContext) _OrganizationAlreadyExists(ctx context.Context, sel ast.SelectionSet, obj *models.OrganizationAlreadyExists) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationAlreadyExistsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrganizationAlreadyExists")
+		case "message":
+			out.Values[i] = ec._OrganizationAlreadyExists_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationDashboardImplementors = []string{"OrganizationDashboard"}
+
+func (ec *executionContext) _OrganizationDashboard(ctx context.Context, sel ast.SelectionSet, obj *models.OrganizationDashboard) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationDashboardImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrganizationDashboard")
+		case "races":
+			out.Values[i] = ec._OrganizationDashboard_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revenue":
+			out.Values[i] = ec._OrganizationDashboard_revenue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationMemberImplementors = []string{"OrganizationMember"}
+
+func (ec *executionContext) _OrganizationMember(ctx context.Context, sel ast.SelectionSet, obj *models.OrganizationMember) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationMemberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrganizationMember")
+		case "user":
+			out.Values[i] = ec._OrganizationMember_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "role":
+			out.Values[i] = ec._OrganizationMember_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationMemberErrorImplementors = []string{"OrganizationMemberError", "Error", "OrganizationMemberResult"}
+
+func (ec *executionContext) _OrganizationMemberError(ctx context.Context, sel ast.SelectionSet, obj *models.OrganizationMemberError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationMemberErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrganizationMemberError")
+		case "message":
+			out.Values[i] = ec._OrganizationMemberError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var organizationNotFoundImplementors = []string{"OrganizationNotFound", "Error", "OrganizationResult", "OrganizationMemberResult", "AssignRaceToOrganizationResult"}
+
+func (ec *executionContext) _OrganizationNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.OrganizationNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, organizationNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrganizationNotFound")
+		case "message":
+			out.Values[i] = ec._OrganizationNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *models.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var passagesRecordedImplementors = []string{"PassagesRecorded", "RecordPassagesResult"}
+
+func (ec *executionContext) _PassagesRecorded(ctx context.Context, sel ast.SelectionSet, obj *models.PassagesRecorded) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, passagesRecordedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PassagesRecorded")
+		case "race":
+			out.Values[i] = ec._PassagesRecorded_race(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rejected":
+			out.Values[i] = ec._PassagesRecorded_rejected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var priceTierImplementors = []string{"PriceTier"}
+
+func (ec *executionContext) _PriceTier(ctx context.Context, sel ast.SelectionSet, obj *models.PriceTier) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, priceTierImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PriceTier")
+		case "until":
+			out.Values[i] = ec._PriceTier_until(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "amount":
+			out.Values[i] = ec._PriceTier_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "race":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_race(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "races":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_races(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "racesNear":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_racesNear(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "auditLog":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_auditLog(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "discountCodes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_discountCodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "notificationPreferences":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_notificationPreferences(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "organization":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_organization(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "exportMyData":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_exportMyData(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "erasure":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_erasure(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "searchRaces":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchRaces(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "series":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_series(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceImplementors = []string{"Race", "AssignBibResult", "RescheduleRaceResult", "CancelRaceResult", "UploadCourseResult", "AssignRaceToOrganizationResult", "SetRegistrationTransfersResult", "SetRelayLineUpResult", "RecordLegSplitResult", "RaceResult", "CreateRaceResult", "RecordResultResult", "RaceStaffResult", "EnterTeamResult"}
+
+func (ec *executionContext) _Race(ctx context.Context, sel ast.SelectionSet, obj *models.Race) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Race")
+		case "id":
+			out.Values[i] = ec._Race_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Race_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "date":
+			out.Values[i] = ec._Race_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._Race_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "venue":
+			out.Values[i] = ec._Race_venue(ctx, field, obj)
+		case "competitors":
+			out.Values[i] = ec._Race_competitors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "teams":
+			out.Values[i] = ec._Race_teams(ctx, field, obj)
+		case "results":
+			out.Values[i] = ec._Race_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "teamStandings":
+			out.Values[i] = ec._Race_teamStandings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "relay":
+			out.Values[i] = ec._Race_relay(ctx, field, obj)
+		case "categories":
+			out.Values[i] = ec._Race_categories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "course":
+			out.Values[i] = ec._Race_course(ctx, field, obj)
+		case "checkpoints":
+			out.Values[i] = ec._Race_checkpoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "splits":
+			out.Values[i] = ec._Race_splits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "bibs":
+			out.Values[i] = ec._Race_bibs(ctx, field, obj)
+		case "sequence":
+			out.Values[i] = ec._Race_sequence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "seriesId":
+			out.Values[i] = ec._Race_seriesId(ctx, field, obj)
+		case "price":
+			out.Values[i] = ec._Race_price(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Race_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "registrationDeadline":
+			out.Values[i] = ec._Race_registrationDeadline(ctx, field, obj)
+		case "cancellation":
+			out.Values[i] = ec._Race_cancellation(ctx, field, obj)
+		case "registrationTransfers":
+			out.Values[i] = ec._Race_registrationTransfers(ctx, field, obj)
+		case "registrationOffers":
+			out.Values[i] = ec._Race_registrationOffers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "owner":
+			out.Values[i] = ec._Race_owner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "organizationId":
+			out.Values[i] = ec._Race_organizationId(ctx, field, obj)
+		case "staff":
+			out.Values[i] = ec._Race_staff(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checkIns":
+			out.Values[i] = ec._Race_checkIns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceAlreadyExistsImplementors = []string{"RaceAlreadyExists", "Error", "CreateRaceResult"}
+
+func (ec *executionContext) _RaceAlreadyExists(ctx context.Context, sel ast.SelectionSet, obj *models.RaceAlreadyExists) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceAlreadyExistsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceAlreadyExists")
+		case "message":
+			out.Values[i] = ec._RaceAlreadyExists_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceBibsImplementors = []string{"RaceBibs"}
+
+func (ec *executionContext) _RaceBibs(ctx context.Context, sel ast.SelectionSet, obj *models.RaceBibs) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceBibsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceBibs")
+		case "strategy":
+			out.Values[i] = ec._RaceBibs_strategy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "first":
+			out.Values[i] = ec._RaceBibs_first(ctx, field, obj)
+		case "entries":
+			out.Values[i] = ec._RaceBibs_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceCancellationImplementors = []string{"RaceCancellation"}
+
+func (ec *executionContext) _RaceCancellation(ctx context.Context, sel ast.SelectionSet, obj *models.RaceCancellation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceCancellationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceCancellation")
+		case "reason":
+			out.Values[i] = ec._RaceCancellation_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cancelledAt":
+			out.Values[i] = ec._RaceCancellation_cancelledAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "transferTo":
+			out.Values[i] = ec._RaceCancellation_transferTo(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceCategoryImplementors = []string{"RaceCategory"}
+
+func (ec *executionContext) _RaceCategory(ctx context.Context, sel ast.SelectionSet, obj *models.RaceCategory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceCategoryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceCategory")
+		case "name":
+			out.Values[i] = ec._RaceCategory_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "distance":
+			out.Values[i] = ec._RaceCategory_distance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "startTime":
+			out.Values[i] = ec._RaceCategory_startTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "capacity":
+			out.Values[i] = ec._RaceCategory_capacity(ctx, field, obj)
+		case "minAge":
+			out.Values[i] = ec._RaceCategory_minAge(ctx, field, obj)
+		case "gender":
+			out.Values[i] = ec._RaceCategory_gender(ctx, field, obj)
+		case "competitors":
+			out.Values[i] = ec._RaceCategory_competitors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "results":
+			out.Values[i] = ec._RaceCategory_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "course":
+			out.Values[i] = ec._RaceCategory_course(ctx, field, obj)
+		case "bibRange":
+			out.Values[i] = ec._RaceCategory_bibRange(ctx, field, obj)
+		case "price":
+			out.Values[i] = ec._RaceCategory_price(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceDashboardImplementors = []string{"RaceDashboard"}
+
+func (ec *executionContext) _RaceDashboard(ctx context.Context, sel ast.SelectionSet, obj *models.RaceDashboard) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceDashboardImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceDashboard")
+		case "raceId":
+			out.Values[i] = ec._RaceDashboard_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "participants":
+			out.Values[i] = ec._RaceDashboard_participants(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "confirmed":
+			out.Values[i] = ec._RaceDashboard_confirmed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checkedIn":
+			out.Values[i] = ec._RaceDashboard_checkedIn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revenue":
+			out.Values[i] = ec._RaceDashboard_revenue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceMatchImplementors = []string{"RaceMatch"}
+
+func (ec *executionContext) _RaceMatch(ctx context.Context, sel ast.SelectionSet, obj *models.RaceMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceMatch")
+		case "race":
+			out.Values[i] = ec._RaceMatch_race(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "rank":
+			out.Values[i] = ec._RaceMatch_rank(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "snippet":
+			out.Values[i] = ec._RaceMatch_snippet(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceNotFoundImplementors = []string{"RaceNotFound", "AssignBibResult", "RescheduleRaceResult", "CancelRaceResult", "CheckInResult", "RecordPassagesResult", "UploadCourseResult", "DiscountCodesResult", "CreateDiscountCodeResult", "AssignRaceToOrganizationResult", "Error", "JoinRaceResult", "CheckoutResult", "RefundRegistrationResult", "SetRegistrationTransfersResult", "OfferRegistrationResult", "WithdrawRegistrationOfferResult", "AcceptRegistrationResult", "SetRelayLineUpResult", "RecordLegSplitResult", "ImportResultsResult", "RaceResult", "RecordResultResult", "RaceStaffResult", "EnterTeamResult"}
+
+func (ec *executionContext) _RaceNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.RaceNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceNotFound")
+		case "message":
+			out.Values[i] = ec._RaceNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var racePriceImplementors = []string{"RacePrice"}
+
+func (ec *executionContext) _RacePrice(ctx context.Context, sel ast.SelectionSet, obj *models.RacePrice) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, racePriceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RacePrice")
+		case "currency":
+			out.Values[i] = ec._RacePrice_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "amount":
+			out.Values[i] = ec._RacePrice_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "tiers":
+			out.Values[i] = ec._RacePrice_tiers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceRelayImplementors = []string{"RaceRelay"}
+
+func (ec *executionContext) _RaceRelay(ctx context.Context, sel ast.SelectionSet, obj *models.RaceRelay) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceRelayImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceRelay")
+		case "legs":
+			out.Values[i] = ec._RaceRelay_legs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "allowRepeatRunners":
+			out.Values[i] = ec._RaceRelay_allowRepeatRunners(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "standings":
+			out.Values[i] = ec._RaceRelay_standings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceSearchImplementors = []string{"RaceSearch", "SearchRacesResult"}
+
+func (ec *executionContext) _RaceSearch(ctx context.Context, sel ast.SelectionSet, obj *models.RaceSearch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceSearchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceSearch")
+		case "matches":
+			out.Values[i] = ec._RaceSearch_matches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceStaffMemberImplementors = []string{"RaceStaffMember"}
+
+func (ec *executionContext) _RaceStaffMember(ctx context.Context, sel ast.SelectionSet, obj *models.RaceStaffMember) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceStaffMemberImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceStaffMember")
+		case "user":
+			out.Values[i] = ec._RaceStaffMember_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "role":
+			out.Values[i] = ec._RaceStaffMember_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceTeamsImplementors = []string{"RaceTeams"}
+
+func (ec *executionContext) _RaceTeams(ctx context.Context, sel ast.SelectionSet, obj *models.RaceTeams) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceTeamsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceTeams")
+		case "minMembers":
+			out.Values[i] = ec._RaceTeams_minMembers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxMembers":
+			out.Values[i] = ec._RaceTeams_maxMembers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "scoring":
+			out.Values[i] = ec._RaceTeams_scoring(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "counting":
+			out.Values[i] = ec._RaceTeams_counting(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var raceTransferImplementors = []string{"RaceTransfer"}
+
+func (ec *executionContext) _RaceTransfer(ctx context.Context, sel ast.SelectionSet, obj *models.RaceTransfer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, raceTransferImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RaceTransfer")
+		case "raceId":
+			out.Values[i] = ec._RaceTransfer_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "category":
+			out.Values[i] = ec._RaceTransfer_category(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var racesImplementors = []string{"Races", "RacesNearResult"}
+
+func (ec *executionContext) _Races(ctx context.Context, sel ast.SelectionSet, obj *models.Races) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, racesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Races")
+		case "races":
+			out.Values[i] = ec._Races_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationImplementors = []string{"Registration", "JoinRaceResult", "RefundRegistrationResult", "AcceptRegistrationResult"}
+
+func (ec *executionContext) _Registration(ctx context.Context, sel ast.SelectionSet, obj *models.Registration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Registration")
+		case "raceId":
+			out.Values[i] = ec._Registration_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._Registration_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Registration_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "fee":
+			out.Values[i] = ec._Registration_fee(ctx, field, obj)
+		case "expiresAt":
+			out.Values[i] = ec._Registration_expiresAt(ctx, field, obj)
+		case "code":
+			out.Values[i] = ec._Registration_code(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationErrorImplementors = []string{"RegistrationError", "Error", "JoinRaceResult", "CheckoutResult", "RefundRegistrationResult", "AcceptRegistrationResult"}
+
+func (ec *executionContext) _RegistrationError(ctx context.Context, sel ast.SelectionSet, obj *models.RegistrationError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistrationError")
+		case "message":
+			out.Values[i] = ec._RegistrationError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationOfferImplementors = []string{"RegistrationOffer", "OfferRegistrationResult"}
+
+func (ec *executionContext) _RegistrationOffer(ctx context.Context, sel ast.SelectionSet, obj *models.RegistrationOffer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationOfferImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistrationOffer")
+		case "raceId":
+			out.Values[i] = ec._RegistrationOffer_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "from":
+			out.Values[i] = ec._RegistrationOffer_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "to":
+			out.Values[i] = ec._RegistrationOffer_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offeredAt":
+			out.Values[i] = ec._RegistrationOffer_offeredAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationOfferWithdrawnImplementors = []string{"RegistrationOfferWithdrawn", "WithdrawRegistrationOfferResult"}
+
+func (ec *executionContext) _RegistrationOfferWithdrawn(ctx context.Context, sel ast.SelectionSet, obj *models.RegistrationOfferWithdrawn) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationOfferWithdrawnImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistrationOfferWithdrawn")
+		case "raceId":
+			out.Values[i] = ec._RegistrationOfferWithdrawn_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationTransferErrorImplementors = []string{"RegistrationTransferError", "Error", "OfferRegistrationResult", "WithdrawRegistrationOfferResult", "AcceptRegistrationResult"}
+
+func (ec *executionContext) _RegistrationTransferError(ctx context.Context, sel ast.SelectionSet, obj *models.RegistrationTransferError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationTransferErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistrationTransferError")
+		case "message":
+			out.Values[i] = ec._RegistrationTransferError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var registrationTransferPolicyImplementors = []string{"RegistrationTransferPolicy"}
+
+func (ec *executionContext) _RegistrationTransferPolicy(ctx context.Context, sel ast.SelectionSet, obj *models.RegistrationTransferPolicy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registrationTransferPolicyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistrationTransferPolicy")
+		case "deadline":
+			out.Values[i] = ec._RegistrationTransferPolicy_deadline(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var rejectedPassageImplementors = []string{"RejectedPassage"}
+
+func (ec *executionContext) _RejectedPassage(ctx context.Context, sel ast.SelectionSet, obj *models.RejectedPassage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rejectedPassageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RejectedPassage")
+		case "index":
+			out.Values[i] = ec._RejectedPassage_index(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+			out.Values[i] = ec._RejectedPassage_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var relayLegImplementors = []string{"RelayLeg"}
+
+func (ec *executionContext) _RelayLeg(ctx context.Context, sel ast.SelectionSet, obj *models.RelayLeg) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relayLegImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelayLeg")
+		case "position":
+			out.Values[i] = ec._RelayLeg_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._RelayLeg_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "distance":
+			out.Values[i] = ec._RelayLeg_distance(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "ranking":
+			out.Values[i] = ec._RelayLeg_ranking(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var relayLegResultImplementors = []string{"RelayLegResult"}
+
+func (ec *executionContext) _RelayLegResult(ctx context.Context, sel ast.SelectionSet, obj *models.RelayLegResult) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relayLegResultImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelayLegResult")
+		case "position":
+			out.Values[i] = ec._RelayLegResult_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "teamId":
+			out.Values[i] = ec._RelayLegResult_teamId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "runner":
+			out.Values[i] = ec._RelayLegResult_runner(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._RelayLegResult_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var relayStandingImplementors = []string{"RelayStanding"}
+
+func (ec *executionContext) _RelayStanding(ctx context.Context, sel ast.SelectionSet, obj *models.RelayStanding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relayStandingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelayStanding")
+		case "position":
+			out.Values[i] = ec._RelayStanding_position(ctx, field, obj)
+		case "teamId":
+			out.Values[i] = ec._RelayStanding_teamId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._RelayStanding_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "legsCompleted":
+			out.Values[i] = ec._RelayStanding_legsCompleted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "complete":
+			out.Values[i] = ec._RelayStanding_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var resultsImportImplementors = []string{"ResultsImport", "ImportResultsResult"}
+
+func (ec *executionContext) _ResultsImport(ctx context.Context, sel ast.SelectionSet, obj *models.ResultsImport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resultsImportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResultsImport")
+		case "race":
+			out.Values[i] = ec._ResultsImport_race(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "results":
+			out.Values[i] = ec._ResultsImport_results(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "skipped":
+			out.Values[i] = ec._ResultsImport_skipped(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "issues":
+			out.Values[i] = ec._ResultsImport_issues(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "committed":
+			out.Values[i] = ec._ResultsImport_committed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesImplementors = []string{"Series", "SeriesResult", "CreateSeriesResult", "UpdateSeriesResult"}
+
+func (ec *executionContext) _Series(ctx context.Context, sel ast.SelectionSet, obj *models.Series) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Series")
+		case "id":
+			out.Values[i] = ec._Series_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Series_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+			out.Values[i] = ec._Series_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "venue":
+			out.Values[i] = ec._Series_venue(ctx, field, obj)
+		case "start":
+			out.Values[i] = ec._Series_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "rule":
+			out.Values[i] = ec._Series_rule(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "points":
+			out.Values[i] = ec._Series_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "bestOf":
+			out.Values[i] = ec._Series_bestOf(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "races":
+			out.Values[i] = ec._Series_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "standings":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Series_standings(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesAlreadyExistsImplementors = []string{"SeriesAlreadyExists", "Error", "CreateSeriesResult"}
+
+func (ec *executionContext) _SeriesAlreadyExists(ctx context.Context, sel ast.SelectionSet, obj *models.SeriesAlreadyExists) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesAlreadyExistsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SeriesAlreadyExists")
+		case "message":
+			out.Values[i] = ec._SeriesAlreadyExists_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesNotFoundImplementors = []string{"SeriesNotFound", "Error", "SeriesResult", "UpdateSeriesResult", "GenerateSeriesRacesResult"}
+
+func (ec *executionContext) _SeriesNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.SeriesNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SeriesNotFound")
+		case "message":
+			out.Values[i] = ec._SeriesNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesRaceImplementors = []string{"SeriesRace"}
+
+func (ec *executionContext) _SeriesRace(ctx context.Context, sel ast.SelectionSet, obj *models.SeriesRace) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesRaceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SeriesRace")
+		case "raceId":
+			out.Values[i] = ec._SeriesRace_raceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "occurrence":
+			out.Values[i] = ec._SeriesRace_occurrence(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesRacesImplementors = []string{"SeriesRaces", "GenerateSeriesRacesResult"}
+
+func (ec *executionContext) _SeriesRaces(ctx context.Context, sel ast.SelectionSet, obj *models.SeriesRaces) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesRacesImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SeriesRaces")
+		case "races":
+			out.Values[i] = ec._SeriesRaces_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var seriesStandingImplementors = []string{"SeriesStanding"}
+
+func (ec *executionContext) _SeriesStanding(ctx context.Context, sel ast.SelectionSet, obj *models.SeriesStanding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, seriesStandingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SeriesStanding")
+		case "position":
+			out.Values[i] = ec._SeriesStanding_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "competitor":
+			out.Values[i] = ec._SeriesStanding_competitor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "points":
+			out.Values[i] = ec._SeriesStanding_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "races":
+			out.Values[i] = ec._SeriesStanding_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var splitImplementors = []string{"Split"}
+
+func (ec *executionContext) _Split(ctx context.Context, sel ast.SelectionSet, obj *models.Split) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, splitImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Split")
+		case "checkpoint":
+			out.Values[i] = ec._Split_checkpoint(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "at":
+			out.Values[i] = ec._Split_at(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "elapsed":
+			out.Values[i] = ec._Split_elapsed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pace":
+			out.Values[i] = ec._Split_pace(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cutoffMissed":
+			out.Values[i] = ec._Split_cutoffMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var staffErrorImplementors = []string{"StaffError", "Error", "RaceStaffResult"}
+
+func (ec *executionContext) _StaffError(ctx context.Context, sel ast.SelectionSet, obj *models.StaffError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, staffErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StaffError")
+		case "message":
+			out.Values[i] = ec._StaffError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamImplementors = []string{"Team", "TeamResult"}
+
+func (ec *executionContext) _Team(ctx context.Context, sel ast.SelectionSet, obj *models.Team) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Team")
+		case "id":
+			out.Values[i] = ec._Team_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Team_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "admin":
+			out.Values[i] = ec._Team_admin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "members":
+			out.Values[i] = ec._Team_members(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "invitations":
+			out.Values[i] = ec._Team_invitations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "joinRequests":
+			out.Values[i] = ec._Team_joinRequests(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamAlreadyEnteredImplementors = []string{"TeamAlreadyEntered", "Error", "EnterTeamResult"}
+
+func (ec *executionContext) _TeamAlreadyEntered(ctx context.Context, sel ast.SelectionSet, obj *models.TeamAlreadyEntered) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamAlreadyEnteredImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamAlreadyEntered")
+		case "message":
+			out.Values[i] = ec._TeamAlreadyEntered_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamInvitationImplementors = []string{"TeamInvitation"}
+
+func (ec *executionContext) _TeamInvitation(ctx context.Context, sel ast.SelectionSet, obj *models.TeamInvitation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamInvitationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamInvitation")
+		case "user":
+			out.Values[i] = ec._TeamInvitation_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._TeamInvitation_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamMembershipErrorImplementors = []string{"TeamMembershipError", "Error", "TeamResult"}
+
+func (ec *executionContext) _TeamMembershipError(ctx context.Context, sel ast.SelectionSet, obj *models.TeamMembershipError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamMembershipErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamMembershipError")
+		case "message":
+			out.Values[i] = ec._TeamMembershipError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamNotFoundImplementors = []string{"TeamNotFound", "SetRelayLineUpResult", "TeamResult", "Error", "EnterTeamResult"}
+
+func (ec *executionContext) _TeamNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.TeamNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamNotFound")
+		case "message":
+			out.Values[i] = ec._TeamNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var teamStandingImplementors = []string{"TeamStanding"}
+
+func (ec *executionContext) _TeamStanding(ctx context.Context, sel ast.SelectionSet, obj *models.TeamStanding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamStandingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TeamStanding")
+		case "position":
+			out.Values[i] = ec._TeamStanding_position(ctx, field, obj)
+		case "teamId":
+			out.Values[i] = ec._TeamStanding_teamId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._TeamStanding_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "points":
+			out.Values[i] = ec._TeamStanding_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "finishers":
+			out.Values[i] = ec._TeamStanding_finishers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "complete":
+			out.Values[i] = ec._TeamStanding_complete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var unsupportedLocaleErrorImplementors = []string{"UnsupportedLocaleError", "Error", "UpdateNotificationPreferencesResult"}
+
+func (ec *executionContext) _UnsupportedLocaleError(ctx context.Context, sel ast.SelectionSet, obj *models.UnsupportedLocaleError) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, unsupportedLocaleErrorImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UnsupportedLocaleError")
+		case "message":
+			out.Values[i] = ec._UnsupportedLocaleError_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userImplementors = []string{"User"}
+
+func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *models.User) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("User")
+		case "id":
+			out.Values[i] = ec._User_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._User_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "races":
+			out.Values[i] = ec._User_races(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var userNotFoundImplementors = []string{"UserNotFound", "OrganizationMemberResult", "OfferRegistrationResult", "RaceStaffResult", "TeamResult", "Error"}
+
+func (ec *executionContext) _UserNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.UserNotFound) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userNotFoundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("UserNotFound")
+		case "message":
+			out.Values[i] = ec._UserNotFound_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var venueImplementors = []string{"Venue"}
+
+func (ec *executionContext) _Venue(ctx context.Context, sel ast.SelectionSet, obj *models.Venue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, venueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Venue")
+		case "name":
+			out.Values[i] = ec._Venue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "address":
+			out.Values[i] = ec._Venue_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lat":
+			out.Values[i] = ec._Venue_lat(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lon":
+			out.Values[i] = ec._Venue_lon(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timeZone":
+			out.Values[i] = ec._Venue_timeZone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAcceptRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAcceptRegistrationInput(ctx context.Context, v interface{}) (models.AcceptRegistrationInput, error) {
+	res, err := ec.unmarshalInputAcceptRegistrationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAcceptRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAcceptRegistrationResult(ctx context.Context, sel ast.SelectionSet, v models.AcceptRegistrationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AcceptRegistrationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAssignBibResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAssignBibResult(ctx context.Context, sel ast.SelectionSet, v models.AssignBibResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AssignBibResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAssignRaceToOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAssignRaceToOrganizationResult(ctx context.Context, sel ast.SelectionSet, v models.AssignRaceToOrganizationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AssignRaceToOrganizationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditLogEdge2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.AuditLogEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAuditLogEdge2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAuditLogEdge2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEdge(ctx context.Context, sel ast.SelectionSet, v *models.AuditLogEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AuditLogEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditLogEntry2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogEntry(ctx context.Context, sel ast.SelectionSet, v *models.AuditLogEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AuditLogEntry(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAuditLogResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogResult(ctx context.Context, sel ast.SelectionSet, v models.AuditLogResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._AuditLogResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBibInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibInput(ctx context.Context, v interface{}) (models.BibInput, error) {
+	res, err := ec.unmarshalInputBibInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBibStrategy2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibStrategy(ctx context.Context, v interface{}) (models.BibStrategy, error) {
+	var res models.BibStrategy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBibStrategy2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibStrategy(ctx context.Context, sel ast.SelectionSet, v models.BibStrategy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCancelRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCancelRaceInput(ctx context.Context, v interface{}) (models.CancelRaceInput, error) {
+	res, err := ec.unmarshalInputCancelRaceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCancelRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCancelRaceResult(ctx context.Context, sel ast.SelectionSet, v models.CancelRaceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CancelRaceResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckIn2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CheckIn) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCheckIn2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckIn(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCheckIn2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckIn(ctx context.Context, sel ast.SelectionSet, v *models.CheckIn) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CheckIn(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCheckInInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInInput(ctx context.Context, v interface{}) (models.CheckInInput, error) {
+	res, err := ec.unmarshalInputCheckInInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCheckInResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckInResult(ctx context.Context, sel ast.SelectionSet, v models.CheckInResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CheckInResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckoutResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckoutResult(ctx context.Context, sel ast.SelectionSet, v models.CheckoutResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CheckoutResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckpoint2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Checkpoint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCheckpoint2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpoint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCheckpoint2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpoint(ctx context.Context, sel ast.SelectionSet, v *models.Checkpoint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Checkpoint(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCheckpointInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointInput(ctx context.Context, v interface{}) (*models.CheckpointInput, error) {
+	res, err := ec.unmarshalInputCheckpointInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCompetitorBib2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorBibᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CompetitorBib) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCompetitorBib2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorBib(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCompetitorBib2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorBib(ctx context.Context, sel ast.SelectionSet, v *models.CompetitorBib) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CompetitorBib(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCompetitorResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CompetitorResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCompetitorResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCompetitorResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorResult(ctx context.Context, sel ast.SelectionSet, v *models.CompetitorResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CompetitorResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCompetitorSplits2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorSplitsᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CompetitorSplits) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCompetitorSplits2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorSplits(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCompetitorSplits2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCompetitorSplits(ctx context.Context, sel ast.SelectionSet, v *models.CompetitorSplits) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CompetitorSplits(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCoursePoint2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCoursePointᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.CoursePoint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCoursePoint2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCoursePoint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCoursePoint2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCoursePoint(ctx context.Context, sel ast.SelectionSet, v *models.CoursePoint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CoursePoint(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCourseUploadInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCourseUploadInput(ctx context.Context, v interface{}) (models.CourseUploadInput, error) {
+	res, err := ec.unmarshalInputCourseUploadInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCreateCalendarTokenResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateCalendarTokenResult(ctx context.Context, sel ast.SelectionSet, v models.CreateCalendarTokenResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreateCalendarTokenResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCreateDiscountCodeResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateDiscountCodeResult(ctx context.Context, sel ast.SelectionSet, v models.CreateDiscountCodeResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreateDiscountCodeResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCreateOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateOrganizationResult(ctx context.Context, sel ast.SelectionSet, v models.CreateOrganizationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreateOrganizationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCreateRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateRaceResult(ctx context.Context, sel ast.SelectionSet, v models.CreateRaceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreateRaceResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCreateSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateSeriesResult(ctx context.Context, sel ast.SelectionSet, v models.CreateSeriesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CreateSeriesResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNDiscountCode2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.DiscountCode) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDiscountCode2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCode(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNDiscountCode2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCode(ctx context.Context, sel ast.SelectionSet, v *models.DiscountCode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._DiscountCode(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDiscountCodeInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodeInput(ctx context.Context, v interface{}) (models.DiscountCodeInput, error) {
+	res, err := ec.unmarshalInputDiscountCodeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDiscountCodesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐDiscountCodesResult(ctx context.Context, sel ast.SelectionSet, v models.DiscountCodesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._DiscountCodesResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEnterTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐEnterTeamResult(ctx context.Context, sel ast.SelectionSet, v models.EnterTeamResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EnterTeamResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNEraseMyDataResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐEraseMyDataResult(ctx context.Context, sel ast.SelectionSet, v models.EraseMyDataResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._EraseMyDataResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNErasureResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐErasureResult(ctx context.Context, sel ast.SelectionSet, v models.ErasureResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ErasureResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNErasureStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐErasureStatus(ctx context.Context, v interface{}) (models.ErasureStatus, error) {
+	var res models.ErasureStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNErasureStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐErasureStatus(ctx context.Context, sel ast.SelectionSet, v models.ErasureStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNExportMyDataResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐExportMyDataResult(ctx context.Context, sel ast.SelectionSet, v models.ExportMyDataResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ExportMyDataResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNGenerateSeriesRacesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGenerateSeriesRacesResult(ctx context.Context, sel ast.SelectionSet, v models.GenerateSeriesRacesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._GenerateSeriesRacesResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNImportIssue2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssueᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ImportIssue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNImportIssue2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNImportIssue2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssue(ctx context.Context, sel ast.SelectionSet, v *models.ImportIssue) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ImportIssue(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNImportIssueKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssueKind(ctx context.Context, v interface{}) (models.ImportIssueKind, error) {
+	var res models.ImportIssueKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNImportIssueKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportIssueKind(ctx context.Context, sel ast.SelectionSet, v models.ImportIssueKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNImportResultsResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportResultsResult(ctx context.Context, sel ast.SelectionSet, v models.ImportResultsResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ImportResultsResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNImportedResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportedResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.ImportedResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNImportedResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportedResult(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNImportedResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐImportedResult(ctx context.Context, sel ast.SelectionSet, v *models.ImportedResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._ImportedResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNJSON2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJSON(ctx context.Context, v interface{}) (models.JSON, error) {
+	var res models.JSON
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNJSON2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJSON(ctx context.Context, sel ast.SelectionSet, v models.JSON) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNJoinRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJoinRaceInput(ctx context.Context, v interface{}) (models.JoinRaceInput, error) {
+	res, err := ec.unmarshalInputJoinRaceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNJoinRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐJoinRaceResult(ctx context.Context, sel ast.SelectionSet, v models.JoinRaceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._JoinRaceResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNLegSplitInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐLegSplitInput(ctx context.Context, v interface{}) (models.LegSplitInput, error) {
+	res, err := ec.unmarshalInputLegSplitInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMoney2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoneyᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Money) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMoney2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoney(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNMoney2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoney(ctx context.Context, sel ast.SelectionSet, v *models.Money) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Money(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNNotificationKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKind(ctx context.Context, v interface{}) (models.NotificationKind, error) {
+	var res models.NotificationKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNNotificationKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKind(ctx context.Context, sel ast.SelectionSet, v models.NotificationKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNNotificationKind2ᚕgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKindᚄ(ctx context.Context, v interface{}) ([]models.NotificationKind, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]models.NotificationKind, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNNotificationKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKind(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNNotificationKind2ᚕgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKindᚄ(ctx context.Context, sel ast.SelectionSet, v []models.NotificationKind) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNotificationKind2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationKind(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalNNotificationPreferencesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationPreferencesInput(ctx context.Context, v interface{}) (models.NotificationPreferencesInput, error) {
+	res, err := ec.unmarshalInputNotificationPreferencesInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNNotificationPreferencesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNotificationPreferencesResult(ctx context.Context, sel ast.SelectionSet, v models.NotificationPreferencesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._NotificationPreferencesResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNOfferRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOfferRegistrationInput(ctx context.Context, v interface{}) (models.OfferRegistrationInput, error) {
+	res, err := ec.unmarshalInputOfferRegistrationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOfferRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOfferRegistrationResult(ctx context.Context, sel ast.SelectionSet, v models.OfferRegistrationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._OfferRegistrationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNOrganizationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationInput(ctx context.Context, v interface{}) (models.OrganizationInput, error) {
+	res, err := ec.unmarshalInputOrganizationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOrganizationMember2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.OrganizationMember) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrganizationMember2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMember(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNOrganizationMember2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMember(ctx context.Context, sel ast.SelectionSet, v *models.OrganizationMember) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._OrganizationMember(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNOrganizationMemberInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberInput(ctx context.Context, v interface{}) (models.OrganizationMemberInput, error) {
+	res, err := ec.unmarshalInputOrganizationMemberInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOrganizationMemberResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationMemberResult(ctx context.Context, sel ast.SelectionSet, v models.OrganizationMemberResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._OrganizationMemberResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNOrganizationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationResult(ctx context.Context, sel ast.SelectionSet, v models.OrganizationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._OrganizationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNOrganizationRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationRole(ctx context.Context, v interface{}) (models.OrganizationRole, error) {
+	var res models.OrganizationRole
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNOrganizationRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationRole(ctx context.Context, sel ast.SelectionSet, v models.OrganizationRole) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *models.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPassageInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassageInputᚄ(ctx context.Context, v interface{}) ([]*models.PassageInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*models.PassageInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPassageInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassageInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalNPassageInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassageInput(ctx context.Context, v interface{}) (*models.PassageInput, error) {
+	res, err := ec.unmarshalInputPassageInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNPassagesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPassagesInput(ctx context.Context, v interface{}) (models.PassagesInput, error) {
+	res, err := ec.unmarshalInputPassagesInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPriceTier2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.PriceTier) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPriceTier2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTier(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNPriceTier2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTier(ctx context.Context, sel ast.SelectionSet, v *models.PriceTier) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PriceTier(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPriceTierInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierInput(ctx context.Context, v interface{}) (*models.PriceTierInput, error) {
+	res, err := ec.unmarshalInputPriceTierInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Race) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx context.Context, sel ast.SelectionSet, v *models.Race) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Race(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRaceCategory2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RaceCategory) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRaceCategory2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategory(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRaceCategory2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategory(ctx context.Context, sel ast.SelectionSet, v *models.RaceCategory) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceCategory(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRaceCategoryInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryInput(ctx context.Context, v interface{}) (*models.RaceCategoryInput, error) {
+	res, err := ec.unmarshalInputRaceCategoryInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRaceDashboard2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceDashboardᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RaceDashboard) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRaceDashboard2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceDashboard(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRaceDashboard2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceDashboard(ctx context.Context, sel ast.SelectionSet, v *models.RaceDashboard) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceDashboard(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceInput(ctx context.Context, v interface{}) (models.RaceInput, error) {
+	res, err := ec.unmarshalInputRaceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRaceMatch2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RaceMatch) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRaceMatch2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceMatch(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRaceMatch2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceMatch(ctx context.Context, sel ast.SelectionSet, v *models.RaceMatch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceMatch(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRaceOrganizationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceOrganizationInput(ctx context.Context, v interface{}) (models.RaceOrganizationInput, error) {
+	res, err := ec.unmarshalInputRaceOrganizationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRaceOwnerInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceOwnerInput(ctx context.Context, v interface{}) (models.RaceOwnerInput, error) {
+	res, err := ec.unmarshalInputRaceOwnerInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResult(ctx context.Context, sel ast.SelectionSet, v models.RaceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRaceResultInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResultInput(ctx context.Context, v interface{}) (models.RaceResultInput, error) {
+	res, err := ec.unmarshalInputRaceResultInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNRaceStaffInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffInput(ctx context.Context, v interface{}) (models.RaceStaffInput, error) {
+	res, err := ec.unmarshalInputRaceStaffInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRaceStaffMember2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffMemberᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RaceStaffMember) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRaceStaffMember2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffMember(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRaceStaffMember2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffMember(ctx context.Context, sel ast.SelectionSet, v *models.RaceStaffMember) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceStaffMember(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRaceStaffResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStaffResult(ctx context.Context, sel ast.SelectionSet, v models.RaceStaffResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RaceStaffResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRaceStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStatus(ctx context.Context, v interface{}) (models.RaceStatus, error) {
+	var res models.RaceStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRaceStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceStatus(ctx context.Context, sel ast.SelectionSet, v models.RaceStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRaces2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx context.Context, sel ast.SelectionSet, v models.Races) graphql.Marshaler {
+	return ec._Races(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRaces2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx context.Context, sel ast.SelectionSet, v *models.Races) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Races(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRacesNearResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacesNearResult(ctx context.Context, sel ast.SelectionSet, v models.RacesNearResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RacesNearResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRecordLegSplitResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordLegSplitResult(ctx context.Context, sel ast.SelectionSet, v models.RecordLegSplitResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RecordLegSplitResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRecordPassagesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordPassagesResult(ctx context.Context, sel ast.SelectionSet, v models.RecordPassagesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RecordPassagesResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRecordResultResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRecordResultResult(ctx context.Context, sel ast.SelectionSet, v models.RecordResultResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RecordResultResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRefundRegistrationInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRefundRegistrationInput(ctx context.Context, v interface{}) (models.RefundRegistrationInput, error) {
+	res, err := ec.unmarshalInputRefundRegistrationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRefundRegistrationResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRefundRegistrationResult(ctx context.Context, sel ast.SelectionSet, v models.RefundRegistrationResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RefundRegistrationResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRegistrationOffer2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationOfferᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RegistrationOffer) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNRegistrationOffer2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationOffer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRegistrationOffer2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationOffer(ctx context.Context, sel ast.SelectionSet, v *models.RegistrationOffer) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RegistrationOffer(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRegistrationStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationStatus(ctx context.Context, v interface{}) (models.RegistrationStatus, error) {
+	var res models.RegistrationStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRegistrationStatus2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationStatus(ctx context.Context, sel ast.SelectionSet, v models.RegistrationStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNRegistrationTransfersInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationTransfersInput(ctx context.Context, v interface{}) (models.RegistrationTransfersInput, error) {
+	res, err := ec.unmarshalInputRegistrationTransfersInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRejectedPassage2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRejectedPassageᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RejectedPassage) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRejectedPassage2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRejectedPassage(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRejectedPassage2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRejectedPassage(ctx context.Context, sel ast.SelectionSet, v *models.RejectedPassage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RejectedPassage(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRelayLeg2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RelayLeg) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRelayLeg2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLeg(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRelayLeg2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLeg(ctx context.Context, sel ast.SelectionSet, v *models.RelayLeg) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RelayLeg(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRelayLegInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegInputᚄ(ctx context.Context, v interface{}) ([]*models.RelayLegInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*models.RelayLegInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRelayLegInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res, nil
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) unmarshalNRelayLegInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegInput(ctx context.Context, v interface{}) (*models.RelayLegInput, error) {
+	res, err := ec.unmarshalInputRelayLegInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "race":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_race(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "races":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_races(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNRelayLegResult2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegResultᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RelayLegResult) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRelayLegResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegResult(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRelayLegResult2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLegResult(ctx context.Context, sel ast.SelectionSet, v *models.RelayLegResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RelayLegResult(ctx, sel, v)
 }
 
-var raceImplementors = []string{"Race", "RaceResult", "CreateRaceResult"}
-
-func (ec *executionContext) _Race(ctx context.Context, sel ast.SelectionSet, obj *models.Race) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, raceImplementors)
+func (ec *executionContext) unmarshalNRelayLineUpInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayLineUpInput(ctx context.Context, v interface{}) (models.RelayLineUpInput, error) {
+	res, err := ec.unmarshalInputRelayLineUpInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Race")
-		case "id":
-			out.Values[i] = ec._Race_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._Race_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "date":
-			out.Values[i] = ec._Race_date(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "competitors":
-			out.Values[i] = ec._Race_competitors(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNRelayStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayStandingᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.RelayStanding) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNRelayStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayStanding(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNRelayStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRelayStanding(ctx context.Context, sel ast.SelectionSet, v *models.RelayStanding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RelayStanding(ctx, sel, v)
 }
 
-var raceAlreadyExistsImplementors = []string{"RaceAlreadyExists", "CreateRaceResult", "Error"}
+func (ec *executionContext) unmarshalNRemoveOrganizationMemberInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRemoveOrganizationMemberInput(ctx context.Context, v interface{}) (models.RemoveOrganizationMemberInput, error) {
+	res, err := ec.unmarshalInputRemoveOrganizationMemberInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _RaceAlreadyExists(ctx context.Context, sel ast.SelectionSet, obj *models.RaceAlreadyExists) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, raceAlreadyExistsImplementors)
+func (ec *executionContext) unmarshalNRemoveRaceStaffInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRemoveRaceStaffInput(ctx context.Context, v interface{}) (models.RemoveRaceStaffInput, error) {
+	res, err := ec.unmarshalInputRemoveRaceStaffInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RaceAlreadyExists")
-		case "message":
-			out.Values[i] = ec._RaceAlreadyExists_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNRescheduleRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRescheduleRaceInput(ctx context.Context, v interface{}) (models.RescheduleRaceInput, error) {
+	res, err := ec.unmarshalInputRescheduleRaceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRescheduleRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRescheduleRaceResult(ctx context.Context, sel ast.SelectionSet, v models.RescheduleRaceResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return ec._RescheduleRaceResult(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNResultsImportInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐResultsImportInput(ctx context.Context, v interface{}) (models.ResultsImportInput, error) {
+	res, err := ec.unmarshalInputResultsImportInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRevokeCalendarTokenResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRevokeCalendarTokenResult(ctx context.Context, sel ast.SelectionSet, v models.RevokeCalendarTokenResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._RevokeCalendarTokenResult(ctx, sel, v)
 }
 
-var raceNotFoundImplementors = []string{"RaceNotFound", "RaceResult", "Error"}
+func (ec *executionContext) marshalNSearchRacesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSearchRacesResult(ctx context.Context, sel ast.SelectionSet, v models.SearchRacesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SearchRacesResult(ctx, sel, v)
+}
 
-func (ec *executionContext) _RaceNotFound(ctx context.Context, sel ast.SelectionSet, obj *models.RaceNotFound) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, raceNotFoundImplementors)
+func (ec *executionContext) unmarshalNSeriesInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesInput(ctx context.Context, v interface{}) (models.SeriesInput, error) {
+	res, err := ec.unmarshalInputSeriesInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RaceNotFound")
-		case "message":
-			out.Values[i] = ec._RaceNotFound_message(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSeriesRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesRaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.SeriesRace) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSeriesRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesRace(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSeriesRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesRace(ctx context.Context, sel ast.SelectionSet, v *models.SeriesRace) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._SeriesRace(ctx, sel, v)
 }
 
-var racesImplementors = []string{"Races"}
-
-func (ec *executionContext) _Races(ctx context.Context, sel ast.SelectionSet, obj *models.Races) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, racesImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Races")
-		case "races":
-			out.Values[i] = ec._Races_races(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesResult(ctx context.Context, sel ast.SelectionSet, v models.SeriesResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._SeriesResult(ctx, sel, v)
 }
 
-var userImplementors = []string{"User"}
-
-func (ec *executionContext) _User(ctx context.Context, sel ast.SelectionSet, obj *models.User) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("User")
-		case "id":
-			out.Values[i] = ec._User_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._User_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "races":
-			out.Values[i] = ec._User_races(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSeriesStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesStandingᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.SeriesStanding) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSeriesStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesStanding(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSeriesStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSeriesStanding(ctx context.Context, sel ast.SelectionSet, v *models.SeriesStanding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._SeriesStanding(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNSetRegistrationTransfersResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSetRegistrationTransfersResult(ctx context.Context, sel ast.SelectionSet, v models.SetRegistrationTransfersResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._SetRegistrationTransfersResult(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalNSetRelayLineUpResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSetRelayLineUpResult(ctx context.Context, sel ast.SelectionSet, v models.SetRelayLineUpResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._SetRelayLineUpResult(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNSplit2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSplitᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Split) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSplit2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSplit(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSplit2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐSplit(ctx context.Context, sel ast.SelectionSet, v *models.Split) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._Split(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNStaffRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐStaffRole(ctx context.Context, v interface{}) (models.StaffRole, error) {
+	var res models.StaffRole
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNStaffRole2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐStaffRole(ctx context.Context, sel ast.SelectionSet, v models.StaffRole) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) unmarshalNTeamEntryInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamEntryInput(ctx context.Context, v interface{}) (models.TeamEntryInput, error) {
+	res, err := ec.unmarshalInputTeamEntryInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNTeamInvitation2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamInvitationᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.TeamInvitation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNTeamInvitation2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamInvitation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNTeamInvitation2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamInvitation(ctx context.Context, sel ast.SelectionSet, v *models.TeamInvitation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._TeamInvitation(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNTeamResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamResult(ctx context.Context, sel ast.SelectionSet, v models.TeamResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._TeamResult(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNTeamScoring2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamScoring(ctx context.Context, v interface{}) (models.TeamScoring, error) {
+	var res models.TeamScoring
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalNTeamScoring2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamScoring(ctx context.Context, sel ast.SelectionSet, v models.TeamScoring) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNTeamStanding2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamStandingᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.TeamStanding) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNTeamStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamStanding(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNTeamStanding2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamStanding(ctx context.Context, sel ast.SelectionSet, v *models.TeamStanding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._TeamStanding(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
-
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNTeamUserInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐTeamUserInput(ctx context.Context, v interface{}) (models.TeamUserInput, error) {
+	res, err := ec.unmarshalInputTeamUserInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNUpdateNotificationPreferencesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUpdateNotificationPreferencesResult(ctx context.Context, sel ast.SelectionSet, v models.UpdateNotificationPreferencesResult) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._UpdateNotificationPreferencesResult(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCreateRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCreateRaceResult(ctx context.Context, sel ast.SelectionSet, v models.CreateRaceResult) graphql.Marshaler {
+func (ec *executionContext) marshalNUpdateSeriesResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUpdateSeriesResult(ctx context.Context, sel ast.SelectionSet, v models.UpdateSeriesResult) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._CreateRaceResult(ctx, sel, v)
+	return ec._UpdateSeriesResult(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNDateTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
-	res, err := graphql.UnmarshalTime(v)
+func (ec *executionContext) unmarshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx context.Context, v interface{}) (graphql.Upload, error) {
+	res, err := graphql.UnmarshalUpload(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
-	res := graphql.MarshalTime(v)
+func (ec *executionContext) marshalNUpload2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚐUpload(ctx context.Context, sel ast.SelectionSet, v graphql.Upload) graphql.Marshaler {
+	res := graphql.MarshalUpload(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
@@ -2896,22 +28106,78 @@ func (ec *executionContext) marshalNDateTime2timeᚐTime(ctx context.Context, se
 	return res
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNUploadCourseResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUploadCourseResult(ctx context.Context, sel ast.SelectionSet, v models.UploadCourseResult) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._UploadCourseResult(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.User) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx context.Context, sel ast.SelectionSet, v *models.User) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._User(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNWithdrawRegistrationOfferResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐWithdrawRegistrationOfferResult(ctx context.Context, sel ast.SelectionSet, v models.WithdrawRegistrationOfferResult) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._WithdrawRegistrationOfferResult(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.Race) graphql.Marshaler {
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -2935,7 +28201,7 @@ func (ec *executionContext) marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -2948,61 +28214,43 @@ func (ec *executionContext) marshalNRace2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNRace2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRace(ctx context.Context, sel ast.SelectionSet, v *models.Race) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Race(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNRaceInput2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceInput(ctx context.Context, v interface{}) (models.RaceInput, error) {
-	res, err := ec.unmarshalInputRaceInput(ctx, v)
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRaceResult2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceResult(ctx context.Context, sel ast.SelectionSet, v models.RaceResult) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	return ec._RaceResult(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNRaces2githubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx context.Context, sel ast.SelectionSet, v models.Races) graphql.Marshaler {
-	return ec._Races(ctx, sel, &v)
+	return res
 }
 
-func (ec *executionContext) marshalNRaces2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaces(ctx context.Context, sel ast.SelectionSet, v *models.Races) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
-		return graphql.Null
 	}
-	return ec._Races(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-	return res
+	return res, nil
 }
 
-func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUserᚄ(ctx context.Context, sel ast.SelectionSet, v []*models.User) graphql.Marshaler {
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -3026,7 +28274,7 @@ func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx, sel, v[i])
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -3039,21 +28287,60 @@ func (ec *executionContext) marshalNUser2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNUser2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐUser(ctx context.Context, sel ast.SelectionSet, v *models.User) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._User(ctx, sel, v)
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -3077,7 +28364,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -3090,22 +28377,159 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalOAuditLogFilter2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐAuditLogFilter(ctx context.Context, v interface{}) (*models.AuditLogFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAuditLogFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBibRange2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibRange(ctx context.Context, sel ast.SelectionSet, v *models.BibRange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BibRange(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOBibRangeInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐBibRangeInput(ctx context.Context, v interface{}) (*models.BibRangeInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputBibRangeInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	return graphql.MarshalBoolean(v)
+}
+
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalBoolean(*v)
+}
+
+func (ec *executionContext) unmarshalOCheckpointInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointInputᚄ(ctx context.Context, v interface{}) ([]*models.CheckpointInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*models.CheckpointInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCheckpointInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCheckpointInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOCourse2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐCourse(ctx context.Context, sel ast.SelectionSet, v *models.Course) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Course(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalODateTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalTime(*v)
+}
+
+func (ec *executionContext) unmarshalOGender2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGender(ctx context.Context, v interface{}) (*models.Gender, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(models.Gender)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOGender2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐGender(ctx context.Context, sel ast.SelectionSet, v *models.Gender) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return graphql.MarshalID(*v)
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+func (ec *executionContext) unmarshalOInt2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	if v == nil {
+		return nil, nil
+	}
 	var vSlice []interface{}
 	if v != nil {
 		if tmp1, ok := v.([]interface{}); ok {
@@ -3115,10 +28539,10 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 		}
 	}
 	var err error
-	res := make([]string, len(vSlice))
+	res := make([]int, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -3126,180 +28550,206 @@ func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx conte
 	return res, nil
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) marshalOInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	ret := make(graphql.Array, len(v))
 	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
-		} else {
-			go f(i)
-		}
-
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
 	}
-	wg.Wait()
+
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*v)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalOMoney2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐMoney(ctx context.Context, sel ast.SelectionSet, v *models.Money) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Money(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) unmarshalONearInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐNearInput(ctx context.Context, v interface{}) (*models.NearInput, error) {
+	if v == nil {
+		return nil, nil
 	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
+	res, err := ec.unmarshalInputNearInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOOrganizationDashboard2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐOrganizationDashboard(ctx context.Context, sel ast.SelectionSet, v *models.OrganizationDashboard) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._OrganizationDashboard(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPriceTierInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierInputᚄ(ctx context.Context, v interface{}) ([]*models.PriceTierInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
 		} else {
-			go f(i)
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*models.PriceTierInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPriceTierInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐPriceTierInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORaceBibs2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceBibs(ctx context.Context, sel ast.SelectionSet, v *models.RaceBibs) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RaceBibs(ctx, sel, v)
+}
 
+func (ec *executionContext) unmarshalORaceBibsInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceBibsInput(ctx context.Context, v interface{}) (*models.RaceBibsInput, error) {
+	if v == nil {
+		return nil, nil
 	}
-	wg.Wait()
-	return ret
+	res, err := ec.unmarshalInputRaceBibsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) marshalORaceCancellation2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCancellation(ctx context.Context, sel ast.SelectionSet, v *models.RaceCancellation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RaceCancellation(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
-	ret := make(graphql.Array, len(v))
-	var wg sync.WaitGroup
-	isLen1 := len(v) == 1
-	if !isLen1 {
-		wg.Add(len(v))
+func (ec *executionContext) unmarshalORaceCategoryInput2ᚕᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryInputᚄ(ctx context.Context, v interface{}) ([]*models.RaceCategoryInput, error) {
+	if v == nil {
+		return nil, nil
 	}
-	for i := range v {
-		i := i
-		fc := &graphql.FieldContext{
-			Index:  &i,
-			Result: &v[i],
-		}
-		ctx := graphql.WithFieldContext(ctx, fc)
-		f := func(i int) {
-			defer func() {
-				if r := recover(); r != nil {
-					ec.Error(ctx, ec.Recover(ctx, r))
-					ret = nil
-				}
-			}()
-			if !isLen1 {
-				defer wg.Done()
-			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
-		}
-		if isLen1 {
-			f(i)
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
 		} else {
-			go f(i)
+			vSlice = []interface{}{v}
 		}
+	}
+	var err error
+	res := make([]*models.RaceCategoryInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRaceCategoryInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceCategoryInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
+func (ec *executionContext) marshalORacePrice2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePrice(ctx context.Context, sel ast.SelectionSet, v *models.RacePrice) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	wg.Wait()
-	return ret
+	return ec._RacePrice(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) unmarshalORacePriceInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRacePriceInput(ctx context.Context, v interface{}) (*models.RacePriceInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRacePriceInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORaceRelay2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceRelay(ctx context.Context, sel ast.SelectionSet, v *models.RaceRelay) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec._RaceRelay(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalORaceRelayInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceRelayInput(ctx context.Context, v interface{}) (*models.RaceRelayInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRaceRelayInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) unmarshalORaceSearchFilter2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceSearchFilter(ctx context.Context, v interface{}) (*models.RaceSearchFilter, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res
+	res, err := ec.unmarshalInputRaceSearchFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalORaceTeams2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTeams(ctx context.Context, sel ast.SelectionSet, v *models.RaceTeams) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RaceTeams(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	return graphql.MarshalBoolean(v)
+func (ec *executionContext) unmarshalORaceTeamsInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTeamsInput(ctx context.Context, v interface{}) (*models.RaceTeamsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRaceTeamsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+func (ec *executionContext) marshalORaceTransfer2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTransfer(ctx context.Context, sel ast.SelectionSet, v *models.RaceTransfer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RaceTransfer(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORaceTransferInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRaceTransferInput(ctx context.Context, v interface{}) (*models.RaceTransferInput, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
+	res, err := ec.unmarshalInputRaceTransferInput(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalORegistrationTransferPolicy2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐRegistrationTransferPolicy(ctx context.Context, sel ast.SelectionSet, v *models.RegistrationTransferPolicy) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return graphql.MarshalBoolean(*v)
+	return ec._RegistrationTransferPolicy(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOResultsColumnMappingInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐResultsColumnMappingInput(ctx context.Context, v interface{}) (*models.ResultsColumnMappingInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputResultsColumnMappingInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
@@ -3326,6 +28776,21 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return graphql.MarshalString(*v)
 }
 
+func (ec *executionContext) marshalOVenue2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenue(ctx context.Context, sel ast.SelectionSet, v *models.Venue) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Venue(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOVenueInput2ᚖgithubᚗcomᚋxabi93ᚋracersᚋinternalᚋserverᚋgraphᚋmodelsᚐVenueInput(ctx context.Context, v interface{}) (*models.VenueInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputVenueInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -0,0 +1,1872 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type AcceptRegistrationResult interface {
+	IsAcceptRegistrationResult()
+}
+
+type AssignBibResult interface {
+	IsAssignBibResult()
+}
+
+type AssignRaceToOrganizationResult interface {
+	IsAssignRaceToOrganizationResult()
+}
+
+type AuditLogResult interface {
+	IsAuditLogResult()
+}
+
+type CancelRaceResult interface {
+	IsCancelRaceResult()
+}
+
+type CheckInResult interface {
+	IsCheckInResult()
+}
+
+type CheckoutResult interface {
+	IsCheckoutResult()
+}
+
+type CreateCalendarTokenResult interface {
+	IsCreateCalendarTokenResult()
+}
+
+type CreateDiscountCodeResult interface {
+	IsCreateDiscountCodeResult()
+}
+
+type CreateOrganizationResult interface {
+	IsCreateOrganizationResult()
+}
+
+type CreateRaceResult interface {
+	IsCreateRaceResult()
+}
+
+type CreateSeriesResult interface {
+	IsCreateSeriesResult()
+}
+
+type DiscountCodesResult interface {
+	IsDiscountCodesResult()
+}
+
+type EnterTeamResult interface {
+	IsEnterTeamResult()
+}
+
+type EraseMyDataResult interface {
+	IsEraseMyDataResult()
+}
+
+type ErasureResult interface {
+	IsErasureResult()
+}
+
+type Error interface {
+	IsError()
+}
+
+type ExportMyDataResult interface {
+	IsExportMyDataResult()
+}
+
+type GenerateSeriesRacesResult interface {
+	IsGenerateSeriesRacesResult()
+}
+
+type ImportResultsResult interface {
+	IsImportResultsResult()
+}
+
+type JoinRaceResult interface {
+	IsJoinRaceResult()
+}
+
+type NotificationPreferencesResult interface {
+	IsNotificationPreferencesResult()
+}
+
+type OfferRegistrationResult interface {
+	IsOfferRegistrationResult()
+}
+
+type OrganizationMemberResult interface {
+	IsOrganizationMemberResult()
+}
+
+type OrganizationResult interface {
+	IsOrganizationResult()
+}
+
+type RaceResult interface {
+	IsRaceResult()
+}
+
+type RaceStaffResult interface {
+	IsRaceStaffResult()
+}
+
+type RacesNearResult interface {
+	IsRacesNearResult()
+}
+
+type RecordLegSplitResult interface {
+	IsRecordLegSplitResult()
+}
+
+type RecordPassagesResult interface {
+	IsRecordPassagesResult()
+}
+
+type RecordResultResult interface {
+	IsRecordResultResult()
+}
+
+type RefundRegistrationResult interface {
+	IsRefundRegistrationResult()
+}
+
+type RescheduleRaceResult interface {
+	IsRescheduleRaceResult()
+}
+
+type RevokeCalendarTokenResult interface {
+	IsRevokeCalendarTokenResult()
+}
+
+type SearchRacesResult interface {
+	IsSearchRacesResult()
+}
+
+type SeriesResult interface {
+	IsSeriesResult()
+}
+
+type SetRegistrationTransfersResult interface {
+	IsSetRegistrationTransfersResult()
+}
+
+type SetRelayLineUpResult interface {
+	IsSetRelayLineUpResult()
+}
+
+type TeamResult interface {
+	IsTeamResult()
+}
+
+type UpdateNotificationPreferencesResult interface {
+	IsUpdateNotificationPreferencesResult()
+}
+
+type UpdateSeriesResult interface {
+	IsUpdateSeriesResult()
+}
+
+type UploadCourseResult interface {
+	IsUploadCourseResult()
+}
+
+type WithdrawRegistrationOfferResult interface {
+	IsWithdrawRegistrationOfferResult()
+}
+
+type AcceptRegistrationInput struct {
+	RaceID string `json:"raceId"`
+	// the competitor that offered the registration
+	UserID string `json:"userId"`
+}
+
+type AuditLog struct {
+	Edges    []*AuditLogEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+func (AuditLog) IsAuditLogResult() {}
+
+type AuditLogEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *AuditLogEntry `json:"node"`
+}
+
+type AuditLogEntry struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Actor          *User     `json:"actor"`
+	RaceID         *string   `json:"raceId"`
+	OrganizationID *string   `json:"organizationId"`
+	OccurredAt     time.Time `json:"occurredAt"`
+	Payload        JSON      `json:"payload"`
+}
+
+type AuditLogFilter struct {
+	UserID *string `json:"userId"`
+	RaceID *string `json:"raceId"`
+	// required unless the current user is a service administrator
+	OrganizationID *string    `json:"organizationId"`
+	Type           *string    `json:"type"`
+	From           *time.Time `json:"from"`
+	To             *time.Time `json:"to"`
+}
+
+type BibInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+	Bib    int    `json:"bib"`
+}
+
+type BibRange struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type BibRangeInput struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+type CalendarToken struct {
+	// only returned once, create a new token if it is lost
+	Secret string `json:"secret"`
+	// path of the personal feed, /calendar/{secret}.ics
+	Feed      string    `json:"feed"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (CalendarToken) IsCreateCalendarTokenResult() {}
+
+type CalendarTokenRevoked struct {
+	Revoked bool `json:"revoked"`
+}
+
+func (CalendarTokenRevoked) IsRevokeCalendarTokenResult() {}
+
+type CancelRaceInput struct {
+	RaceID string `json:"raceId"`
+	// told to the competitors
+	Reason string `json:"reason"`
+	// the competitors are released when missing
+	TransferTo *RaceTransferInput `json:"transferTo"`
+}
+
+type CancellationError struct {
+	Message string `json:"message"`
+}
+
+func (CancellationError) IsError()            {}
+func (CancellationError) IsCancelRaceResult() {}
+
+type CheckIn struct {
+	Competitor *User     `json:"competitor"`
+	At         time.Time `json:"at"`
+}
+
+type CheckInError struct {
+	Message string `json:"message"`
+}
+
+func (CheckInError) IsError()         {}
+func (CheckInError) IsCheckInResult() {}
+
+type CheckInInput struct {
+	RaceID string `json:"raceId"`
+	// the competitor is identified by the QR payload of the confirmation email, the user id or the bib number
+	Qr     *string `json:"qr"`
+	UserID *string `json:"userId"`
+	Bib    *int    `json:"bib"`
+}
+
+type Checkout struct {
+	PaymentID string `json:"paymentId"`
+	// the page the competitor pays at
+	URL string `json:"url"`
+}
+
+func (Checkout) IsCheckoutResult() {}
+
+type Checkpoint struct {
+	Name string `json:"name"`
+	// distance along the course in metres
+	Distance int `json:"distance"`
+	// max time since the start to pass the checkpoint, no cutoff when missing
+	Cutoff *string `json:"cutoff"`
+}
+
+type CheckpointInput struct {
+	Name string `json:"name"`
+	// distance along the course in metres
+	Distance int `json:"distance"`
+	// max time since the start in [[hh:]mm:]ss format, no cutoff when missing
+	Cutoff *string `json:"cutoff"`
+}
+
+type CompetitorBib struct {
+	Bib        int   `json:"bib"`
+	Competitor *User `json:"competitor"`
+}
+
+type CompetitorCheckIn struct {
+	RaceID     string             `json:"raceId"`
+	Competitor *User              `json:"competitor"`
+	Category   *string            `json:"category"`
+	Bib        *int               `json:"bib"`
+	Status     RegistrationStatus `json:"status"`
+	// missing until the competitor is checked in
+	CheckedInAt *time.Time `json:"checkedInAt"`
+}
+
+func (CompetitorCheckIn) IsCheckInResult() {}
+
+type CompetitorNotInRaceError struct {
+	Message string `json:"message"`
+}
+
+func (CompetitorNotInRaceError) IsAssignBibResult()    {}
+func (CompetitorNotInRaceError) IsCheckInResult()      {}
+func (CompetitorNotInRaceError) IsError()              {}
+func (CompetitorNotInRaceError) IsRecordResultResult() {}
+
+type CompetitorResult struct {
+	Position   int    `json:"position"`
+	Competitor *User  `json:"competitor"`
+	Time       string `json:"time"`
+}
+
+type CompetitorSplits struct {
+	Competitor *User `json:"competitor"`
+	// splits in course order, checkpoints not passed yet are missing
+	Splits []*Split `json:"splits"`
+}
+
+type Course struct {
+	// distance in metres
+	Distance int `json:"distance"`
+	// elevation gain in metres
+	ElevationGain int `json:"elevationGain"`
+	// elevation loss in metres
+	ElevationLoss int `json:"elevationLoss"`
+	// simplified course in encoded polyline format
+	Polyline string         `json:"polyline"`
+	Points   []*CoursePoint `json:"points"`
+}
+
+type CoursePoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+	// elevation in metres
+	Elevation float64 `json:"elevation"`
+}
+
+type CourseUploadInput struct {
+	RaceID string `json:"raceId"`
+	// category the course belongs to, the race course when missing
+	Category *string `json:"category"`
+	// GPX or KML file
+	File graphql.Upload `json:"file"`
+}
+
+type DataExport struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	// the zip file encoded in base64
+	Data string `json:"data"`
+}
+
+func (DataExport) IsExportMyDataResult() {}
+
+type DiscountCode struct {
+	RaceID         string     `json:"raceId"`
+	Code           string     `json:"code"`
+	Percent        *int       `json:"percent"`
+	Amount         *int       `json:"amount"`
+	MaxRedemptions *int       `json:"maxRedemptions"`
+	ValidFrom      *time.Time `json:"validFrom"`
+	ValidUntil     *time.Time `json:"validUntil"`
+	Category       *string    `json:"category"`
+	Redemptions    int        `json:"redemptions"`
+}
+
+func (DiscountCode) IsCreateDiscountCodeResult() {}
+
+type DiscountCodeAlreadyExists struct {
+	Message string `json:"message"`
+}
+
+func (DiscountCodeAlreadyExists) IsError()                    {}
+func (DiscountCodeAlreadyExists) IsCreateDiscountCodeResult() {}
+
+type DiscountCodeInput struct {
+	RaceID string `json:"raceId"`
+	// letters, digits, - and _, case insensitive
+	Code string `json:"code"`
+	// percentage off the fee, 100 for a free entry, required when amount is missing
+	Percent *int `json:"percent"`
+	// amount off the fee in the minor unit of its currency, required when percent is missing
+	Amount *int `json:"amount"`
+	// no limit when missing
+	MaxRedemptions *int       `json:"maxRedemptions"`
+	ValidFrom      *time.Time `json:"validFrom"`
+	ValidUntil     *time.Time `json:"validUntil"`
+	// valid for any category when missing
+	Category *string `json:"category"`
+}
+
+// the code exists but can not be redeemed in the registration
+type DiscountCodeNotApplicableError struct {
+	Message string `json:"message"`
+}
+
+func (DiscountCodeNotApplicableError) IsError()          {}
+func (DiscountCodeNotApplicableError) IsJoinRaceResult() {}
+
+type DiscountCodeNotFound struct {
+	Message string `json:"message"`
+}
+
+func (DiscountCodeNotFound) IsError()          {}
+func (DiscountCodeNotFound) IsJoinRaceResult() {}
+
+type DiscountCodes struct {
+	Codes []*DiscountCode `json:"codes"`
+}
+
+func (DiscountCodes) IsDiscountCodesResult() {}
+
+type Erasure struct {
+	ID          string        `json:"id"`
+	Status      ErasureStatus `json:"status"`
+	RequestedAt time.Time     `json:"requestedAt"`
+	FinishedAt  *time.Time    `json:"finishedAt"`
+}
+
+func (Erasure) IsEraseMyDataResult() {}
+func (Erasure) IsErasureResult()     {}
+
+type ErasureNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErasureNotFound) IsError()         {}
+func (ErasureNotFound) IsErasureResult() {}
+
+type Forbidden struct {
+	Message string `json:"message"`
+}
+
+func (Forbidden) IsAuditLogResult()                      {}
+func (Forbidden) IsAssignBibResult()                     {}
+func (Forbidden) IsRescheduleRaceResult()                {}
+func (Forbidden) IsCreateCalendarTokenResult()           {}
+func (Forbidden) IsRevokeCalendarTokenResult()           {}
+func (Forbidden) IsCancelRaceResult()                    {}
+func (Forbidden) IsCheckInResult()                       {}
+func (Forbidden) IsRecordPassagesResult()                {}
+func (Forbidden) IsUploadCourseResult()                  {}
+func (Forbidden) IsDiscountCodesResult()                 {}
+func (Forbidden) IsCreateDiscountCodeResult()            {}
+func (Forbidden) IsNotificationPreferencesResult()       {}
+func (Forbidden) IsUpdateNotificationPreferencesResult() {}
+func (Forbidden) IsOrganizationMemberResult()            {}
+func (Forbidden) IsAssignRaceToOrganizationResult()      {}
+func (Forbidden) IsExportMyDataResult()                  {}
+func (Forbidden) IsEraseMyDataResult()                   {}
+func (Forbidden) IsErasureResult()                       {}
+func (Forbidden) IsRefundRegistrationResult()            {}
+func (Forbidden) IsSetRegistrationTransfersResult()      {}
+func (Forbidden) IsSetRelayLineUpResult()                {}
+func (Forbidden) IsRecordLegSplitResult()                {}
+func (Forbidden) IsImportResultsResult()                 {}
+func (Forbidden) IsRecordResultResult()                  {}
+func (Forbidden) IsError()                               {}
+func (Forbidden) IsUpdateSeriesResult()                  {}
+func (Forbidden) IsGenerateSeriesRacesResult()           {}
+func (Forbidden) IsRaceStaffResult()                     {}
+func (Forbidden) IsTeamResult()                          {}
+func (Forbidden) IsEnterTeamResult()                     {}
+
+type ImportIssue struct {
+	Line    int             `json:"line"`
+	Bib     string          `json:"bib"`
+	Kind    ImportIssueKind `json:"kind"`
+	Message string          `json:"message"`
+}
+
+type ImportedResult struct {
+	Line       int    `json:"line"`
+	Bib        int    `json:"bib"`
+	Competitor *User  `json:"competitor"`
+	Time       string `json:"time"`
+}
+
+type InvalidBibError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidBibError) IsError()              {}
+func (InvalidBibError) IsAssignBibResult()    {}
+func (InvalidBibError) IsCheckInResult()      {}
+func (InvalidBibError) IsRecordResultResult() {}
+
+type InvalidCourseError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidCourseError) IsError()              {}
+func (InvalidCourseError) IsUploadCourseResult() {}
+
+type InvalidCursorError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidCursorError) IsError()          {}
+func (InvalidCursorError) IsAuditLogResult() {}
+
+type InvalidDiscountCodeError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidDiscountCodeError) IsError()                    {}
+func (InvalidDiscountCodeError) IsCreateDiscountCodeResult() {}
+
+type InvalidIDError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidIDError) IsAuditLogResult()                  {}
+func (InvalidIDError) IsAssignBibResult()                 {}
+func (InvalidIDError) IsRescheduleRaceResult()            {}
+func (InvalidIDError) IsCancelRaceResult()                {}
+func (InvalidIDError) IsCheckInResult()                   {}
+func (InvalidIDError) IsRecordPassagesResult()            {}
+func (InvalidIDError) IsUploadCourseResult()              {}
+func (InvalidIDError) IsDiscountCodesResult()             {}
+func (InvalidIDError) IsCreateDiscountCodeResult()        {}
+func (InvalidIDError) IsOrganizationResult()              {}
+func (InvalidIDError) IsCreateOrganizationResult()        {}
+func (InvalidIDError) IsOrganizationMemberResult()        {}
+func (InvalidIDError) IsAssignRaceToOrganizationResult()  {}
+func (InvalidIDError) IsErasureResult()                   {}
+func (InvalidIDError) IsJoinRaceResult()                  {}
+func (InvalidIDError) IsCheckoutResult()                  {}
+func (InvalidIDError) IsRefundRegistrationResult()        {}
+func (InvalidIDError) IsSetRegistrationTransfersResult()  {}
+func (InvalidIDError) IsOfferRegistrationResult()         {}
+func (InvalidIDError) IsWithdrawRegistrationOfferResult() {}
+func (InvalidIDError) IsAcceptRegistrationResult()        {}
+func (InvalidIDError) IsSetRelayLineUpResult()            {}
+func (InvalidIDError) IsRecordLegSplitResult()            {}
+func (InvalidIDError) IsImportResultsResult()             {}
+func (InvalidIDError) IsRaceResult()                      {}
+func (InvalidIDError) IsCreateRaceResult()                {}
+func (InvalidIDError) IsRecordResultResult()              {}
+func (InvalidIDError) IsError()                           {}
+func (InvalidIDError) IsSeriesResult()                    {}
+func (InvalidIDError) IsCreateSeriesResult()              {}
+func (InvalidIDError) IsUpdateSeriesResult()              {}
+func (InvalidIDError) IsGenerateSeriesRacesResult()       {}
+func (InvalidIDError) IsRaceStaffResult()                 {}
+func (InvalidIDError) IsTeamResult()                      {}
+func (InvalidIDError) IsEnterTeamResult()                 {}
+
+type InvalidLegSplitError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidLegSplitError) IsError()                {}
+func (InvalidLegSplitError) IsRecordLegSplitResult() {}
+
+type InvalidOrganizationNameError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidOrganizationNameError) IsError()                    {}
+func (InvalidOrganizationNameError) IsCreateOrganizationResult() {}
+
+type InvalidRaceBibsError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceBibsError) IsError()            {}
+func (InvalidRaceBibsError) IsCreateRaceResult() {}
+
+type InvalidRaceCategoryError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceCategoryError) IsError()            {}
+func (InvalidRaceCategoryError) IsCreateRaceResult() {}
+
+type InvalidRaceCheckpointsError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceCheckpointsError) IsError()            {}
+func (InvalidRaceCheckpointsError) IsCreateRaceResult() {}
+
+type InvalidRaceDateError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceDateError) IsRescheduleRaceResult() {}
+func (InvalidRaceDateError) IsError()                {}
+func (InvalidRaceDateError) IsCreateRaceResult()     {}
+func (InvalidRaceDateError) IsCreateSeriesResult()   {}
+
+type InvalidRaceNameError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceNameError) IsError()              {}
+func (InvalidRaceNameError) IsCreateRaceResult()   {}
+func (InvalidRaceNameError) IsCreateSeriesResult() {}
+func (InvalidRaceNameError) IsUpdateSeriesResult() {}
+
+type InvalidRacePriceError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRacePriceError) IsError()            {}
+func (InvalidRacePriceError) IsCreateRaceResult() {}
+
+type InvalidRaceRelayError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceRelayError) IsError()            {}
+func (InvalidRaceRelayError) IsCreateRaceResult() {}
+
+type InvalidRaceSearchError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceSearchError) IsError()             {}
+func (InvalidRaceSearchError) IsSearchRacesResult() {}
+
+type InvalidRaceTeamsError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceTeamsError) IsError()            {}
+func (InvalidRaceTeamsError) IsCreateRaceResult() {}
+
+type InvalidRaceTimeError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRaceTimeError) IsError()                {}
+func (InvalidRaceTimeError) IsRecordLegSplitResult() {}
+func (InvalidRaceTimeError) IsRecordResultResult()   {}
+
+type InvalidRacesNearError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRacesNearError) IsError()           {}
+func (InvalidRacesNearError) IsRacesNearResult() {}
+
+type InvalidRelayLineUpError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidRelayLineUpError) IsError()                {}
+func (InvalidRelayLineUpError) IsSetRelayLineUpResult() {}
+
+type InvalidResultsFileError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidResultsFileError) IsError()               {}
+func (InvalidResultsFileError) IsImportResultsResult() {}
+
+type InvalidSeriesError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidSeriesError) IsError()              {}
+func (InvalidSeriesError) IsCreateSeriesResult() {}
+func (InvalidSeriesError) IsUpdateSeriesResult() {}
+
+type InvalidTeamEntryError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidTeamEntryError) IsError()           {}
+func (InvalidTeamEntryError) IsEnterTeamResult() {}
+
+type InvalidTransferDeadlineError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidTransferDeadlineError) IsError()                          {}
+func (InvalidTransferDeadlineError) IsSetRegistrationTransfersResult() {}
+
+type InvalidVenueError struct {
+	Message string `json:"message"`
+}
+
+func (InvalidVenueError) IsError()              {}
+func (InvalidVenueError) IsCreateRaceResult()   {}
+func (InvalidVenueError) IsCreateSeriesResult() {}
+func (InvalidVenueError) IsUpdateSeriesResult() {}
+
+type JoinRaceInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+	// required when the race has categories
+	Category *string `json:"category"`
+	// discount code of the race
+	Code *string `json:"code"`
+}
+
+type LegSplitInput struct {
+	RaceID string `json:"raceId"`
+	TeamID string `json:"teamId"`
+	Leg    int    `json:"leg"`
+	// split time in [[hh:]mm:]ss[.sss] format
+	Time string `json:"time"`
+}
+
+type Money struct {
+	// in the minor unit of the currency, like cents
+	Amount int `json:"amount"`
+	// ISO 4217 code, like EUR
+	Currency string `json:"currency"`
+}
+
+type NearInput struct {
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+	RadiusKm float64 `json:"radiusKm"`
+}
+
+type NotificationPreferences struct {
+	Locale   string             `json:"locale"`
+	Disabled []NotificationKind `json:"disabled"`
+}
+
+func (NotificationPreferences) IsNotificationPreferencesResult()       {}
+func (NotificationPreferences) IsUpdateNotificationPreferencesResult() {}
+
+type NotificationPreferencesInput struct {
+	// the language of the emails, en or es
+	Locale string `json:"locale"`
+	// the kinds of notifications the user opts out of
+	Disabled []NotificationKind `json:"disabled"`
+}
+
+type OfferRegistrationInput struct {
+	RaceID string `json:"raceId"`
+	// the user the registration is offered to
+	UserID string `json:"userId"`
+}
+
+type Organization struct {
+	ID      string                `json:"id"`
+	Name    string                `json:"name"`
+	Members []*OrganizationMember `json:"members"`
+	// sorted by date
+	Races []*Race `json:"races"`
+	// null unless the current user is an admin of the organization
+	Dashboard *OrganizationDashboard `json:"dashboard"`
+}
+
+func (Organization) IsOrganizationResult()       {}
+func (Organization) IsCreateOrganizationResult() {}
+func (Organization) IsOrganizationMemberResult() {}
+
+type OrganizationAlreadyExists struct {
+	Message string `json:"message"`
+}
+
+func (OrganizationAlreadyExists) IsError()                    {}
+func (OrganizationAlreadyExists) IsCreateOrganizationResult() {}
+
+type OrganizationDashboard struct {
+	Races []*RaceDashboard `json:"races"`
+	// confirmed registration fees of all the races, one amount per currency
+	Revenue []*Money `json:"revenue"`
+}
+
+type OrganizationInput struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OrganizationMember struct {
+	User *User            `json:"user"`
+	Role OrganizationRole `json:"role"`
+}
+
+type OrganizationMemberError struct {
+	Message string `json:"message"`
+}
+
+func (OrganizationMemberError) IsError()                    {}
+func (OrganizationMemberError) IsOrganizationMemberResult() {}
+
+type OrganizationMemberInput struct {
+	OrganizationID string           `json:"organizationId"`
+	UserID         string           `json:"userId"`
+	Role           OrganizationRole `json:"role"`
+}
+
+type OrganizationNotFound struct {
+	Message string `json:"message"`
+}
+
+func (OrganizationNotFound) IsError()                          {}
+func (OrganizationNotFound) IsOrganizationResult()             {}
+func (OrganizationNotFound) IsOrganizationMemberResult()       {}
+func (OrganizationNotFound) IsAssignRaceToOrganizationResult() {}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+type PassageInput struct {
+	// the competitor is identified by the user id or the bib number
+	UserID     *string   `json:"userId"`
+	Bib        *int      `json:"bib"`
+	Checkpoint string    `json:"checkpoint"`
+	At         time.Time `json:"at"`
+}
+
+type PassagesInput struct {
+	RaceID   string          `json:"raceId"`
+	Passages []*PassageInput `json:"passages"`
+}
+
+type PassagesRecorded struct {
+	Race *Race `json:"race"`
+	// passages of the batch that were not recorded, the rest are
+	Rejected []*RejectedPassage `json:"rejected"`
+}
+
+func (PassagesRecorded) IsRecordPassagesResult() {}
+
+type PriceTier struct {
+	Until  time.Time `json:"until"`
+	Amount int       `json:"amount"`
+}
+
+type PriceTierInput struct {
+	// the tier applies to the registrations until this date
+	Until  time.Time `json:"until"`
+	Amount int       `json:"amount"`
+}
+
+type RaceAlreadyExists struct {
+	Message string `json:"message"`
+}
+
+func (RaceAlreadyExists) IsError()            {}
+func (RaceAlreadyExists) IsCreateRaceResult() {}
+
+type RaceBibs struct {
+	Strategy BibStrategy `json:"strategy"`
+	// number the sequential strategy starts from
+	First   *int             `json:"first"`
+	Entries []*CompetitorBib `json:"entries"`
+}
+
+type RaceBibsInput struct {
+	Strategy BibStrategy `json:"strategy"`
+	// number the sequential strategy starts from
+	First *int `json:"first"`
+}
+
+type RaceCancellation struct {
+	Reason      string    `json:"reason"`
+	CancelledAt time.Time `json:"cancelledAt"`
+	// the competitors are released when missing
+	TransferTo *RaceTransfer `json:"transferTo"`
+}
+
+type RaceCategory struct {
+	Name string `json:"name"`
+	// distance in metres
+	Distance int `json:"distance"`
+	// in the venue time zone, UTC when the race has no venue
+	StartTime   time.Time           `json:"startTime"`
+	Capacity    *int                `json:"capacity"`
+	MinAge      *int                `json:"minAge"`
+	Gender      *Gender             `json:"gender"`
+	Competitors []*User             `json:"competitors"`
+	Results     []*CompetitorResult `json:"results"`
+	// download the original file at /races/{id}/course?category={name}
+	Course   *Course   `json:"course"`
+	BibRange *BibRange `json:"bibRange"`
+	// overrides the race price
+	Price *RacePrice `json:"price"`
+}
+
+type RaceCategoryInput struct {
+	Name string `json:"name"`
+	// distance in metres
+	Distance  int       `json:"distance"`
+	StartTime time.Time `json:"startTime"`
+	// max number of competitors, no limit when missing
+	Capacity *int `json:"capacity"`
+	// age required on the race day, no limit when missing
+	MinAge *int `json:"minAge"`
+	// open to any gender when missing
+	Gender *Gender `json:"gender"`
+	// numbers reserved for the category, required by the category range bib strategy
+	BibRange *BibRangeInput `json:"bibRange"`
+	// the race price when missing
+	Price *RacePriceInput `json:"price"`
+}
+
+type RaceDashboard struct {
+	RaceID       string `json:"raceId"`
+	Participants int    `json:"participants"`
+	// participants with a confirmed registration, all of them in free races
+	Confirmed int `json:"confirmed"`
+	CheckedIn int `json:"checkedIn"`
+	// confirmed registration fees, one amount per currency, empty in free races
+	Revenue []*Money `json:"revenue"`
+}
+
+type RaceInput struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Date        time.Time            `json:"date"`
+	Description *string              `json:"description"`
+	Teams       *RaceTeamsInput      `json:"teams"`
+	Relay       *RaceRelayInput      `json:"relay"`
+	Categories  []*RaceCategoryInput `json:"categories"`
+	// intermediate timing points in course order
+	Checkpoints []*CheckpointInput `json:"checkpoints"`
+	// no bib numbers when missing
+	Bibs *RaceBibsInput `json:"bibs"`
+	// times are shown in UTC when missing
+	Venue *VenueInput `json:"venue"`
+	// free when missing
+	Price *RacePriceInput `json:"price"`
+	// the registration is open until the race is finished when missing
+	RegistrationDeadline *time.Time `json:"registrationDeadline"`
+}
+
+type RaceMatch struct {
+	Race *Race `json:"race"`
+	// relevance for the query, higher first
+	Rank float64 `json:"rank"`
+	// HTML escaped text that matched, with the matching words in <mark> tags
+	Snippet string `json:"snippet"`
+}
+
+type RaceNotFound struct {
+	Message string `json:"message"`
+}
+
+func (RaceNotFound) IsAssignBibResult()                 {}
+func (RaceNotFound) IsRescheduleRaceResult()            {}
+func (RaceNotFound) IsCancelRaceResult()                {}
+func (RaceNotFound) IsCheckInResult()                   {}
+func (RaceNotFound) IsRecordPassagesResult()            {}
+func (RaceNotFound) IsUploadCourseResult()              {}
+func (RaceNotFound) IsDiscountCodesResult()             {}
+func (RaceNotFound) IsCreateDiscountCodeResult()        {}
+func (RaceNotFound) IsAssignRaceToOrganizationResult()  {}
+func (RaceNotFound) IsError()                           {}
+func (RaceNotFound) IsJoinRaceResult()                  {}
+func (RaceNotFound) IsCheckoutResult()                  {}
+func (RaceNotFound) IsRefundRegistrationResult()        {}
+func (RaceNotFound) IsSetRegistrationTransfersResult()  {}
+func (RaceNotFound) IsOfferRegistrationResult()         {}
+func (RaceNotFound) IsWithdrawRegistrationOfferResult() {}
+func (RaceNotFound) IsAcceptRegistrationResult()        {}
+func (RaceNotFound) IsSetRelayLineUpResult()            {}
+func (RaceNotFound) IsRecordLegSplitResult()            {}
+func (RaceNotFound) IsImportResultsResult()             {}
+func (RaceNotFound) IsRaceResult()                      {}
+func (RaceNotFound) IsRecordResultResult()              {}
+func (RaceNotFound) IsRaceStaffResult()                 {}
+func (RaceNotFound) IsEnterTeamResult()                 {}
+
+type RaceOrganizationInput struct {
+	OrganizationID string `json:"organizationId"`
+	RaceID         string `json:"raceId"`
+}
+
+type RaceOwnerInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+}
+
+type RacePrice struct {
+	Currency string `json:"currency"`
+	// price once the early-bird tiers are over
+	Amount int          `json:"amount"`
+	Tiers  []*PriceTier `json:"tiers"`
+}
+
+type RacePriceInput struct {
+	// ISO 4217 code, like EUR
+	Currency string `json:"currency"`
+	// in the minor unit of the currency, like cents
+	Amount int `json:"amount"`
+	// early-bird prices, by date
+	Tiers []*PriceTierInput `json:"tiers"`
+}
+
+type RaceRelay struct {
+	Legs               []*RelayLeg      `json:"legs"`
+	AllowRepeatRunners bool             `json:"allowRepeatRunners"`
+	Standings          []*RelayStanding `json:"standings"`
+}
+
+type RaceRelayInput struct {
+	Legs               []*RelayLegInput `json:"legs"`
+	AllowRepeatRunners bool             `json:"allowRepeatRunners"`
+}
+
+type RaceResultInput struct {
+	RaceID string `json:"raceId"`
+	// the competitor is identified by the user id or the bib number
+	UserID *string `json:"userId"`
+	Bib    *int    `json:"bib"`
+	// finish time in [[hh:]mm:]ss[.sss] format
+	Time string `json:"time"`
+}
+
+type RaceSearch struct {
+	Matches []*RaceMatch `json:"matches"`
+}
+
+func (RaceSearch) IsSearchRacesResult() {}
+
+type RaceSearchFilter struct {
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+	Near *NearInput `json:"near"`
+}
+
+type RaceStaffInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+	// any role but OWNER
+	Role StaffRole `json:"role"`
+}
+
+type RaceStaffMember struct {
+	User *User     `json:"user"`
+	Role StaffRole `json:"role"`
+}
+
+type RaceTeams struct {
+	MinMembers int         `json:"minMembers"`
+	MaxMembers int         `json:"maxMembers"`
+	Scoring    TeamScoring `json:"scoring"`
+	Counting   int         `json:"counting"`
+}
+
+type RaceTeamsInput struct {
+	MinMembers int         `json:"minMembers"`
+	MaxMembers int         `json:"maxMembers"`
+	Scoring    TeamScoring `json:"scoring"`
+	Counting   int         `json:"counting"`
+}
+
+type RaceTransfer struct {
+	RaceID   string  `json:"raceId"`
+	Category *string `json:"category"`
+}
+
+type RaceTransferInput struct {
+	// an open race of the same owner
+	RaceID string `json:"raceId"`
+	// required when the race has categories
+	Category *string `json:"category"`
+}
+
+type Races struct {
+	Races []*Race `json:"races"`
+}
+
+func (Races) IsRacesNearResult() {}
+
+type RefundRegistrationInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+}
+
+type Registration struct {
+	RaceID     string             `json:"raceId"`
+	Competitor *User              `json:"competitor"`
+	Status     RegistrationStatus `json:"status"`
+	// missing when the race is free
+	Fee *Money `json:"fee"`
+	// the pending registration is released when not paid before
+	ExpiresAt *time.Time `json:"expiresAt"`
+	// the discount code redeemed
+	Code *string `json:"code"`
+}
+
+func (Registration) IsJoinRaceResult()           {}
+func (Registration) IsRefundRegistrationResult() {}
+func (Registration) IsAcceptRegistrationResult() {}
+
+type RegistrationError struct {
+	Message string `json:"message"`
+}
+
+func (RegistrationError) IsError()                    {}
+func (RegistrationError) IsJoinRaceResult()           {}
+func (RegistrationError) IsCheckoutResult()           {}
+func (RegistrationError) IsRefundRegistrationResult() {}
+func (RegistrationError) IsAcceptRegistrationResult() {}
+
+type RegistrationOffer struct {
+	RaceID    string    `json:"raceId"`
+	From      *User     `json:"from"`
+	To        *User     `json:"to"`
+	OfferedAt time.Time `json:"offeredAt"`
+}
+
+func (RegistrationOffer) IsOfferRegistrationResult() {}
+
+type RegistrationOfferWithdrawn struct {
+	RaceID string `json:"raceId"`
+}
+
+func (RegistrationOfferWithdrawn) IsWithdrawRegistrationOfferResult() {}
+
+type RegistrationTransferError struct {
+	Message string `json:"message"`
+}
+
+func (RegistrationTransferError) IsError()                           {}
+func (RegistrationTransferError) IsOfferRegistrationResult()         {}
+func (RegistrationTransferError) IsWithdrawRegistrationOfferResult() {}
+func (RegistrationTransferError) IsAcceptRegistrationResult()        {}
+
+type RegistrationTransferPolicy struct {
+	// the registrations can be transferred until the race starts when missing
+	Deadline *time.Time `json:"deadline"`
+}
+
+type RegistrationTransfersInput struct {
+	RaceID  string `json:"raceId"`
+	Allowed bool   `json:"allowed"`
+	// the registrations can be transferred until the race starts when missing
+	Deadline *time.Time `json:"deadline"`
+}
+
+type RejectedPassage struct {
+	// position of the passage in the input
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+type RelayLeg struct {
+	// position of the leg in the relay, starting from zero
+	Position int    `json:"position"`
+	Name     string `json:"name"`
+	// distance in metres
+	Distance int               `json:"distance"`
+	Ranking  []*RelayLegResult `json:"ranking"`
+}
+
+type RelayLegInput struct {
+	Name string `json:"name"`
+	// distance in metres
+	Distance int `json:"distance"`
+}
+
+type RelayLegResult struct {
+	Position int    `json:"position"`
+	TeamID   string `json:"teamId"`
+	Runner   *User  `json:"runner"`
+	Time     string `json:"time"`
+}
+
+type RelayLineUpInput struct {
+	RaceID string `json:"raceId"`
+	TeamID string `json:"teamId"`
+	// runner of each leg, in legs order
+	Runners []string `json:"runners"`
+}
+
+type RelayStanding struct {
+	Position      *int   `json:"position"`
+	TeamID        string `json:"teamId"`
+	Time          string `json:"time"`
+	LegsCompleted int    `json:"legsCompleted"`
+	Complete      bool   `json:"complete"`
+}
+
+type RemoveOrganizationMemberInput struct {
+	OrganizationID string `json:"organizationId"`
+	UserID         string `json:"userId"`
+}
+
+type RemoveRaceStaffInput struct {
+	RaceID string `json:"raceId"`
+	UserID string `json:"userId"`
+}
+
+type RescheduleRaceInput struct {
+	RaceID string    `json:"raceId"`
+	Date   time.Time `json:"date"`
+}
+
+// header names of the columns
+type ResultsColumnMappingInput struct {
+	Bib      string  `json:"bib"`
+	ChipTime *string `json:"chipTime"`
+	GunTime  *string `json:"gunTime"`
+	Status   *string `json:"status"`
+}
+
+type ResultsImport struct {
+	Race    *Race             `json:"race"`
+	Results []*ImportedResult `json:"results"`
+	// rows of competitors that did not finish
+	Skipped int            `json:"skipped"`
+	Issues  []*ImportIssue `json:"issues"`
+	// false on a dry run or when there is nothing to record
+	Committed bool `json:"committed"`
+}
+
+func (ResultsImport) IsImportResultsResult() {}
+
+type ResultsImportInput struct {
+	RaceID string `json:"raceId"`
+	// registered parser of the file, csv or chip
+	Format string `json:"format"`
+	// columns of each value, required by the csv format
+	Mapping *ResultsColumnMappingInput `json:"mapping"`
+	File    graphql.Upload             `json:"file"`
+	// report what would be imported without recording the results
+	DryRun bool `json:"dryRun"`
+}
+
+type SeriesAlreadyExists struct {
+	Message string `json:"message"`
+}
+
+func (SeriesAlreadyExists) IsError()              {}
+func (SeriesAlreadyExists) IsCreateSeriesResult() {}
+
+type SeriesInput struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	// times are shown in UTC when missing
+	Venue *VenueInput `json:"venue"`
+	// date of the first race, the next ones keep its time of the day in the venue time zone
+	Start time.Time `json:"start"`
+	// RFC 5545 recurrence rule with FREQ DAILY, WEEKLY or MONTHLY, INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL
+	Rule string `json:"rule"`
+	// standings points by position, the first for the winner, 25, 18, 15, 12, 10, 8, 6, 4, 2, 1 when missing
+	Points []int `json:"points"`
+	// number of best races counted for each competitor, all of them when missing
+	BestOf *int `json:"bestOf"`
+}
+
+type SeriesNotFound struct {
+	Message string `json:"message"`
+}
+
+func (SeriesNotFound) IsError()                     {}
+func (SeriesNotFound) IsSeriesResult()              {}
+func (SeriesNotFound) IsUpdateSeriesResult()        {}
+func (SeriesNotFound) IsGenerateSeriesRacesResult() {}
+
+type SeriesRace struct {
+	RaceID string `json:"raceId"`
+	// the occurrence of the rule the race was created for
+	Occurrence time.Time `json:"occurrence"`
+}
+
+type SeriesRaces struct {
+	Races []*Race `json:"races"`
+}
+
+func (SeriesRaces) IsGenerateSeriesRacesResult() {}
+
+type SeriesStanding struct {
+	Position   int   `json:"position"`
+	Competitor *User `json:"competitor"`
+	Points     int   `json:"points"`
+	// races of the series the competitor finished
+	Races int `json:"races"`
+}
+
+type Split struct {
+	Checkpoint string    `json:"checkpoint"`
+	At         time.Time `json:"at"`
+	// time since the competitor start
+	Elapsed string `json:"elapsed"`
+	// time per kilometre since the previous passed checkpoint
+	Pace         string `json:"pace"`
+	CutoffMissed bool   `json:"cutoffMissed"`
+}
+
+type StaffError struct {
+	Message string `json:"message"`
+}
+
+func (StaffError) IsError()           {}
+func (StaffError) IsRaceStaffResult() {}
+
+type Team struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Admin        *User             `json:"admin"`
+	Members      []*User           `json:"members"`
+	Invitations  []*TeamInvitation `json:"invitations"`
+	JoinRequests []*User           `json:"joinRequests"`
+}
+
+func (Team) IsTeamResult() {}
+
+type TeamAlreadyEntered struct {
+	Message string `json:"message"`
+}
+
+func (TeamAlreadyEntered) IsError()           {}
+func (TeamAlreadyEntered) IsEnterTeamResult() {}
+
+type TeamEntryInput struct {
+	RaceID  string   `json:"raceId"`
+	TeamID  string   `json:"teamId"`
+	Members []string `json:"members"`
+}
+
+type TeamInvitation struct {
+	User      *User     `json:"user"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type TeamMembershipError struct {
+	Message string `json:"message"`
+}
+
+func (TeamMembershipError) IsError()      {}
+func (TeamMembershipError) IsTeamResult() {}
+
+type TeamNotFound struct {
+	Message string `json:"message"`
+}
+
+func (TeamNotFound) IsSetRelayLineUpResult() {}
+func (TeamNotFound) IsTeamResult()           {}
+func (TeamNotFound) IsError()                {}
+func (TeamNotFound) IsEnterTeamResult()      {}
+
+type TeamStanding struct {
+	Position  *int   `json:"position"`
+	TeamID    string `json:"teamId"`
+	Time      string `json:"time"`
+	Points    int    `json:"points"`
+	Finishers int    `json:"finishers"`
+	Complete  bool   `json:"complete"`
+}
+
+type TeamUserInput struct {
+	TeamID string `json:"teamId"`
+	UserID string `json:"userId"`
+}
+
+type UnsupportedLocaleError struct {
+	Message string `json:"message"`
+}
+
+func (UnsupportedLocaleError) IsError()                               {}
+func (UnsupportedLocaleError) IsUpdateNotificationPreferencesResult() {}
+
+type User struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Races []*Race `json:"races"`
+}
+
+type UserNotFound struct {
+	Message string `json:"message"`
+}
+
+func (UserNotFound) IsOrganizationMemberResult() {}
+func (UserNotFound) IsOfferRegistrationResult()  {}
+func (UserNotFound) IsRaceStaffResult()          {}
+func (UserNotFound) IsTeamResult()               {}
+func (UserNotFound) IsError()                    {}
+
+type Venue struct {
+	Name    string  `json:"name"`
+	Address string  `json:"address"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	// IANA name of the zone the race times are shown in, like Europe/Madrid
+	TimeZone string `json:"timeZone"`
+}
+
+type VenueInput struct {
+	Name    string  `json:"name"`
+	Address *string `json:"address"`
+	Lat     float64 `json:"lat"`
+	Lon     float64 `json:"lon"`
+	// IANA name of the zone, like Europe/Madrid
+	TimeZone string `json:"timeZone"`
+}
+
+type BibStrategy string
+
+const (
+	// the race owner assigns every number
+	BibStrategyManual BibStrategy = "MANUAL"
+	// the next number of the race in registration order
+	BibStrategySequential BibStrategy = "SEQUENTIAL"
+	// the next number of the competitor category range
+	BibStrategyCategoryRange BibStrategy = "CATEGORY_RANGE"
+)
+
+var AllBibStrategy = []BibStrategy{
+	BibStrategyManual,
+	BibStrategySequential,
+	BibStrategyCategoryRange,
+}
+
+func (e BibStrategy) IsValid() bool {
+	switch e {
+	case BibStrategyManual, BibStrategySequential, BibStrategyCategoryRange:
+		return true
+	}
+	return false
+}
+
+func (e BibStrategy) String() string {
+	return string(e)
+}
+
+func (e *BibStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BibStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BibStrategy", str)
+	}
+	return nil
+}
+
+func (e BibStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ErasureStatus string
+
+const (
+	ErasureStatusPending ErasureStatus = "PENDING"
+	ErasureStatusRunning ErasureStatus = "RUNNING"
+	ErasureStatusDone    ErasureStatus = "DONE"
+)
+
+var AllErasureStatus = []ErasureStatus{
+	ErasureStatusPending,
+	ErasureStatusRunning,
+	ErasureStatusDone,
+}
+
+func (e ErasureStatus) IsValid() bool {
+	switch e {
+	case ErasureStatusPending, ErasureStatusRunning, ErasureStatusDone:
+		return true
+	}
+	return false
+}
+
+func (e ErasureStatus) String() string {
+	return string(e)
+}
+
+func (e *ErasureStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ErasureStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ErasureStatus", str)
+	}
+	return nil
+}
+
+func (e ErasureStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Gender string
+
+const (
+	GenderFemale Gender = "FEMALE"
+	GenderMale   Gender = "MALE"
+)
+
+var AllGender = []Gender{
+	GenderFemale,
+	GenderMale,
+}
+
+func (e Gender) IsValid() bool {
+	switch e {
+	case GenderFemale, GenderMale:
+		return true
+	}
+	return false
+}
+
+func (e Gender) String() string {
+	return string(e)
+}
+
+func (e *Gender) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Gender(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Gender", str)
+	}
+	return nil
+}
+
+func (e Gender) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ImportIssueKind string
+
+const (
+	ImportIssueKindBadBib       ImportIssueKind = "BAD_BIB"
+	ImportIssueKindUnmatchedBib ImportIssueKind = "UNMATCHED_BIB"
+	ImportIssueKindDuplicateBib ImportIssueKind = "DUPLICATE_BIB"
+	ImportIssueKindBadTime      ImportIssueKind = "BAD_TIME"
+)
+
+var AllImportIssueKind = []ImportIssueKind{
+	ImportIssueKindBadBib,
+	ImportIssueKindUnmatchedBib,
+	ImportIssueKindDuplicateBib,
+	ImportIssueKindBadTime,
+}
+
+func (e ImportIssueKind) IsValid() bool {
+	switch e {
+	case ImportIssueKindBadBib, ImportIssueKindUnmatchedBib, ImportIssueKindDuplicateBib, ImportIssueKindBadTime:
+		return true
+	}
+	return false
+}
+
+func (e ImportIssueKind) String() string {
+	return string(e)
+}
+
+func (e *ImportIssueKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ImportIssueKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ImportIssueKind", str)
+	}
+	return nil
+}
+
+func (e ImportIssueKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationKind string
+
+const (
+	// the changes of the user registrations
+	NotificationKindRegistrations NotificationKind = "REGISTRATIONS"
+	// the reschedules, race day reminders and cancellations of the races the user joined
+	NotificationKindRaceUpdates NotificationKind = "RACE_UPDATES"
+	// the notifications of the races the user organizes
+	NotificationKindOrganizer NotificationKind = "ORGANIZER"
+)
+
+var AllNotificationKind = []NotificationKind{
+	NotificationKindRegistrations,
+	NotificationKindRaceUpdates,
+	NotificationKindOrganizer,
+}
+
+func (e NotificationKind) IsValid() bool {
+	switch e {
+	case NotificationKindRegistrations, NotificationKindRaceUpdates, NotificationKindOrganizer:
+		return true
+	}
+	return false
+}
+
+func (e NotificationKind) String() string {
+	return string(e)
+}
+
+func (e *NotificationKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationKind", str)
+	}
+	return nil
+}
+
+func (e NotificationKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrganizationRole string
+
+const (
+	// manages the members and all the races of the organization
+	OrganizationRoleAdmin  OrganizationRole = "ADMIN"
+	OrganizationRoleMember OrganizationRole = "MEMBER"
+)
+
+var AllOrganizationRole = []OrganizationRole{
+	OrganizationRoleAdmin,
+	OrganizationRoleMember,
+}
+
+func (e OrganizationRole) IsValid() bool {
+	switch e {
+	case OrganizationRoleAdmin, OrganizationRoleMember:
+		return true
+	}
+	return false
+}
+
+func (e OrganizationRole) String() string {
+	return string(e)
+}
+
+func (e *OrganizationRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrganizationRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrganizationRole", str)
+	}
+	return nil
+}
+
+func (e OrganizationRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RaceStatus string
+
+const (
+	// accepting registrations
+	RaceStatusOpen RaceStatus = "OPEN"
+	// the registration deadline passed
+	RaceStatusRegistrationClosed RaceStatus = "REGISTRATION_CLOSED"
+	// the race day is over
+	RaceStatusFinished RaceStatus = "FINISHED"
+	// the race was called off
+	RaceStatusCancelled RaceStatus = "CANCELLED"
+)
+
+var AllRaceStatus = []RaceStatus{
+	RaceStatusOpen,
+	RaceStatusRegistrationClosed,
+	RaceStatusFinished,
+	RaceStatusCancelled,
+}
+
+func (e RaceStatus) IsValid() bool {
+	switch e {
+	case RaceStatusOpen, RaceStatusRegistrationClosed, RaceStatusFinished, RaceStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e RaceStatus) String() string {
+	return string(e)
+}
+
+func (e *RaceStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RaceStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RaceStatus", str)
+	}
+	return nil
+}
+
+func (e RaceStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RegistrationStatus string
+
+const (
+	RegistrationStatusPendingPayment RegistrationStatus = "PENDING_PAYMENT"
+	RegistrationStatusConfirmed      RegistrationStatus = "CONFIRMED"
+	RegistrationStatusRefundPending  RegistrationStatus = "REFUND_PENDING"
+	RegistrationStatusRefunded       RegistrationStatus = "REFUNDED"
+)
+
+var AllRegistrationStatus = []RegistrationStatus{
+	RegistrationStatusPendingPayment,
+	RegistrationStatusConfirmed,
+	RegistrationStatusRefundPending,
+	RegistrationStatusRefunded,
+}
+
+func (e RegistrationStatus) IsValid() bool {
+	switch e {
+	case RegistrationStatusPendingPayment, RegistrationStatusConfirmed, RegistrationStatusRefundPending, RegistrationStatusRefunded:
+		return true
+	}
+	return false
+}
+
+func (e RegistrationStatus) String() string {
+	return string(e)
+}
+
+func (e *RegistrationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RegistrationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RegistrationStatus", str)
+	}
+	return nil
+}
+
+func (e RegistrationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StaffRole string
+
+const (
+	// the race belongs to the user, transferred with transferRaceOwnership
+	StaffRoleOwner StaffRole = "OWNER"
+	// edits the race, enters the results and checks the competitors in
+	StaffRoleCoOrganizer StaffRole = "CO_ORGANIZER"
+	// enters the results and timing passages
+	StaffRoleTimekeeper StaffRole = "TIMEKEEPER"
+	// checks the competitors in at the packet pickup
+	StaffRoleVolunteer StaffRole = "VOLUNTEER"
+)
+
+var AllStaffRole = []StaffRole{
+	StaffRoleOwner,
+	StaffRoleCoOrganizer,
+	StaffRoleTimekeeper,
+	StaffRoleVolunteer,
+}
+
+func (e StaffRole) IsValid() bool {
+	switch e {
+	case StaffRoleOwner, StaffRoleCoOrganizer, StaffRoleTimekeeper, StaffRoleVolunteer:
+		return true
+	}
+	return false
+}
+
+func (e StaffRole) String() string {
+	return string(e)
+}
+
+func (e *StaffRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StaffRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StaffRole", str)
+	}
+	return nil
+}
+
+func (e StaffRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TeamScoring string
+
+const (
+	TeamScoringBestTimes      TeamScoring = "BEST_TIMES"
+	TeamScoringPositionPoints TeamScoring = "POSITION_POINTS"
+)
+
+var AllTeamScoring = []TeamScoring{
+	TeamScoringBestTimes,
+	TeamScoringPositionPoints,
+}
+
+func (e TeamScoring) IsValid() bool {
+	switch e {
+	case TeamScoringBestTimes, TeamScoringPositionPoints:
+		return true
+	}
+	return false
+}
+
+func (e TeamScoring) String() string {
+	return string(e)
+}
+
+func (e *TeamScoring) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TeamScoring(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TeamScoring", str)
+	}
+	return nil
+}
+
+func (e TeamScoring) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
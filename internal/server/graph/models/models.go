@@ -1,30 +1,529 @@
 package models
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"sort"
 	"time"
 
 	racers "github.com/xabi93/racers/internal"
 	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
 )
 
 type Race struct {
-	ID             string
-	Name           string
-	Date           time.Time
-	Competitors    []*User
-	competitorsIDs []racers.UserID
+	ID            string
+	Name          string
+	Date          time.Time
+	Description   string
+	Venue         *Venue
+	Competitors   []*User
+	Teams         *RaceTeams
+	Results       []*CompetitorResult
+	TeamStandings []*TeamStanding
+	Relay         *RaceRelay
+	Categories    []*RaceCategory
+	Course        *Course
+	Checkpoints   []*Checkpoint
+	Splits        []*CompetitorSplits
+	Bibs          *RaceBibs
+	Sequence      int
+	SeriesID      *string
+	Price         *RacePrice
+	Status        RaceStatus
+	// RegistrationDeadline is in the venue time zone
+	RegistrationDeadline  *time.Time
+	Cancellation          *RaceCancellation
+	RegistrationTransfers *RegistrationTransferPolicy
+	RegistrationOffers    []*RegistrationOffer
+	Owner                 *User
+	OrganizationID        *string
+	Staff                 []*RaceStaffMember
+	CheckIns              []*CheckIn
+	competitorsIDs        []racers.UserID
 }
 
-func (Race) IsCreateRaceResult() {}
-func (Race) IsRaceResult()       {}
+func (Race) IsRaceStaffResult()                {}
+func (Race) IsAssignRaceToOrganizationResult() {}
+func (Race) IsCancelRaceResult()               {}
+func (Race) IsSetRegistrationTransfersResult() {}
+func (Race) IsCreateRaceResult()               {}
+func (Race) IsRaceResult()                     {}
+func (Race) IsRecordResultResult()             {}
+func (Race) IsEnterTeamResult()                {}
+func (Race) IsSetRelayLineUpResult()           {}
+func (Race) IsRecordLegSplitResult()           {}
+func (Race) IsUploadCourseResult()             {}
+func (Race) IsAssignBibResult()                {}
+func (Race) IsRescheduleRaceResult()           {}
 
 func NewRace(race racers.Race) *Race {
+	var seriesID *string
+	if race.Series != nil {
+		s := id.ID(race.Series.Series).String()
+		seriesID = &s
+	}
+
+	var orgID *string
+	if race.Organization != nil {
+		s := id.ID(*race.Organization).String()
+		orgID = &s
+	}
+
+	status := RaceStatusOpen
+	if race.Status != "" {
+		status = RaceStatus(race.Status)
+	}
+	var deadline *time.Time
+	if race.RegistrationDeadline != nil {
+		d := race.RegistrationDeadline.In(race.Location())
+		deadline = &d
+	}
+
 	return &Race{
-		ID:             id.ID(race.ID).String(),
-		Name:           string(race.Name),
-		Date:           time.Time(race.Date),
-		competitorsIDs: race.Competitors.List(),
+		ID:                    id.ID(race.ID).String(),
+		Name:                  string(race.Name),
+		Date:                  race.LocalDate(),
+		Description:           race.Description,
+		Venue:                 newVenue(race.Venue),
+		Teams:                 newRaceTeams(race.Teams),
+		Results:               newCompetitorResults(race.Results.Ranking()),
+		TeamStandings:         newTeamStandings(race.TeamStandings()),
+		Relay:                 newRaceRelay(race),
+		Categories:            newRaceCategories(race),
+		Course:                newCourse(race.Course),
+		Checkpoints:           newCheckpoints(race.Checkpoints),
+		Splits:                newCompetitorSplits(race.AllSplits()),
+		Bibs:                  newRaceBibs(race),
+		Sequence:              race.Sequence,
+		SeriesID:              seriesID,
+		Price:                 newRacePrice(race.Price),
+		Status:                status,
+		RegistrationDeadline:  deadline,
+		Cancellation:          newRaceCancellation(race),
+		RegistrationTransfers: newRegistrationTransferPolicy(race),
+		RegistrationOffers:    newRegistrationOffers(race),
+		Owner:                 &User{ID: id.ID(race.Owner).String()},
+		OrganizationID:        orgID,
+		Staff:                 newStaff(race),
+		CheckIns:              newCheckIns(race),
+		competitorsIDs:        race.Competitors.List(),
+	}
+}
+
+func newVenue(v *racers.Venue) *Venue {
+	if v == nil {
+		return nil
+	}
+
+	return &Venue{
+		Name:     v.Name,
+		Address:  v.Address,
+		Lat:      v.Lat,
+		Lon:      v.Lon,
+		TimeZone: v.TimeZone.String(),
+	}
+}
+
+var teamScorings = map[racers.TeamScoring]TeamScoring{
+	racers.TeamScoringBestTimes:      TeamScoringBestTimes,
+	racers.TeamScoringPositionPoints: TeamScoringPositionPoints,
+}
+
+// DomainTeamScoring returns the domain value of the scoring
+func DomainTeamScoring(s TeamScoring) string {
+	for domain, graph := range teamScorings {
+		if graph == s {
+			return string(domain)
+		}
+	}
+
+	return s.String()
+}
+
+var genders = map[racers.Gender]Gender{
+	racers.GenderFemale: GenderFemale,
+	racers.GenderMale:   GenderMale,
+}
+
+// DomainGender returns the domain value of the gender
+func DomainGender(g Gender) string {
+	for domain, graph := range genders {
+		if graph == g {
+			return string(domain)
+		}
+	}
+
+	return g.String()
+}
+
+var bibStrategies = map[racers.BibStrategy]BibStrategy{
+	racers.BibStrategyManual:        BibStrategyManual,
+	racers.BibStrategySequential:    BibStrategySequential,
+	racers.BibStrategyCategoryRange: BibStrategyCategoryRange,
+}
+
+// DomainBibStrategy returns the domain value of the strategy
+func DomainBibStrategy(s BibStrategy) string {
+	for domain, graph := range bibStrategies {
+		if graph == s {
+			return string(domain)
+		}
+	}
+
+	return s.String()
+}
+
+func newRaceCancellation(race racers.Race) *RaceCancellation {
+	c := race.Cancellation
+	if c == nil {
+		return nil
+	}
+
+	result := &RaceCancellation{Reason: c.Reason, CancelledAt: c.At.In(race.Location())}
+	if t := c.Transfer; t != nil {
+		result.TransferTo = &RaceTransfer{RaceID: id.ID(t.Race).String()}
+		if t.Category != "" {
+			category := string(t.Category)
+			result.TransferTo.Category = &category
+		}
+	}
+
+	return result
+}
+
+func newRegistrationTransferPolicy(race racers.Race) *RegistrationTransferPolicy {
+	if race.Transfers == nil {
+		return nil
+	}
+
+	policy := &RegistrationTransferPolicy{}
+	if race.Transfers.Deadline != nil {
+		d := race.Transfers.Deadline.In(race.Location())
+		policy.Deadline = &d
+	}
+
+	return policy
+}
+
+func newRegistrationOffers(race racers.Race) []*RegistrationOffer {
+	offers := make([]*RegistrationOffer, 0, len(race.RegistrationTransfers))
+	for from, t := range race.RegistrationTransfers {
+		o := NewRegistrationOffer(service.RegistrationOffer{Race: race.ID, From: from, RegistrationTransfer: t})
+		o.OfferedAt = o.OfferedAt.In(race.Location())
+		offers = append(offers, &o)
+	}
+	sort.Slice(offers, func(i, j int) bool { return offers[i].OfferedAt.Before(offers[j].OfferedAt) })
+
+	return offers
+}
+
+// staffOrder sorts the staff by role, from the most to the least permissions
+var staffOrder = map[racers.StaffRole]int{
+	racers.StaffOwner:       0,
+	racers.StaffCoOrganizer: 1,
+	racers.StaffTimekeeper:  2,
+	racers.StaffVolunteer:   3,
+}
+
+func newStaff(race racers.Race) []*RaceStaffMember {
+	staff := []*RaceStaffMember{{User: &User{ID: id.ID(race.Owner).String()}, Role: StaffRoleOwner}}
+	for u, role := range race.Staff {
+		staff = append(staff, &RaceStaffMember{User: &User{ID: id.ID(u).String()}, Role: StaffRole(role)})
+	}
+	sort.Slice(staff, func(i, j int) bool {
+		oi, oj := staffOrder[racers.StaffRole(staff[i].Role)], staffOrder[racers.StaffRole(staff[j].Role)]
+		if oi != oj {
+			return oi < oj
+		}
+
+		return staff[i].User.ID < staff[j].User.ID
+	})
+
+	return staff
+}
+
+func newCheckIns(race racers.Race) []*CheckIn {
+	checkIns := make([]*CheckIn, 0, len(race.CheckIns))
+	for c, at := range race.CheckIns {
+		checkIns = append(checkIns, &CheckIn{Competitor: &User{ID: id.ID(c).String()}, At: at.In(race.Location())})
+	}
+	sort.Slice(checkIns, func(i, j int) bool { return checkIns[i].At.Before(checkIns[j].At) })
+
+	return checkIns
+}
+
+func NewCompetitorCheckIn(e service.CheckInEntry) CompetitorCheckIn {
+	c := CompetitorCheckIn{
+		RaceID:      id.ID(e.Race).String(),
+		Competitor:  &User{ID: id.ID(e.Competitor.ID).String()},
+		Status:      RegistrationStatus(e.Registration.Status),
+		CheckedInAt: e.CheckedInAt,
+	}
+	if e.Category != "" {
+		category := string(e.Category)
+		c.Category = &category
+	}
+	if e.Bib != 0 {
+		bib := int(e.Bib)
+		c.Bib = &bib
+	}
+
+	return c
+}
+
+func NewRegistrationOffer(o service.RegistrationOffer) RegistrationOffer {
+	return RegistrationOffer{
+		RaceID:    id.ID(o.Race).String(),
+		From:      &User{ID: id.ID(o.From).String()},
+		To:        &User{ID: id.ID(o.To).String()},
+		OfferedAt: o.OfferedAt,
+	}
+}
+
+func newRaceBibs(race racers.Race) *RaceBibs {
+	if race.Bibs == nil {
+		return nil
+	}
+
+	entries := make([]*CompetitorBib, 0, len(race.BibEntries))
+	for u, b := range race.BibEntries {
+		entries = append(entries, &CompetitorBib{Bib: int(b), Competitor: &User{ID: id.ID(u).String()}})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Bib < entries[j].Bib })
+
+	bibs := &RaceBibs{Strategy: bibStrategies[race.Bibs.Strategy], Entries: entries}
+	if race.Bibs.Strategy == racers.BibStrategySequential {
+		first := int(race.Bibs.First)
+		bibs.First = &first
+	}
+
+	return bibs
+}
+
+func newRaceCategories(race racers.Race) []*RaceCategory {
+	competitors := make(map[racers.CategoryName][]*User)
+	for u, c := range race.CategoryEntries {
+		competitors[c] = append(competitors[c], &User{ID: id.ID(u).String()})
+	}
+
+	result := make([]*RaceCategory, len(race.Categories))
+	for i, c := range race.Categories {
+		category := &RaceCategory{
+			Name:        string(c.Name),
+			Distance:    int(c.Distance),
+			StartTime:   c.StartTime.In(race.Location()),
+			Competitors: competitors[c.Name],
+			Results:     newCompetitorResults(race.CategoryRanking(c.Name)),
+			Course:      newCourse(c.Course),
+		}
+		if category.Competitors == nil {
+			category.Competitors = []*User{}
+		}
+		if c.Capacity > 0 {
+			capacity := c.Capacity
+			category.Capacity = &capacity
+		}
+		if c.Eligibility.MinAge > 0 {
+			minAge := c.Eligibility.MinAge
+			category.MinAge = &minAge
+		}
+		if g, ok := genders[c.Eligibility.Gender]; ok {
+			category.Gender = &g
+		}
+		if c.Bibs != nil {
+			category.BibRange = &BibRange{From: int(c.Bibs.From), To: int(c.Bibs.To)}
+		}
+		category.Price = newRacePrice(c.Price)
+
+		result[i] = category
+	}
+
+	return result
+}
+
+func newCourse(c *racers.Course) *Course {
+	if c == nil {
+		return nil
+	}
+
+	points := make([]*CoursePoint, len(c.Points))
+	for i, p := range c.Points {
+		points[i] = &CoursePoint{Lat: p.Lat, Lon: p.Lon, Elevation: p.Elevation}
+	}
+
+	return &Course{
+		Distance:      int(c.Distance),
+		ElevationGain: c.ElevationGain,
+		ElevationLoss: c.ElevationLoss,
+		Polyline:      c.Polyline(),
+		Points:        points,
+	}
+}
+
+func newCheckpoints(checkpoints racers.RaceCheckpoints) []*Checkpoint {
+	result := make([]*Checkpoint, len(checkpoints))
+	for i, c := range checkpoints {
+		result[i] = &Checkpoint{Name: string(c.Name), Distance: int(c.Distance)}
+		if c.Cutoff != 0 {
+			cutoff := c.Cutoff.String()
+			result[i].Cutoff = &cutoff
+		}
+	}
+
+	return result
+}
+
+func newCompetitorSplits(all []racers.CompetitorSplits) []*CompetitorSplits {
+	result := make([]*CompetitorSplits, len(all))
+	for i, cs := range all {
+		splits := make([]*Split, len(cs.Splits))
+		for j, s := range cs.Splits {
+			splits[j] = &Split{
+				Checkpoint:   string(s.Checkpoint),
+				At:           s.At,
+				Elapsed:      s.Elapsed.String(),
+				Pace:         racers.RaceTime(s.Pace).String(),
+				CutoffMissed: s.CutoffMissed,
+			}
+		}
+
+		result[i] = &CompetitorSplits{Competitor: &User{ID: id.ID(cs.Competitor).String()}, Splits: splits}
+	}
+
+	return result
+}
+
+func newRaceTeams(teams *racers.RaceTeams) *RaceTeams {
+	if teams == nil {
+		return nil
+	}
+
+	return &RaceTeams{
+		MinMembers: teams.Size.Min,
+		MaxMembers: teams.Size.Max,
+		Scoring:    teamScorings[teams.Scoring],
+		Counting:   teams.Counting,
+	}
+}
+
+func newCompetitorResults(ranking []racers.RaceResult) []*CompetitorResult {
+	result := make([]*CompetitorResult, len(ranking))
+	for i, r := range ranking {
+		result[i] = &CompetitorResult{
+			Position:   r.Position,
+			Competitor: &User{ID: id.ID(r.Competitor).String()},
+			Time:       r.Time.String(),
+		}
+	}
+
+	return result
+}
+
+func newTeamStandings(standings []racers.TeamStanding) []*TeamStanding {
+	result := make([]*TeamStanding, len(standings))
+	for i, s := range standings {
+		var position *int
+		if s.Complete() {
+			p := s.Position
+			position = &p
+		}
+
+		result[i] = &TeamStanding{
+			Position:  position,
+			TeamID:    id.ID(s.Team).String(),
+			Time:      racers.RaceTime(s.Time).String(),
+			Points:    s.Points,
+			Finishers: s.Finishers,
+			Complete:  s.Complete(),
+		}
+	}
+
+	return result
+}
+
+func newRaceRelay(race racers.Race) *RaceRelay {
+	if race.Relay == nil {
+		return nil
+	}
+
+	legs := make([]*RelayLeg, len(race.Relay.Legs))
+	for i, l := range race.Relay.Legs {
+		ranking := race.RelayLegRanking(i)
+		results := make([]*RelayLegResult, len(ranking))
+		for j, r := range ranking {
+			results[j] = &RelayLegResult{
+				Position: r.Position,
+				TeamID:   id.ID(r.Team).String(),
+				Runner:   &User{ID: id.ID(r.Runner).String()},
+				Time:     r.Time.String(),
+			}
+		}
+
+		legs[i] = &RelayLeg{
+			Position: i,
+			Name:     string(l.Name),
+			Distance: int(l.Distance),
+			Ranking:  results,
+		}
+	}
+
+	standings := race.RelayStandings()
+	relayStandings := make([]*RelayStanding, len(standings))
+	for i, s := range standings {
+		var position *int
+		if s.Complete() {
+			p := s.Position
+			position = &p
+		}
+
+		relayStandings[i] = &RelayStanding{
+			Position:      position,
+			TeamID:        id.ID(s.Team).String(),
+			Time:          racers.RaceTime(s.Time).String(),
+			LegsCompleted: s.LegsCompleted,
+			Complete:      s.Complete(),
+		}
+	}
+
+	return &RaceRelay{
+		Legs:               legs,
+		AllowRepeatRunners: race.Relay.AllowRepeatRunners,
+		Standings:          relayStandings,
+	}
+}
+
+func NewTeam(team racers.Team) *Team {
+	members := team.Members.List()
+	users := make([]*User, len(members))
+	for i, m := range members {
+		users[i] = &User{ID: id.ID(m).String()}
+	}
+
+	invitations := make([]*TeamInvitation, 0, len(team.Invitations))
+	for u, expiresAt := range team.Invitations {
+		invitations = append(invitations, &TeamInvitation{
+			User:      &User{ID: id.ID(u).String()},
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	requests := team.JoinRequests.List()
+	requestUsers := make([]*User, len(requests))
+	for i, r := range requests {
+		requestUsers[i] = &User{ID: id.ID(r).String()}
+	}
+
+	return &Team{
+		ID:           id.ID(team.ID).String(),
+		Name:         string(team.Name),
+		Admin:        &User{ID: id.ID(team.Admin).String()},
+		Members:      users,
+		Invitations:  invitations,
+		JoinRequests: requestUsers,
 	}
 }
 
@@ -40,3 +539,336 @@ func NewRaces(races []racers.Race) *Races {
 func NewInternalError() error {
 	return errors.New("internal error")
 }
+
+// JSON is a graphql scalar for raw json values
+type JSON struct{ json.RawMessage }
+
+func (j JSON) MarshalGQL(w io.Writer) {
+	if len(j.RawMessage) == 0 {
+		_, _ = io.WriteString(w, "null")
+		return
+	}
+
+	_, _ = w.Write(j.RawMessage)
+}
+
+func (j *JSON) UnmarshalGQL(v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	j.RawMessage = raw
+
+	return nil
+}
+
+func NewAuditLog(page service.AuditLogPage) *AuditLog {
+	edges := make([]*AuditLogEdge, len(page.Events))
+	for i, e := range page.Events {
+		var raceID, orgID *string
+		if e.RaceID != nil {
+			s := id.ID(*e.RaceID).String()
+			raceID = &s
+		}
+		if e.OrganizationID != nil {
+			s := id.ID(*e.OrganizationID).String()
+			orgID = &s
+		}
+
+		edges[i] = &AuditLogEdge{
+			Cursor: e.Cursor(),
+			Node: &AuditLogEntry{
+				ID:             e.ID.String(),
+				Type:           e.Type,
+				Actor:          &User{ID: id.ID(e.UserID).String()},
+				RaceID:         raceID,
+				OrganizationID: orgID,
+				OccurredAt:     e.OccurredAt,
+				Payload:        JSON{e.Payload},
+			},
+		}
+	}
+
+	pageInfo := &PageInfo{HasNextPage: page.HasNextPage}
+	if len(edges) > 0 {
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &AuditLog{Edges: edges, PageInfo: pageInfo}
+}
+
+var importIssueKinds = map[service.ImportIssueKind]ImportIssueKind{
+	service.ImportIssueBadBib:       ImportIssueKindBadBib,
+	service.ImportIssueUnmatchedBib: ImportIssueKindUnmatchedBib,
+	service.ImportIssueDuplicateBib: ImportIssueKindDuplicateBib,
+	service.ImportIssueBadTime:      ImportIssueKindBadTime,
+}
+
+func NewResultsImport(imp service.ResultsImport) ResultsImport {
+	res := make([]*ImportedResult, len(imp.Results))
+	for i, r := range imp.Results {
+		res[i] = &ImportedResult{
+			Line:       r.Line,
+			Bib:        int(r.Bib),
+			Competitor: &User{ID: id.ID(r.Competitor).String()},
+			Time:       r.Time.String(),
+		}
+	}
+
+	issues := make([]*ImportIssue, len(imp.Issues))
+	for i, is := range imp.Issues {
+		issues[i] = &ImportIssue{Line: is.Line, Bib: is.Bib, Kind: importIssueKinds[is.Kind], Message: is.Err.Error()}
+	}
+
+	return ResultsImport{
+		Race:      NewRace(imp.Race),
+		Results:   res,
+		Skipped:   imp.Skipped,
+		Issues:    issues,
+		Committed: imp.Committed,
+	}
+}
+
+// NewCalendarToken returns the created token with the path of its feed
+func NewCalendarToken(t service.CalendarTokenCreated) CalendarToken {
+	return CalendarToken{
+		Secret:    t.Secret,
+		Feed:      fmt.Sprintf("/calendar/%s.ics", t.Secret),
+		CreatedAt: t.Token.CreatedAt,
+	}
+}
+
+// NewRaceSearch returns the races found by a search
+func NewRaceSearch(matches []service.RaceMatch) RaceSearch {
+	result := RaceSearch{Matches: make([]*RaceMatch, len(matches))}
+	for i, m := range matches {
+		result.Matches[i] = &RaceMatch{Race: NewRace(m.Race), Rank: m.Rank, Snippet: m.Snippet}
+	}
+
+	return result
+}
+
+// Series is the graph model of a race series, the standings are resolved on demand
+type Series struct {
+	ID          string
+	Name        string
+	Description string
+	Venue       *Venue
+	Start       time.Time
+	Rule        string
+	Points      []int
+	BestOf      int
+	Races       []*SeriesRace
+}
+
+func (Series) IsSeriesResult()       {}
+func (Series) IsCreateSeriesResult() {}
+func (Series) IsUpdateSeriesResult() {}
+
+func NewSeries(s racers.Series) *Series {
+	loc := time.UTC
+	if s.Template.Venue != nil {
+		loc = s.Template.Venue.TimeZone
+	}
+
+	result := &Series{
+		ID:          id.ID(s.ID).String(),
+		Name:        string(s.Template.Name),
+		Description: s.Template.Description,
+		Venue:       newVenue(s.Template.Venue),
+		Start:       s.Start.In(loc),
+		Rule:        s.Recurrence.String(),
+		Points:      s.Scoring.Points,
+		BestOf:      s.Scoring.BestOf,
+		Races:       make([]*SeriesRace, len(s.Instances)),
+	}
+	for i, instance := range s.Instances {
+		result.Races[i] = &SeriesRace{RaceID: id.ID(instance.Race).String(), Occurrence: instance.Occurrence.In(loc)}
+	}
+
+	return result
+}
+
+func NewSeriesStandings(standings []racers.SeriesStanding) []*SeriesStanding {
+	result := make([]*SeriesStanding, len(standings))
+	for i, s := range standings {
+		result[i] = &SeriesStanding{
+			Position:   s.Position,
+			Competitor: &User{ID: id.ID(s.Competitor).String()},
+			Points:     s.Points,
+			Races:      s.Races,
+		}
+	}
+
+	return result
+}
+
+func NewSeriesRaces(races []racers.Race) SeriesRaces {
+	result := SeriesRaces{Races: make([]*Race, len(races))}
+	for i, r := range races {
+		result.Races[i] = NewRace(r)
+	}
+
+	return result
+}
+
+func newRacePrice(p *racers.RacePrice) *RacePrice {
+	if p == nil {
+		return nil
+	}
+
+	price := &RacePrice{Currency: string(p.Currency), Amount: int(p.Amount), Tiers: make([]*PriceTier, len(p.Tiers))}
+	for i, t := range p.Tiers {
+		price.Tiers[i] = &PriceTier{Until: t.Until, Amount: int(t.Amount)}
+	}
+
+	return price
+}
+
+func NewRegistration(r service.RaceRegistration) Registration {
+	reg := Registration{
+		RaceID:     id.ID(r.Race).String(),
+		Competitor: &User{ID: id.ID(r.Competitor).String()},
+		Status:     RegistrationStatus(r.Status),
+	}
+	if !r.Fee.IsZero() {
+		reg.Fee = &Money{Amount: int(r.Fee.Amount), Currency: string(r.Fee.Currency)}
+	}
+	if r.Status == racers.RegistrationPendingPayment {
+		expiresAt := r.ExpiresAt
+		reg.ExpiresAt = &expiresAt
+	}
+	if r.Code != "" {
+		code := r.Code
+		reg.Code = &code
+	}
+
+	return reg
+}
+
+func NewDiscountCode(c racers.DiscountCode) DiscountCode {
+	code := DiscountCode{
+		RaceID:      id.ID(c.Race).String(),
+		Code:        c.Code,
+		Redemptions: c.Redemptions,
+	}
+	if c.Discount.Percent > 0 {
+		percent := c.Discount.Percent
+		code.Percent = &percent
+	}
+	if c.Discount.Amount > 0 {
+		amount := int(c.Discount.Amount)
+		code.Amount = &amount
+	}
+	if c.MaxRedemptions > 0 {
+		max := c.MaxRedemptions
+		code.MaxRedemptions = &max
+	}
+	if !c.ValidFrom.IsZero() {
+		from := c.ValidFrom
+		code.ValidFrom = &from
+	}
+	if !c.ValidUntil.IsZero() {
+		until := c.ValidUntil
+		code.ValidUntil = &until
+	}
+	if c.Category != "" {
+		category := string(c.Category)
+		code.Category = &category
+	}
+
+	return code
+}
+
+func NewDiscountCodes(codes []racers.DiscountCode) DiscountCodes {
+	result := DiscountCodes{Codes: make([]*DiscountCode, len(codes))}
+	for i, c := range codes {
+		code := NewDiscountCode(c)
+		result.Codes[i] = &code
+	}
+
+	return result
+}
+
+func NewNotificationPreferences(p service.NotificationPreferences) NotificationPreferences {
+	prefs := NotificationPreferences{Locale: p.Locale, Disabled: make([]NotificationKind, len(p.Disabled))}
+	for i, k := range p.Disabled {
+		prefs.Disabled[i] = NotificationKind(k)
+	}
+
+	return prefs
+}
+
+func NewOrganization(o service.OrganizationOverview) Organization {
+	members := make([]*OrganizationMember, 0, len(o.Organization.Members))
+	for u, role := range o.Organization.Members {
+		members = append(members, &OrganizationMember{User: &User{ID: id.ID(u).String()}, Role: OrganizationRole(role)})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Role != members[j].Role {
+			return members[i].Role == OrganizationRoleAdmin
+		}
+
+		return members[i].User.ID < members[j].User.ID
+	})
+
+	races := make([]*Race, len(o.Races))
+	for i, r := range o.Races {
+		races[i] = NewRace(r)
+	}
+
+	return Organization{
+		ID:        id.ID(o.Organization.ID).String(),
+		Name:      string(o.Organization.Name),
+		Members:   members,
+		Races:     races,
+		Dashboard: newOrganizationDashboard(o.Dashboard),
+	}
+}
+
+func newOrganizationDashboard(d *service.OrganizationDashboard) *OrganizationDashboard {
+	if d == nil {
+		return nil
+	}
+
+	races := make([]*RaceDashboard, len(d.Races))
+	for i, r := range d.Races {
+		races[i] = &RaceDashboard{
+			RaceID:       id.ID(r.Race).String(),
+			Participants: r.Participants,
+			Confirmed:    r.Confirmed,
+			CheckedIn:    r.CheckedIn,
+			Revenue:      newAmounts(r.Revenue),
+		}
+	}
+
+	return &OrganizationDashboard{Races: races, Revenue: newAmounts(d.Revenue)}
+}
+
+func newAmounts(amounts []racers.Money) []*Money {
+	result := make([]*Money, len(amounts))
+	for i, m := range amounts {
+		result[i] = &Money{Amount: int(m.Amount), Currency: string(m.Currency)}
+	}
+
+	return result
+}
+
+// NewDataExport returns the export with the zip encoded in base64
+func NewDataExport(e service.DataExport) DataExport {
+	return DataExport{
+		FileName:    e.FileName,
+		ContentType: "application/zip",
+		Data:        base64.StdEncoding.EncodeToString(e.Data),
+	}
+}
+
+func NewErasure(e service.Erasure) Erasure {
+	return Erasure{
+		ID:          e.ID.String(),
+		Status:      ErasureStatus(e.Status),
+		RequestedAt: e.RequestedAt,
+		FinishedAt:  e.FinishedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) UpdateNotificationPreferences(ctx context.Context, preferences models.NotificationPreferencesInput) (models.UpdateNotificationPreferencesResult, error) {
+	disabled := make([]string, len(preferences.Disabled))
+	for i, k := range preferences.Disabled {
+		disabled[i] = string(k)
+	}
+
+	prefs, err := r.notifications.UpdatePreferences(ctx, service.UpdateNotificationPreferences{
+		Locale:   preferences.Locale,
+		Disabled: disabled,
+	})
+	if err != nil {
+		switch {
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUnsupportedLocale):
+			return models.UnsupportedLocaleError{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewNotificationPreferences(prefs), nil
+}
+
+func (r *queryResolver) NotificationPreferences(ctx context.Context) (models.NotificationPreferencesResult, error) {
+	prefs, err := r.notifications.Preferences(ctx)
+	if err != nil {
+		if errorsx.Is(err, service.ErrForbidden) {
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewNotificationPreferences(prefs), nil
+}
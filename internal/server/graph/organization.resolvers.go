@@ -0,0 +1,97 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) CreateOrganization(ctx context.Context, organization models.OrganizationInput) (models.CreateOrganizationResult, error) {
+	result, err := r.orgs.Create(ctx, service.CreateOrganization{ID: organization.ID, Name: organization.Name})
+
+	var (
+		invalidID   racers.InvalidOrganizationIDError
+		invalidName racers.InvalidOrganizationNameError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		case errorsx.As(err, &invalidName):
+			return models.InvalidOrganizationNameError{Message: invalidName.Error()}, nil
+		case errorsx.Is(err, service.ErrOrganizationAlreadyExists):
+			return models.OrganizationAlreadyExists{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewOrganization(result), nil
+}
+
+func (r *mutationResolver) AddOrganizationMember(ctx context.Context, member models.OrganizationMemberInput) (models.OrganizationMemberResult, error) {
+	result, err := r.orgs.AddMember(ctx, service.OrganizationMember{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           string(member.Role),
+	})
+
+	return organizationMemberResult(result, err)
+}
+
+func (r *mutationResolver) RemoveOrganizationMember(ctx context.Context, member models.RemoveOrganizationMemberInput) (models.OrganizationMemberResult, error) {
+	result, err := r.orgs.RemoveMember(ctx, service.OrganizationMember{OrganizationID: member.OrganizationID, UserID: member.UserID})
+
+	return organizationMemberResult(result, err)
+}
+
+func (r *mutationResolver) AssignRaceToOrganization(ctx context.Context, assignment models.RaceOrganizationInput) (models.AssignRaceToOrganizationResult, error) {
+	result, err := r.orgs.AssignRace(ctx, service.AssignRace{OrganizationID: assignment.OrganizationID, RaceID: assignment.RaceID})
+
+	var (
+		invalidOrgID  racers.InvalidOrganizationIDError
+		invalidRaceID racers.InvalidRaceIDError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidOrgID):
+			return models.InvalidIDError{Message: invalidOrgID.Error()}, nil
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrOrganizationNotFound):
+			return models.OrganizationNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(result), nil
+}
+
+func (r *queryResolver) Organization(ctx context.Context, id string) (models.OrganizationResult, error) {
+	result, err := r.orgs.Get(ctx, id)
+
+	var invalidID racers.InvalidOrganizationIDError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		case errorsx.Is(err, service.ErrOrganizationNotFound):
+			return models.OrganizationNotFound{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewOrganization(result), nil
+}
@@ -0,0 +1,55 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) EraseMyData(ctx context.Context) (models.EraseMyDataResult, error) {
+	erasure, err := r.privacy.EraseMyData(ctx)
+	if err != nil {
+		if errorsx.Is(err, service.ErrForbidden) {
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewErasure(erasure), nil
+}
+
+func (r *queryResolver) ExportMyData(ctx context.Context) (models.ExportMyDataResult, error) {
+	export, err := r.privacy.ExportMyData(ctx)
+	if err != nil {
+		if errorsx.Is(err, service.ErrForbidden) {
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewDataExport(export), nil
+}
+
+func (r *queryResolver) Erasure(ctx context.Context, id string) (models.ErasureResult, error) {
+	erasure, err := r.privacy.Erasure(ctx, id)
+
+	var invalidID service.InvalidErasureIDError
+	if err != nil {
+		switch {
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrErasureNotFound):
+			return models.ErasureNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewErasure(erasure), nil
+}
@@ -0,0 +1,129 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) JoinRace(ctx context.Context, registration models.JoinRaceInput) (models.JoinRaceResult, error) {
+	join := service.JoinRace{
+		RaceID:   registration.RaceID,
+		UserID:   registration.UserID,
+		Category: stringValue(registration.Category),
+	}
+
+	var (
+		reg service.RaceRegistration
+		err error
+	)
+	if registration.Code != nil {
+		reg, err = r.codes.Join(ctx, service.JoinRaceWithCode{JoinRace: join, Code: *registration.Code})
+	} else {
+		reg, err = r.racers.Join(ctx, join)
+	}
+
+	var (
+		invalidRace     racers.InvalidRaceIDError
+		invalidUser     racers.InvalidUserIDError
+		inRace          racers.CompetitorInRaceError
+		unknownCategory racers.UnknownCategoryError
+		categoryFull    racers.CategoryFullError
+		notEligible     racers.NotEligibleError
+		rangeExhausted  racers.BibRangeExhaustedError
+		notApplicable   racers.DiscountCodeNotApplicableError
+		closed          racers.RegistrationClosedError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRace):
+			return models.InvalidIDError{Message: invalidRace.Error()}, nil
+		case errorsx.As(err, &invalidUser):
+			return models.InvalidIDError{Message: invalidUser.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUserNotFound):
+			return models.RegistrationError{Message: err.Error()}, nil
+		case errorsx.As(err, &inRace):
+			return models.RegistrationError{Message: inRace.Error()}, nil
+		case errorsx.As(err, &unknownCategory):
+			return models.RegistrationError{Message: unknownCategory.Error()}, nil
+		case errorsx.As(err, &categoryFull):
+			return models.RegistrationError{Message: categoryFull.Error()}, nil
+		case errorsx.As(err, &notEligible):
+			return models.RegistrationError{Message: notEligible.Error()}, nil
+		case errorsx.As(err, &rangeExhausted):
+			return models.RegistrationError{Message: rangeExhausted.Error()}, nil
+		case errorsx.As(err, &closed):
+			return models.RegistrationError{Message: closed.Error()}, nil
+		case errorsx.Is(err, service.ErrDiscountCodeNotFound):
+			return models.DiscountCodeNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notApplicable):
+			return models.DiscountCodeNotApplicableError{Message: notApplicable.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRegistration(reg), nil
+}
+
+func (r *mutationResolver) Checkout(ctx context.Context, raceID string) (models.CheckoutResult, error) {
+	payment, err := r.payments.Checkout(ctx, service.StartCheckout{RaceID: raceID})
+
+	var (
+		invalidID racers.InvalidRaceIDError
+		notFound  racers.RegistrationNotFoundError
+		invalid   racers.InvalidRegistrationStatusError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidID):
+			return models.InvalidIDError{Message: invalidID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notFound):
+			return models.RegistrationError{Message: notFound.Error()}, nil
+		case errorsx.As(err, &invalid):
+			return models.RegistrationError{Message: invalid.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.Checkout{PaymentID: payment.ID, URL: payment.URL}, nil
+}
+
+func (r *mutationResolver) RefundRegistration(ctx context.Context, registration models.RefundRegistrationInput) (models.RefundRegistrationResult, error) {
+	reg, err := r.payments.Refund(ctx, service.RefundRegistration{RaceID: registration.RaceID, UserID: registration.UserID})
+
+	var (
+		invalidRace racers.InvalidRaceIDError
+		invalidUser racers.InvalidUserIDError
+		notFound    racers.RegistrationNotFoundError
+		invalid     racers.InvalidRegistrationStatusError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRace):
+			return models.InvalidIDError{Message: invalidRace.Error()}, nil
+		case errorsx.As(err, &invalidUser):
+			return models.InvalidIDError{Message: invalidUser.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &notFound):
+			return models.RegistrationError{Message: notFound.Error()}, nil
+		case errorsx.As(err, &invalid):
+			return models.RegistrationError{Message: invalid.Error()}, nil
+		}
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRegistration(reg), nil
+}
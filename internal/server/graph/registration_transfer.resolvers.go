@@ -0,0 +1,133 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) SetRegistrationTransfers(ctx context.Context, race models.RegistrationTransfersInput) (models.SetRegistrationTransfersResult, error) {
+	result, err := r.racers.SetTransferPolicy(ctx, service.SetTransferPolicy{RaceID: race.RaceID, Allowed: race.Allowed, Deadline: race.Deadline})
+
+	var (
+		invalidRaceID   racers.InvalidRaceIDError
+		invalidDeadline racers.InvalidTransferDeadlineError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidDeadline):
+			return models.InvalidTransferDeadlineError{Message: invalidDeadline.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(result), nil
+}
+
+func (r *mutationResolver) OfferRegistration(ctx context.Context, transfer models.OfferRegistrationInput) (models.OfferRegistrationResult, error) {
+	offer, err := r.racers.OfferTransfer(ctx, service.OfferRegistrationTransfer{RaceID: transfer.RaceID, UserID: transfer.UserID})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		notAllowed    racers.RegistrationTransferNotAllowedError
+		notInRace     racers.CompetitorNotInRaceError
+		inRace        racers.CompetitorInRaceError
+		invalidStatus racers.InvalidRegistrationStatusError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUserNotFound):
+			return models.UserNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notAllowed):
+			return models.RegistrationTransferError{Message: notAllowed.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.RegistrationTransferError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &inRace):
+			return models.RegistrationTransferError{Message: inRace.Error()}, nil
+		case errorsx.As(err, &invalidStatus):
+			return models.RegistrationTransferError{Message: invalidStatus.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRegistrationOffer(offer), nil
+}
+
+func (r *mutationResolver) WithdrawRegistrationOffer(ctx context.Context, raceID string) (models.WithdrawRegistrationOfferResult, error) {
+	err := r.racers.WithdrawTransfer(ctx, service.WithdrawRegistrationTransfer{RaceID: raceID})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		notFound      racers.RegistrationTransferNotFoundError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notFound):
+			return models.RegistrationTransferError{Message: notFound.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.RegistrationOfferWithdrawn{RaceID: raceID}, nil
+}
+
+func (r *mutationResolver) AcceptRegistration(ctx context.Context, transfer models.AcceptRegistrationInput) (models.AcceptRegistrationResult, error) {
+	reg, err := r.racers.AcceptTransfer(ctx, service.AcceptRegistrationTransfer{RaceID: transfer.RaceID, UserID: transfer.UserID})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		notFound      racers.RegistrationTransferNotFoundError
+		notAllowed    racers.RegistrationTransferNotAllowedError
+		inRace        racers.CompetitorInRaceError
+		notEligible   racers.NotEligibleError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notFound):
+			return models.RegistrationTransferError{Message: notFound.Error()}, nil
+		case errorsx.As(err, &notAllowed):
+			return models.RegistrationTransferError{Message: notAllowed.Error()}, nil
+		case errorsx.As(err, &inRace):
+			return models.RegistrationError{Message: inRace.Error()}, nil
+		case errorsx.As(err, &notEligible):
+			return models.RegistrationError{Message: notEligible.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRegistration(reg), nil
+}
@@ -0,0 +1,108 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) SetRelayLineUp(ctx context.Context, lineUp models.RelayLineUpInput) (models.SetRelayLineUpResult, error) {
+	race, err := r.racers.SetRelayLineUp(ctx, service.SetRelayLineUp{
+		RaceID:  lineUp.RaceID,
+		TeamID:  lineUp.TeamID,
+		Runners: lineUp.Runners,
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidTeamID racers.InvalidTeamIDError
+		invalidUserID racers.InvalidUserIDError
+		notAdmin      racers.NotTeamAdminError
+		notRelay      racers.NotRelayRaceError
+		incomplete    racers.IncompleteRelayLineUpError
+		severalLegs   racers.RunnerInSeveralLegsError
+		notMember     racers.NotTeamMemberError
+		notInRace     racers.CompetitorNotInRaceError
+		inOtherTeam   racers.CompetitorInRaceTeamError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidTeamID):
+			return models.InvalidIDError{Message: invalidTeamID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrTeamNotFound):
+			return models.TeamNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notAdmin):
+			return models.Forbidden{Message: notAdmin.Error()}, nil
+		case errorsx.As(err, &notRelay):
+			return models.InvalidRelayLineUpError{Message: notRelay.Error()}, nil
+		case errorsx.As(err, &incomplete):
+			return models.InvalidRelayLineUpError{Message: incomplete.Error()}, nil
+		case errorsx.As(err, &severalLegs):
+			return models.InvalidRelayLineUpError{Message: severalLegs.Error()}, nil
+		case errorsx.As(err, &notMember):
+			return models.InvalidRelayLineUpError{Message: notMember.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.InvalidRelayLineUpError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &inOtherTeam):
+			return models.InvalidRelayLineUpError{Message: inOtherTeam.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
+
+func (r *mutationResolver) RecordLegSplit(ctx context.Context, split models.LegSplitInput) (models.RecordLegSplitResult, error) {
+	race, err := r.racers.RecordLegSplit(ctx, service.RecordLegSplit{
+		RaceID: split.RaceID,
+		TeamID: split.TeamID,
+		Leg:    split.Leg,
+		Time:   split.Time,
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidTeamID racers.InvalidTeamIDError
+		invalidTime   racers.InvalidRaceTimeError
+		notRelay      racers.NotRelayRaceError
+		notInRelay    racers.TeamNotInRelayError
+		unknownLeg    racers.UnknownRelayLegError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidTeamID):
+			return models.InvalidIDError{Message: invalidTeamID.Error()}, nil
+		case errorsx.As(err, &invalidTime):
+			return models.InvalidRaceTimeError{Message: invalidTime.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &notRelay):
+			return models.InvalidLegSplitError{Message: notRelay.Error()}, nil
+		case errorsx.As(err, &notInRelay):
+			return models.InvalidLegSplitError{Message: notInRelay.Error()}, nil
+		case errorsx.As(err, &unknownLeg):
+			return models.InvalidLegSplitError{Message: unknownLeg.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
@@ -1,15 +1,252 @@
 package graph
 
 import (
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/results"
+	"github.com/xabi93/racers/internal/server/graph/models"
 	"github.com/xabi93/racers/internal/service"
 )
 
 //go:generate go run github.com/99designs/gqlgen
 
-func New(races service.Races) Config {
-	return Config{Resolvers: &Resolver{races}}
+func New(races service.Races, teams service.Teams, audit service.Audit, calendars service.Calendars, series service.Series, payments service.Payments, codes service.DiscountCodes, notifications service.Notifications, cancels service.Cancellations, checkIns service.CheckIns, orgs service.Organizations, privacy service.Privacy) Config {
+	return Config{Resolvers: &Resolver{races, teams, audit, calendars, series, payments, codes, notifications, cancels, checkIns, orgs, privacy}}
 }
 
 type Resolver struct {
-	racers service.Races
+	racers    service.Races
+	teams     service.Teams
+	audit     service.Audit
+	calendars service.Calendars
+	series    service.Series
+	payments  service.Payments
+	codes     service.DiscountCodes
+
+	notifications service.Notifications
+	cancels       service.Cancellations
+	checkIns      service.CheckIns
+	orgs          service.Organizations
+	privacy       service.Privacy
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+// seriesDefinition maps the series input to the service request
+func seriesDefinition(in models.SeriesInput) service.SeriesDefinition {
+	def := service.SeriesDefinition{
+		Name:        in.Name,
+		Description: stringValue(in.Description),
+		Start:       in.Start,
+		Rule:        in.Rule,
+		Points:      in.Points,
+		BestOf:      intValue(in.BestOf),
+	}
+	if v := in.Venue; v != nil {
+		def.Venue = &service.CreateRaceVenue{Name: v.Name, Address: stringValue(v.Address), Lat: v.Lat, Lon: v.Lon, TimeZone: v.TimeZone}
+	}
+
+	return def
+}
+
+// racePrice maps the price input to the service request, nil when the race is free
+func racePrice(in *models.RacePriceInput) *service.CreateRacePrice {
+	if in == nil {
+		return nil
+	}
+
+	price := &service.CreateRacePrice{Currency: in.Currency, Amount: int64(in.Amount)}
+	for _, t := range in.Tiers {
+		price.Tiers = append(price.Tiers, service.CreatePriceTier{Until: t.Until, Amount: int64(t.Amount)})
+	}
+
+	return price
+}
+
+// teamResult maps the result of the team membership operations
+func teamResult(team racers.Team, err error) (models.TeamResult, error) {
+	var (
+		invalidTeamID  racers.InvalidTeamIDError
+		invalidUserID  racers.InvalidUserIDError
+		notAdmin       racers.NotTeamAdminError
+		notMember      racers.NotTeamMemberError
+		inTeam         racers.UserAlreadyInTeamError
+		noInvitation   racers.TeamInvitationNotFoundError
+		expired        racers.TeamInvitationExpiredError
+		noJoinRequest  racers.TeamJoinRequestNotFoundError
+		adminCantLeave racers.TeamAdminCannotLeaveError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidTeamID):
+			return models.InvalidIDError{Message: invalidTeamID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrTeamNotFound):
+			return models.TeamNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUserNotFound):
+			return models.UserNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notAdmin):
+			return models.Forbidden{Message: notAdmin.Error()}, nil
+		case errorsx.As(err, &notMember):
+			return models.TeamMembershipError{Message: notMember.Error()}, nil
+		case errorsx.As(err, &inTeam):
+			return models.TeamMembershipError{Message: inTeam.Error()}, nil
+		case errorsx.As(err, &noInvitation):
+			return models.TeamMembershipError{Message: noInvitation.Error()}, nil
+		case errorsx.As(err, &expired):
+			return models.TeamMembershipError{Message: expired.Error()}, nil
+		case errorsx.As(err, &noJoinRequest):
+			return models.TeamMembershipError{Message: noJoinRequest.Error()}, nil
+		case errorsx.As(err, &adminCantLeave):
+			return models.TeamMembershipError{Message: adminCantLeave.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewTeam(team), nil
+}
+
+// raceStaffResult maps the result of the race staff and ownership operations
+func raceStaffResult(race racers.Race, err error) (models.RaceStaffResult, error) {
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		invalidRole   racers.InvalidStaffRoleError
+		inRace        racers.StaffInRaceError
+		notInRace     racers.StaffNotInRaceError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUserNotFound):
+			return models.UserNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidRole):
+			return models.StaffError{Message: invalidRole.Error()}, nil
+		case errorsx.As(err, &inRace):
+			return models.StaffError{Message: inRace.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.StaffError{Message: notInRace.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
+
+func organizationMemberResult(org service.OrganizationOverview, err error) (models.OrganizationMemberResult, error) {
+	var (
+		invalidOrgID  racers.InvalidOrganizationIDError
+		invalidUserID racers.InvalidUserIDError
+		invalidRole   racers.InvalidOrganizationRoleError
+		memberErr     racers.OrganizationMemberError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidOrgID):
+			return models.InvalidIDError{Message: invalidOrgID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrOrganizationNotFound):
+			return models.OrganizationNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrUserNotFound):
+			return models.UserNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &invalidRole):
+			return models.OrganizationMemberError{Message: invalidRole.Error()}, nil
+		case errorsx.As(err, &memberErr):
+			return models.OrganizationMemberError{Message: memberErr.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewOrganization(org), nil
+}
+
+// importResultsRequest builds the service request of the results import, outside of the resolver
+// as its argument shadows the results package
+func importResultsRequest(input models.ResultsImportInput) service.ImportResults {
+	req := service.ImportResults{
+		RaceID: input.RaceID,
+		Format: input.Format,
+		File:   input.File.File,
+		DryRun: input.DryRun,
+	}
+	if m := input.Mapping; m != nil {
+		req.Mapping = results.Mapping{
+			Bib:      m.Bib,
+			ChipTime: stringValue(m.ChipTime),
+			GunTime:  stringValue(m.GunTime),
+			Status:   stringValue(m.Status),
+		}
+	}
+
+	return req
+}
+
+// importResultsResult maps the result of the results import
+func importResultsResult(imp service.ResultsImport, err error) (models.ImportResultsResult, error) {
+	var (
+		invalidRaceID  racers.InvalidRaceIDError
+		unknownFormat  results.UnknownFormatError
+		invalidMapping results.InvalidMappingError
+		parseErr       results.ParseError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		case errorsx.As(err, &unknownFormat):
+			return models.InvalidResultsFileError{Message: unknownFormat.Error()}, nil
+		case errorsx.As(err, &invalidMapping):
+			return models.InvalidResultsFileError{Message: invalidMapping.Error()}, nil
+		case errorsx.As(err, &parseErr):
+			return models.InvalidResultsFileError{Message: parseErr.Error()}, nil
+		case errorsx.Is(err, service.ErrResultsFileTooLarge):
+			return models.InvalidResultsFileError{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewResultsImport(imp), nil
 }
@@ -0,0 +1,14 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/xabi93/racers/internal/server/graph/models"
+)
+
+func (r *mutationResolver) ImportResults(ctx context.Context, results models.ResultsImportInput) (models.ImportResultsResult, error) {
+	return importResultsResult(r.racers.ImportResults(ctx, importResultsRequest(results)))
+}
@@ -13,16 +13,85 @@ import (
 )
 
 func (r *mutationResolver) CreateRace(ctx context.Context, race models.RaceInput) (models.CreateRaceResult, error) {
-	result, err := r.racers.Create(ctx, service.CreateRace{
-		ID:   race.ID,
-		Name: race.Name,
-		Date: race.Date,
-	})
+	req := service.CreateRace{
+		ID:          race.ID,
+		Name:        race.Name,
+		Date:        race.Date,
+		Description: stringValue(race.Description),
+	}
+	if race.Teams != nil {
+		req.Teams = &service.CreateRaceTeams{
+			MinMembers: race.Teams.MinMembers,
+			MaxMembers: race.Teams.MaxMembers,
+			Scoring:    models.DomainTeamScoring(race.Teams.Scoring),
+			Counting:   race.Teams.Counting,
+		}
+	}
+	if race.Relay != nil {
+		legs := make([]service.CreateRelayLeg, len(race.Relay.Legs))
+		for i, l := range race.Relay.Legs {
+			legs[i] = service.CreateRelayLeg{Name: l.Name, Distance: l.Distance}
+		}
+		req.Relay = &service.CreateRaceRelay{Legs: legs, AllowRepeatRunners: race.Relay.AllowRepeatRunners}
+	}
+	for _, c := range race.Categories {
+		category := service.CreateRaceCategory{
+			Name:      c.Name,
+			Distance:  c.Distance,
+			StartTime: c.StartTime,
+			Capacity:  intValue(c.Capacity),
+			MinAge:    intValue(c.MinAge),
+		}
+		if c.Gender != nil {
+			category.Gender = models.DomainGender(*c.Gender)
+		}
+		if c.BibRange != nil {
+			category.BibFrom, category.BibTo = c.BibRange.From, c.BibRange.To
+		}
+		category.Price = racePrice(c.Price)
+		req.Categories = append(req.Categories, category)
+	}
+	for _, c := range race.Checkpoints {
+		checkpoint := service.CreateCheckpoint{Name: c.Name, Distance: c.Distance}
+		if c.Cutoff != nil {
+			checkpoint.Cutoff = *c.Cutoff
+		}
+		req.Checkpoints = append(req.Checkpoints, checkpoint)
+	}
+	if race.Bibs != nil {
+		req.Bibs = &service.CreateRaceBibs{Strategy: models.DomainBibStrategy(race.Bibs.Strategy), First: intValue(race.Bibs.First)}
+	}
+	if v := race.Venue; v != nil {
+		req.Venue = &service.CreateRaceVenue{Name: v.Name, Address: stringValue(v.Address), Lat: v.Lat, Lon: v.Lon, TimeZone: v.TimeZone}
+	}
+	req.Price = racePrice(race.Price)
+	req.RegistrationDeadline = race.RegistrationDeadline
+
+	result, err := r.racers.Create(ctx, req)
 
 	var (
-		invalidID   racers.InvalidRaceIDError
-		invalidName racers.InvalidRaceNameError
-		invalidDate racers.InvalidRaceDateError
+		invalidID       racers.InvalidRaceIDError
+		invalidName     racers.InvalidRaceNameError
+		invalidDate     racers.InvalidRaceDateError
+		invalidSize     racers.InvalidRaceTeamSizeError
+		invalidScoring  racers.InvalidTeamScoringError
+		invalidCounting racers.InvalidRaceTeamsCountingError
+		invalidLegs     racers.InvalidRelayLegsError
+		invalidLegName  racers.InvalidRelayLegNameError
+		invalidDistance racers.InvalidDistanceError
+		invalidCategory racers.InvalidCategoryError
+		invalidCatName  racers.InvalidCategoryNameError
+		invalidGender   racers.InvalidGenderError
+		invalidCps      racers.InvalidCheckpointsError
+		invalidCpName   racers.InvalidCheckpointNameError
+		invalidCutoff   racers.InvalidRaceTimeError
+		invalidBibs     racers.InvalidRaceBibsError
+		invalidStrategy racers.InvalidBibStrategyError
+		invalidVenue    racers.InvalidVenueError
+		invalidTimeZone racers.InvalidTimeZoneError
+		invalidDeadline racers.InvalidRegistrationDeadlineError
+		invalidCurrency racers.InvalidCurrencyError
+		invalidPrice    racers.InvalidRacePriceError
 	)
 	if err != nil {
 		switch {
@@ -32,6 +101,44 @@ func (r *mutationResolver) CreateRace(ctx context.Context, race models.RaceInput
 			return models.InvalidRaceNameError{Message: invalidName.Error()}, nil
 		case errorsx.As(err, &invalidDate):
 			return models.InvalidRaceDateError{Message: invalidDate.Error()}, nil
+		case errorsx.As(err, &invalidSize):
+			return models.InvalidRaceTeamsError{Message: invalidSize.Error()}, nil
+		case errorsx.As(err, &invalidScoring):
+			return models.InvalidRaceTeamsError{Message: invalidScoring.Error()}, nil
+		case errorsx.As(err, &invalidCounting):
+			return models.InvalidRaceTeamsError{Message: invalidCounting.Error()}, nil
+		case errorsx.As(err, &invalidLegs):
+			return models.InvalidRaceRelayError{Message: invalidLegs.Error()}, nil
+		case errorsx.As(err, &invalidLegName):
+			return models.InvalidRaceRelayError{Message: invalidLegName.Error()}, nil
+		case errorsx.As(err, &invalidCategory):
+			return models.InvalidRaceCategoryError{Message: invalidCategory.Error()}, nil
+		case errorsx.As(err, &invalidCatName):
+			return models.InvalidRaceCategoryError{Message: invalidCatName.Error()}, nil
+		case errorsx.As(err, &invalidGender):
+			return models.InvalidRaceCategoryError{Message: invalidGender.Error()}, nil
+		case errorsx.As(err, &invalidCps):
+			return models.InvalidRaceCheckpointsError{Message: invalidCps.Error()}, nil
+		case errorsx.As(err, &invalidCpName):
+			return models.InvalidRaceCheckpointsError{Message: invalidCpName.Error()}, nil
+		case errorsx.As(err, &invalidCutoff):
+			return models.InvalidRaceCheckpointsError{Message: invalidCutoff.Error()}, nil
+		case errorsx.As(err, &invalidBibs):
+			return models.InvalidRaceBibsError{Message: invalidBibs.Error()}, nil
+		case errorsx.As(err, &invalidStrategy):
+			return models.InvalidRaceBibsError{Message: invalidStrategy.Error()}, nil
+		case errorsx.As(err, &invalidVenue):
+			return models.InvalidVenueError{Message: invalidVenue.Error()}, nil
+		case errorsx.As(err, &invalidTimeZone):
+			return models.InvalidVenueError{Message: invalidTimeZone.Error()}, nil
+		case errorsx.As(err, &invalidDeadline):
+			return models.InvalidRaceDateError{Message: invalidDeadline.Error()}, nil
+		case errorsx.As(err, &invalidCurrency):
+			return models.InvalidRacePriceError{Message: invalidCurrency.Error()}, nil
+		case errorsx.As(err, &invalidPrice):
+			return models.InvalidRacePriceError{Message: invalidPrice.Error()}, nil
+		case errorsx.As(err, &invalidDistance):
+			return models.InvalidRaceRelayError{Message: invalidDistance.Error()}, nil
 		case errorsx.Is(err, service.ErrRaceAlreadyExists):
 			return models.RaceAlreadyExists{Message: err.Error()}, nil
 		}
@@ -41,6 +148,48 @@ func (r *mutationResolver) CreateRace(ctx context.Context, race models.RaceInput
 	return models.NewRace(result), err
 }
 
+func (r *mutationResolver) RecordResult(ctx context.Context, result models.RaceResultInput) (models.RecordResultResult, error) {
+	race, err := r.racers.RecordResult(ctx, service.RecordResult{
+		RaceID: result.RaceID,
+		UserID: stringValue(result.UserID),
+		Bib:    intValue(result.Bib),
+		Time:   result.Time,
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidUserID racers.InvalidUserIDError
+		invalidTime   racers.InvalidRaceTimeError
+		notInRace     racers.CompetitorNotInRaceError
+		invalidBib    racers.InvalidBibError
+		unknownBib    racers.UnknownBibError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.As(err, &invalidTime):
+			return models.InvalidRaceTimeError{Message: invalidTime.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.CompetitorNotInRaceError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &invalidBib):
+			return models.InvalidBibError{Message: invalidBib.Error()}, nil
+		case errorsx.As(err, &unknownBib):
+			return models.InvalidBibError{Message: unknownBib.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
+
 func (r *queryResolver) Race(ctx context.Context, id string) (models.RaceResult, error) {
 	result, err := r.racers.Get(ctx, service.GetRace{ID: id})
 
@@ -68,6 +217,24 @@ func (r *queryResolver) Races(ctx context.Context) (*models.Races, error) {
 	return models.NewRaces(races), nil
 }
 
+func (r *queryResolver) RacesNear(ctx context.Context, lat float64, lon float64, radiusKm float64) (models.RacesNearResult, error) {
+	races, err := r.racers.Near(ctx, service.RacesNear{Lat: lat, Lon: lon, RadiusKm: radiusKm})
+
+	var invalidCoordinates racers.InvalidCoordinatesError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidCoordinates):
+			return models.InvalidRacesNearError{Message: invalidCoordinates.Error()}, nil
+		case errorsx.Is(err, service.ErrInvalidRadius):
+			return models.InvalidRacesNearError{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRaces(races), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
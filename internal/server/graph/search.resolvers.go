@@ -0,0 +1,39 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *queryResolver) SearchRaces(ctx context.Context, query string, filter *models.RaceSearchFilter, first *int) (models.SearchRacesResult, error) {
+	req := service.SearchRaces{Query: query, First: intValue(first)}
+	if filter != nil {
+		req.From, req.To = filter.From, filter.To
+		if n := filter.Near; n != nil {
+			req.Near = &service.RacesNear{Lat: n.Lat, Lon: n.Lon, RadiusKm: n.RadiusKm}
+		}
+	}
+
+	matches, err := r.racers.Search(ctx, req)
+
+	var invalidCoordinates racers.InvalidCoordinatesError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidCoordinates):
+			return models.InvalidRaceSearchError{Message: invalidCoordinates.Error()}, nil
+		case errorsx.Is(err, service.ErrInvalidRadius), errorsx.Is(err, service.ErrEmptySearchQuery):
+			return models.InvalidRaceSearchError{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRaceSearch(matches), nil
+}
@@ -0,0 +1,140 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) CreateSeries(ctx context.Context, series models.SeriesInput) (models.CreateSeriesResult, error) {
+	result, err := r.series.Create(ctx, service.CreateSeries{ID: series.ID, SeriesDefinition: seriesDefinition(series)})
+
+	var (
+		invalidSeriesID racers.InvalidSeriesIDError
+		invalidName     racers.InvalidRaceNameError
+		invalidDate     racers.InvalidRaceDateError
+		invalidVenue    racers.InvalidVenueError
+		invalidTimeZone racers.InvalidTimeZoneError
+		invalidRule     racers.InvalidRecurrenceError
+		invalidScoring  racers.InvalidSeriesScoringError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidSeriesID):
+			return models.InvalidIDError{Message: invalidSeriesID.Error()}, nil
+		case errorsx.As(err, &invalidName):
+			return models.InvalidRaceNameError{Message: invalidName.Error()}, nil
+		case errorsx.As(err, &invalidDate):
+			return models.InvalidRaceDateError{Message: invalidDate.Error()}, nil
+		case errorsx.As(err, &invalidVenue):
+			return models.InvalidVenueError{Message: invalidVenue.Error()}, nil
+		case errorsx.As(err, &invalidTimeZone):
+			return models.InvalidVenueError{Message: invalidTimeZone.Error()}, nil
+		case errorsx.As(err, &invalidRule):
+			return models.InvalidSeriesError{Message: invalidRule.Error()}, nil
+		case errorsx.As(err, &invalidScoring):
+			return models.InvalidSeriesError{Message: invalidScoring.Error()}, nil
+		case errorsx.Is(err, service.ErrSeriesAlreadyExists):
+			return models.SeriesAlreadyExists{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewSeries(result), nil
+}
+
+func (r *mutationResolver) UpdateSeries(ctx context.Context, series models.SeriesInput) (models.UpdateSeriesResult, error) {
+	result, err := r.series.Update(ctx, service.UpdateSeries{ID: series.ID, SeriesDefinition: seriesDefinition(series)})
+
+	var (
+		invalidSeriesID racers.InvalidSeriesIDError
+		invalidName     racers.InvalidRaceNameError
+		invalidVenue    racers.InvalidVenueError
+		invalidTimeZone racers.InvalidTimeZoneError
+		invalidRule     racers.InvalidRecurrenceError
+		invalidScoring  racers.InvalidSeriesScoringError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidSeriesID):
+			return models.InvalidIDError{Message: invalidSeriesID.Error()}, nil
+		case errorsx.As(err, &invalidName):
+			return models.InvalidRaceNameError{Message: invalidName.Error()}, nil
+		case errorsx.As(err, &invalidVenue):
+			return models.InvalidVenueError{Message: invalidVenue.Error()}, nil
+		case errorsx.As(err, &invalidTimeZone):
+			return models.InvalidVenueError{Message: invalidTimeZone.Error()}, nil
+		case errorsx.As(err, &invalidRule):
+			return models.InvalidSeriesError{Message: invalidRule.Error()}, nil
+		case errorsx.As(err, &invalidScoring):
+			return models.InvalidSeriesError{Message: invalidScoring.Error()}, nil
+		case errorsx.Is(err, service.ErrSeriesNotFound):
+			return models.SeriesNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewSeries(result), nil
+}
+
+func (r *mutationResolver) GenerateSeriesRaces(ctx context.Context, id string) (models.GenerateSeriesRacesResult, error) {
+	races, err := r.series.Generate(ctx, service.GetSeries{ID: id})
+
+	var invalidSeriesID racers.InvalidSeriesIDError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidSeriesID):
+			return models.InvalidIDError{Message: invalidSeriesID.Error()}, nil
+		case errorsx.Is(err, service.ErrSeriesNotFound):
+			return models.SeriesNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrForbidden):
+			return models.Forbidden{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewSeriesRaces(races), nil
+}
+
+func (r *queryResolver) Series(ctx context.Context, id string) (models.SeriesResult, error) {
+	series, err := r.series.Get(ctx, service.GetSeries{ID: id})
+
+	var invalidSeriesID racers.InvalidSeriesIDError
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidSeriesID):
+			return models.InvalidIDError{Message: invalidSeriesID.Error()}, nil
+		case errorsx.Is(err, service.ErrSeriesNotFound):
+			return models.SeriesNotFound{Message: err.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewSeries(series), nil
+}
+
+func (r *seriesResolver) Standings(ctx context.Context, obj *models.Series) ([]*models.SeriesStanding, error) {
+	standings, err := r.series.Standings(ctx, service.GetSeries{ID: obj.ID})
+	if err != nil {
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewSeriesStandings(standings), nil
+}
+
+// Series returns SeriesResolver implementation.
+func (r *Resolver) Series() SeriesResolver { return &seriesResolver{r} }
+
+type seriesResolver struct{ *Resolver }
@@ -0,0 +1,29 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) AddRaceStaff(ctx context.Context, staff models.RaceStaffInput) (models.RaceStaffResult, error) {
+	result, err := r.racers.AddStaff(ctx, service.RaceStaffMember{RaceID: staff.RaceID, UserID: staff.UserID, Role: string(staff.Role)})
+
+	return raceStaffResult(result, err)
+}
+
+func (r *mutationResolver) RemoveRaceStaff(ctx context.Context, staff models.RemoveRaceStaffInput) (models.RaceStaffResult, error) {
+	result, err := r.racers.RemoveStaff(ctx, service.RaceStaffMember{RaceID: staff.RaceID, UserID: staff.UserID})
+
+	return raceStaffResult(result, err)
+}
+
+func (r *mutationResolver) TransferRaceOwnership(ctx context.Context, owner models.RaceOwnerInput) (models.RaceStaffResult, error) {
+	result, err := r.racers.TransferOwnership(ctx, service.RaceStaffMember{RaceID: owner.RaceID, UserID: owner.UserID})
+
+	return raceStaffResult(result, err)
+}
@@ -0,0 +1,102 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func (r *mutationResolver) EnterTeam(ctx context.Context, entry models.TeamEntryInput) (models.EnterTeamResult, error) {
+	race, err := r.racers.EnterTeam(ctx, service.EnterTeam{
+		RaceID:  entry.RaceID,
+		TeamID:  entry.TeamID,
+		Members: entry.Members,
+	})
+
+	var (
+		invalidRaceID racers.InvalidRaceIDError
+		invalidTeamID racers.InvalidTeamIDError
+		invalidUserID racers.InvalidUserIDError
+		notAdmin      racers.NotTeamAdminError
+		teamInRace    racers.TeamInRaceError
+		withoutTeams  racers.RaceWithoutTeamsError
+		notMember     racers.NotTeamMemberError
+		notInRace     racers.CompetitorNotInRaceError
+		inOtherTeam   racers.CompetitorInRaceTeamError
+		invalidSize   racers.InvalidTeamEntrySizeError
+	)
+	if err != nil {
+		switch {
+		case errorsx.As(err, &invalidRaceID):
+			return models.InvalidIDError{Message: invalidRaceID.Error()}, nil
+		case errorsx.As(err, &invalidTeamID):
+			return models.InvalidIDError{Message: invalidTeamID.Error()}, nil
+		case errorsx.As(err, &invalidUserID):
+			return models.InvalidIDError{Message: invalidUserID.Error()}, nil
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			return models.RaceNotFound{Message: err.Error()}, nil
+		case errorsx.Is(err, service.ErrTeamNotFound):
+			return models.TeamNotFound{Message: err.Error()}, nil
+		case errorsx.As(err, &notAdmin):
+			return models.Forbidden{Message: notAdmin.Error()}, nil
+		case errorsx.As(err, &teamInRace):
+			return models.TeamAlreadyEntered{Message: teamInRace.Error()}, nil
+		case errorsx.As(err, &withoutTeams):
+			return models.InvalidTeamEntryError{Message: withoutTeams.Error()}, nil
+		case errorsx.As(err, &notMember):
+			return models.InvalidTeamEntryError{Message: notMember.Error()}, nil
+		case errorsx.As(err, &notInRace):
+			return models.InvalidTeamEntryError{Message: notInRace.Error()}, nil
+		case errorsx.As(err, &inOtherTeam):
+			return models.InvalidTeamEntryError{Message: inOtherTeam.Error()}, nil
+		case errorsx.As(err, &invalidSize):
+			return models.InvalidTeamEntryError{Message: invalidSize.Error()}, nil
+		}
+
+		return nil, models.NewInternalError()
+	}
+
+	return models.NewRace(race), nil
+}
+
+func (r *mutationResolver) InviteToTeam(ctx context.Context, invitation models.TeamUserInput) (models.TeamResult, error) {
+	return teamResult(r.teams.Invite(ctx, service.InviteToTeam{TeamID: invitation.TeamID, UserID: invitation.UserID}))
+}
+
+func (r *mutationResolver) AcceptTeamInvitation(ctx context.Context, teamID string) (models.TeamResult, error) {
+	return teamResult(r.teams.AcceptInvitation(ctx, service.AnswerTeamInvitation{TeamID: teamID}))
+}
+
+func (r *mutationResolver) DeclineTeamInvitation(ctx context.Context, teamID string) (models.TeamResult, error) {
+	return teamResult(r.teams.DeclineInvitation(ctx, service.AnswerTeamInvitation{TeamID: teamID}))
+}
+
+func (r *mutationResolver) RequestToJoinTeam(ctx context.Context, teamID string) (models.TeamResult, error) {
+	return teamResult(r.teams.RequestJoin(ctx, service.RequestJoinTeam{TeamID: teamID}))
+}
+
+func (r *mutationResolver) ApproveJoinRequest(ctx context.Context, request models.TeamUserInput) (models.TeamResult, error) {
+	return teamResult(r.teams.ApproveJoinRequest(ctx, service.AnswerJoinRequest{TeamID: request.TeamID, UserID: request.UserID}))
+}
+
+func (r *mutationResolver) RejectJoinRequest(ctx context.Context, request models.TeamUserInput) (models.TeamResult, error) {
+	return teamResult(r.teams.RejectJoinRequest(ctx, service.AnswerJoinRequest{TeamID: request.TeamID, UserID: request.UserID}))
+}
+
+func (r *mutationResolver) LeaveTeam(ctx context.Context, teamID string) (models.TeamResult, error) {
+	return teamResult(r.teams.Leave(ctx, service.LeaveTeam{TeamID: teamID}))
+}
+
+func (r *mutationResolver) RemoveMember(ctx context.Context, member models.TeamUserInput) (models.TeamResult, error) {
+	return teamResult(r.teams.RemoveMember(ctx, service.RemoveTeamMember{TeamID: member.TeamID, MemberID: member.UserID}))
+}
+
+func (r *mutationResolver) TransferAdmin(ctx context.Context, to models.TeamUserInput) (models.TeamResult, error) {
+	return teamResult(r.teams.TransferAdmin(ctx, service.TransferTeamAdmin{TeamID: to.TeamID, To: to.UserID}))
+}
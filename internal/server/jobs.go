@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+
+	"github.com/xabi93/racers/internal/jobs"
+	"github.com/xabi93/racers/internal/tenant"
+)
+
+// scheduledJobs are the housekeeping tasks of the races, the schedules run every quarter of an hour
+// at most so the race days starting at any time zone offset are noticed on time
+func (s *Server) scheduledJobs() []jobs.Job {
+	return []jobs.Job{
+		{Name: "expire-registrations", Schedule: jobs.MustParseSchedule("* * * * *"), Run: s.perTenant(s.payments.ExpireRegistrations)},
+		{Name: "resume-refunds", Schedule: jobs.MustParseSchedule("*/5 * * * *"), Run: s.perTenant(s.payments.ResumeRefunds)},
+		{Name: "close-registrations", Schedule: jobs.MustParseSchedule("*/5 * * * *"), Run: s.perTenant(s.races.CloseRegistrations)},
+		{Name: "race-reminders", Schedule: jobs.MustParseSchedule("*/15 * * * *"), Run: s.perTenant(s.races.RemindRaces)},
+		{Name: "finish-races", Schedule: jobs.MustParseSchedule("*/15 * * * *"), Run: s.perTenant(s.races.FinishRaces)},
+		{Name: "resume-cancellations", Schedule: jobs.MustParseSchedule("*/5 * * * *"), Run: s.perTenant(s.cancels.ResumeCancellations)},
+		{Name: "erase-users", Schedule: jobs.MustParseSchedule("* * * * *"), Run: s.perTenant(s.privacy.ProcessErasures)},
+	}
+}
+
+// perTenant runs the job for every tenant, a job run covers all of them
+func (s *Server) perTenant(run func(ctx context.Context) (int, error)) func(ctx context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		return tenant.Each(ctx, s.tenants, run)
+	}
+}
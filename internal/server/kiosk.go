@@ -0,0 +1,96 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/service"
+
+	"github.com/gorilla/mux"
+)
+
+// KioskEndpoint looks a competitor up by the bib or qr query params for the race staff at the packet pickup,
+// posting to it checks the competitor in
+const KioskEndpoint = "/races/{id}/kiosk"
+
+type kioskEntry struct {
+	Competitor  string     `json:"competitor"`
+	Name        string     `json:"name"`
+	Category    string     `json:"category,omitempty"`
+	Bib         int        `json:"bib,omitempty"`
+	Status      string     `json:"status"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+}
+
+func kioskHandler(checkIns service.CheckIns, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		find := service.FindCompetitor{RaceID: mux.Vars(r)["id"], Token: r.URL.Query().Get("qr")}
+		if bib := r.URL.Query().Get("bib"); bib != "" {
+			b, err := strconv.Atoi(bib)
+			if err != nil {
+				http.Error(w, "invalid bib", http.StatusBadRequest)
+				return
+			}
+			find.Bib = b
+		}
+		if find.Token == "" && find.Bib == 0 {
+			http.Error(w, "bib or qr is required", http.StatusBadRequest)
+			return
+		}
+
+		lookup := checkIns.Lookup
+		if r.Method == http.MethodPost {
+			lookup = checkIns.CheckIn
+		}
+		entry, err := lookup(r.Context(), find)
+
+		var (
+			invalidID     racers.InvalidRaceIDError
+			invalidBib    racers.InvalidBibError
+			unknownBib    racers.UnknownBibError
+			notInRace     racers.CompetitorNotInRaceError
+			invalidStatus racers.InvalidRegistrationStatusError
+			closed        racers.CheckInClosedError
+		)
+		switch {
+		case errorsx.As(err, &invalidID):
+			http.Error(w, invalidID.Error(), http.StatusBadRequest)
+			return
+		case errorsx.As(err, &invalidBib):
+			http.Error(w, invalidBib.Error(), http.StatusBadRequest)
+			return
+		case errorsx.Is(err, service.ErrInvalidCheckInToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		case errorsx.Is(err, service.ErrForbidden):
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		case errorsx.Is(err, service.ErrRaceNotFound), errorsx.As(err, &unknownBib), errorsx.As(err, &notInRace):
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		case errorsx.As(err, &invalidStatus), errorsx.As(err, &closed):
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		case err != nil:
+			logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(kioskEntry{
+			Competitor:  id.ID(entry.Competitor.ID).String(),
+			Name:        entry.Competitor.Name,
+			Category:    string(entry.Category),
+			Bib:         int(entry.Bib),
+			Status:      string(entry.Registration.Status),
+			CheckedInAt: entry.CheckedInAt,
+		})
+	}
+}
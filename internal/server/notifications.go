@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/notifications"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/tenant"
+)
+
+// notificationsInterval is how often the published events are emailed
+const notificationsInterval = 30 * time.Second
+
+// mailer returns the SMTP mailer when a host is configured, otherwise the emails are written to a directory
+func mailer(c Conf) notifications.Mailer {
+	if c.SMTP.Host == "" {
+		return notifications.NewDir(c.MailDir, c.SMTP.From)
+	}
+
+	return notifications.NewSMTP(c.SMTP)
+}
+
+func unsubscribeHandler(n service.Notifications, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, err := n.Unsubscribe(r.Context(), r.URL.Query().Get("token"))
+		switch {
+		case errorsx.Is(err, service.ErrInvalidUnsubscribeToken):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		case err != nil:
+			logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintln(w, "You will not receive these notifications anymore.")
+	}
+}
+
+// sendNotifications emails the published events of every tenant periodically until the context is done
+func sendNotifications(ctx context.Context, n notifications.Notifier, tenants tenant.Lister, logger log.Logger) {
+	ticker := time.NewTicker(notificationsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := tenant.Each(ctx, tenants, n.Process)
+			if err != nil {
+				logger.Error(ctx, err, nil)
+			}
+			if sent > 0 {
+				logger.Info(ctx, fmt.Sprintf("%d notifications sent", sent), nil)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	racers "github.com/xabi93/racers/internal"
+	errorsx "github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/payments"
+	"github.com/xabi93/racers/internal/service"
+)
+
+// PaymentCallbackEndpoint receives the completed payments from the gateway, signed with the shared secret
+const PaymentCallbackEndpoint = "/payments/callback"
+
+// maxCallbackSize is the largest callback body read
+const maxCallbackSize = 1 << 16
+
+func paymentCallbackHandler(p service.Payments, secret []byte, logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxCallbackSize))
+		if err != nil {
+			http.Error(w, "invalid body", http.StatusBadRequest)
+			return
+		}
+
+		if !payments.Verify(secret, body, r.Header.Get(payments.SignatureHeader)) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var callback service.PaymentCallback
+		if err := json.Unmarshal(body, &callback); err != nil {
+			http.Error(w, fmt.Sprintf("invalid callback: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		err = p.ConfirmPayment(r.Context(), callback)
+
+		var (
+			invalidRace racers.InvalidRaceIDError
+			invalidUser racers.InvalidUserIDError
+		)
+		switch {
+		case errorsx.As(err, &invalidRace), errorsx.As(err, &invalidUser):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		case errorsx.Is(err, service.ErrRaceNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		case err != nil:
+			logger.Error(r.Context(), err, nil)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -8,10 +8,14 @@ import (
 	"net/http"
 
 	"github.com/xabi93/racers/internal/instrumentation/log"
+	"github.com/xabi93/racers/internal/jobs"
+	"github.com/xabi93/racers/internal/notifications"
+	"github.com/xabi93/racers/internal/payments"
 	"github.com/xabi93/racers/internal/server/graph"
 	"github.com/xabi93/racers/internal/server/graph/instrumentation"
 	"github.com/xabi93/racers/internal/service"
 	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
 	"github.com/xabi93/racers/internal/users"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -24,11 +28,16 @@ import (
 const GraphEndpoint = "/graph"
 
 func New(conf Conf, logger log.Logger, db *sql.DB, uProvider users.UsersProvider) (Server, error) {
+	if err := conf.checkSecrets(); err != nil {
+		return Server{}, err
+	}
+
 	s := Server{
-		conf:   conf,
-		logger: logger,
-		db:     db,
-		users:  users.Users{UsersProvider: uProvider},
+		conf:     conf,
+		logger:   logger,
+		db:       db,
+		users:    users.Users{UsersProvider: uProvider},
+		registry: prometheus.NewRegistry(),
 	}
 
 	if err := s.initService(); err != nil {
@@ -46,9 +55,27 @@ type Server struct {
 	db     *sql.DB
 	users  users.Users
 
-	handler http.Handler
-
-	races service.Races
+	handler  http.Handler
+	registry *prometheus.Registry
+
+	races     service.Races
+	teams     service.Teams
+	audit     service.Audit
+	calendars service.Calendars
+	series    service.Series
+	payments  service.Payments
+	codes     service.DiscountCodes
+	cancels   service.Cancellations
+	checkIns  service.CheckIns
+	orgs      service.Organizations
+	privacy   service.Privacy
+
+	tenants postgres.Tenants
+	members postgres.TenantMembers
+
+	notifications service.Notifications
+	notifier      notifications.Notifier
+	scheduler     jobs.Scheduler
 }
 
 func (s *Server) initService() error {
@@ -57,10 +84,35 @@ func (s *Server) initService() error {
 		return err
 	}
 
+	s.tenants = postgres.NewTenants(db)
+	s.members = postgres.NewTenantMembers(db)
 	eventsRepo := postgres.NewEvents(db)
 	racesRepo := postgres.NewRaces(db)
+	teamsRepo := postgres.NewTeams(db)
+	orgsRepo := postgres.NewOrganizations(db)
+
+	s.races = service.NewRaces(racesRepo, teamsRepo, orgsRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.teams = service.NewTeams(teamsRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.audit = service.NewAudit(eventsRepo, orgsRepo, s.users)
+	s.calendars = service.NewCalendars(racesRepo, postgres.NewCalendarTokens(db), s.users)
+	s.series = service.NewSeries(postgres.NewSeries(db), racesRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	// the fake gateway stands in until a payment provider is integrated
+	gateway := payments.NewFake([]byte(s.conf.PaymentSecret), s.conf.PublicURL)
+	s.payments = service.NewPayments(racesRepo, orgsRepo, gateway, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.cancels = service.NewCancellations(racesRepo, gateway, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.codes = service.NewDiscountCodes(postgres.NewDiscountCodes(db), racesRepo, orgsRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.checkIns = service.NewCheckIns(racesRepo, orgsRepo, s.users, postgres.TransactionFactory(db), eventsRepo, []byte(s.conf.CheckInSecret))
+	s.orgs = service.NewOrganizations(orgsRepo, racesRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.privacy = service.NewPrivacy(postgres.NewUserData(db), postgres.NewErasures(db), s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.notifications = service.NewNotifications(postgres.NewNotificationPreferences(db), s.users, []byte(s.conf.NotificationSecret))
+
+	templates, err := notifications.NewTemplates()
+	if err != nil {
+		return err
+	}
+	s.notifier = notifications.NewNotifier(eventsRepo, racesRepo, s.users, s.notifications, s.checkIns, mailer(s.conf), templates, s.conf.PublicURL)
 
-	s.races = service.NewRaces(racesRepo, s.users, postgres.TransactionFactory(db), eventsRepo)
+	s.scheduler = jobs.NewScheduler(postgres.NewJobRuns(db), s.logger, s.registry, "racers", s.scheduledJobs()...)
 
 	return nil
 }
@@ -69,17 +121,35 @@ func (s *Server) initHandler() {
 	r := mux.NewRouter()
 
 	r.Use(users.AuthMiddleware(s.users))
+	r.Use(tenant.Middleware(
+		tenant.Resolver{Domain: s.conf.TenantDomain},
+		func(ctx context.Context) string { return s.users.Current(ctx).Tenant },
+		s.tenants.Exists,
+		s.users.Admit(s.members),
+	))
 
 	r.Handle("/playground", playground.Handler("racers", GraphEndpoint))
 
-	graphServer := handler.NewDefaultServer(graph.NewExecutableSchema(graph.New(s.races)))
+	graphServer := handler.NewDefaultServer(graph.NewExecutableSchema(graph.New(s.races, s.teams, s.audit, s.calendars, s.series, s.payments, s.codes, s.notifications, s.cancels, s.checkIns, s.orgs, s.privacy)))
 
-	promRegistry := prometheus.NewRegistry()
-	graphServer.Use(instrumentation.NewPrometheus(promRegistry, "racers"))
+	graphServer.Use(instrumentation.NewPrometheus(s.registry, "racers"))
 	r.Handle(GraphEndpoint, graphServer)
 
+	r.Handle(CourseEndpoint, courseFileHandler(s.races, s.logger)).Methods(http.MethodGet)
+	r.Handle(StartListEndpoint, startListHandler(s.races, s.logger)).Methods(http.MethodGet)
+	r.Handle(ResultsEndpoint, resultsHandler(s.races, s.logger)).Methods(http.MethodGet)
+
+	feeds := calendarFeeds{s.calendars, s.conf.PublicURL, s.logger}
+	r.Handle(RaceCalendarEndpoint, feeds.race()).Methods(http.MethodGet)
+	r.Handle(UpcomingCalendarEndpoint, feeds.upcoming()).Methods(http.MethodGet)
+	r.Handle(UserCalendarEndpoint, feeds.user()).Methods(http.MethodGet)
+
+	r.Handle(KioskEndpoint, kioskHandler(s.checkIns, s.logger)).Methods(http.MethodGet, http.MethodPost)
+	r.Handle(PaymentCallbackEndpoint, paymentCallbackHandler(s.payments, []byte(s.conf.PaymentSecret), s.logger)).Methods(http.MethodPost)
+	r.Handle(notifications.UnsubscribePath, unsubscribeHandler(s.notifications, s.logger)).Methods(http.MethodGet)
+
 	r.Handle("/metrics", promhttp.InstrumentMetricHandler(
-		promRegistry, promhttp.HandlerFor(promRegistry, promhttp.HandlerOpts{}),
+		s.registry, promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{}),
 	))
 
 	s.handler = r
@@ -94,5 +164,8 @@ func (s *Server) Serve() error {
 
 	s.logger.Info(context.Background(), fmt.Sprintf("Server running on: %s", addr), nil)
 
+	go s.scheduler.Start(context.Background())
+	go sendNotifications(context.Background(), s.notifier, s.tenants, s.logger)
+
 	return http.ListenAndServe(addr, s.handler)
 }
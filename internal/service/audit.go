@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+)
+
+const (
+	defaultAuditPageSize = 20
+	maxAuditPageSize     = 100
+)
+
+func NewAudit(events EventsGetter, orgs OrganizationsGetter, users UsersGetter) Audit {
+	return Audit{events, orgs, users}
+}
+
+// Audit gives the service administrators access to everything that happened in the system,
+// and the organization admins to what happened in their organizations
+type Audit struct {
+	events EventsGetter
+	orgs   OrganizationsGetter
+	users  UsersGetter
+}
+
+type AuditLog struct {
+	UserID string
+	RaceID string
+	// OrganizationID is required unless the current user is a service administrator
+	OrganizationID string
+	Type           string
+	From           *time.Time
+	To             *time.Time
+	First          int
+	After          string
+}
+
+type AuditLogPage struct {
+	Events      []StoredEvent
+	HasNextPage bool
+}
+
+func (s Audit) Log(ctx context.Context, r AuditLog) (AuditLogPage, error) {
+	current := s.users.Current(ctx)
+
+	filter := EventsFilter{Type: r.Type, From: r.From, To: r.To}
+	if r.OrganizationID != "" {
+		orgID, err := racers.NewOrganizationID(r.OrganizationID)
+		if err != nil {
+			return AuditLogPage{}, err
+		}
+		filter.OrganizationID = &orgID
+	}
+
+	if !current.Admin {
+		if filter.OrganizationID == nil {
+			return AuditLogPage{}, ErrForbidden
+		}
+		// an unknown organization is forbidden, not to reveal which ones exist
+		org, err := s.orgs.Get(ctx, *filter.OrganizationID)
+		if err != nil && !errors.Is(err, ErrOrganizationNotFound) {
+			return AuditLogPage{}, err
+		}
+		if !org.IsAdmin(current.ID) {
+			return AuditLogPage{}, ErrForbidden
+		}
+	}
+
+	if r.UserID != "" {
+		userID, err := racers.NewUserID(r.UserID)
+		if err != nil {
+			return AuditLogPage{}, err
+		}
+		filter.UserID = &userID
+	}
+	if r.RaceID != "" {
+		raceID, err := racers.NewRaceID(r.RaceID)
+		if err != nil {
+			return AuditLogPage{}, err
+		}
+		filter.RaceID = &raceID
+	}
+
+	var after *EventsCursor
+	if r.After != "" {
+		c, err := DecodeEventsCursor(r.After)
+		if err != nil {
+			return AuditLogPage{}, err
+		}
+		after = &c
+	}
+
+	first := r.First
+	switch {
+	case first <= 0:
+		first = defaultAuditPageSize
+	case first > maxAuditPageSize:
+		first = maxAuditPageSize
+	}
+
+	// one more than requested to know if there is a next page
+	events, err := s.events.Find(ctx, filter, after, first+1)
+	if err != nil {
+		return AuditLogPage{}, err
+	}
+
+	if len(events) > first {
+		return AuditLogPage{Events: events[:first], HasNextPage: true}, nil
+	}
+
+	return AuditLogPage{Events: events}, nil
+}
+
+// Cursor returns the opaque cursor that points to the event
+func (e StoredEvent) Cursor() string {
+	return EventsCursor{OccurredAt: e.OccurredAt, ID: e.ID}.String()
+}
+
+func (c EventsCursor) String() string {
+	return base64.URLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%s|%s", c.OccurredAt.UTC().Format(time.RFC3339Nano), c.ID)),
+	)
+}
+
+// DecodeEventsCursor parses a cursor returned by StoredEvent.Cursor
+func DecodeEventsCursor(s string) (EventsCursor, error) {
+	raw, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return EventsCursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 2 {
+		return EventsCursor{}, ErrInvalidCursor
+	}
+
+	occurredAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return EventsCursor{}, ErrInvalidCursor
+	}
+
+	eventID, err := id.NewID(parts[1])
+	if err != nil {
+		return EventsCursor{}, ErrInvalidCursor
+	}
+
+	return EventsCursor{OccurredAt: occurredAt, ID: eventID}, nil
+}
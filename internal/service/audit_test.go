@@ -0,0 +1,167 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+var adminUser = racers.User{ID: racers.UserID(id.Generate()), Admin: true}
+
+type testAuditService struct {
+	service service.Audit
+	events  *EventsGetterMock
+	orgs    *OrganizationsRepositoryMock
+	users   *UsersGetterMock
+}
+
+func newTestAuditService(current racers.User) testAuditService {
+	s := testAuditService{
+		events: &EventsGetterMock{},
+		orgs:   &OrganizationsRepositoryMock{},
+		users: &UsersGetterMock{
+			CurrentFunc: func(context.Context) racers.User { return current },
+		},
+	}
+
+	s.service = service.NewAudit(s.events, s.orgs, s.users)
+
+	return s
+}
+
+func storedEvents(n int) []service.StoredEvent {
+	events := make([]service.StoredEvent, n)
+	occurredAt := time.Now().UTC()
+	for i := range events {
+		events[i] = service.StoredEvent{
+			ID:         id.Generate(),
+			Type:       "RaceCreated",
+			UserID:     racers.UserID(id.Generate()),
+			Payload:    []byte(`{}`),
+			OccurredAt: occurredAt.Add(-time.Duration(i) * time.Minute),
+		}
+	}
+
+	return events
+}
+
+func TestAuditLog(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("When the user is not an admin, returns forbidden", func(t *testing.T) {
+		s := newTestAuditService(racers.User{ID: racers.UserID(id.Generate())})
+
+		_, err := s.service.Log(context.Background(), service.AuditLog{})
+		require.Equal(service.ErrForbidden, err)
+		require.Len(s.events.FindCalls(), 0)
+	})
+
+	t.Run("Scenario: organization admin", func(t *testing.T) {
+		orgAdmin := racers.User{ID: racers.UserID(id.Generate())}
+		org := racers.CreateOrganization(racers.OrganizationID(id.Generate()), "Club", orgAdmin.ID)
+
+		t.Run("When filters by the organization, returns its events", func(t *testing.T) {
+			s := newTestAuditService(orgAdmin)
+			s.orgs.GetFunc = func(context.Context, racers.OrganizationID) (racers.Organization, error) { return org, nil }
+
+			_, err := s.service.Log(context.Background(), service.AuditLog{OrganizationID: id.ID(org.ID).String()})
+			require.NoError(err)
+			require.Equal(&org.ID, s.events.FindCalls()[0].Filter.OrganizationID)
+		})
+
+		t.Run("When filters by another organization, returns forbidden", func(t *testing.T) {
+			s := newTestAuditService(orgAdmin)
+			other := racers.CreateOrganization(racers.OrganizationID(id.Generate()), "Other", racers.UserID(id.Generate()))
+			s.orgs.GetFunc = func(context.Context, racers.OrganizationID) (racers.Organization, error) { return other, nil }
+
+			_, err := s.service.Log(context.Background(), service.AuditLog{OrganizationID: id.ID(other.ID).String()})
+			require.Equal(service.ErrForbidden, err)
+			require.Len(s.events.FindCalls(), 0)
+		})
+	})
+
+	t.Run("Scenario: invalid request", func(t *testing.T) {
+		for field, req := range map[string]service.AuditLog{
+			"user id":         {UserID: "invalid"},
+			"race id":         {RaceID: "invalid"},
+			"organization id": {OrganizationID: "invalid"},
+			"cursor":          {After: "invalid"},
+		} {
+			t.Run(field, func(t *testing.T) {
+				s := newTestAuditService(adminUser)
+
+				_, err := s.service.Log(context.Background(), req)
+				require.Error(err)
+			})
+		}
+	})
+
+	t.Run("When fails finding events", func(t *testing.T) {
+		s := newTestAuditService(adminUser)
+		s.events.FindFunc = func(context.Context, service.EventsFilter, *service.EventsCursor, int) ([]service.StoredEvent, error) {
+			return nil, errors.New("")
+		}
+
+		_, err := s.service.Log(context.Background(), service.AuditLog{})
+		require.Error(err)
+	})
+
+	t.Run("When there are more events than requested, returns the page and has next page", func(t *testing.T) {
+		s := newTestAuditService(adminUser)
+		events := storedEvents(3)
+		s.events.FindFunc = func(context.Context, service.EventsFilter, *service.EventsCursor, int) ([]service.StoredEvent, error) {
+			return events, nil
+		}
+
+		raceID := id.Generate()
+		page, err := s.service.Log(context.Background(), service.AuditLog{
+			RaceID: raceID.String(),
+			Type:   "RaceCreated",
+			First:  2,
+			After:  events[0].Cursor(),
+		})
+		require.NoError(err)
+		require.Equal(events[:2], page.Events)
+		require.True(page.HasNextPage)
+
+		require.Len(s.events.FindCalls(), 1)
+		call := s.events.FindCalls()[0]
+		require.Equal(3, call.Limit)
+		require.Equal(racers.RaceID(raceID), *call.Filter.RaceID)
+		require.Nil(call.Filter.UserID)
+		require.Equal("RaceCreated", call.Filter.Type)
+		require.Equal(events[0].ID, call.After.ID)
+		require.True(events[0].OccurredAt.Equal(call.After.OccurredAt))
+	})
+
+	t.Run("When there are no more events, has not next page", func(t *testing.T) {
+		s := newTestAuditService(adminUser)
+		events := storedEvents(2)
+		s.events.FindFunc = func(context.Context, service.EventsFilter, *service.EventsCursor, int) ([]service.StoredEvent, error) {
+			return events, nil
+		}
+
+		page, err := s.service.Log(context.Background(), service.AuditLog{First: 1000})
+		require.NoError(err)
+		require.Equal(events, page.Events)
+		require.False(page.HasNextPage)
+		require.Nil(s.events.FindCalls()[0].After)
+	})
+}
+
+func TestEventsCursor(t *testing.T) {
+	require := require.New(t)
+
+	c := service.EventsCursor{OccurredAt: time.Now().UTC(), ID: id.Generate()}
+
+	decoded, err := service.DecodeEventsCursor(c.String())
+	require.NoError(err)
+	require.Equal(c.ID, decoded.ID)
+	require.True(c.OccurredAt.Equal(decoded.OccurredAt))
+}
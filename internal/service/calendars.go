@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// calendarSecretSize are the random bytes of the calendar token secrets
+const calendarSecretSize = 32
+
+func NewCalendars(races RacesRepository, tokens CalendarTokensRepository, users UsersGetter) Calendars {
+	return Calendars{races, tokens, users}
+}
+
+// Calendars serves the races as calendar feeds
+type Calendars struct {
+	races  RacesRepository
+	tokens CalendarTokensRepository
+	users  UsersGetter
+}
+
+// CalendarToken gives access to the personal calendar feed of a user, only the hash of the secret is stored
+type CalendarToken struct {
+	User       racers.UserID
+	SecretHash string
+	CreatedAt  time.Time
+}
+
+// CalendarTokenCreated is the new token with its secret, the secret can not be recovered later
+type CalendarTokenCreated struct {
+	Token  CalendarToken
+	Secret string
+}
+
+// hashCalendarSecret returns the stored hash of a token secret
+func hashCalendarSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateToken creates a calendar token for the current user, revoking the previous one
+func (s Calendars) CreateToken(ctx context.Context) (CalendarTokenCreated, error) {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return CalendarTokenCreated{}, ErrForbidden
+	}
+
+	b := make([]byte, calendarSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return CalendarTokenCreated{}, err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+
+	token := CalendarToken{User: user.ID, SecretHash: hashCalendarSecret(secret), CreatedAt: time.Now()}
+	if err := s.tokens.Save(ctx, token); err != nil {
+		return CalendarTokenCreated{}, err
+	}
+
+	return CalendarTokenCreated{Token: token, Secret: secret}, nil
+}
+
+// RevokeToken removes the calendar token of the current user, its feed is no longer served
+func (s Calendars) RevokeToken(ctx context.Context) error {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return ErrForbidden
+	}
+
+	return s.tokens.Delete(ctx, user.ID)
+}
+
+// RaceFeed returns the race of the feed
+func (s Calendars) RaceFeed(ctx context.Context, r GetRace) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.ID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.races.Get(ctx, raceID)
+}
+
+// UpcomingFeed returns the races not run yet sorted by date
+func (s Calendars) UpcomingFeed(ctx context.Context) ([]racers.Race, error) {
+	return s.races.Upcoming(ctx, time.Now())
+}
+
+type UserFeed struct {
+	Secret string
+}
+
+// UserFeed returns the races the owner of the token joined sorted by date, past races included
+func (s Calendars) UserFeed(ctx context.Context, r UserFeed) ([]racers.Race, error) {
+	if r.Secret == "" {
+		return nil, ErrCalendarTokenNotFound
+	}
+
+	token, err := s.tokens.Get(ctx, hashCalendarSecret(r.Secret))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.races.ByCompetitor(ctx, token.User)
+}
@@ -0,0 +1,122 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestCalendars(t *testing.T) {
+	suite.Run(t, new(calendarsSuite))
+}
+
+type calendarsSuite struct {
+	suite.Suite
+
+	service service.Calendars
+
+	user   racers.User
+	past   racers.Race
+	next   racers.Race
+	latest racers.Race
+
+	races  *RacesRepositoryMock
+	tokens *CalendarTokensRepositoryMock
+	users  *UsersGetterMock
+}
+
+func (s *calendarsSuite) SetupTest() {
+	s.user = racers.User{ID: racers.UserID(id.Generate())}
+
+	newRace := func(date time.Time, competitors ...racers.UserID) racers.Race {
+		return racers.Race{
+			ID:          racers.RaceID(id.Generate()),
+			Name:        racers.RaceName("Behobia"),
+			Date:        racers.RaceDate(date),
+			Competitors: racers.NewRaceCompetitors(competitors...),
+		}
+	}
+	s.past = newRace(time.Now().AddDate(0, -1, 0), s.user.ID)
+	s.next = newRace(time.Now().AddDate(0, 1, 0))
+	s.latest = newRace(time.Now().AddDate(0, 2, 0), s.user.ID)
+
+	s.races = &RacesRepositoryMock{
+		UpcomingFunc: func(context.Context, time.Time) ([]racers.Race, error) {
+			return []racers.Race{s.next, s.latest}, nil
+		},
+		ByCompetitorFunc: func(context.Context, racers.UserID) ([]racers.Race, error) {
+			return []racers.Race{s.past, s.latest}, nil
+		},
+	}
+	s.tokens = &CalendarTokensRepositoryMock{}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.user },
+	}
+
+	s.service = service.NewCalendars(s.races, s.tokens, s.users)
+}
+
+func (s calendarsSuite) TestCreateToken_Anonymous() {
+	s.users.CurrentFunc = func(context.Context) racers.User { return racers.User{} }
+
+	_, err := s.service.CreateToken(context.Background())
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s calendarsSuite) TestCreateToken_Success() {
+	created, err := s.service.CreateToken(context.Background())
+	s.NoError(err)
+
+	s.NotEmpty(created.Secret)
+	s.Equal(s.user.ID, created.Token.User)
+	s.NotEqual(created.Secret, created.Token.SecretHash)
+	s.Equal(created.Token, s.tokens.SaveCalls()[0].Token)
+}
+
+func (s calendarsSuite) TestRevokeToken() {
+	s.NoError(s.service.RevokeToken(context.Background()))
+
+	s.Equal(s.user.ID, s.tokens.DeleteCalls()[0].User)
+}
+
+func (s calendarsSuite) TestUpcomingFeed() {
+	races, err := s.service.UpcomingFeed(context.Background())
+	s.NoError(err)
+
+	s.Equal([]racers.Race{s.next, s.latest}, races)
+	s.WithinDuration(time.Now(), s.races.UpcomingCalls()[0].From, time.Minute)
+}
+
+func (s calendarsSuite) TestUserFeed() {
+	s.Run("unknown token", func() {
+		s.tokens.GetFunc = func(context.Context, string) (service.CalendarToken, error) {
+			return service.CalendarToken{}, service.ErrCalendarTokenNotFound
+		}
+
+		_, err := s.service.UserFeed(context.Background(), service.UserFeed{Secret: "revoked"})
+		s.Equal(service.ErrCalendarTokenNotFound, err)
+	})
+
+	s.Run("joined races", func() {
+		created, err := s.service.CreateToken(context.Background())
+		s.NoError(err)
+		s.tokens.GetFunc = func(_ context.Context, hash string) (service.CalendarToken, error) {
+			if hash != created.Token.SecretHash {
+				return service.CalendarToken{}, service.ErrCalendarTokenNotFound
+			}
+			return created.Token, nil
+		}
+
+		races, err := s.service.UserFeed(context.Background(), service.UserFeed{Secret: created.Secret})
+		s.NoError(err)
+
+		s.Equal([]racers.Race{s.past, s.latest}, races)
+		s.Equal(s.user.ID, s.races.ByCompetitorCalls()[0].ID)
+	})
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+)
+
+// cancellationBatch is the number of competitors transferred or released in a unit of work,
+// the races with less competitors are cancelled in a single one
+const cancellationBatch = 500
+
+func NewCancellations(races RacesRepository, gateway PaymentGateway, users UsersGetter, uow UnitOfWork, eb EventBus) Cancellations {
+	return Cancellations{races, gateway, users, uow, eb}
+}
+
+// Cancellations calls off races, transferring their competitors to another race or releasing them
+type Cancellations struct {
+	races   RacesRepository
+	gateway PaymentGateway
+	users   UsersGetter
+	uow     UnitOfWork
+	eb      EventBus
+}
+
+type CancelRace struct {
+	RaceID string
+	Reason string
+	// TransferTo is nil when the competitors are released
+	TransferTo *CancelRaceTransfer
+}
+
+// CancelRaceTransfer is the race and category the competitors are moved to
+type CancelRaceTransfer struct {
+	RaceID   string
+	Category string
+}
+
+// RaceCancelled is published when the race is called off, Transfer is nil when the competitors are released
+type RaceCancelled struct {
+	Race     racers.RaceID
+	Reason   string
+	Transfer *racers.RaceTransfer
+}
+
+func (e RaceCancelled) RaceID() racers.RaceID { return e.Race }
+
+// CompetitorTransferred is published when a competitor of a cancelled race is moved to the transfer race
+type CompetitorTransferred struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	To         racers.RaceID
+	Category   racers.CategoryName
+}
+
+func (e CompetitorTransferred) RaceID() racers.RaceID { return e.Race }
+
+// CompetitorReleased is published when a competitor of a cancelled race is withdrawn, Payment is the payment
+// refunded, empty when there was nothing to refund
+type CompetitorReleased struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Payment    string
+	Fee        racers.Money
+}
+
+func (e CompetitorReleased) RaceID() racers.RaceID { return e.Race }
+
+// Cancel calls the race off and transfers or releases its competitors, only the race owner is allowed and
+// the competitors can only be transferred to an open race of the same owner. The competitors are handled in
+// batches, the first one with the cancellation, the batches left after a failure are resumed by ResumeCancellations
+func (s Cancellations) Cancel(ctx context.Context, r CancelRace) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	current := s.users.Current(ctx).ID
+	now := time.Now()
+	transfer, err := s.transfer(ctx, r.TransferTo, now)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	var (
+		race racers.Race
+		done bool
+	)
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err = s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		if current != race.Owner {
+			return ErrForbidden
+		}
+
+		if err := race.Cancel(r.Reason, transfer, now); err != nil {
+			return err
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		cancelled := RaceCancelled{Race: race.ID, Reason: race.Cancellation.Reason, Transfer: transfer}
+		if err := s.eb.Publish(ctx, newEvent(cancelled, current)); err != nil {
+			return err
+		}
+
+		done, err = s.batch(ctx, &race, current)
+		return err
+	})
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	for !done {
+		if done, err = s.next(ctx, race.ID, current); err != nil {
+			return racers.Race{}, err
+		}
+	}
+
+	return s.races.Get(ctx, race.ID)
+}
+
+// transfer validates the transfer race is an open race of the current user with the category
+func (s Cancellations) transfer(ctx context.Context, r *CancelRaceTransfer, now time.Time) (*racers.RaceTransfer, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.users.Current(ctx).ID != race.Owner {
+		return nil, ErrForbidden
+	}
+
+	category := racers.CategoryName(r.Category)
+	if err := race.CheckTransfer(category, now); err != nil {
+		return nil, err
+	}
+
+	return &racers.RaceTransfer{Race: race.ID, Category: category}, nil
+}
+
+// ResumeCancellations transfers or releases the competitors left in the cancelled races, it returns the number
+// of races completed
+func (s Cancellations) ResumeCancellations(ctx context.Context) (int, error) {
+	ids, err := s.races.CancelledWithCompetitors(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var completed int
+	for _, id := range ids {
+		for done := false; !done; {
+			if done, err = s.next(ctx, id, systemUser); err != nil {
+				return completed, err
+			}
+		}
+		completed++
+	}
+
+	return completed, nil
+}
+
+// next handles the next batch of competitors of the cancelled race in its own unit of work
+func (s Cancellations) next(ctx context.Context, id racers.RaceID, user racers.UserID) (bool, error) {
+	var done bool
+	err := s.uow(ctx, func(ctx context.Context) error {
+		race, err := s.races.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		done, err = s.batch(ctx, &race, user)
+		return err
+	})
+
+	return done, err
+}
+
+// batch transfers or releases a batch of competitors of the cancelled race, it returns true once no competitors
+// are left. The competitors that can not join the transfer race are released, the paid registrations of the
+// released ones are refunded
+func (s Cancellations) batch(ctx context.Context, race *racers.Race, user racers.UserID) (bool, error) {
+	released := race.ReleaseCompetitors(cancellationBatch)
+	if len(released) == 0 {
+		return true, nil
+	}
+
+	var target *racers.Race
+	if t := race.Cancellation.Transfer; t != nil {
+		r, err := s.races.Get(ctx, t.Race)
+		if err != nil {
+			return false, err
+		}
+		target = &r
+	}
+
+	now := time.Now()
+	events := make([]Event, 0, len(released))
+	for _, c := range released {
+		if target != nil {
+			transferred, err := s.transferIn(ctx, target, race.Cancellation.Transfer.Category, c, now)
+			if err != nil {
+				return false, err
+			}
+			if transferred {
+				events = append(events, newEvent(CompetitorTransferred{
+					Race:       race.ID,
+					Competitor: c.ID,
+					To:         target.ID,
+					Category:   race.Cancellation.Transfer.Category,
+				}, user))
+				continue
+			}
+		}
+
+		e := CompetitorReleased{Race: race.ID, Competitor: c.ID}
+		if reg := c.Registration; reg != nil && reg.Status == racers.RegistrationConfirmed && reg.Payment != "" {
+			if err := s.gateway.Refund(ctx, reg.Payment); err != nil {
+				return false, err
+			}
+			e.Payment, e.Fee = reg.Payment, reg.Fee
+		}
+		events = append(events, newEvent(e, user))
+	}
+
+	if err := s.races.Save(ctx, *race); err != nil {
+		return false, err
+	}
+	if target != nil {
+		if err := s.races.Save(ctx, *target); err != nil {
+			return false, err
+		}
+	}
+	if err := s.eb.Publish(ctx, events...); err != nil {
+		return false, err
+	}
+
+	return len(released) < cancellationBatch, nil
+}
+
+// transferIn joins the competitor to the category of the transfer race, it returns false when the competitor
+// is not allowed to join it
+func (s Cancellations) transferIn(ctx context.Context, target *racers.Race, category racers.CategoryName, c racers.ReleasedCompetitor, now time.Time) (bool, error) {
+	u, err := s.users.Get(ctx, c.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		inRace      racers.CompetitorInRaceError
+		full        racers.CategoryFullError
+		notEligible racers.NotEligibleError
+		noBibs      racers.BibRangeExhaustedError
+	)
+	switch err := target.TransferIn(u, category, c.Registration, now); {
+	case errors.As(err, &inRace), errors.As(err, &full), errors.As(err, &notEligible), errors.As(err, &noBibs):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestCancellations(t *testing.T) {
+	suite.Run(t, new(cancellationsSuite))
+}
+
+type cancellationsSuite struct {
+	suite.Suite
+
+	service service.Cancellations
+
+	races   map[racers.RaceID]racers.Race
+	race    racers.Race
+	target  racers.Race
+	owner   racers.User
+	paid    racers.User
+	free    racers.User
+	current racers.User
+
+	repo     *RacesRepositoryMock
+	gateway  *PaymentGatewayMock
+	eventBus *EventBusMock
+}
+
+func (s *cancellationsSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.paid = racers.User{ID: racers.UserID(id.Generate())}
+	s.free = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.owner
+
+	fee := racers.Money{Amount: 2000, Currency: "EUR"}
+	s.race = racers.Race{
+		ID:            racers.RaceID(id.Generate()),
+		Date:          racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:         s.owner.ID,
+		Competitors:   racers.NewRaceCompetitors(s.paid.ID, s.free.ID),
+		Registrations: racers.RaceRegistrations{s.paid.ID: {Status: racers.RegistrationConfirmed, Fee: fee, Payment: "pay_1"}},
+	}
+	s.target = racers.Race{
+		ID:         racers.RaceID(id.Generate()),
+		Date:       racers.RaceDate(time.Now().AddDate(0, 2, 0)),
+		Owner:      s.owner.ID,
+		Categories: racers.RaceCategories{{Name: "10K", Capacity: 1}},
+	}
+	s.races = map[racers.RaceID]racers.Race{s.race.ID: s.race, s.target.ID: s.target}
+
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(_ context.Context, id racers.RaceID) (racers.Race, error) {
+			race, ok := s.races[id]
+			if !ok {
+				return racers.Race{}, service.ErrRaceNotFound
+			}
+			return race, nil
+		},
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.races[race.ID] = race
+			return nil
+		},
+	}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc:     func(_ context.Context, id racers.UserID) (racers.User, error) { return racers.User{ID: id}, nil },
+	}
+	s.gateway = &PaymentGatewayMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewCancellations(s.repo, s.gateway, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+// published returns the payloads of the events published
+func (s *cancellationsSuite) published() []interface{} {
+	var payloads []interface{}
+	for _, call := range s.eventBus.PublishCalls() {
+		for _, e := range call.Events {
+			payloads = append(payloads, e.Payload)
+		}
+	}
+
+	return payloads
+}
+
+func (s *cancellationsSuite) TestCancel_Forbidden() {
+	s.current = s.paid
+
+	_, err := s.service.Cancel(context.Background(), service.CancelRace{RaceID: id.ID(s.race.ID).String(), Reason: "storm"})
+
+	s.Equal(service.ErrForbidden, err)
+	s.Empty(s.repo.SaveCalls())
+}
+
+func (s *cancellationsSuite) TestCancel_InvalidTransfer() {
+	_, err := s.service.Cancel(context.Background(), service.CancelRace{
+		RaceID:     id.ID(s.race.ID).String(),
+		Reason:     "storm",
+		TransferTo: &service.CancelRaceTransfer{RaceID: id.ID(s.target.ID).String(), Category: "42K"},
+	})
+
+	s.True(errors.As(err, &racers.UnknownCategoryError{}))
+	s.Empty(s.repo.SaveCalls())
+}
+
+func (s *cancellationsSuite) TestCancel_ReleasesAndRefunds() {
+	race, err := s.service.Cancel(context.Background(), service.CancelRace{RaceID: id.ID(s.race.ID).String(), Reason: "storm"})
+	s.Require().NoError(err)
+
+	s.Equal(racers.RaceCancelled, race.Status)
+	s.Empty(race.Competitors.List())
+	s.Require().Len(s.gateway.RefundCalls(), 1)
+	s.Equal("pay_1", s.gateway.RefundCalls()[0].Payment)
+
+	events := s.published()
+	s.Require().Len(events, 3)
+	s.Equal(service.RaceCancelled{Race: s.race.ID, Reason: "storm"}, events[0])
+	s.ElementsMatch([]interface{}{
+		service.CompetitorReleased{Race: s.race.ID, Competitor: s.paid.ID, Payment: "pay_1", Fee: racers.Money{Amount: 2000, Currency: "EUR"}},
+		service.CompetitorReleased{Race: s.race.ID, Competitor: s.free.ID},
+	}, events[1:])
+}
+
+func (s *cancellationsSuite) TestCancel_TransfersWhileThereAreSpots() {
+	race, err := s.service.Cancel(context.Background(), service.CancelRace{
+		RaceID:     id.ID(s.race.ID).String(),
+		Reason:     "storm",
+		TransferTo: &service.CancelRaceTransfer{RaceID: id.ID(s.target.ID).String(), Category: "10K"},
+	})
+	s.Require().NoError(err)
+
+	s.Empty(race.Competitors.List())
+	target := s.races[s.target.ID]
+	s.Len(target.Competitors.List(), 1)
+
+	var transferred, released int
+	for _, e := range s.published()[1:] {
+		switch e := e.(type) {
+		case service.CompetitorTransferred:
+			transferred++
+			s.Equal(s.target.ID, e.To)
+			s.True(target.HasCompetitor(e.Competitor))
+		case service.CompetitorReleased:
+			released++
+			s.False(target.HasCompetitor(e.Competitor))
+		}
+	}
+	s.Equal(1, transferred)
+	s.Equal(1, released, "the category is full for the second competitor")
+}
+
+func (s *cancellationsSuite) TestResumeCancellations() {
+	race := s.races[s.race.ID]
+	s.Require().NoError(race.Cancel("storm", nil, time.Now()))
+	s.races[race.ID] = race
+	s.repo.CancelledWithCompetitorsFunc = func(context.Context) ([]racers.RaceID, error) {
+		return []racers.RaceID{race.ID}, nil
+	}
+
+	completed, err := s.service.ResumeCancellations(context.Background())
+	s.Require().NoError(err)
+
+	s.Equal(1, completed)
+	s.Empty(s.races[race.ID].Competitors.List())
+	s.Len(s.published(), 2)
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func NewCheckIns(races RacesRepository, orgs OrganizationsGetter, users UsersGetter, uow UnitOfWork, eb EventBus, secret []byte) CheckIns {
+	return CheckIns{races, orgs, users, uow, eb, secret}
+}
+
+// CheckIns checks the competitors in at the packet pickup, the check-in tokens printed as QR codes
+// are signed with the secret
+type CheckIns struct {
+	races  RacesRepository
+	orgs   OrganizationsGetter
+	users  UsersGetter
+	uow    UnitOfWork
+	eb     EventBus
+	secret []byte
+}
+
+// FindCompetitor identifies a competitor of the race by the check-in token, the user id or the bib,
+// in that order
+type FindCompetitor struct {
+	RaceID string
+	UserID string
+	Bib    int
+	Token  string
+}
+
+// CheckInEntry is what the staff needs to hand the packet over to a competitor
+type CheckInEntry struct {
+	Race       racers.RaceID
+	Competitor racers.User
+	// Category is empty when the race has a single category
+	Category racers.CategoryName
+	// Bib is zero when the race has no bib numbers
+	Bib          racers.Bib
+	Registration racers.Registration
+	// CheckedInAt is nil until the competitor is checked in
+	CheckedInAt *time.Time
+}
+
+// CompetitorCheckedIn is published the first time a competitor is checked in
+type CompetitorCheckedIn struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	At         time.Time
+}
+
+func (e CompetitorCheckedIn) RaceID() racers.RaceID { return e.Race }
+
+// Token returns the check-in token of the competitor in the race, it is printed as a QR code in the
+// confirmation emails
+func (s CheckIns) Token(race racers.RaceID, competitor racers.UserID) string {
+	payload := fmt.Sprintf("%s.%s", id.ID(race), id.ID(competitor))
+
+	return fmt.Sprintf("%s.%s", base64.RawURLEncoding.EncodeToString([]byte(payload)), s.sign(payload))
+}
+
+func (s CheckIns) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	_, _ = mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// competitorOf returns the competitor of the token, which must be signed for the race
+func (s CheckIns) competitorOf(race racers.RaceID, token string) (racers.UserID, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return racers.UserID{}, ErrInvalidCheckInToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || !hmac.Equal([]byte(s.sign(string(payload))), []byte(parts[1])) {
+		return racers.UserID{}, ErrInvalidCheckInToken
+	}
+
+	fields := strings.Split(string(payload), ".")
+	if len(fields) != 2 || fields[0] != id.ID(race).String() {
+		return racers.UserID{}, ErrInvalidCheckInToken
+	}
+	competitor, err := racers.NewUserID(fields[1])
+	if err != nil {
+		return racers.UserID{}, ErrInvalidCheckInToken
+	}
+
+	return competitor, nil
+}
+
+// find returns the race and the competitor, ErrForbidden when the current user can not check in
+func (s CheckIns) find(ctx context.Context, r FindCompetitor) (racers.Race, racers.UserID, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, racers.UserID{}, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return racers.Race{}, racers.UserID{}, err
+	}
+
+	ok, err := can(ctx, s.orgs, race, s.users.Current(ctx).ID, racers.PermissionCheckIn)
+	if err != nil {
+		return racers.Race{}, racers.UserID{}, err
+	}
+	if !ok {
+		return racers.Race{}, racers.UserID{}, ErrForbidden
+	}
+
+	var competitor racers.UserID
+	if r.Token != "" {
+		competitor, err = s.competitorOf(race.ID, r.Token)
+	} else {
+		competitor, err = resolveCompetitor(race, r.UserID, r.Bib)
+	}
+	if err != nil {
+		return racers.Race{}, racers.UserID{}, err
+	}
+
+	if !race.HasCompetitor(competitor) {
+		return racers.Race{}, racers.UserID{}, racers.CompetitorNotInRaceError{RaceID: race.ID, CompetitorID: competitor}
+	}
+
+	return race, competitor, nil
+}
+
+// entry returns the check-in entry of the competitor
+func (s CheckIns) entry(ctx context.Context, race racers.Race, competitor racers.UserID) (CheckInEntry, error) {
+	u, err := s.users.Get(ctx, competitor)
+	if err != nil {
+		return CheckInEntry{}, err
+	}
+
+	reg, _ := race.Registration(competitor)
+	e := CheckInEntry{
+		Race:         race.ID,
+		Competitor:   u,
+		Category:     race.CategoryEntries[competitor],
+		Bib:          race.BibEntries[competitor],
+		Registration: reg,
+	}
+	if at, ok := race.CheckIns[competitor]; ok {
+		e.CheckedInAt = &at
+	}
+
+	return e, nil
+}
+
+// Lookup returns the check-in entry of a competitor, only the staff checking in is allowed
+func (s CheckIns) Lookup(ctx context.Context, r FindCompetitor) (CheckInEntry, error) {
+	race, competitor, err := s.find(ctx, r)
+	if err != nil {
+		return CheckInEntry{}, err
+	}
+
+	return s.entry(ctx, race, competitor)
+}
+
+// CheckIn records the competitor picked up the packet, only the staff checking in is allowed.
+// Checking in a competitor again keeps the first check-in
+func (s CheckIns) CheckIn(ctx context.Context, r FindCompetitor) (CheckInEntry, error) {
+	var (
+		race       racers.Race
+		competitor racers.UserID
+	)
+	err := s.uow(ctx, func(ctx context.Context) error {
+		var err error
+		if race, competitor, err = s.find(ctx, r); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		checked, err := race.CheckIn(competitor, now)
+		if err != nil || !checked {
+			return err
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(CompetitorCheckedIn{Race: race.ID, Competitor: competitor, At: now}, s.users.Current(ctx).ID))
+	})
+	if err != nil {
+		return CheckInEntry{}, err
+	}
+
+	return s.entry(ctx, race, competitor)
+}
@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestCheckIns(t *testing.T) {
+	suite.Run(t, new(checkInsSuite))
+}
+
+type checkInsSuite struct {
+	suite.Suite
+
+	service service.CheckIns
+	races   service.Races
+
+	race       racers.Race
+	owner      racers.User
+	volunteer  racers.User
+	competitor racers.User
+	current    racers.User
+
+	repo     *RacesRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *checkInsSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.volunteer = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate()), Name: "Jane"}
+	s.current = s.owner
+
+	s.race = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Date:        racers.RaceDate(time.Now().Add(time.Hour)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor.ID),
+		BibEntries:  racers.RaceBibEntries{s.competitor.ID: 7},
+	}
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+	}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc: func(_ context.Context, id racers.UserID) (racers.User, error) {
+			for _, u := range []racers.User{s.owner, s.volunteer, s.competitor} {
+				if u.ID == id {
+					return u, nil
+				}
+			}
+			return racers.User{}, service.ErrUserNotFound
+		},
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewCheckIns(s.repo, nil, users, service.NoopUnitOfWork, s.eventBus, []byte("secret"))
+	s.races = service.NewRaces(s.repo, nil, nil, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *checkInsSuite) TestCheckIn_NotStaff() {
+	s.current = s.volunteer
+
+	_, err := s.service.CheckIn(context.Background(), service.FindCompetitor{RaceID: id.ID(s.race.ID).String(), Bib: 7})
+
+	s.Equal(service.ErrForbidden, err)
+	s.Empty(s.repo.SaveCalls())
+}
+
+func (s *checkInsSuite) TestCheckIn_ByBib() {
+	_, err := s.races.AddStaff(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.volunteer.ID).String(),
+		Role:   string(racers.StaffVolunteer),
+	})
+	s.Require().NoError(err)
+
+	s.current = s.volunteer
+	entry, err := s.service.CheckIn(context.Background(), service.FindCompetitor{RaceID: id.ID(s.race.ID).String(), Bib: 7})
+	s.Require().NoError(err)
+
+	s.Equal(s.competitor, entry.Competitor)
+	s.Require().NotNil(entry.CheckedInAt)
+
+	calls := s.eventBus.PublishCalls()
+	s.Equal(service.RaceStaffAdded{Race: s.race.ID, User: s.volunteer.ID, Role: racers.StaffVolunteer}, calls[0].Events[0].Payload)
+	s.Equal(
+		service.CompetitorCheckedIn{Race: s.race.ID, Competitor: s.competitor.ID, At: *entry.CheckedInAt},
+		calls[1].Events[0].Payload,
+	)
+
+	_, err = s.service.CheckIn(context.Background(), service.FindCompetitor{RaceID: id.ID(s.race.ID).String(), Bib: 7})
+	s.Require().NoError(err)
+	s.Len(s.eventBus.PublishCalls(), 2, "checking in again publishes nothing")
+}
+
+func (s *checkInsSuite) TestLookup_ByToken() {
+	token := s.service.Token(s.race.ID, s.competitor.ID)
+
+	entry, err := s.service.Lookup(context.Background(), service.FindCompetitor{RaceID: id.ID(s.race.ID).String(), Token: token})
+	s.Require().NoError(err)
+
+	s.Equal(s.competitor.ID, entry.Competitor.ID)
+	s.Equal(racers.Bib(7), entry.Bib)
+	s.Nil(entry.CheckedInAt)
+}
+
+func (s *checkInsSuite) TestLookup_InvalidToken() {
+	for name, token := range map[string]string{
+		"malformed":  "token",
+		"other race": s.service.Token(racers.RaceID(id.Generate()), s.competitor.ID),
+		"forged":     service.NewCheckIns(nil, nil, nil, nil, nil, []byte("other")).Token(s.race.ID, s.competitor.ID),
+	} {
+		_, err := s.service.Lookup(context.Background(), service.FindCompetitor{RaceID: id.ID(s.race.ID).String(), Token: token})
+		s.Equal(service.ErrInvalidCheckInToken, err, name)
+	}
+}
+
+func (s *checkInsSuite) TestLookup_NotCompetitor() {
+	_, err := s.service.Lookup(context.Background(), service.FindCompetitor{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.volunteer.ID).String(),
+	})
+
+	s.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+func NewDiscountCodes(codes DiscountCodesRepository, races RacesRepository, orgs OrganizationsGetter, users UsersGetter, uow UnitOfWork, eb EventBus) DiscountCodes {
+	return DiscountCodes{codes, races, orgs, users, uow, eb}
+}
+
+// DiscountCodes manages the codes organizers hand out to register with a discount
+type DiscountCodes struct {
+	codes DiscountCodesRepository
+	races RacesRepository
+	orgs  OrganizationsGetter
+	users UsersGetter
+	uow   UnitOfWork
+	eb    EventBus
+}
+
+type CreateDiscountCode struct {
+	RaceID string `json:"race_id,omitempty"`
+	Code   string `json:"code,omitempty"`
+	// Percent off the fee, zero when the discount is a fixed amount
+	Percent int `json:"percent,omitempty"`
+	// Amount off the fee in the minor unit of its currency, zero when the discount is a percentage
+	Amount int64 `json:"amount,omitempty"`
+	// MaxRedemptions is zero for no limit
+	MaxRedemptions int `json:"max_redemptions,omitempty"`
+	// ValidFrom and ValidUntil are zero for no limit
+	ValidFrom  time.Time `json:"valid_from,omitempty"`
+	ValidUntil time.Time `json:"valid_until,omitempty"`
+	// Category is empty when the code is valid for any category
+	Category string `json:"category,omitempty"`
+}
+
+type DiscountCodeCreated struct {
+	Code racers.DiscountCode `json:"code,omitempty"`
+}
+
+func (e DiscountCodeCreated) RaceID() racers.RaceID { return e.Code.Race }
+
+// Create adds a discount code to the race, only the race editors are allowed
+func (s DiscountCodes) Create(ctx context.Context, r CreateDiscountCode) (racers.DiscountCode, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.DiscountCode{}, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return racers.DiscountCode{}, err
+	}
+
+	if err := permit(ctx, s.orgs, race, s.users.Current(ctx).ID, racers.PermissionEdit); err != nil {
+		return racers.DiscountCode{}, err
+	}
+
+	category := racers.CategoryName(r.Category)
+	if _, ok := race.Categories.Get(category); category != "" && !ok {
+		return racers.DiscountCode{}, racers.UnknownCategoryError{RaceID: race.ID, Category: category}
+	}
+
+	code, err := racers.NewDiscountCode(
+		race.ID,
+		r.Code,
+		racers.Discount{Percent: r.Percent, Amount: r.Amount},
+		r.MaxRedemptions,
+		r.ValidFrom,
+		r.ValidUntil,
+		category,
+	)
+	if err != nil {
+		return racers.DiscountCode{}, err
+	}
+
+	exists, err := s.codes.Exists(ctx, race.ID, code.Code)
+	if err != nil {
+		return racers.DiscountCode{}, err
+	}
+	if exists {
+		return racers.DiscountCode{}, ErrDiscountCodeAlreadyExists
+	}
+
+	err = s.uow(ctx, func(ctx context.Context) error {
+		if err := s.codes.Save(ctx, code); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(DiscountCodeCreated{Code: code}, s.users.Current(ctx).ID))
+	})
+	if err != nil {
+		return racers.DiscountCode{}, err
+	}
+
+	return code, nil
+}
+
+// List returns the codes of the race with their redemptions, only the race editors are allowed
+func (s DiscountCodes) List(ctx context.Context, r GetRace) ([]racers.DiscountCode, error) {
+	raceID, err := racers.NewRaceID(r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := permit(ctx, s.orgs, race, s.users.Current(ctx).ID, racers.PermissionEdit); err != nil {
+		return nil, err
+	}
+
+	return s.codes.List(ctx, raceID)
+}
+
+type JoinRaceWithCode struct {
+	JoinRace
+	Code string
+}
+
+// CodeRedeemed is published when a competitor joins a race with a discount code
+type CodeRedeemed struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Code       string
+	// Fee is the fee left to pay after the discount
+	Fee racers.Money
+}
+
+func (e CodeRedeemed) RaceID() racers.RaceID { return e.Race }
+
+// Join registers the user in the race like Races.Join, discounting the fee with the code.
+// The redemption is counted in the same unit of work as the registration, so concurrent
+// registrations never exceed the max redemptions of the code
+func (s DiscountCodes) Join(ctx context.Context, r JoinRaceWithCode) (RaceRegistration, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	competitorID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	user, err := s.users.Get(ctx, competitorID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	var registration RaceRegistration
+	err = s.uow(ctx, func(ctx context.Context) error {
+		// the race is locked before redeeming the code, so the registrations are checked against the
+		// registrations done meanwhile and none is overwritten
+		race, err := s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		code, err := s.codes.Get(ctx, race.ID, strings.ToUpper(strings.TrimSpace(r.Code)))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		expired := race.ExpireRegistrations(now)
+
+		if err := race.JoinWithCode(user, racers.CategoryName(r.Category), code, now); err != nil {
+			return err
+		}
+
+		redeemed, err := s.codes.Redeem(ctx, race.ID, code.Code)
+		if err != nil {
+			return err
+		}
+		if !redeemed {
+			return racers.DiscountCodeNotApplicableError{Code: code.Code, Reason: "no redemptions left"}
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		reg, _ := race.Registration(user.ID)
+		registration = RaceRegistration{Race: race.ID, Competitor: user.ID, Registration: reg}
+
+		events := joinEvents(race, user, expired, s.users.Current(ctx).ID)
+		events = append(events, newEvent(CodeRedeemed{Race: race.ID, Competitor: user.ID, Code: code.Code, Fee: reg.Fee}, s.users.Current(ctx).ID))
+
+		return s.eb.Publish(ctx, events...)
+	})
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	return registration, nil
+}
@@ -0,0 +1,220 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestDiscountCodes(t *testing.T) {
+	suite.Run(t, new(discountCodesSuite))
+}
+
+type discountCodesSuite struct {
+	suite.Suite
+
+	service service.DiscountCodes
+
+	race       racers.Race
+	owner      racers.User
+	competitor racers.User
+	current    racers.User
+	code       racers.DiscountCode
+
+	codes    *DiscountCodesRepositoryMock
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *discountCodesSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.owner
+
+	s.race = racers.Race{
+		ID:         racers.RaceID(id.Generate()),
+		Date:       racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:      s.owner.ID,
+		Price:      &racers.RacePrice{Currency: "EUR", Amount: 2000},
+		Categories: racers.RaceCategories{{Name: "10K"}},
+	}
+	s.code = racers.DiscountCode{Race: s.race.ID, Code: "SPONSOR", Discount: racers.Discount{Percent: 100}, MaxRedemptions: 1}
+
+	s.races = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+	}
+	s.codes = &DiscountCodesRepositoryMock{
+		GetFunc: func(_ context.Context, _ racers.RaceID, code string) (racers.DiscountCode, error) {
+			if code != s.code.Code {
+				return racers.DiscountCode{}, service.ErrDiscountCodeNotFound
+			}
+			return s.code, nil
+		},
+		RedeemFunc: func(context.Context, racers.RaceID, string) (bool, error) {
+			if s.code.Exhausted() {
+				return false, nil
+			}
+			s.code.Redemptions++
+			return true, nil
+		},
+	}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc:     func(context.Context, racers.UserID) (racers.User, error) { return s.competitor, nil },
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewDiscountCodes(s.codes, s.races, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *discountCodesSuite) createRequest() service.CreateDiscountCode {
+	return service.CreateDiscountCode{RaceID: id.ID(s.race.ID).String(), Code: "club-25", Percent: 25, MaxRedemptions: 10, Category: "10K"}
+}
+
+func (s *discountCodesSuite) TestCreate() {
+	s.Run("not owner", func() {
+		s.current = s.competitor
+		defer func() { s.current = s.owner }()
+
+		_, err := s.service.Create(context.Background(), s.createRequest())
+		s.Equal(service.ErrForbidden, err)
+	})
+
+	s.Run("co-organizer", func() {
+		s.current = racers.User{ID: racers.UserID(id.Generate())}
+		s.race.Staff = racers.RaceStaff{s.current.ID: racers.StaffCoOrganizer}
+		defer func() { s.current, s.race.Staff = s.owner, nil }()
+
+		_, err := s.service.Create(context.Background(), s.createRequest())
+		s.NoError(err)
+	})
+
+	s.Run("unknown category", func() {
+		req := s.createRequest()
+		req.Category = "5K"
+
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.UnknownCategoryError{}))
+	})
+
+	s.Run("already exists", func() {
+		s.codes.ExistsFunc = func(context.Context, racers.RaceID, string) (bool, error) { return true, nil }
+		defer func() { s.codes.ExistsFunc = nil }()
+
+		_, err := s.service.Create(context.Background(), s.createRequest())
+		s.Equal(service.ErrDiscountCodeAlreadyExists, err)
+	})
+
+	code, err := s.service.Create(context.Background(), s.createRequest())
+	s.NoError(err)
+
+	expected := racers.DiscountCode{Race: s.race.ID, Code: "CLUB-25", Discount: racers.Discount{Percent: 25}, MaxRedemptions: 10, Category: "10K"}
+	s.Equal(expected, code)
+	s.Equal(expected, s.codes.SaveCalls()[0].Code)
+	s.Equal(service.DiscountCodeCreated{Code: expected}, s.eventBus.PublishCalls()[0].Events[0].Payload)
+}
+
+func (s *discountCodesSuite) joinRequest(code string) service.JoinRaceWithCode {
+	return service.JoinRaceWithCode{
+		JoinRace: service.JoinRace{RaceID: id.ID(s.race.ID).String(), UserID: id.ID(s.competitor.ID).String(), Category: "10K"},
+		Code:     code,
+	}
+}
+
+func (s *discountCodesSuite) TestJoin() {
+	reg, err := s.service.Join(context.Background(), s.joinRequest("sponsor"))
+	s.NoError(err)
+
+	s.Equal(racers.RegistrationConfirmed, reg.Status)
+	s.Equal("SPONSOR", reg.Code)
+	s.True(s.race.HasCompetitor(s.competitor.ID))
+	s.Equal(1, s.code.Redemptions)
+
+	events := s.eventBus.PublishCalls()[0].Events
+	s.Equal(
+		service.CodeRedeemed{Race: s.race.ID, Competitor: s.competitor.ID, Code: "SPONSOR", Fee: racers.Money{Currency: "EUR"}},
+		events[len(events)-1].Payload,
+	)
+}
+
+func (s *discountCodesSuite) TestJoin_NotFound() {
+	_, err := s.service.Join(context.Background(), s.joinRequest("unknown"))
+
+	s.Equal(service.ErrDiscountCodeNotFound, err)
+	s.Empty(s.races.SaveCalls())
+}
+
+func (s *discountCodesSuite) TestJoin_RedemptionsExceeded() {
+	// another registration redeemed the last use after the code was read
+	s.codes.RedeemFunc = func(context.Context, racers.RaceID, string) (bool, error) { return false, nil }
+
+	_, err := s.service.Join(context.Background(), s.joinRequest("SPONSOR"))
+
+	s.True(errors.As(err, &racers.DiscountCodeNotApplicableError{}))
+	s.Empty(s.races.SaveCalls())
+	s.Empty(s.eventBus.PublishCalls())
+}
+
+func (s *discountCodesSuite) TestJoin_LocksRaceBeforeRedeeming() {
+	type unitOfWork struct{}
+	uow := func(ctx context.Context, work service.Work) error {
+		return work(context.WithValue(ctx, unitOfWork{}, true))
+	}
+
+	var steps []string
+	get := s.races.GetFunc
+	s.races.GetFunc = func(ctx context.Context, raceID racers.RaceID) (racers.Race, error) {
+		s.NotNil(ctx.Value(unitOfWork{}), "the race is read in the unit of work of the redemption")
+		steps = append(steps, "race")
+		return get(ctx, raceID)
+	}
+	redeem := s.codes.RedeemFunc
+	s.codes.RedeemFunc = func(ctx context.Context, raceID racers.RaceID, code string) (bool, error) {
+		steps = append(steps, "redeem")
+		return redeem(ctx, raceID, code)
+	}
+
+	_, err := service.NewDiscountCodes(s.codes, s.races, nil, s.users, uow, s.eventBus).Join(context.Background(), s.joinRequest("SPONSOR"))
+	s.Require().NoError(err)
+
+	s.Equal([]string{"race", "redeem"}, steps)
+}
+
+func (s *discountCodesSuite) TestList() {
+	s.codes.ListFunc = func(context.Context, racers.RaceID) ([]racers.DiscountCode, error) {
+		return []racers.DiscountCode{s.code}, nil
+	}
+
+	s.Run("not owner", func() {
+		s.current = s.competitor
+		defer func() { s.current = s.owner }()
+
+		_, err := s.service.List(context.Background(), service.GetRace{ID: id.ID(s.race.ID).String()})
+		s.Equal(service.ErrForbidden, err)
+	})
+
+	s.Run("co-organizer", func() {
+		s.current = racers.User{ID: racers.UserID(id.Generate())}
+		s.race.Staff = racers.RaceStaff{s.current.ID: racers.StaffCoOrganizer}
+		defer func() { s.current, s.race.Staff = s.owner, nil }()
+
+		codes, err := s.service.List(context.Background(), service.GetRace{ID: id.ID(s.race.ID).String()})
+		s.NoError(err)
+		s.Equal([]racers.DiscountCode{s.code}, codes)
+	})
+
+	codes, err := s.service.List(context.Background(), service.GetRace{ID: id.ID(s.race.ID).String()})
+	s.NoError(err)
+	s.Equal([]racers.DiscountCode{s.code}, codes)
+}
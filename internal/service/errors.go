@@ -6,11 +6,71 @@ import "github.com/xabi93/racers/internal/errors"
 var (
 	ErrRaceNotFound      = errors.New("race not found")
 	ErrRaceAlreadyExists = errors.New("race already exists")
+	ErrInvalidRadius     = errors.New("invalid radius, expected a positive distance up to 1000 km")
+	ErrEmptySearchQuery  = errors.New("empty search query")
+)
+
+// Courses errors
+var (
+	ErrCourseNotFound     = errors.New("course not found")
+	ErrCourseFileTooLarge = errors.New("course file too large")
+)
+
+// Results import errors
+var (
+	ErrResultsFileTooLarge = errors.New("results file too large")
+)
+
+// Series errors
+var (
+	ErrSeriesNotFound      = errors.New("series not found")
+	ErrSeriesAlreadyExists = errors.New("series already exists")
+)
+
+// Discount codes errors
+var (
+	ErrDiscountCodeNotFound      = errors.New("discount code not found")
+	ErrDiscountCodeAlreadyExists = errors.New("discount code already exists")
+)
+
+// Organizations errors
+var (
+	ErrOrganizationNotFound      = errors.New("organization not found")
+	ErrOrganizationAlreadyExists = errors.New("organization already exists")
+)
+
+// Calendars errors
+var (
+	ErrCalendarTokenNotFound = errors.New("calendar token not found")
+)
+
+// Notifications errors
+var (
+	ErrNotificationPreferencesNotFound = errors.New("notification preferences not found")
+	ErrUnsupportedLocale               = errors.New("unsupported locale")
+	ErrUnknownNotificationKind         = errors.New("unknown notification kind")
+	ErrInvalidUnsubscribeToken         = errors.New("invalid unsubscribe token")
+)
+
+// Check-in errors
+var (
+	ErrInvalidCheckInToken = errors.New("invalid check-in token")
+)
+
+// Privacy errors
+var (
+	ErrErasureNotFound = errors.New("erasure not found")
 )
 
 // Users errors
 var (
 	ErrUserNotFound = errors.New("user not found")
+	ErrForbidden    = errors.New("user is not allowed to perform this action")
+)
+
+// Audit errors
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
 )
 
 // Teams errors
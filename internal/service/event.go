@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"time"
 
@@ -9,7 +10,7 @@ import (
 	"github.com/xabi93/racers/internal/id"
 )
 
-//go:generate moq -stub -pkg service_test -out mock_event_test.go . EventBus
+//go:generate moq -stub -pkg service_test -out mock_event_test.go . EventBus EventsGetter EventsStream
 
 func newEvent(payload interface{}, userID racers.UserID) Event {
 	return Event{id.Generate(), payload, userID, time.Now()}
@@ -26,6 +27,83 @@ func (e Event) Name() string {
 	return reflect.ValueOf(e.Payload).Type().Name()
 }
 
+// RaceEvent is implemented by the event payloads that belong to a race
+type RaceEvent interface {
+	RaceID() racers.RaceID
+}
+
+// RaceID returns the race the event belongs to, nil if the event is not related to a race
+func (e Event) RaceID() *racers.RaceID {
+	re, ok := e.Payload.(RaceEvent)
+	if !ok {
+		return nil
+	}
+
+	raceID := re.RaceID()
+
+	return &raceID
+}
+
+// OrganizationEvent is implemented by the event payloads that belong to an organization, the events of the
+// races owned by an organization belong to it as well
+type OrganizationEvent interface {
+	OrganizationID() racers.OrganizationID
+}
+
+// OrganizationID returns the organization the event belongs to, nil if the payload is not an organization event
+func (e Event) OrganizationID() *racers.OrganizationID {
+	oe, ok := e.Payload.(OrganizationEvent)
+	if !ok {
+		return nil
+	}
+
+	orgID := oe.OrganizationID()
+
+	return &orgID
+}
+
 type EventBus interface {
 	Publish(ctx context.Context, events ...Event) error
 }
+
+// StoredEvent is an event already published, with its payload as it was stored
+type StoredEvent struct {
+	ID     id.ID
+	Type   string
+	UserID racers.UserID
+	RaceID *racers.RaceID
+	// OrganizationID is nil when the event does not belong to an organization
+	OrganizationID *racers.OrganizationID
+	Payload        json.RawMessage
+	OccurredAt     time.Time
+}
+
+// EventsFilter defines the criteria to find stored events, empty fields are not applied
+type EventsFilter struct {
+	UserID         *racers.UserID
+	RaceID         *racers.RaceID
+	OrganizationID *racers.OrganizationID
+	Type           string
+	From           *time.Time
+	To             *time.Time
+}
+
+// EventsCursor points to a stored event, events are sorted by occurred at and id
+type EventsCursor struct {
+	OccurredAt time.Time
+	ID         id.ID
+}
+
+type EventsGetter interface {
+	// Find returns the events matching the filter, newest first, that come after the given cursor
+	Find(ctx context.Context, filter EventsFilter, after *EventsCursor, limit int) ([]StoredEvent, error)
+}
+
+// EventsStream reads the stored events in the order they occurred, for the consumers that process each event once
+type EventsStream interface {
+	// After returns the events that come after the cursor, oldest first, that occurred before the given instant
+	After(ctx context.Context, after *EventsCursor, before time.Time, limit int) ([]StoredEvent, error)
+	// Cursor returns the last event processed by the consumer, nil if it did not process any
+	Cursor(ctx context.Context, consumer string) (*EventsCursor, error)
+	SaveCursor(ctx context.Context, consumer string, cursor EventsCursor) error
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/xabi93/racers/internal/service"
 	"sync"
+	"time"
 )
 
 // Ensure, that EventBusMock does implement service.EventBus.
@@ -82,3 +83,288 @@ func (mock *EventBusMock) PublishCalls() []struct {
 	mock.lockPublish.RUnlock()
 	return calls
 }
+
+// Ensure, that EventsGetterMock does implement service.EventsGetter.
+// If this is not the case, regenerate this file with moq.
+var _ service.EventsGetter = &EventsGetterMock{}
+
+// EventsGetterMock is a mock implementation of service.EventsGetter.
+//
+//     func TestSomethingThatUsesEventsGetter(t *testing.T) {
+//
+//         // make and configure a mocked service.EventsGetter
+//         mockedEventsGetter := &EventsGetterMock{
+//             FindFunc: func(ctx context.Context, filter service.EventsFilter, after *service.EventsCursor, limit int) ([]service.StoredEvent, error) {
+// 	               panic("mock out the Find method")
+//             },
+//         }
+//
+//         // use mockedEventsGetter in code that requires service.EventsGetter
+//         // and then make assertions.
+//
+//     }
+type EventsGetterMock struct {
+	// FindFunc mocks the Find method.
+	FindFunc func(ctx context.Context, filter service.EventsFilter, after *service.EventsCursor, limit int) ([]service.StoredEvent, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Find holds details about calls to the Find method.
+		Find []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter service.EventsFilter
+			// After is the after argument value.
+			After *service.EventsCursor
+			// Limit is the limit argument value.
+			Limit int
+		}
+	}
+	lockFind sync.RWMutex
+}
+
+// Find calls FindFunc.
+func (mock *EventsGetterMock) Find(ctx context.Context, filter service.EventsFilter, after *service.EventsCursor, limit int) ([]service.StoredEvent, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Filter service.EventsFilter
+		After  *service.EventsCursor
+		Limit  int
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+		After:  after,
+		Limit:  limit,
+	}
+	mock.lockFind.Lock()
+	mock.calls.Find = append(mock.calls.Find, callInfo)
+	mock.lockFind.Unlock()
+	if mock.FindFunc == nil {
+		var (
+			out1 []service.StoredEvent
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.FindFunc(ctx, filter, after, limit)
+}
+
+// FindCalls gets all the calls that were made to Find.
+// Check the length with:
+//     len(mockedEventsGetter.FindCalls())
+func (mock *EventsGetterMock) FindCalls() []struct {
+	Ctx    context.Context
+	Filter service.EventsFilter
+	After  *service.EventsCursor
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter service.EventsFilter
+		After  *service.EventsCursor
+		Limit  int
+	}
+	mock.lockFind.RLock()
+	calls = mock.calls.Find
+	mock.lockFind.RUnlock()
+	return calls
+}
+
+// Ensure, that EventsStreamMock does implement service.EventsStream.
+// If this is not the case, regenerate this file with moq.
+var _ service.EventsStream = &EventsStreamMock{}
+
+// EventsStreamMock is a mock implementation of service.EventsStream.
+//
+//     func TestSomethingThatUsesEventsStream(t *testing.T) {
+//
+//         // make and configure a mocked service.EventsStream
+//         mockedEventsStream := &EventsStreamMock{
+//             AfterFunc: func(ctx context.Context, after *service.EventsCursor, before time.Time, limit int) ([]service.StoredEvent, error) {
+// 	               panic("mock out the After method")
+//             },
+//             CursorFunc: func(ctx context.Context, consumer string) (*service.EventsCursor, error) {
+// 	               panic("mock out the Cursor method")
+//             },
+//             SaveCursorFunc: func(ctx context.Context, consumer string, cursor service.EventsCursor) error {
+// 	               panic("mock out the SaveCursor method")
+//             },
+//         }
+//
+//         // use mockedEventsStream in code that requires service.EventsStream
+//         // and then make assertions.
+//
+//     }
+type EventsStreamMock struct {
+	// AfterFunc mocks the After method.
+	AfterFunc func(ctx context.Context, after *service.EventsCursor, before time.Time, limit int) ([]service.StoredEvent, error)
+
+	// CursorFunc mocks the Cursor method.
+	CursorFunc func(ctx context.Context, consumer string) (*service.EventsCursor, error)
+
+	// SaveCursorFunc mocks the SaveCursor method.
+	SaveCursorFunc func(ctx context.Context, consumer string, cursor service.EventsCursor) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// After holds details about calls to the After method.
+		After []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// After is the after argument value.
+			After *service.EventsCursor
+			// Before is the before argument value.
+			Before time.Time
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// Cursor holds details about calls to the Cursor method.
+		Cursor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Consumer is the consumer argument value.
+			Consumer string
+		}
+		// SaveCursor holds details about calls to the SaveCursor method.
+		SaveCursor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Consumer is the consumer argument value.
+			Consumer string
+			// Cursor is the cursor argument value.
+			Cursor service.EventsCursor
+		}
+	}
+	lockAfter      sync.RWMutex
+	lockCursor     sync.RWMutex
+	lockSaveCursor sync.RWMutex
+}
+
+// After calls AfterFunc.
+func (mock *EventsStreamMock) After(ctx context.Context, after *service.EventsCursor, before time.Time, limit int) ([]service.StoredEvent, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		After  *service.EventsCursor
+		Before time.Time
+		Limit  int
+	}{
+		Ctx:    ctx,
+		After:  after,
+		Before: before,
+		Limit:  limit,
+	}
+	mock.lockAfter.Lock()
+	mock.calls.After = append(mock.calls.After, callInfo)
+	mock.lockAfter.Unlock()
+	if mock.AfterFunc == nil {
+		var (
+			out1 []service.StoredEvent
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.AfterFunc(ctx, after, before, limit)
+}
+
+// AfterCalls gets all the calls that were made to After.
+// Check the length with:
+//     len(mockedEventsStream.AfterCalls())
+func (mock *EventsStreamMock) AfterCalls() []struct {
+	Ctx    context.Context
+	After  *service.EventsCursor
+	Before time.Time
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		After  *service.EventsCursor
+		Before time.Time
+		Limit  int
+	}
+	mock.lockAfter.RLock()
+	calls = mock.calls.After
+	mock.lockAfter.RUnlock()
+	return calls
+}
+
+// Cursor calls CursorFunc.
+func (mock *EventsStreamMock) Cursor(ctx context.Context, consumer string) (*service.EventsCursor, error) {
+	callInfo := struct {
+		Ctx      context.Context
+		Consumer string
+	}{
+		Ctx:      ctx,
+		Consumer: consumer,
+	}
+	mock.lockCursor.Lock()
+	mock.calls.Cursor = append(mock.calls.Cursor, callInfo)
+	mock.lockCursor.Unlock()
+	if mock.CursorFunc == nil {
+		var (
+			out1 *service.EventsCursor
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.CursorFunc(ctx, consumer)
+}
+
+// CursorCalls gets all the calls that were made to Cursor.
+// Check the length with:
+//     len(mockedEventsStream.CursorCalls())
+func (mock *EventsStreamMock) CursorCalls() []struct {
+	Ctx      context.Context
+	Consumer string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Consumer string
+	}
+	mock.lockCursor.RLock()
+	calls = mock.calls.Cursor
+	mock.lockCursor.RUnlock()
+	return calls
+}
+
+// SaveCursor calls SaveCursorFunc.
+func (mock *EventsStreamMock) SaveCursor(ctx context.Context, consumer string, cursor service.EventsCursor) error {
+	callInfo := struct {
+		Ctx      context.Context
+		Consumer string
+		Cursor   service.EventsCursor
+	}{
+		Ctx:      ctx,
+		Consumer: consumer,
+		Cursor:   cursor,
+	}
+	mock.lockSaveCursor.Lock()
+	mock.calls.SaveCursor = append(mock.calls.SaveCursor, callInfo)
+	mock.lockSaveCursor.Unlock()
+	if mock.SaveCursorFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveCursorFunc(ctx, consumer, cursor)
+}
+
+// SaveCursorCalls gets all the calls that were made to SaveCursor.
+// Check the length with:
+//     len(mockedEventsStream.SaveCursorCalls())
+func (mock *EventsStreamMock) SaveCursorCalls() []struct {
+	Ctx      context.Context
+	Consumer string
+	Cursor   service.EventsCursor
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Consumer string
+		Cursor   service.EventsCursor
+	}
+	mock.lockSaveCursor.RLock()
+	calls = mock.calls.SaveCursor
+	mock.lockSaveCursor.RUnlock()
+	return calls
+}
@@ -6,8 +6,10 @@ package service_test
 import (
 	"context"
 	"github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
 	"github.com/xabi93/racers/internal/service"
 	"sync"
+	"time"
 )
 
 // Ensure, that RacesRepositoryMock does implement service.RacesRepository.
@@ -23,15 +25,63 @@ var _ service.RacesRepository = &RacesRepositoryMock{}
 //             AllFunc: func(ctx context.Context) ([]racers.Race, error) {
 // 	               panic("mock out the All method")
 //             },
+//             ByCompetitorFunc: func(ctx context.Context, id racers.UserID) ([]racers.Race, error) {
+// 	               panic("mock out the ByCompetitor method")
+//             },
+//             ByOrganizationFunc: func(ctx context.Context, id racers.OrganizationID) ([]racers.Race, error) {
+// 	               panic("mock out the ByOrganization method")
+//             },
+//             CancelledWithCompetitorsFunc: func(ctx context.Context) ([]racers.RaceID, error) {
+// 	               panic("mock out the CancelledWithCompetitors method")
+//             },
+//             CourseFileFunc: func(ctx context.Context, id racers.RaceID, category racers.CategoryName) (racers.CourseFile, error) {
+// 	               panic("mock out the CourseFile method")
+//             },
 //             ExistsFunc: func(ctx context.Context, race racers.Race) (bool, error) {
 // 	               panic("mock out the Exists method")
 //             },
 //             GetFunc: func(ctx context.Context, id racers.RaceID) (racers.Race, error) {
 // 	               panic("mock out the Get method")
 //             },
+//             NearFunc: func(ctx context.Context, lat float64, lon float64, radius float64) ([]racers.Race, error) {
+// 	               panic("mock out the Near method")
+//             },
+//             OwnerFunc: func(ctx context.Context, id racers.RaceID) (racers.UserID, error) {
+// 	               panic("mock out the Owner method")
+//             },
+//             ResultSheetFunc: func(ctx context.Context, id racers.RaceID, fn func(service.ResultSheetEntry) error) error {
+// 	               panic("mock out the ResultSheet method")
+//             },
 //             SaveFunc: func(ctx context.Context, race racers.Race) error {
 // 	               panic("mock out the Save method")
 //             },
+//             SaveCourseFileFunc: func(ctx context.Context, id racers.RaceID, category racers.CategoryName, file racers.CourseFile) error {
+// 	               panic("mock out the SaveCourseFile method")
+//             },
+//             SearchFunc: func(ctx context.Context, search service.RaceSearch) ([]service.RaceMatch, error) {
+// 	               panic("mock out the Search method")
+//             },
+//             StartListFunc: func(ctx context.Context, id racers.RaceID, fn func(service.StartListEntry) error) error {
+// 	               panic("mock out the StartList method")
+//             },
+//             UnfinishedFunc: func(ctx context.Context, before time.Time) ([]racers.RaceID, error) {
+// 	               panic("mock out the Unfinished method")
+//             },
+//             UnremindedFunc: func(ctx context.Context, from time.Time, to time.Time) ([]racers.RaceID, error) {
+// 	               panic("mock out the Unreminded method")
+//             },
+//             UpcomingFunc: func(ctx context.Context, from time.Time) ([]racers.Race, error) {
+// 	               panic("mock out the Upcoming method")
+//             },
+//             WithExpiredRegistrationsFunc: func(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+// 	               panic("mock out the WithExpiredRegistrations method")
+//             },
+//             WithPendingRefundsFunc: func(ctx context.Context) ([]racers.RaceID, error) {
+// 	               panic("mock out the WithPendingRefunds method")
+//             },
+//             WithRegistrationDeadlineFunc: func(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+// 	               panic("mock out the WithRegistrationDeadline method")
+//             },
 //         }
 //
 //         // use mockedRacesRepository in code that requires service.RacesRepository
@@ -42,15 +92,63 @@ type RacesRepositoryMock struct {
 	// AllFunc mocks the All method.
 	AllFunc func(ctx context.Context) ([]racers.Race, error)
 
+	// ByCompetitorFunc mocks the ByCompetitor method.
+	ByCompetitorFunc func(ctx context.Context, id racers.UserID) ([]racers.Race, error)
+
+	// ByOrganizationFunc mocks the ByOrganization method.
+	ByOrganizationFunc func(ctx context.Context, id racers.OrganizationID) ([]racers.Race, error)
+
+	// CancelledWithCompetitorsFunc mocks the CancelledWithCompetitors method.
+	CancelledWithCompetitorsFunc func(ctx context.Context) ([]racers.RaceID, error)
+
+	// CourseFileFunc mocks the CourseFile method.
+	CourseFileFunc func(ctx context.Context, id racers.RaceID, category racers.CategoryName) (racers.CourseFile, error)
+
 	// ExistsFunc mocks the Exists method.
 	ExistsFunc func(ctx context.Context, race racers.Race) (bool, error)
 
 	// GetFunc mocks the Get method.
 	GetFunc func(ctx context.Context, id racers.RaceID) (racers.Race, error)
 
+	// NearFunc mocks the Near method.
+	NearFunc func(ctx context.Context, lat float64, lon float64, radius float64) ([]racers.Race, error)
+
+	// OwnerFunc mocks the Owner method.
+	OwnerFunc func(ctx context.Context, id racers.RaceID) (racers.UserID, error)
+
+	// ResultSheetFunc mocks the ResultSheet method.
+	ResultSheetFunc func(ctx context.Context, id racers.RaceID, fn func(service.ResultSheetEntry) error) error
+
 	// SaveFunc mocks the Save method.
 	SaveFunc func(ctx context.Context, race racers.Race) error
 
+	// SaveCourseFileFunc mocks the SaveCourseFile method.
+	SaveCourseFileFunc func(ctx context.Context, id racers.RaceID, category racers.CategoryName, file racers.CourseFile) error
+
+	// SearchFunc mocks the Search method.
+	SearchFunc func(ctx context.Context, search service.RaceSearch) ([]service.RaceMatch, error)
+
+	// StartListFunc mocks the StartList method.
+	StartListFunc func(ctx context.Context, id racers.RaceID, fn func(service.StartListEntry) error) error
+
+	// UnfinishedFunc mocks the Unfinished method.
+	UnfinishedFunc func(ctx context.Context, before time.Time) ([]racers.RaceID, error)
+
+	// UnremindedFunc mocks the Unreminded method.
+	UnremindedFunc func(ctx context.Context, from time.Time, to time.Time) ([]racers.RaceID, error)
+
+	// UpcomingFunc mocks the Upcoming method.
+	UpcomingFunc func(ctx context.Context, from time.Time) ([]racers.Race, error)
+
+	// WithExpiredRegistrationsFunc mocks the WithExpiredRegistrations method.
+	WithExpiredRegistrationsFunc func(ctx context.Context, now time.Time) ([]racers.RaceID, error)
+
+	// WithPendingRefundsFunc mocks the WithPendingRefunds method.
+	WithPendingRefundsFunc func(ctx context.Context) ([]racers.RaceID, error)
+
+	// WithRegistrationDeadlineFunc mocks the WithRegistrationDeadline method.
+	WithRegistrationDeadlineFunc func(ctx context.Context, now time.Time) ([]racers.RaceID, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// All holds details about calls to the All method.
@@ -58,6 +156,34 @@ type RacesRepositoryMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// ByCompetitor holds details about calls to the ByCompetitor method.
+		ByCompetitor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.UserID
+		}
+		// ByOrganization holds details about calls to the ByOrganization method.
+		ByOrganization []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.OrganizationID
+		}
+		// CancelledWithCompetitors holds details about calls to the CancelledWithCompetitors method.
+		CancelledWithCompetitors []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// CourseFile holds details about calls to the CourseFile method.
+		CourseFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.RaceID
+			// Category is the category argument value.
+			Category racers.CategoryName
+		}
 		// Exists holds details about calls to the Exists method.
 		Exists []struct {
 			// Ctx is the ctx argument value.
@@ -72,6 +198,33 @@ type RacesRepositoryMock struct {
 			// ID is the id argument value.
 			ID racers.RaceID
 		}
+		// Near holds details about calls to the Near method.
+		Near []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Lat is the lat argument value.
+			Lat float64
+			// Lon is the lon argument value.
+			Lon float64
+			// Radius is the radius argument value.
+			Radius float64
+		}
+		// Owner holds details about calls to the Owner method.
+		Owner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.RaceID
+		}
+		// ResultSheet holds details about calls to the ResultSheet method.
+		ResultSheet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.RaceID
+			// Fn is the fn argument value.
+			Fn func(service.ResultSheetEntry) error
+		}
 		// Save holds details about calls to the Save method.
 		Save []struct {
 			// Ctx is the ctx argument value.
@@ -79,11 +232,96 @@ type RacesRepositoryMock struct {
 			// Race is the race argument value.
 			Race racers.Race
 		}
+		// SaveCourseFile holds details about calls to the SaveCourseFile method.
+		SaveCourseFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.RaceID
+			// Category is the category argument value.
+			Category racers.CategoryName
+			// File is the file argument value.
+			File racers.CourseFile
+		}
+		// Search holds details about calls to the Search method.
+		Search []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Search is the search argument value.
+			Search service.RaceSearch
+		}
+		// StartList holds details about calls to the StartList method.
+		StartList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.RaceID
+			// Fn is the fn argument value.
+			Fn func(service.StartListEntry) error
+		}
+		// Unfinished holds details about calls to the Unfinished method.
+		Unfinished []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// Unreminded holds details about calls to the Unreminded method.
+		Unreminded []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// Upcoming holds details about calls to the Upcoming method.
+		Upcoming []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+		}
+		// WithExpiredRegistrations holds details about calls to the WithExpiredRegistrations method.
+		WithExpiredRegistrations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// WithPendingRefunds holds details about calls to the WithPendingRefunds method.
+		WithPendingRefunds []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// WithRegistrationDeadline holds details about calls to the WithRegistrationDeadline method.
+		WithRegistrationDeadline []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+		}
 	}
-	lockAll    sync.RWMutex
-	lockExists sync.RWMutex
-	lockGet    sync.RWMutex
-	lockSave   sync.RWMutex
+	lockAll                      sync.RWMutex
+	lockByCompetitor             sync.RWMutex
+	lockByOrganization           sync.RWMutex
+	lockCancelledWithCompetitors sync.RWMutex
+	lockCourseFile               sync.RWMutex
+	lockExists                   sync.RWMutex
+	lockGet                      sync.RWMutex
+	lockNear                     sync.RWMutex
+	lockOwner                    sync.RWMutex
+	lockResultSheet              sync.RWMutex
+	lockSave                     sync.RWMutex
+	lockSaveCourseFile           sync.RWMutex
+	lockSearch                   sync.RWMutex
+	lockStartList                sync.RWMutex
+	lockUnfinished               sync.RWMutex
+	lockUnreminded               sync.RWMutex
+	lockUpcoming                 sync.RWMutex
+	lockWithExpiredRegistrations sync.RWMutex
+	lockWithPendingRefunds       sync.RWMutex
+	lockWithRegistrationDeadline sync.RWMutex
 }
 
 // All calls AllFunc.
@@ -121,6 +359,162 @@ func (mock *RacesRepositoryMock) AllCalls() []struct {
 	return calls
 }
 
+// ByCompetitor calls ByCompetitorFunc.
+func (mock *RacesRepositoryMock) ByCompetitor(ctx context.Context, id racers.UserID) ([]racers.Race, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.UserID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockByCompetitor.Lock()
+	mock.calls.ByCompetitor = append(mock.calls.ByCompetitor, callInfo)
+	mock.lockByCompetitor.Unlock()
+	if mock.ByCompetitorFunc == nil {
+		var (
+			out1 []racers.Race
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ByCompetitorFunc(ctx, id)
+}
+
+// ByCompetitorCalls gets all the calls that were made to ByCompetitor.
+// Check the length with:
+//     len(mockedRacesRepository.ByCompetitorCalls())
+func (mock *RacesRepositoryMock) ByCompetitorCalls() []struct {
+	Ctx context.Context
+	ID  racers.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.UserID
+	}
+	mock.lockByCompetitor.RLock()
+	calls = mock.calls.ByCompetitor
+	mock.lockByCompetitor.RUnlock()
+	return calls
+}
+
+// ByOrganization calls ByOrganizationFunc.
+func (mock *RacesRepositoryMock) ByOrganization(ctx context.Context, id racers.OrganizationID) ([]racers.Race, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockByOrganization.Lock()
+	mock.calls.ByOrganization = append(mock.calls.ByOrganization, callInfo)
+	mock.lockByOrganization.Unlock()
+	if mock.ByOrganizationFunc == nil {
+		var (
+			out1 []racers.Race
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ByOrganizationFunc(ctx, id)
+}
+
+// ByOrganizationCalls gets all the calls that were made to ByOrganization.
+// Check the length with:
+//     len(mockedRacesRepository.ByOrganizationCalls())
+func (mock *RacesRepositoryMock) ByOrganizationCalls() []struct {
+	Ctx context.Context
+	ID  racers.OrganizationID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}
+	mock.lockByOrganization.RLock()
+	calls = mock.calls.ByOrganization
+	mock.lockByOrganization.RUnlock()
+	return calls
+}
+
+// CancelledWithCompetitors calls CancelledWithCompetitorsFunc.
+func (mock *RacesRepositoryMock) CancelledWithCompetitors(ctx context.Context) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCancelledWithCompetitors.Lock()
+	mock.calls.CancelledWithCompetitors = append(mock.calls.CancelledWithCompetitors, callInfo)
+	mock.lockCancelledWithCompetitors.Unlock()
+	if mock.CancelledWithCompetitorsFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.CancelledWithCompetitorsFunc(ctx)
+}
+
+// CancelledWithCompetitorsCalls gets all the calls that were made to CancelledWithCompetitors.
+// Check the length with:
+//     len(mockedRacesRepository.CancelledWithCompetitorsCalls())
+func (mock *RacesRepositoryMock) CancelledWithCompetitorsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCancelledWithCompetitors.RLock()
+	calls = mock.calls.CancelledWithCompetitors
+	mock.lockCancelledWithCompetitors.RUnlock()
+	return calls
+}
+
+// CourseFile calls CourseFileFunc.
+func (mock *RacesRepositoryMock) CourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName) (racers.CourseFile, error) {
+	callInfo := struct {
+		Ctx      context.Context
+		ID       racers.RaceID
+		Category racers.CategoryName
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Category: category,
+	}
+	mock.lockCourseFile.Lock()
+	mock.calls.CourseFile = append(mock.calls.CourseFile, callInfo)
+	mock.lockCourseFile.Unlock()
+	if mock.CourseFileFunc == nil {
+		var (
+			out1 racers.CourseFile
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.CourseFileFunc(ctx, id, category)
+}
+
+// CourseFileCalls gets all the calls that were made to CourseFile.
+// Check the length with:
+//     len(mockedRacesRepository.CourseFileCalls())
+func (mock *RacesRepositoryMock) CourseFileCalls() []struct {
+	Ctx      context.Context
+	ID       racers.RaceID
+	Category racers.CategoryName
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       racers.RaceID
+		Category racers.CategoryName
+	}
+	mock.lockCourseFile.RLock()
+	calls = mock.calls.CourseFile
+	mock.lockCourseFile.RUnlock()
+	return calls
+}
+
 // Exists calls ExistsFunc.
 func (mock *RacesRepositoryMock) Exists(ctx context.Context, race racers.Race) (bool, error) {
 	callInfo := struct {
@@ -199,6 +593,134 @@ func (mock *RacesRepositoryMock) GetCalls() []struct {
 	return calls
 }
 
+// Near calls NearFunc.
+func (mock *RacesRepositoryMock) Near(ctx context.Context, lat float64, lon float64, radius float64) ([]racers.Race, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Lat    float64
+		Lon    float64
+		Radius float64
+	}{
+		Ctx:    ctx,
+		Lat:    lat,
+		Lon:    lon,
+		Radius: radius,
+	}
+	mock.lockNear.Lock()
+	mock.calls.Near = append(mock.calls.Near, callInfo)
+	mock.lockNear.Unlock()
+	if mock.NearFunc == nil {
+		var (
+			out1 []racers.Race
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.NearFunc(ctx, lat, lon, radius)
+}
+
+// NearCalls gets all the calls that were made to Near.
+// Check the length with:
+//     len(mockedRacesRepository.NearCalls())
+func (mock *RacesRepositoryMock) NearCalls() []struct {
+	Ctx    context.Context
+	Lat    float64
+	Lon    float64
+	Radius float64
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Lat    float64
+		Lon    float64
+		Radius float64
+	}
+	mock.lockNear.RLock()
+	calls = mock.calls.Near
+	mock.lockNear.RUnlock()
+	return calls
+}
+
+// Owner calls OwnerFunc.
+func (mock *RacesRepositoryMock) Owner(ctx context.Context, id racers.RaceID) (racers.UserID, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.RaceID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockOwner.Lock()
+	mock.calls.Owner = append(mock.calls.Owner, callInfo)
+	mock.lockOwner.Unlock()
+	if mock.OwnerFunc == nil {
+		var (
+			out1 racers.UserID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.OwnerFunc(ctx, id)
+}
+
+// OwnerCalls gets all the calls that were made to Owner.
+// Check the length with:
+//     len(mockedRacesRepository.OwnerCalls())
+func (mock *RacesRepositoryMock) OwnerCalls() []struct {
+	Ctx context.Context
+	ID  racers.RaceID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.RaceID
+	}
+	mock.lockOwner.RLock()
+	calls = mock.calls.Owner
+	mock.lockOwner.RUnlock()
+	return calls
+}
+
+// ResultSheet calls ResultSheetFunc.
+func (mock *RacesRepositoryMock) ResultSheet(ctx context.Context, id racers.RaceID, fn func(service.ResultSheetEntry) error) error {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.RaceID
+		Fn  func(service.ResultSheetEntry) error
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Fn:  fn,
+	}
+	mock.lockResultSheet.Lock()
+	mock.calls.ResultSheet = append(mock.calls.ResultSheet, callInfo)
+	mock.lockResultSheet.Unlock()
+	if mock.ResultSheetFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.ResultSheetFunc(ctx, id, fn)
+}
+
+// ResultSheetCalls gets all the calls that were made to ResultSheet.
+// Check the length with:
+//     len(mockedRacesRepository.ResultSheetCalls())
+func (mock *RacesRepositoryMock) ResultSheetCalls() []struct {
+	Ctx context.Context
+	ID  racers.RaceID
+	Fn  func(service.ResultSheetEntry) error
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.RaceID
+		Fn  func(service.ResultSheetEntry) error
+	}
+	mock.lockResultSheet.RLock()
+	calls = mock.calls.ResultSheet
+	mock.lockResultSheet.RUnlock()
+	return calls
+}
+
 // Save calls SaveFunc.
 func (mock *RacesRepositoryMock) Save(ctx context.Context, race racers.Race) error {
 	callInfo := struct {
@@ -237,11 +759,372 @@ func (mock *RacesRepositoryMock) SaveCalls() []struct {
 	return calls
 }
 
-// Ensure, that TeamsRepositoryMock does implement service.TeamsRepository.
-// If this is not the case, regenerate this file with moq.
-var _ service.TeamsRepository = &TeamsRepositoryMock{}
-
-// TeamsRepositoryMock is a mock implementation of service.TeamsRepository.
+// SaveCourseFile calls SaveCourseFileFunc.
+func (mock *RacesRepositoryMock) SaveCourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName, file racers.CourseFile) error {
+	callInfo := struct {
+		Ctx      context.Context
+		ID       racers.RaceID
+		Category racers.CategoryName
+		File     racers.CourseFile
+	}{
+		Ctx:      ctx,
+		ID:       id,
+		Category: category,
+		File:     file,
+	}
+	mock.lockSaveCourseFile.Lock()
+	mock.calls.SaveCourseFile = append(mock.calls.SaveCourseFile, callInfo)
+	mock.lockSaveCourseFile.Unlock()
+	if mock.SaveCourseFileFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveCourseFileFunc(ctx, id, category, file)
+}
+
+// SaveCourseFileCalls gets all the calls that were made to SaveCourseFile.
+// Check the length with:
+//     len(mockedRacesRepository.SaveCourseFileCalls())
+func (mock *RacesRepositoryMock) SaveCourseFileCalls() []struct {
+	Ctx      context.Context
+	ID       racers.RaceID
+	Category racers.CategoryName
+	File     racers.CourseFile
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ID       racers.RaceID
+		Category racers.CategoryName
+		File     racers.CourseFile
+	}
+	mock.lockSaveCourseFile.RLock()
+	calls = mock.calls.SaveCourseFile
+	mock.lockSaveCourseFile.RUnlock()
+	return calls
+}
+
+// Search calls SearchFunc.
+func (mock *RacesRepositoryMock) Search(ctx context.Context, search service.RaceSearch) ([]service.RaceMatch, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Search service.RaceSearch
+	}{
+		Ctx:    ctx,
+		Search: search,
+	}
+	mock.lockSearch.Lock()
+	mock.calls.Search = append(mock.calls.Search, callInfo)
+	mock.lockSearch.Unlock()
+	if mock.SearchFunc == nil {
+		var (
+			out1 []service.RaceMatch
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.SearchFunc(ctx, search)
+}
+
+// SearchCalls gets all the calls that were made to Search.
+// Check the length with:
+//     len(mockedRacesRepository.SearchCalls())
+func (mock *RacesRepositoryMock) SearchCalls() []struct {
+	Ctx    context.Context
+	Search service.RaceSearch
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Search service.RaceSearch
+	}
+	mock.lockSearch.RLock()
+	calls = mock.calls.Search
+	mock.lockSearch.RUnlock()
+	return calls
+}
+
+// StartList calls StartListFunc.
+func (mock *RacesRepositoryMock) StartList(ctx context.Context, id racers.RaceID, fn func(service.StartListEntry) error) error {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.RaceID
+		Fn  func(service.StartListEntry) error
+	}{
+		Ctx: ctx,
+		ID:  id,
+		Fn:  fn,
+	}
+	mock.lockStartList.Lock()
+	mock.calls.StartList = append(mock.calls.StartList, callInfo)
+	mock.lockStartList.Unlock()
+	if mock.StartListFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.StartListFunc(ctx, id, fn)
+}
+
+// StartListCalls gets all the calls that were made to StartList.
+// Check the length with:
+//     len(mockedRacesRepository.StartListCalls())
+func (mock *RacesRepositoryMock) StartListCalls() []struct {
+	Ctx context.Context
+	ID  racers.RaceID
+	Fn  func(service.StartListEntry) error
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.RaceID
+		Fn  func(service.StartListEntry) error
+	}
+	mock.lockStartList.RLock()
+	calls = mock.calls.StartList
+	mock.lockStartList.RUnlock()
+	return calls
+}
+
+// Unfinished calls UnfinishedFunc.
+func (mock *RacesRepositoryMock) Unfinished(ctx context.Context, before time.Time) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockUnfinished.Lock()
+	mock.calls.Unfinished = append(mock.calls.Unfinished, callInfo)
+	mock.lockUnfinished.Unlock()
+	if mock.UnfinishedFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.UnfinishedFunc(ctx, before)
+}
+
+// UnfinishedCalls gets all the calls that were made to Unfinished.
+// Check the length with:
+//     len(mockedRacesRepository.UnfinishedCalls())
+func (mock *RacesRepositoryMock) UnfinishedCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockUnfinished.RLock()
+	calls = mock.calls.Unfinished
+	mock.lockUnfinished.RUnlock()
+	return calls
+}
+
+// Unreminded calls UnremindedFunc.
+func (mock *RacesRepositoryMock) Unreminded(ctx context.Context, from time.Time, to time.Time) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+		To:   to,
+	}
+	mock.lockUnreminded.Lock()
+	mock.calls.Unreminded = append(mock.calls.Unreminded, callInfo)
+	mock.lockUnreminded.Unlock()
+	if mock.UnremindedFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.UnremindedFunc(ctx, from, to)
+}
+
+// UnremindedCalls gets all the calls that were made to Unreminded.
+// Check the length with:
+//     len(mockedRacesRepository.UnremindedCalls())
+func (mock *RacesRepositoryMock) UnremindedCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+		To   time.Time
+	}
+	mock.lockUnreminded.RLock()
+	calls = mock.calls.Unreminded
+	mock.lockUnreminded.RUnlock()
+	return calls
+}
+
+// Upcoming calls UpcomingFunc.
+func (mock *RacesRepositoryMock) Upcoming(ctx context.Context, from time.Time) ([]racers.Race, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		From time.Time
+	}{
+		Ctx:  ctx,
+		From: from,
+	}
+	mock.lockUpcoming.Lock()
+	mock.calls.Upcoming = append(mock.calls.Upcoming, callInfo)
+	mock.lockUpcoming.Unlock()
+	if mock.UpcomingFunc == nil {
+		var (
+			out1 []racers.Race
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.UpcomingFunc(ctx, from)
+}
+
+// UpcomingCalls gets all the calls that were made to Upcoming.
+// Check the length with:
+//     len(mockedRacesRepository.UpcomingCalls())
+func (mock *RacesRepositoryMock) UpcomingCalls() []struct {
+	Ctx  context.Context
+	From time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		From time.Time
+	}
+	mock.lockUpcoming.RLock()
+	calls = mock.calls.Upcoming
+	mock.lockUpcoming.RUnlock()
+	return calls
+}
+
+// WithExpiredRegistrations calls WithExpiredRegistrationsFunc.
+func (mock *RacesRepositoryMock) WithExpiredRegistrations(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx context.Context
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Now: now,
+	}
+	mock.lockWithExpiredRegistrations.Lock()
+	mock.calls.WithExpiredRegistrations = append(mock.calls.WithExpiredRegistrations, callInfo)
+	mock.lockWithExpiredRegistrations.Unlock()
+	if mock.WithExpiredRegistrationsFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.WithExpiredRegistrationsFunc(ctx, now)
+}
+
+// WithExpiredRegistrationsCalls gets all the calls that were made to WithExpiredRegistrations.
+// Check the length with:
+//     len(mockedRacesRepository.WithExpiredRegistrationsCalls())
+func (mock *RacesRepositoryMock) WithExpiredRegistrationsCalls() []struct {
+	Ctx context.Context
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Now time.Time
+	}
+	mock.lockWithExpiredRegistrations.RLock()
+	calls = mock.calls.WithExpiredRegistrations
+	mock.lockWithExpiredRegistrations.RUnlock()
+	return calls
+}
+
+// WithPendingRefunds calls WithPendingRefundsFunc.
+func (mock *RacesRepositoryMock) WithPendingRefunds(ctx context.Context) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockWithPendingRefunds.Lock()
+	mock.calls.WithPendingRefunds = append(mock.calls.WithPendingRefunds, callInfo)
+	mock.lockWithPendingRefunds.Unlock()
+	if mock.WithPendingRefundsFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.WithPendingRefundsFunc(ctx)
+}
+
+// WithPendingRefundsCalls gets all the calls that were made to WithPendingRefunds.
+// Check the length with:
+//     len(mockedRacesRepository.WithPendingRefundsCalls())
+func (mock *RacesRepositoryMock) WithPendingRefundsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockWithPendingRefunds.RLock()
+	calls = mock.calls.WithPendingRefunds
+	mock.lockWithPendingRefunds.RUnlock()
+	return calls
+}
+
+// WithRegistrationDeadline calls WithRegistrationDeadlineFunc.
+func (mock *RacesRepositoryMock) WithRegistrationDeadline(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+	callInfo := struct {
+		Ctx context.Context
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Now: now,
+	}
+	mock.lockWithRegistrationDeadline.Lock()
+	mock.calls.WithRegistrationDeadline = append(mock.calls.WithRegistrationDeadline, callInfo)
+	mock.lockWithRegistrationDeadline.Unlock()
+	if mock.WithRegistrationDeadlineFunc == nil {
+		var (
+			out1 []racers.RaceID
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.WithRegistrationDeadlineFunc(ctx, now)
+}
+
+// WithRegistrationDeadlineCalls gets all the calls that were made to WithRegistrationDeadline.
+// Check the length with:
+//     len(mockedRacesRepository.WithRegistrationDeadlineCalls())
+func (mock *RacesRepositoryMock) WithRegistrationDeadlineCalls() []struct {
+	Ctx context.Context
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Now time.Time
+	}
+	mock.lockWithRegistrationDeadline.RLock()
+	calls = mock.calls.WithRegistrationDeadline
+	mock.lockWithRegistrationDeadline.RUnlock()
+	return calls
+}
+
+// Ensure, that TeamsRepositoryMock does implement service.TeamsRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.TeamsRepository = &TeamsRepositoryMock{}
+
+// TeamsRepositoryMock is a mock implementation of service.TeamsRepository.
 //
 //     func TestSomethingThatUsesTeamsRepository(t *testing.T) {
 //
@@ -433,6 +1316,9 @@ var _ service.UsersGetter = &UsersGetterMock{}
 //             GetFunc: func(ctx context.Context, id racers.UserID) (racers.User, error) {
 // 	               panic("mock out the Get method")
 //             },
+//             ListFunc: func(ctx context.Context, ids []racers.UserID) ([]racers.User, error) {
+// 	               panic("mock out the List method")
+//             },
 //         }
 //
 //         // use mockedUsersGetter in code that requires service.UsersGetter
@@ -446,6 +1332,9 @@ type UsersGetterMock struct {
 	// GetFunc mocks the Get method.
 	GetFunc func(ctx context.Context, id racers.UserID) (racers.User, error)
 
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, ids []racers.UserID) ([]racers.User, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Current holds details about calls to the Current method.
@@ -460,9 +1349,17 @@ type UsersGetterMock struct {
 			// ID is the id argument value.
 			ID racers.UserID
 		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []racers.UserID
+		}
 	}
 	lockCurrent sync.RWMutex
 	lockGet     sync.RWMutex
+	lockList    sync.RWMutex
 }
 
 // Current calls CurrentFunc.
@@ -537,3 +1434,1499 @@ func (mock *UsersGetterMock) GetCalls() []struct {
 	mock.lockGet.RUnlock()
 	return calls
 }
+
+// List calls ListFunc.
+func (mock *UsersGetterMock) List(ctx context.Context, ids []racers.UserID) ([]racers.User, error) {
+	callInfo := struct {
+		Ctx context.Context
+		Ids []racers.UserID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	if mock.ListFunc == nil {
+		var (
+			out1 []racers.User
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ListFunc(ctx, ids)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//     len(mockedUsersGetter.ListCalls())
+func (mock *UsersGetterMock) ListCalls() []struct {
+	Ctx context.Context
+	Ids []racers.UserID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []racers.UserID
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Ensure, that OrganizationsRepositoryMock does implement service.OrganizationsRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.OrganizationsRepository = &OrganizationsRepositoryMock{}
+
+// OrganizationsRepositoryMock is a mock implementation of service.OrganizationsRepository.
+//
+//     func TestSomethingThatUsesOrganizationsRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.OrganizationsRepository
+//         mockedOrganizationsRepository := &OrganizationsRepositoryMock{
+//             ExistsFunc: func(ctx context.Context, id racers.OrganizationID) (bool, error) {
+// 	               panic("mock out the Exists method")
+//             },
+//             GetFunc: func(ctx context.Context, id racers.OrganizationID) (racers.Organization, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             SaveFunc: func(ctx context.Context, org racers.Organization) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedOrganizationsRepository in code that requires service.OrganizationsRepository
+//         // and then make assertions.
+//
+//     }
+type OrganizationsRepositoryMock struct {
+	// ExistsFunc mocks the Exists method.
+	ExistsFunc func(ctx context.Context, id racers.OrganizationID) (bool, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id racers.OrganizationID) (racers.Organization, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, org racers.Organization) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Exists holds details about calls to the Exists method.
+		Exists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.OrganizationID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.OrganizationID
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Org is the org argument value.
+			Org racers.Organization
+		}
+	}
+	lockExists sync.RWMutex
+	lockGet    sync.RWMutex
+	lockSave   sync.RWMutex
+}
+
+// Exists calls ExistsFunc.
+func (mock *OrganizationsRepositoryMock) Exists(ctx context.Context, id racers.OrganizationID) (bool, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockExists.Lock()
+	mock.calls.Exists = append(mock.calls.Exists, callInfo)
+	mock.lockExists.Unlock()
+	if mock.ExistsFunc == nil {
+		var (
+			out1 bool
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ExistsFunc(ctx, id)
+}
+
+// ExistsCalls gets all the calls that were made to Exists.
+// Check the length with:
+//     len(mockedOrganizationsRepository.ExistsCalls())
+func (mock *OrganizationsRepositoryMock) ExistsCalls() []struct {
+	Ctx context.Context
+	ID  racers.OrganizationID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}
+	mock.lockExists.RLock()
+	calls = mock.calls.Exists
+	mock.lockExists.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *OrganizationsRepositoryMock) Get(ctx context.Context, id racers.OrganizationID) (racers.Organization, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 racers.Organization
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedOrganizationsRepository.GetCalls())
+func (mock *OrganizationsRepositoryMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  racers.OrganizationID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.OrganizationID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *OrganizationsRepositoryMock) Save(ctx context.Context, org racers.Organization) error {
+	callInfo := struct {
+		Ctx context.Context
+		Org racers.Organization
+	}{
+		Ctx: ctx,
+		Org: org,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, org)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedOrganizationsRepository.SaveCalls())
+func (mock *OrganizationsRepositoryMock) SaveCalls() []struct {
+	Ctx context.Context
+	Org racers.Organization
+} {
+	var calls []struct {
+		Ctx context.Context
+		Org racers.Organization
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that CalendarTokensRepositoryMock does implement service.CalendarTokensRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.CalendarTokensRepository = &CalendarTokensRepositoryMock{}
+
+// CalendarTokensRepositoryMock is a mock implementation of service.CalendarTokensRepository.
+//
+//     func TestSomethingThatUsesCalendarTokensRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.CalendarTokensRepository
+//         mockedCalendarTokensRepository := &CalendarTokensRepositoryMock{
+//             DeleteFunc: func(ctx context.Context, user racers.UserID) error {
+// 	               panic("mock out the Delete method")
+//             },
+//             GetFunc: func(ctx context.Context, secretHash string) (service.CalendarToken, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             SaveFunc: func(ctx context.Context, token service.CalendarToken) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedCalendarTokensRepository in code that requires service.CalendarTokensRepository
+//         // and then make assertions.
+//
+//     }
+type CalendarTokensRepositoryMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, user racers.UserID) error
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, secretHash string) (service.CalendarToken, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, token service.CalendarToken) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User racers.UserID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SecretHash is the secretHash argument value.
+			SecretHash string
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token service.CalendarToken
+		}
+	}
+	lockDelete sync.RWMutex
+	lockGet    sync.RWMutex
+	lockSave   sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *CalendarTokensRepositoryMock) Delete(ctx context.Context, user racers.UserID) error {
+	callInfo := struct {
+		Ctx  context.Context
+		User racers.UserID
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	if mock.DeleteFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.DeleteFunc(ctx, user)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//     len(mockedCalendarTokensRepository.DeleteCalls())
+func (mock *CalendarTokensRepositoryMock) DeleteCalls() []struct {
+	Ctx  context.Context
+	User racers.UserID
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User racers.UserID
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *CalendarTokensRepositoryMock) Get(ctx context.Context, secretHash string) (service.CalendarToken, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		SecretHash string
+	}{
+		Ctx:        ctx,
+		SecretHash: secretHash,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 service.CalendarToken
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, secretHash)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedCalendarTokensRepository.GetCalls())
+func (mock *CalendarTokensRepositoryMock) GetCalls() []struct {
+	Ctx        context.Context
+	SecretHash string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SecretHash string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *CalendarTokensRepositoryMock) Save(ctx context.Context, token service.CalendarToken) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Token service.CalendarToken
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, token)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedCalendarTokensRepository.SaveCalls())
+func (mock *CalendarTokensRepositoryMock) SaveCalls() []struct {
+	Ctx   context.Context
+	Token service.CalendarToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token service.CalendarToken
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that SeriesRepositoryMock does implement service.SeriesRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.SeriesRepository = &SeriesRepositoryMock{}
+
+// SeriesRepositoryMock is a mock implementation of service.SeriesRepository.
+//
+//     func TestSomethingThatUsesSeriesRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.SeriesRepository
+//         mockedSeriesRepository := &SeriesRepositoryMock{
+//             ExistsFunc: func(ctx context.Context, id racers.SeriesID) (bool, error) {
+// 	               panic("mock out the Exists method")
+//             },
+//             GetFunc: func(ctx context.Context, id racers.SeriesID) (racers.Series, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             SaveFunc: func(ctx context.Context, series racers.Series) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedSeriesRepository in code that requires service.SeriesRepository
+//         // and then make assertions.
+//
+//     }
+type SeriesRepositoryMock struct {
+	// ExistsFunc mocks the Exists method.
+	ExistsFunc func(ctx context.Context, id racers.SeriesID) (bool, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id racers.SeriesID) (racers.Series, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, series racers.Series) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Exists holds details about calls to the Exists method.
+		Exists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.SeriesID
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID racers.SeriesID
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Series is the series argument value.
+			Series racers.Series
+		}
+	}
+	lockExists sync.RWMutex
+	lockGet    sync.RWMutex
+	lockSave   sync.RWMutex
+}
+
+// Exists calls ExistsFunc.
+func (mock *SeriesRepositoryMock) Exists(ctx context.Context, id racers.SeriesID) (bool, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.SeriesID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockExists.Lock()
+	mock.calls.Exists = append(mock.calls.Exists, callInfo)
+	mock.lockExists.Unlock()
+	if mock.ExistsFunc == nil {
+		var (
+			out1 bool
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ExistsFunc(ctx, id)
+}
+
+// ExistsCalls gets all the calls that were made to Exists.
+// Check the length with:
+//     len(mockedSeriesRepository.ExistsCalls())
+func (mock *SeriesRepositoryMock) ExistsCalls() []struct {
+	Ctx context.Context
+	ID  racers.SeriesID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.SeriesID
+	}
+	mock.lockExists.RLock()
+	calls = mock.calls.Exists
+	mock.lockExists.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *SeriesRepositoryMock) Get(ctx context.Context, id racers.SeriesID) (racers.Series, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  racers.SeriesID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 racers.Series
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedSeriesRepository.GetCalls())
+func (mock *SeriesRepositoryMock) GetCalls() []struct {
+	Ctx context.Context
+	ID  racers.SeriesID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  racers.SeriesID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *SeriesRepositoryMock) Save(ctx context.Context, series racers.Series) error {
+	callInfo := struct {
+		Ctx    context.Context
+		Series racers.Series
+	}{
+		Ctx:    ctx,
+		Series: series,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, series)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedSeriesRepository.SaveCalls())
+func (mock *SeriesRepositoryMock) SaveCalls() []struct {
+	Ctx    context.Context
+	Series racers.Series
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Series racers.Series
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that DiscountCodesRepositoryMock does implement service.DiscountCodesRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.DiscountCodesRepository = &DiscountCodesRepositoryMock{}
+
+// DiscountCodesRepositoryMock is a mock implementation of service.DiscountCodesRepository.
+//
+//     func TestSomethingThatUsesDiscountCodesRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.DiscountCodesRepository
+//         mockedDiscountCodesRepository := &DiscountCodesRepositoryMock{
+//             ExistsFunc: func(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+// 	               panic("mock out the Exists method")
+//             },
+//             GetFunc: func(ctx context.Context, race racers.RaceID, code string) (racers.DiscountCode, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             ListFunc: func(ctx context.Context, race racers.RaceID) ([]racers.DiscountCode, error) {
+// 	               panic("mock out the List method")
+//             },
+//             RedeemFunc: func(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+// 	               panic("mock out the Redeem method")
+//             },
+//             SaveFunc: func(ctx context.Context, code racers.DiscountCode) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedDiscountCodesRepository in code that requires service.DiscountCodesRepository
+//         // and then make assertions.
+//
+//     }
+type DiscountCodesRepositoryMock struct {
+	// ExistsFunc mocks the Exists method.
+	ExistsFunc func(ctx context.Context, race racers.RaceID, code string) (bool, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, race racers.RaceID, code string) (racers.DiscountCode, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, race racers.RaceID) ([]racers.DiscountCode, error)
+
+	// RedeemFunc mocks the Redeem method.
+	RedeemFunc func(ctx context.Context, race racers.RaceID, code string) (bool, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, code racers.DiscountCode) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Exists holds details about calls to the Exists method.
+		Exists []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Race is the race argument value.
+			Race racers.RaceID
+			// Code is the code argument value.
+			Code string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Race is the race argument value.
+			Race racers.RaceID
+			// Code is the code argument value.
+			Code string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Race is the race argument value.
+			Race racers.RaceID
+		}
+		// Redeem holds details about calls to the Redeem method.
+		Redeem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Race is the race argument value.
+			Race racers.RaceID
+			// Code is the code argument value.
+			Code string
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code racers.DiscountCode
+		}
+	}
+	lockExists sync.RWMutex
+	lockGet    sync.RWMutex
+	lockList   sync.RWMutex
+	lockRedeem sync.RWMutex
+	lockSave   sync.RWMutex
+}
+
+// Exists calls ExistsFunc.
+func (mock *DiscountCodesRepositoryMock) Exists(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}{
+		Ctx:  ctx,
+		Race: race,
+		Code: code,
+	}
+	mock.lockExists.Lock()
+	mock.calls.Exists = append(mock.calls.Exists, callInfo)
+	mock.lockExists.Unlock()
+	if mock.ExistsFunc == nil {
+		var (
+			out1 bool
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ExistsFunc(ctx, race, code)
+}
+
+// ExistsCalls gets all the calls that were made to Exists.
+// Check the length with:
+//     len(mockedDiscountCodesRepository.ExistsCalls())
+func (mock *DiscountCodesRepositoryMock) ExistsCalls() []struct {
+	Ctx  context.Context
+	Race racers.RaceID
+	Code string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}
+	mock.lockExists.RLock()
+	calls = mock.calls.Exists
+	mock.lockExists.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *DiscountCodesRepositoryMock) Get(ctx context.Context, race racers.RaceID, code string) (racers.DiscountCode, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}{
+		Ctx:  ctx,
+		Race: race,
+		Code: code,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 racers.DiscountCode
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, race, code)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedDiscountCodesRepository.GetCalls())
+func (mock *DiscountCodesRepositoryMock) GetCalls() []struct {
+	Ctx  context.Context
+	Race racers.RaceID
+	Code string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// List calls ListFunc.
+func (mock *DiscountCodesRepositoryMock) List(ctx context.Context, race racers.RaceID) ([]racers.DiscountCode, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Race racers.RaceID
+	}{
+		Ctx:  ctx,
+		Race: race,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	if mock.ListFunc == nil {
+		var (
+			out1 []racers.DiscountCode
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ListFunc(ctx, race)
+}
+
+// ListCalls gets all the calls that were made to List.
+// Check the length with:
+//     len(mockedDiscountCodesRepository.ListCalls())
+func (mock *DiscountCodesRepositoryMock) ListCalls() []struct {
+	Ctx  context.Context
+	Race racers.RaceID
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Race racers.RaceID
+	}
+	mock.lockList.RLock()
+	calls = mock.calls.List
+	mock.lockList.RUnlock()
+	return calls
+}
+
+// Redeem calls RedeemFunc.
+func (mock *DiscountCodesRepositoryMock) Redeem(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}{
+		Ctx:  ctx,
+		Race: race,
+		Code: code,
+	}
+	mock.lockRedeem.Lock()
+	mock.calls.Redeem = append(mock.calls.Redeem, callInfo)
+	mock.lockRedeem.Unlock()
+	if mock.RedeemFunc == nil {
+		var (
+			out1 bool
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.RedeemFunc(ctx, race, code)
+}
+
+// RedeemCalls gets all the calls that were made to Redeem.
+// Check the length with:
+//     len(mockedDiscountCodesRepository.RedeemCalls())
+func (mock *DiscountCodesRepositoryMock) RedeemCalls() []struct {
+	Ctx  context.Context
+	Race racers.RaceID
+	Code string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Race racers.RaceID
+		Code string
+	}
+	mock.lockRedeem.RLock()
+	calls = mock.calls.Redeem
+	mock.lockRedeem.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *DiscountCodesRepositoryMock) Save(ctx context.Context, code racers.DiscountCode) error {
+	callInfo := struct {
+		Ctx  context.Context
+		Code racers.DiscountCode
+	}{
+		Ctx:  ctx,
+		Code: code,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, code)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedDiscountCodesRepository.SaveCalls())
+func (mock *DiscountCodesRepositoryMock) SaveCalls() []struct {
+	Ctx  context.Context
+	Code racers.DiscountCode
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Code racers.DiscountCode
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that NotificationPreferencesRepositoryMock does implement service.NotificationPreferencesRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.NotificationPreferencesRepository = &NotificationPreferencesRepositoryMock{}
+
+// NotificationPreferencesRepositoryMock is a mock implementation of service.NotificationPreferencesRepository.
+//
+//     func TestSomethingThatUsesNotificationPreferencesRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.NotificationPreferencesRepository
+//         mockedNotificationPreferencesRepository := &NotificationPreferencesRepositoryMock{
+//             GetFunc: func(ctx context.Context, user racers.UserID) (service.NotificationPreferences, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             SaveFunc: func(ctx context.Context, prefs service.NotificationPreferences) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedNotificationPreferencesRepository in code that requires service.NotificationPreferencesRepository
+//         // and then make assertions.
+//
+//     }
+type NotificationPreferencesRepositoryMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, user racers.UserID) (service.NotificationPreferences, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, prefs service.NotificationPreferences) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User racers.UserID
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Prefs is the prefs argument value.
+			Prefs service.NotificationPreferences
+		}
+	}
+	lockGet  sync.RWMutex
+	lockSave sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *NotificationPreferencesRepositoryMock) Get(ctx context.Context, user racers.UserID) (service.NotificationPreferences, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		User racers.UserID
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 service.NotificationPreferences
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, user)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedNotificationPreferencesRepository.GetCalls())
+func (mock *NotificationPreferencesRepositoryMock) GetCalls() []struct {
+	Ctx  context.Context
+	User racers.UserID
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User racers.UserID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *NotificationPreferencesRepositoryMock) Save(ctx context.Context, prefs service.NotificationPreferences) error {
+	callInfo := struct {
+		Ctx   context.Context
+		Prefs service.NotificationPreferences
+	}{
+		Ctx:   ctx,
+		Prefs: prefs,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, prefs)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedNotificationPreferencesRepository.SaveCalls())
+func (mock *NotificationPreferencesRepositoryMock) SaveCalls() []struct {
+	Ctx   context.Context
+	Prefs service.NotificationPreferences
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Prefs service.NotificationPreferences
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that UserDataRepositoryMock does implement service.UserDataRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.UserDataRepository = &UserDataRepositoryMock{}
+
+// UserDataRepositoryMock is a mock implementation of service.UserDataRepository.
+//
+//     func TestSomethingThatUsesUserDataRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.UserDataRepository
+//         mockedUserDataRepository := &UserDataRepositoryMock{
+//             AnonymizeFunc: func(ctx context.Context, user racers.UserID, tombstone racers.UserID) error {
+// 	               panic("mock out the Anonymize method")
+//             },
+//             ExportFunc: func(ctx context.Context, user racers.UserID) (service.UserData, error) {
+// 	               panic("mock out the Export method")
+//             },
+//         }
+//
+//         // use mockedUserDataRepository in code that requires service.UserDataRepository
+//         // and then make assertions.
+//
+//     }
+type UserDataRepositoryMock struct {
+	// AnonymizeFunc mocks the Anonymize method.
+	AnonymizeFunc func(ctx context.Context, user racers.UserID, tombstone racers.UserID) error
+
+	// ExportFunc mocks the Export method.
+	ExportFunc func(ctx context.Context, user racers.UserID) (service.UserData, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Anonymize holds details about calls to the Anonymize method.
+		Anonymize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User racers.UserID
+			// Tombstone is the tombstone argument value.
+			Tombstone racers.UserID
+		}
+		// Export holds details about calls to the Export method.
+		Export []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User racers.UserID
+		}
+	}
+	lockAnonymize sync.RWMutex
+	lockExport    sync.RWMutex
+}
+
+// Anonymize calls AnonymizeFunc.
+func (mock *UserDataRepositoryMock) Anonymize(ctx context.Context, user racers.UserID, tombstone racers.UserID) error {
+	callInfo := struct {
+		Ctx       context.Context
+		User      racers.UserID
+		Tombstone racers.UserID
+	}{
+		Ctx:       ctx,
+		User:      user,
+		Tombstone: tombstone,
+	}
+	mock.lockAnonymize.Lock()
+	mock.calls.Anonymize = append(mock.calls.Anonymize, callInfo)
+	mock.lockAnonymize.Unlock()
+	if mock.AnonymizeFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.AnonymizeFunc(ctx, user, tombstone)
+}
+
+// AnonymizeCalls gets all the calls that were made to Anonymize.
+// Check the length with:
+//     len(mockedUserDataRepository.AnonymizeCalls())
+func (mock *UserDataRepositoryMock) AnonymizeCalls() []struct {
+	Ctx       context.Context
+	User      racers.UserID
+	Tombstone racers.UserID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		User      racers.UserID
+		Tombstone racers.UserID
+	}
+	mock.lockAnonymize.RLock()
+	calls = mock.calls.Anonymize
+	mock.lockAnonymize.RUnlock()
+	return calls
+}
+
+// Export calls ExportFunc.
+func (mock *UserDataRepositoryMock) Export(ctx context.Context, user racers.UserID) (service.UserData, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		User racers.UserID
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockExport.Lock()
+	mock.calls.Export = append(mock.calls.Export, callInfo)
+	mock.lockExport.Unlock()
+	if mock.ExportFunc == nil {
+		var (
+			out1 service.UserData
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.ExportFunc(ctx, user)
+}
+
+// ExportCalls gets all the calls that were made to Export.
+// Check the length with:
+//     len(mockedUserDataRepository.ExportCalls())
+func (mock *UserDataRepositoryMock) ExportCalls() []struct {
+	Ctx  context.Context
+	User racers.UserID
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User racers.UserID
+	}
+	mock.lockExport.RLock()
+	calls = mock.calls.Export
+	mock.lockExport.RUnlock()
+	return calls
+}
+
+// Ensure, that ErasuresRepositoryMock does implement service.ErasuresRepository.
+// If this is not the case, regenerate this file with moq.
+var _ service.ErasuresRepository = &ErasuresRepositoryMock{}
+
+// ErasuresRepositoryMock is a mock implementation of service.ErasuresRepository.
+//
+//     func TestSomethingThatUsesErasuresRepository(t *testing.T) {
+//
+//         // make and configure a mocked service.ErasuresRepository
+//         mockedErasuresRepository := &ErasuresRepositoryMock{
+//             GetFunc: func(ctx context.Context, erasure id.ID) (service.Erasure, error) {
+// 	               panic("mock out the Get method")
+//             },
+//             PendingFunc: func(ctx context.Context) ([]service.Erasure, error) {
+// 	               panic("mock out the Pending method")
+//             },
+//             PendingForFunc: func(ctx context.Context, user racers.UserID) (service.Erasure, error) {
+// 	               panic("mock out the PendingFor method")
+//             },
+//             SaveFunc: func(ctx context.Context, erasure service.Erasure) error {
+// 	               panic("mock out the Save method")
+//             },
+//         }
+//
+//         // use mockedErasuresRepository in code that requires service.ErasuresRepository
+//         // and then make assertions.
+//
+//     }
+type ErasuresRepositoryMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, erasure id.ID) (service.Erasure, error)
+
+	// PendingFunc mocks the Pending method.
+	PendingFunc func(ctx context.Context) ([]service.Erasure, error)
+
+	// PendingForFunc mocks the PendingFor method.
+	PendingForFunc func(ctx context.Context, user racers.UserID) (service.Erasure, error)
+
+	// SaveFunc mocks the Save method.
+	SaveFunc func(ctx context.Context, erasure service.Erasure) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Erasure is the erasure argument value.
+			Erasure id.ID
+		}
+		// Pending holds details about calls to the Pending method.
+		Pending []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// PendingFor holds details about calls to the PendingFor method.
+		PendingFor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User racers.UserID
+		}
+		// Save holds details about calls to the Save method.
+		Save []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Erasure is the erasure argument value.
+			Erasure service.Erasure
+		}
+	}
+	lockGet        sync.RWMutex
+	lockPending    sync.RWMutex
+	lockPendingFor sync.RWMutex
+	lockSave       sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *ErasuresRepositoryMock) Get(ctx context.Context, erasure id.ID) (service.Erasure, error) {
+	callInfo := struct {
+		Ctx     context.Context
+		Erasure id.ID
+	}{
+		Ctx:     ctx,
+		Erasure: erasure,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	if mock.GetFunc == nil {
+		var (
+			out1 service.Erasure
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.GetFunc(ctx, erasure)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//     len(mockedErasuresRepository.GetCalls())
+func (mock *ErasuresRepositoryMock) GetCalls() []struct {
+	Ctx     context.Context
+	Erasure id.ID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Erasure id.ID
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// Pending calls PendingFunc.
+func (mock *ErasuresRepositoryMock) Pending(ctx context.Context) ([]service.Erasure, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockPending.Lock()
+	mock.calls.Pending = append(mock.calls.Pending, callInfo)
+	mock.lockPending.Unlock()
+	if mock.PendingFunc == nil {
+		var (
+			out1 []service.Erasure
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.PendingFunc(ctx)
+}
+
+// PendingCalls gets all the calls that were made to Pending.
+// Check the length with:
+//     len(mockedErasuresRepository.PendingCalls())
+func (mock *ErasuresRepositoryMock) PendingCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockPending.RLock()
+	calls = mock.calls.Pending
+	mock.lockPending.RUnlock()
+	return calls
+}
+
+// PendingFor calls PendingForFunc.
+func (mock *ErasuresRepositoryMock) PendingFor(ctx context.Context, user racers.UserID) (service.Erasure, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		User racers.UserID
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockPendingFor.Lock()
+	mock.calls.PendingFor = append(mock.calls.PendingFor, callInfo)
+	mock.lockPendingFor.Unlock()
+	if mock.PendingForFunc == nil {
+		var (
+			out1 service.Erasure
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.PendingForFunc(ctx, user)
+}
+
+// PendingForCalls gets all the calls that were made to PendingFor.
+// Check the length with:
+//     len(mockedErasuresRepository.PendingForCalls())
+func (mock *ErasuresRepositoryMock) PendingForCalls() []struct {
+	Ctx  context.Context
+	User racers.UserID
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User racers.UserID
+	}
+	mock.lockPendingFor.RLock()
+	calls = mock.calls.PendingFor
+	mock.lockPendingFor.RUnlock()
+	return calls
+}
+
+// Save calls SaveFunc.
+func (mock *ErasuresRepositoryMock) Save(ctx context.Context, erasure service.Erasure) error {
+	callInfo := struct {
+		Ctx     context.Context
+		Erasure service.Erasure
+	}{
+		Ctx:     ctx,
+		Erasure: erasure,
+	}
+	mock.lockSave.Lock()
+	mock.calls.Save = append(mock.calls.Save, callInfo)
+	mock.lockSave.Unlock()
+	if mock.SaveFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.SaveFunc(ctx, erasure)
+}
+
+// SaveCalls gets all the calls that were made to Save.
+// Check the length with:
+//     len(mockedErasuresRepository.SaveCalls())
+func (mock *ErasuresRepositoryMock) SaveCalls() []struct {
+	Ctx     context.Context
+	Erasure service.Erasure
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Erasure service.Erasure
+	}
+	mock.lockSave.RLock()
+	calls = mock.calls.Save
+	mock.lockSave.RUnlock()
+	return calls
+}
+
+// Ensure, that PaymentGatewayMock does implement service.PaymentGateway.
+// If this is not the case, regenerate this file with moq.
+var _ service.PaymentGateway = &PaymentGatewayMock{}
+
+// PaymentGatewayMock is a mock implementation of service.PaymentGateway.
+//
+//     func TestSomethingThatUsesPaymentGateway(t *testing.T) {
+//
+//         // make and configure a mocked service.PaymentGateway
+//         mockedPaymentGateway := &PaymentGatewayMock{
+//             CheckoutFunc: func(ctx context.Context, c service.PaymentCheckout) (service.Payment, error) {
+// 	               panic("mock out the Checkout method")
+//             },
+//             RefundFunc: func(ctx context.Context, payment string) error {
+// 	               panic("mock out the Refund method")
+//             },
+//         }
+//
+//         // use mockedPaymentGateway in code that requires service.PaymentGateway
+//         // and then make assertions.
+//
+//     }
+type PaymentGatewayMock struct {
+	// CheckoutFunc mocks the Checkout method.
+	CheckoutFunc func(ctx context.Context, c service.PaymentCheckout) (service.Payment, error)
+
+	// RefundFunc mocks the Refund method.
+	RefundFunc func(ctx context.Context, payment string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Checkout holds details about calls to the Checkout method.
+		Checkout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// C is the c argument value.
+			C service.PaymentCheckout
+		}
+		// Refund holds details about calls to the Refund method.
+		Refund []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Payment is the payment argument value.
+			Payment string
+		}
+	}
+	lockCheckout sync.RWMutex
+	lockRefund   sync.RWMutex
+}
+
+// Checkout calls CheckoutFunc.
+func (mock *PaymentGatewayMock) Checkout(ctx context.Context, c service.PaymentCheckout) (service.Payment, error) {
+	callInfo := struct {
+		Ctx context.Context
+		C   service.PaymentCheckout
+	}{
+		Ctx: ctx,
+		C:   c,
+	}
+	mock.lockCheckout.Lock()
+	mock.calls.Checkout = append(mock.calls.Checkout, callInfo)
+	mock.lockCheckout.Unlock()
+	if mock.CheckoutFunc == nil {
+		var (
+			out1 service.Payment
+			out2 error
+		)
+		return out1, out2
+	}
+	return mock.CheckoutFunc(ctx, c)
+}
+
+// CheckoutCalls gets all the calls that were made to Checkout.
+// Check the length with:
+//     len(mockedPaymentGateway.CheckoutCalls())
+func (mock *PaymentGatewayMock) CheckoutCalls() []struct {
+	Ctx context.Context
+	C   service.PaymentCheckout
+} {
+	var calls []struct {
+		Ctx context.Context
+		C   service.PaymentCheckout
+	}
+	mock.lockCheckout.RLock()
+	calls = mock.calls.Checkout
+	mock.lockCheckout.RUnlock()
+	return calls
+}
+
+// Refund calls RefundFunc.
+func (mock *PaymentGatewayMock) Refund(ctx context.Context, payment string) error {
+	callInfo := struct {
+		Ctx     context.Context
+		Payment string
+	}{
+		Ctx:     ctx,
+		Payment: payment,
+	}
+	mock.lockRefund.Lock()
+	mock.calls.Refund = append(mock.calls.Refund, callInfo)
+	mock.lockRefund.Unlock()
+	if mock.RefundFunc == nil {
+		var (
+			out1 error
+		)
+		return out1
+	}
+	return mock.RefundFunc(ctx, payment)
+}
+
+// RefundCalls gets all the calls that were made to Refund.
+// Check the length with:
+//     len(mockedPaymentGateway.RefundCalls())
+func (mock *PaymentGatewayMock) RefundCalls() []struct {
+	Ctx     context.Context
+	Payment string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Payment string
+	}
+	mock.lockRefund.RLock()
+	calls = mock.calls.Refund
+	mock.lockRefund.RUnlock()
+	return calls
+}
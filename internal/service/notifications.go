@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+// NotificationKind groups the notifications a user can opt out of
+type NotificationKind string
+
+// Notification kinds
+const (
+	// NotificationRegistrations are the changes of the user registrations
+	NotificationRegistrations NotificationKind = "REGISTRATIONS"
+	// NotificationRaceUpdates are the reschedules, race day reminders and cancellations of the races the user joined
+	NotificationRaceUpdates NotificationKind = "RACE_UPDATES"
+	// NotificationOrganizer are the notifications of the races the user organizes
+	NotificationOrganizer NotificationKind = "ORGANIZER"
+)
+
+// NotificationKinds are all the kinds of notifications
+var NotificationKinds = []NotificationKind{NotificationRegistrations, NotificationRaceUpdates, NotificationOrganizer}
+
+func newNotificationKind(s string) (NotificationKind, error) {
+	for _, k := range NotificationKinds {
+		if string(k) == s {
+			return k, nil
+		}
+	}
+
+	return "", ErrUnknownNotificationKind
+}
+
+// DefaultLocale is the language of the notifications of the users without preferences
+const DefaultLocale = "en"
+
+// Locales are the languages the notifications are written in
+var Locales = []string{"en", "es"}
+
+// NotificationPreferences are the language and the kinds of notifications the user opted out of
+type NotificationPreferences struct {
+	User     racers.UserID
+	Locale   string
+	Disabled []NotificationKind
+}
+
+// Enabled returns if the user receives the kind of notifications
+func (p NotificationPreferences) Enabled(kind NotificationKind) bool {
+	for _, k := range p.Disabled {
+		if k == kind {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *NotificationPreferences) disable(kind NotificationKind) {
+	if p.Enabled(kind) {
+		p.Disabled = append(p.Disabled, kind)
+	}
+	sort.Slice(p.Disabled, func(i, j int) bool { return p.Disabled[i] < p.Disabled[j] })
+}
+
+func NewNotifications(prefs NotificationPreferencesRepository, users UsersGetter, secret []byte) Notifications {
+	return Notifications{prefs, users, secret}
+}
+
+// Notifications manages what the users are notified of, the unsubscribe tokens are signed with the secret
+type Notifications struct {
+	prefs  NotificationPreferencesRepository
+	users  UsersGetter
+	secret []byte
+}
+
+// PreferencesOf returns the preferences of the user, the defaults when the user never changed them
+func (s Notifications) PreferencesOf(ctx context.Context, user racers.UserID) (NotificationPreferences, error) {
+	prefs, err := s.prefs.Get(ctx, user)
+	if err == ErrNotificationPreferencesNotFound {
+		return NotificationPreferences{User: user, Locale: DefaultLocale}, nil
+	}
+
+	return prefs, err
+}
+
+// Preferences returns the preferences of the current user
+func (s Notifications) Preferences(ctx context.Context) (NotificationPreferences, error) {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return NotificationPreferences{}, ErrForbidden
+	}
+
+	return s.PreferencesOf(ctx, user.ID)
+}
+
+type UpdateNotificationPreferences struct {
+	Locale   string   `json:"locale,omitempty"`
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// UpdatePreferences replaces the preferences of the current user
+func (s Notifications) UpdatePreferences(ctx context.Context, r UpdateNotificationPreferences) (NotificationPreferences, error) {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return NotificationPreferences{}, ErrForbidden
+	}
+
+	prefs := NotificationPreferences{User: user.ID, Locale: r.Locale}
+	if !supportedLocale(r.Locale) {
+		return NotificationPreferences{}, ErrUnsupportedLocale
+	}
+	for _, d := range r.Disabled {
+		kind, err := newNotificationKind(d)
+		if err != nil {
+			return NotificationPreferences{}, err
+		}
+		prefs.disable(kind)
+	}
+
+	if err := s.prefs.Save(ctx, prefs); err != nil {
+		return NotificationPreferences{}, err
+	}
+
+	return prefs, nil
+}
+
+func supportedLocale(locale string) bool {
+	for _, l := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnsubscribeToken returns the token of the unsubscribe links, it opts the user out of the kind of notifications
+func (s Notifications) UnsubscribeToken(user racers.UserID, kind NotificationKind) string {
+	payload := fmt.Sprintf("%s.%s", id.ID(user), kind)
+
+	return fmt.Sprintf("%s.%s", base64.RawURLEncoding.EncodeToString([]byte(payload)), s.sign(payload))
+}
+
+func (s Notifications) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	_, _ = mac.Write([]byte(payload))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Unsubscribe opts the user of the token out of its kind of notifications, it needs no authentication
+func (s Notifications) Unsubscribe(ctx context.Context, token string) (NotificationPreferences, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return NotificationPreferences{}, ErrInvalidUnsubscribeToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || !hmac.Equal([]byte(s.sign(string(payload))), []byte(parts[1])) {
+		return NotificationPreferences{}, ErrInvalidUnsubscribeToken
+	}
+
+	fields := strings.Split(string(payload), ".")
+	if len(fields) != 2 {
+		return NotificationPreferences{}, ErrInvalidUnsubscribeToken
+	}
+	user, err := racers.NewUserID(fields[0])
+	if err != nil {
+		return NotificationPreferences{}, ErrInvalidUnsubscribeToken
+	}
+	kind, err := newNotificationKind(fields[1])
+	if err != nil {
+		return NotificationPreferences{}, ErrInvalidUnsubscribeToken
+	}
+
+	prefs, err := s.PreferencesOf(ctx, user)
+	if err != nil {
+		return NotificationPreferences{}, err
+	}
+
+	if !prefs.Enabled(kind) {
+		return prefs, nil
+	}
+	prefs.disable(kind)
+
+	if err := s.prefs.Save(ctx, prefs); err != nil {
+		return NotificationPreferences{}, err
+	}
+
+	return prefs, nil
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestNotifications(t *testing.T) {
+	suite.Run(t, new(notificationsSuite))
+}
+
+type notificationsSuite struct {
+	suite.Suite
+
+	service service.Notifications
+
+	user  racers.User
+	saved map[racers.UserID]service.NotificationPreferences
+
+	prefs *NotificationPreferencesRepositoryMock
+	users *UsersGetterMock
+}
+
+func (s *notificationsSuite) SetupTest() {
+	s.user = racers.User{ID: racers.UserID(id.Generate())}
+	s.saved = make(map[racers.UserID]service.NotificationPreferences)
+
+	s.prefs = &NotificationPreferencesRepositoryMock{
+		GetFunc: func(_ context.Context, user racers.UserID) (service.NotificationPreferences, error) {
+			prefs, ok := s.saved[user]
+			if !ok {
+				return service.NotificationPreferences{}, service.ErrNotificationPreferencesNotFound
+			}
+			return prefs, nil
+		},
+		SaveFunc: func(_ context.Context, prefs service.NotificationPreferences) error {
+			s.saved[prefs.User] = prefs
+			return nil
+		},
+	}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.user },
+	}
+
+	s.service = service.NewNotifications(s.prefs, s.users, []byte("secret"))
+}
+
+func (s *notificationsSuite) TestPreferences_Defaults() {
+	prefs, err := s.service.Preferences(context.Background())
+	s.NoError(err)
+
+	s.Equal(service.NotificationPreferences{User: s.user.ID, Locale: service.DefaultLocale}, prefs)
+	for _, k := range service.NotificationKinds {
+		s.True(prefs.Enabled(k))
+	}
+}
+
+func (s *notificationsSuite) TestUpdatePreferences() {
+	s.Run("anonymous", func() {
+		s.users.CurrentFunc = func(context.Context) racers.User { return racers.User{} }
+		defer func() { s.users.CurrentFunc = func(context.Context) racers.User { return s.user } }()
+
+		_, err := s.service.UpdatePreferences(context.Background(), service.UpdateNotificationPreferences{Locale: "en"})
+		s.Equal(service.ErrForbidden, err)
+	})
+
+	s.Run("unsupported locale", func() {
+		_, err := s.service.UpdatePreferences(context.Background(), service.UpdateNotificationPreferences{Locale: "xx"})
+		s.Equal(service.ErrUnsupportedLocale, err)
+	})
+
+	s.Run("unknown kind", func() {
+		_, err := s.service.UpdatePreferences(context.Background(), service.UpdateNotificationPreferences{Locale: "en", Disabled: []string{"NEWSLETTER"}})
+		s.Equal(service.ErrUnknownNotificationKind, err)
+	})
+
+	prefs, err := s.service.UpdatePreferences(context.Background(), service.UpdateNotificationPreferences{
+		Locale:   "es",
+		Disabled: []string{"RACE_UPDATES", "ORGANIZER", "RACE_UPDATES"},
+	})
+	s.NoError(err)
+
+	expected := service.NotificationPreferences{
+		User:     s.user.ID,
+		Locale:   "es",
+		Disabled: []service.NotificationKind{service.NotificationOrganizer, service.NotificationRaceUpdates},
+	}
+	s.Equal(expected, prefs)
+	s.Equal(expected, s.saved[s.user.ID])
+}
+
+func (s *notificationsSuite) TestUnsubscribe() {
+	token := s.service.UnsubscribeToken(s.user.ID, service.NotificationRaceUpdates)
+
+	s.Run("invalid tokens", func() {
+		other := service.NewNotifications(s.prefs, s.users, []byte("other")).UnsubscribeToken(s.user.ID, service.NotificationRaceUpdates)
+
+		for _, t := range []string{"", "token", token + "x", other} {
+			_, err := s.service.Unsubscribe(context.Background(), t)
+			s.Equal(service.ErrInvalidUnsubscribeToken, err, t)
+		}
+	})
+
+	prefs, err := s.service.Unsubscribe(context.Background(), token)
+	s.NoError(err)
+	s.False(prefs.Enabled(service.NotificationRaceUpdates))
+	s.True(prefs.Enabled(service.NotificationRegistrations))
+	s.Equal(service.DefaultLocale, s.saved[s.user.ID].Locale)
+
+	_, err = s.service.Unsubscribe(context.Background(), token)
+	s.NoError(err)
+	s.Len(s.prefs.SaveCalls(), 1)
+}
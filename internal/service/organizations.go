@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+func NewOrganizations(orgs OrganizationsRepository, races RacesRepository, users UsersGetter, uow UnitOfWork, eb EventBus) Organizations {
+	return Organizations{orgs, races, users, uow, eb}
+}
+
+// Organizations are the clubs and federations owning races, their admins manage all of them
+type Organizations struct {
+	orgs  OrganizationsRepository
+	races RacesRepository
+	users UsersGetter
+	uow   UnitOfWork
+	eb    EventBus
+}
+
+type CreateOrganization struct {
+	ID   string
+	Name string
+}
+
+type OrganizationMember struct {
+	OrganizationID string
+	UserID         string
+	// Role is ignored when the member is removed
+	Role string
+}
+
+type AssignRace struct {
+	OrganizationID string
+	RaceID         string
+}
+
+// OrganizationCreated is published when a user creates an organization, becoming its admin
+type OrganizationCreated struct {
+	Organization racers.OrganizationID
+	Name         racers.OrganizationName
+	Admin        racers.UserID
+}
+
+func (e OrganizationCreated) OrganizationID() racers.OrganizationID { return e.Organization }
+
+// OrganizationMemberAdded is published when an admin adds a member, or changes its role
+type OrganizationMemberAdded struct {
+	Organization racers.OrganizationID
+	User         racers.UserID
+	Role         racers.OrganizationRole
+}
+
+func (e OrganizationMemberAdded) OrganizationID() racers.OrganizationID { return e.Organization }
+
+// OrganizationMemberRemoved is published when an admin removes a member
+type OrganizationMemberRemoved struct {
+	Organization racers.OrganizationID
+	User         racers.UserID
+}
+
+func (e OrganizationMemberRemoved) OrganizationID() racers.OrganizationID { return e.Organization }
+
+// RaceAssignedToOrganization is published when the owner of a race hands its management to an organization
+type RaceAssignedToOrganization struct {
+	Organization racers.OrganizationID
+	Race         racers.RaceID
+}
+
+func (e RaceAssignedToOrganization) OrganizationID() racers.OrganizationID { return e.Organization }
+func (e RaceAssignedToOrganization) RaceID() racers.RaceID                 { return e.Race }
+
+// OrganizationOverview is an organization with its races
+type OrganizationOverview struct {
+	Organization racers.Organization
+	Races        []racers.Race
+	// Dashboard is nil unless the current user is an admin of the organization
+	Dashboard *OrganizationDashboard
+}
+
+// OrganizationDashboard sums up the races of an organization for its admins
+type OrganizationDashboard struct {
+	Races []RaceDashboard
+	// Revenue is the sum of the confirmed registration fees of the races, one amount per currency
+	Revenue []racers.Money
+}
+
+// RaceDashboard sums up the participants of a race
+type RaceDashboard struct {
+	Race         racers.RaceID
+	Participants int
+	// Confirmed are the participants with a confirmed registration, all of them in free races
+	Confirmed int
+	CheckedIn int
+	// Revenue is the sum of the confirmed registration fees, one amount per currency, empty in free races
+	Revenue []racers.Money
+}
+
+// Create creates an organization with the current user as its admin
+func (s Organizations) Create(ctx context.Context, r CreateOrganization) (OrganizationOverview, error) {
+	orgID, err := racers.NewOrganizationID(r.ID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	name, err := racers.NewOrganizationName(r.Name)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	exists, err := s.orgs.Exists(ctx, orgID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+	if exists {
+		return OrganizationOverview{}, ErrOrganizationAlreadyExists
+	}
+
+	current := s.users.Current(ctx).ID
+	org := racers.CreateOrganization(orgID, name, current)
+
+	err = s.uow(ctx, func(ctx context.Context) error {
+		if err := s.orgs.Save(ctx, org); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(OrganizationCreated{Organization: org.ID, Name: org.Name, Admin: current}, current))
+	})
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	return s.overview(ctx, org)
+}
+
+// AddMember adds an existing user to the organization, or changes its role, only the admins are allowed
+func (s Organizations) AddMember(ctx context.Context, r OrganizationMember) (OrganizationOverview, error) {
+	role, err := racers.NewOrganizationRole(r.Role)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	return s.changeMember(ctx, r, func(org *racers.Organization, u racers.UserID) (interface{}, error) {
+		if _, err := s.users.Get(ctx, u); err != nil {
+			return nil, err
+		}
+
+		return OrganizationMemberAdded{Organization: org.ID, User: u, Role: role}, org.AddMember(u, role)
+	})
+}
+
+// RemoveMember removes a member from the organization, only the admins are allowed
+func (s Organizations) RemoveMember(ctx context.Context, r OrganizationMember) (OrganizationOverview, error) {
+	return s.changeMember(ctx, r, func(org *racers.Organization, u racers.UserID) (interface{}, error) {
+		return OrganizationMemberRemoved{Organization: org.ID, User: u}, org.RemoveMember(u)
+	})
+}
+
+// changeMember applies the change to the members of the organization and publishes the event it returns
+func (s Organizations) changeMember(ctx context.Context, r OrganizationMember, change func(*racers.Organization, racers.UserID) (interface{}, error)) (OrganizationOverview, error) {
+	orgID, err := racers.NewOrganizationID(r.OrganizationID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	userID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	org, err := s.orgs.Get(ctx, orgID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	current := s.users.Current(ctx).ID
+	if !org.IsAdmin(current) {
+		return OrganizationOverview{}, ErrForbidden
+	}
+
+	event, err := change(&org, userID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	err = s.uow(ctx, func(ctx context.Context) error {
+		if err := s.orgs.Save(ctx, org); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(event, current))
+	})
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	return s.overview(ctx, org)
+}
+
+// AssignRace hands the management of a race to an organization, only the race owner being an admin of
+// the organization is allowed. The owner keeps its role in the race
+func (s Organizations) AssignRace(ctx context.Context, r AssignRace) (racers.Race, error) {
+	orgID, err := racers.NewOrganizationID(r.OrganizationID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	org, err := s.orgs.Get(ctx, orgID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	current := s.users.Current(ctx).ID
+
+	var race racers.Race
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err = s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		if current != race.Owner || !org.IsAdmin(current) {
+			return ErrForbidden
+		}
+
+		race.Organization = &org.ID
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(RaceAssignedToOrganization{Organization: org.ID, Race: race.ID}, current))
+	})
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return race, nil
+}
+
+// Get returns the organization with its races, and the dashboard when the current user is one of its admins
+func (s Organizations) Get(ctx context.Context, orgID string) (OrganizationOverview, error) {
+	id, err := racers.NewOrganizationID(orgID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	org, err := s.orgs.Get(ctx, id)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	return s.overview(ctx, org)
+}
+
+// overview returns the organization with its races, and the dashboard for its admins
+func (s Organizations) overview(ctx context.Context, org racers.Organization) (OrganizationOverview, error) {
+	races, err := s.races.ByOrganization(ctx, org.ID)
+	if err != nil {
+		return OrganizationOverview{}, err
+	}
+
+	result := OrganizationOverview{Organization: org, Races: races}
+	if org.IsAdmin(s.users.Current(ctx).ID) {
+		dashboard := newOrganizationDashboard(races)
+		result.Dashboard = &dashboard
+	}
+
+	return result, nil
+}
+
+func newOrganizationDashboard(races []racers.Race) OrganizationDashboard {
+	var (
+		result  = OrganizationDashboard{Races: make([]RaceDashboard, len(races))}
+		revenue = make(map[racers.Currency]int64)
+	)
+	for i, race := range races {
+		result.Races[i] = newRaceDashboard(race)
+		for _, m := range result.Races[i].Revenue {
+			revenue[m.Currency] += m.Amount
+		}
+	}
+	result.Revenue = sortedMoney(revenue)
+
+	return result
+}
+
+func newRaceDashboard(race racers.Race) RaceDashboard {
+	var (
+		competitors = race.Competitors.List()
+		result      = RaceDashboard{Race: race.ID, Participants: len(competitors), CheckedIn: len(race.CheckIns)}
+		revenue     = make(map[racers.Currency]int64)
+	)
+	for _, c := range competitors {
+		reg, _ := race.Registration(c)
+		if reg.Status != racers.RegistrationConfirmed {
+			continue
+		}
+		result.Confirmed++
+		if !reg.Fee.IsZero() {
+			revenue[reg.Fee.Currency] += reg.Fee.Amount
+		}
+	}
+	result.Revenue = sortedMoney(revenue)
+
+	return result
+}
+
+// sortedMoney returns the amounts sorted by currency
+func sortedMoney(amounts map[racers.Currency]int64) []racers.Money {
+	result := make([]racers.Money, 0, len(amounts))
+	for c, a := range amounts {
+		result = append(result, racers.Money{Amount: a, Currency: c})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Currency < result[j].Currency })
+
+	return result
+}
+
+// permit returns ErrForbidden if the user has not the permission in the race, see can
+func permit(ctx context.Context, orgs OrganizationsGetter, race racers.Race, u racers.UserID, p racers.Permission) error {
+	ok, err := can(ctx, orgs, race, u, p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// can returns if the user has the permission in the race, by its role in the race or as an admin of the
+// organization owning it
+func can(ctx context.Context, orgs OrganizationsGetter, race racers.Race, u racers.UserID, p racers.Permission) (bool, error) {
+	if race.Can(u, p) {
+		return true, nil
+	}
+	if race.Organization == nil || orgs == nil {
+		return false, nil
+	}
+
+	org, err := orgs.Get(ctx, *race.Organization)
+	if err != nil {
+		return false, err
+	}
+
+	return org.IsAdmin(u), nil
+}
@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestOrganizations(t *testing.T) {
+	suite.Run(t, new(organizationsSuite))
+}
+
+type organizationsSuite struct {
+	suite.Suite
+
+	service service.Organizations
+	races   service.Races
+
+	org     racers.Organization
+	race    racers.Race
+	admin   racers.User
+	member  racers.User
+	current racers.User
+
+	orgs     *OrganizationsRepositoryMock
+	repo     *RacesRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *organizationsSuite) SetupTest() {
+	s.admin = racers.User{ID: racers.UserID(id.Generate())}
+	s.member = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.admin
+
+	s.org = racers.CreateOrganization(racers.OrganizationID(id.Generate()), "Club", s.admin.ID)
+	s.race = racers.Race{
+		ID:    racers.RaceID(id.Generate()),
+		Date:  racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner: s.admin.ID,
+	}
+
+	s.orgs = &OrganizationsRepositoryMock{
+		GetFunc: func(context.Context, racers.OrganizationID) (racers.Organization, error) { return s.org, nil },
+		SaveFunc: func(_ context.Context, org racers.Organization) error {
+			s.org = org
+			return nil
+		},
+	}
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+		ByOrganizationFunc: func(context.Context, racers.OrganizationID) ([]racers.Race, error) {
+			return []racers.Race{s.race}, nil
+		},
+	}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc: func(_ context.Context, id racers.UserID) (racers.User, error) {
+			for _, u := range []racers.User{s.admin, s.member} {
+				if u.ID == id {
+					return u, nil
+				}
+			}
+			return racers.User{}, service.ErrUserNotFound
+		},
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewOrganizations(s.orgs, s.repo, users, service.NoopUnitOfWork, s.eventBus)
+	s.races = service.NewRaces(s.repo, nil, s.orgs, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *organizationsSuite) addMember(role racers.OrganizationRole) {
+	_, err := s.service.AddMember(context.Background(), service.OrganizationMember{
+		OrganizationID: id.ID(s.org.ID).String(),
+		UserID:         id.ID(s.member.ID).String(),
+		Role:           string(role),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *organizationsSuite) assignRace() {
+	_, err := s.service.AssignRace(context.Background(), service.AssignRace{
+		OrganizationID: id.ID(s.org.ID).String(),
+		RaceID:         id.ID(s.race.ID).String(),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *organizationsSuite) TestCreate() {
+	s.orgs.ExistsFunc = func(context.Context, racers.OrganizationID) (bool, error) { return false, nil }
+	orgID := id.Generate()
+
+	org, err := s.service.Create(context.Background(), service.CreateOrganization{ID: orgID.String(), Name: "Federation"})
+	s.Require().NoError(err)
+
+	s.True(org.Organization.IsAdmin(s.admin.ID))
+	s.NotNil(org.Dashboard)
+	s.Equal(
+		service.OrganizationCreated{Organization: racers.OrganizationID(orgID), Name: "Federation", Admin: s.admin.ID},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
+
+func (s *organizationsSuite) TestAddMember_NotAdmin() {
+	s.addMember(racers.OrganizationMember)
+	s.current = s.member
+
+	_, err := s.service.RemoveMember(context.Background(), service.OrganizationMember{
+		OrganizationID: id.ID(s.org.ID).String(),
+		UserID:         id.ID(s.admin.ID).String(),
+	})
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s *organizationsSuite) TestAssignRace_NotOwner() {
+	s.addMember(racers.OrganizationAdmin)
+	s.current = s.member
+
+	_, err := s.service.AssignRace(context.Background(), service.AssignRace{
+		OrganizationID: id.ID(s.org.ID).String(),
+		RaceID:         id.ID(s.race.ID).String(),
+	})
+
+	s.Equal(service.ErrForbidden, err)
+	s.Empty(s.repo.SaveCalls())
+}
+
+func (s *organizationsSuite) TestAdminManagesTheRaces() {
+	s.addMember(racers.OrganizationAdmin)
+	s.current = s.member
+
+	reschedule := service.RescheduleRace{RaceID: id.ID(s.race.ID).String(), Date: time.Now().AddDate(0, 2, 0)}
+	_, err := s.races.Reschedule(context.Background(), reschedule)
+	s.Equal(service.ErrForbidden, err, "the race is not owned by the organization yet")
+
+	s.current = s.admin
+	s.assignRace()
+	s.Equal(&s.org.ID, s.race.Organization)
+
+	s.current = s.member
+	_, err = s.races.Reschedule(context.Background(), reschedule)
+	s.Require().NoError(err)
+}
+
+func (s *organizationsSuite) TestGet_Dashboard() {
+	s.assignRace()
+	paid := racers.UserID(id.Generate())
+	pending := racers.UserID(id.Generate())
+	s.race.Competitors = racers.NewRaceCompetitors(paid, pending, s.member.ID)
+	s.race.Registrations = racers.RaceRegistrations{
+		paid:    {Status: racers.RegistrationConfirmed, Fee: racers.Money{Amount: 2000, Currency: "EUR"}},
+		pending: {Status: racers.RegistrationPendingPayment, Fee: racers.Money{Amount: 2000, Currency: "EUR"}},
+	}
+	s.race.CheckIns = racers.RaceCheckIns{paid: time.Now()}
+
+	org, err := s.service.Get(context.Background(), id.ID(s.org.ID).String())
+	s.Require().NoError(err)
+
+	s.Len(org.Races, 1)
+	s.Equal(&service.OrganizationDashboard{
+		Races: []service.RaceDashboard{{
+			Race:         s.race.ID,
+			Participants: 3,
+			Confirmed:    2,
+			CheckedIn:    1,
+			Revenue:      []racers.Money{{Amount: 2000, Currency: "EUR"}},
+		}},
+		Revenue: []racers.Money{{Amount: 2000, Currency: "EUR"}},
+	}, org.Dashboard)
+
+	s.current = s.member
+	org, err = s.service.Get(context.Background(), id.ID(s.org.ID).String())
+	s.Require().NoError(err)
+	s.Nil(org.Dashboard, "only the admins see the dashboard")
+}
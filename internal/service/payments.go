@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+)
+
+// PaymentGateway charges the entry fees of the paid races
+type PaymentGateway interface {
+	// Checkout starts the payment of the fee, the gateway confirms it calling back when it succeeds
+	Checkout(ctx context.Context, c PaymentCheckout) (Payment, error)
+	// Refund returns the payment to the competitor, refunding it again does nothing
+	Refund(ctx context.Context, payment string) error
+}
+
+// PaymentCheckout is the payment of the entry fee of a competitor
+type PaymentCheckout struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Fee        racers.Money
+}
+
+// Payment is a payment started in the gateway
+type Payment struct {
+	ID string
+	// URL is the page the competitor pays at
+	URL string
+}
+
+func NewPayments(races RacesRepository, orgs OrganizationsGetter, gateway PaymentGateway, users UsersGetter, uow UnitOfWork, eb EventBus) Payments {
+	return Payments{races, orgs, gateway, users, uow, eb}
+}
+
+// Payments charges and refunds the registrations of the paid races
+type Payments struct {
+	races   RacesRepository
+	orgs    OrganizationsGetter
+	gateway PaymentGateway
+	users   UsersGetter
+	uow     UnitOfWork
+	eb      EventBus
+}
+
+type StartCheckout struct {
+	RaceID string
+}
+
+// PaymentStarted is published when a competitor starts paying the pending registration
+type PaymentStarted struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Payment    string
+	Fee        racers.Money
+}
+
+func (e PaymentStarted) RaceID() racers.RaceID { return e.Race }
+
+// Checkout starts the payment of the pending registration of the current user, a previous payment not completed
+// is replaced and refunded if it completes later
+func (s Payments) Checkout(ctx context.Context, r StartCheckout) (Payment, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	competitor := s.users.Current(ctx).ID
+	reg, ok := race.Registration(competitor)
+	if !ok || reg.Status != racers.RegistrationPendingPayment || !time.Now().Before(reg.ExpiresAt) {
+		return Payment{}, racers.RegistrationNotFoundError{RaceID: race.ID, CompetitorID: competitor}
+	}
+
+	payment, err := s.gateway.Checkout(ctx, PaymentCheckout{Race: race.ID, Competitor: competitor, Fee: reg.Fee})
+	if err != nil {
+		return Payment{}, err
+	}
+
+	// the race is read again locked, the gateway is not called holding the lock of the race
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err := s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		if err := race.StartPayment(competitor, payment.ID); err != nil {
+			return err
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(PaymentStarted{Race: race.ID, Competitor: competitor, Payment: payment.ID, Fee: reg.Fee}, competitor))
+	})
+	if err != nil {
+		return Payment{}, err
+	}
+
+	return payment, nil
+}
+
+// PaymentCallback is the notification of the gateway of a completed payment
+type PaymentCallback struct {
+	Payment      string `json:"payment_id"`
+	RaceID       string `json:"race_id"`
+	CompetitorID string `json:"competitor_id"`
+	Amount       int64  `json:"amount"`
+	Currency     string `json:"currency"`
+}
+
+// RegistrationConfirmed is published when the fee of a registration is paid
+type RegistrationConfirmed struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Payment    string
+}
+
+func (e RegistrationConfirmed) RaceID() racers.RaceID { return e.Race }
+
+// PaymentRejected is published when a completed payment can not confirm a registration and it is refunded
+type PaymentRejected struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Payment    string
+	Reason     string
+}
+
+func (e PaymentRejected) RaceID() racers.RaceID { return e.Race }
+
+// ConfirmPayment confirms the registration paid, notifying the same payment again does nothing.
+// The payments of registrations expired, refunded or paid with other payment are refunded
+func (s Payments) ConfirmPayment(ctx context.Context, r PaymentCallback) error {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return err
+	}
+
+	competitor, err := racers.NewUserID(r.CompetitorID)
+	if err != nil {
+		return err
+	}
+
+	// rejected is the reason the payment is refunded, empty when it is not
+	var rejected string
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err := s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		changed, err := race.ConfirmPayment(competitor, r.Payment, racers.Money{Amount: r.Amount, Currency: racers.Currency(r.Currency)})
+
+		var (
+			notFound      racers.RegistrationNotFoundError
+			invalidStatus racers.InvalidRegistrationStatusError
+			mismatch      racers.PaymentMismatchError
+		)
+		switch {
+		case errors.As(err, &notFound), errors.As(err, &invalidStatus), errors.As(err, &mismatch):
+			rejected = err.Error()
+			return nil
+		case err != nil:
+			return err
+		case !changed:
+			return nil
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(RegistrationConfirmed{Race: race.ID, Competitor: competitor, Payment: r.Payment}, competitor))
+	})
+	if err != nil || rejected == "" {
+		return err
+	}
+
+	// the gateway is called once the race is unlocked, the gateway notifies the payment again when it fails
+	if err := s.gateway.Refund(ctx, r.Payment); err != nil {
+		return err
+	}
+
+	return s.uow(ctx, func(ctx context.Context) error {
+		return s.eb.Publish(ctx, newEvent(PaymentRejected{Race: raceID, Competitor: competitor, Payment: r.Payment, Reason: rejected}, competitor))
+	})
+}
+
+type RefundRegistration struct {
+	RaceID string
+	UserID string
+}
+
+// RegistrationRefunded is published when a paid registration is refunded and the competitor withdrawn
+type RegistrationRefunded struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Payment    string
+	Fee        racers.Money
+}
+
+func (e RegistrationRefunded) RaceID() racers.RaceID { return e.Race }
+
+// Refund refunds the fee of a confirmed registration and withdraws the competitor, done by the race editors.
+// The refund is committed pending before the gateway is called, so the gateway is never called in a transaction,
+// the payment is the idempotency key of the refund and the refunds left pending are resumed by ResumeRefunds
+func (s Payments) Refund(ctx context.Context, r RefundRegistration) (RaceRegistration, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	competitor, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	user := s.users.Current(ctx).ID
+
+	var reg racers.Registration
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err := s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		if err := permit(ctx, s.orgs, race, user, racers.PermissionEdit); err != nil {
+			return err
+		}
+
+		if reg, err = race.Refund(competitor); err != nil {
+			return err
+		}
+
+		return s.races.Save(ctx, race)
+	})
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	reg, err = s.completeRefund(ctx, raceID, competitor, reg.Payment, user)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	return RaceRegistration{Race: raceID, Competitor: competitor, Registration: reg}, nil
+}
+
+// completeRefund returns the payment of the pending refund and marks the registration refunded
+func (s Payments) completeRefund(ctx context.Context, raceID racers.RaceID, competitor racers.UserID, payment string, by racers.UserID) (racers.Registration, error) {
+	if err := s.gateway.Refund(ctx, payment); err != nil {
+		return racers.Registration{}, err
+	}
+
+	var reg racers.Registration
+	err := s.uow(ctx, func(ctx context.Context) error {
+		race, err := s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		var changed bool
+		reg, changed, err = race.CompleteRefund(competitor)
+		if err != nil || !changed {
+			return err
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(RegistrationRefunded{Race: raceID, Competitor: competitor, Payment: reg.Payment, Fee: reg.Fee}, by))
+	})
+
+	return reg, err
+}
+
+// ResumeRefunds completes the refunds left pending when the gateway failed, it returns the number of refunds completed
+func (s Payments) ResumeRefunds(ctx context.Context) (int, error) {
+	ids, err := s.races.WithPendingRefunds(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var refunded int
+	for _, id := range ids {
+		race, err := s.races.Get(ctx, id)
+		if err != nil {
+			return refunded, err
+		}
+
+		for _, c := range race.PendingRefunds() {
+			if _, err := s.completeRefund(ctx, race.ID, c, race.Registrations[c].Payment, race.Owner); err != nil {
+				return refunded, err
+			}
+			refunded++
+		}
+	}
+
+	return refunded, nil
+}
+
+// RegistrationExpired is published when a pending registration is not paid in time and its spot is released
+type RegistrationExpired struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+}
+
+func (e RegistrationExpired) RaceID() racers.RaceID { return e.Race }
+
+// ExpireRegistrations releases the spots of the pending registrations not paid in time,
+// it returns the number of registrations expired
+func (s Payments) ExpireRegistrations(ctx context.Context) (int, error) {
+	now := time.Now()
+	ids, err := s.races.WithExpiredRegistrations(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var expired int
+	for _, id := range ids {
+		err := s.uow(ctx, func(ctx context.Context) error {
+			race, err := s.races.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			competitors := race.ExpireRegistrations(now)
+			if len(competitors) == 0 {
+				return nil
+			}
+
+			if err := s.races.Save(ctx, race); err != nil {
+				return err
+			}
+
+			events := make([]Event, len(competitors))
+			for i, c := range competitors {
+				events[i] = newEvent(RegistrationExpired{Race: race.ID, Competitor: c}, c)
+			}
+			expired += len(competitors)
+
+			return s.eb.Publish(ctx, events...)
+		})
+		if err != nil {
+			return expired, err
+		}
+	}
+
+	return expired, nil
+}
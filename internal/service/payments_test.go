@@ -0,0 +1,240 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/payments"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestPayments(t *testing.T) {
+	suite.Run(t, new(paymentsSuite))
+}
+
+type paymentsSuite struct {
+	suite.Suite
+
+	service service.Payments
+	races   service.Races
+
+	race       racers.Race
+	owner      racers.User
+	competitor racers.User
+	current    racers.User
+
+	gateway  *payments.Fake
+	repo     *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *paymentsSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.competitor
+
+	s.race = racers.Race{
+		ID:    racers.RaceID(id.Generate()),
+		Date:  racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner: s.owner.ID,
+		Price: &racers.RacePrice{Currency: "EUR", Amount: 2000},
+	}
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+	}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc:     func(context.Context, racers.UserID) (racers.User, error) { return s.competitor, nil },
+	}
+	s.eventBus = &EventBusMock{}
+	s.gateway = payments.NewFake([]byte("secret"), "https://racers.example")
+
+	s.service = service.NewPayments(s.repo, nil, s.gateway, s.users, service.NoopUnitOfWork, s.eventBus)
+	s.races = service.NewRaces(s.repo, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+// pay joins the race, starts the checkout and returns the gateway callback
+func (s *paymentsSuite) pay() service.PaymentCallback {
+	reg, err := s.races.Join(context.Background(), service.JoinRace{RaceID: id.ID(s.race.ID).String(), UserID: id.ID(s.competitor.ID).String()})
+	s.Require().NoError(err)
+	s.Equal(racers.RegistrationPendingPayment, reg.Status)
+	s.Equal(racers.Money{Amount: 2000, Currency: "EUR"}, reg.Fee)
+
+	payment, err := s.service.Checkout(context.Background(), service.StartCheckout{RaceID: id.ID(s.race.ID).String()})
+	s.Require().NoError(err)
+
+	body, _, err := s.gateway.Complete(payment.ID)
+	s.Require().NoError(err)
+
+	var callback service.PaymentCallback
+	s.Require().NoError(json.Unmarshal(body, &callback))
+
+	return callback
+}
+
+func (s *paymentsSuite) TestCheckout_NoPendingRegistration() {
+	_, err := s.service.Checkout(context.Background(), service.StartCheckout{RaceID: id.ID(s.race.ID).String()})
+
+	s.True(errors.As(err, &racers.RegistrationNotFoundError{}))
+}
+
+func (s *paymentsSuite) TestConfirmPayment_Idempotent() {
+	callback := s.pay()
+
+	s.NoError(s.service.ConfirmPayment(context.Background(), callback))
+	s.Equal(racers.RegistrationConfirmed, s.race.Registrations[s.competitor.ID].Status)
+
+	published := len(s.eventBus.PublishCalls())
+	s.NoError(s.service.ConfirmPayment(context.Background(), callback))
+	s.Len(s.eventBus.PublishCalls(), published)
+
+	s.Equal(
+		service.RegistrationConfirmed{Race: s.race.ID, Competitor: s.competitor.ID, Payment: callback.Payment},
+		s.eventBus.PublishCalls()[published-1].Events[0].Payload,
+	)
+}
+
+func (s *paymentsSuite) TestConfirmPayment_ExpiredRefunds() {
+	callback := s.pay()
+	reg := s.race.Registrations[s.competitor.ID]
+	reg.ExpiresAt = time.Now().Add(-time.Second)
+	s.race.Registrations[s.competitor.ID] = reg
+	s.race.ExpireRegistrations(time.Now())
+
+	s.NoError(s.service.ConfirmPayment(context.Background(), callback))
+
+	p, _ := s.gateway.Payment(callback.Payment)
+	s.True(p.Refunded)
+	s.False(s.race.HasCompetitor(s.competitor.ID))
+}
+
+// unitOfWorkGateway tells if the payments are refunded in a unit of work
+type unitOfWorkGateway struct {
+	service.PaymentGateway
+	inUnitOfWork *bool
+	refundedIn   []bool
+}
+
+func (g *unitOfWorkGateway) Refund(ctx context.Context, payment string) error {
+	g.refundedIn = append(g.refundedIn, *g.inUnitOfWork)
+	return g.PaymentGateway.Refund(ctx, payment)
+}
+
+func (s *paymentsSuite) TestConfirmPayment_RefundsOutsideUnitOfWork() {
+	callback := s.pay()
+	reg := s.race.Registrations[s.competitor.ID]
+	reg.ExpiresAt = time.Now().Add(-time.Second)
+	s.race.Registrations[s.competitor.ID] = reg
+	s.race.ExpireRegistrations(time.Now())
+
+	var inUnitOfWork bool
+	uow := func(ctx context.Context, work service.Work) error {
+		inUnitOfWork = true
+		defer func() { inUnitOfWork = false }()
+		return work(ctx)
+	}
+	gateway := &unitOfWorkGateway{PaymentGateway: s.gateway, inUnitOfWork: &inUnitOfWork}
+
+	s.NoError(service.NewPayments(s.repo, nil, gateway, s.users, uow, s.eventBus).ConfirmPayment(context.Background(), callback))
+
+	s.Equal([]bool{false}, gateway.refundedIn, "the gateway is not called holding the race")
+	p, _ := s.gateway.Payment(callback.Payment)
+	s.True(p.Refunded)
+}
+
+func (s *paymentsSuite) TestRefund() {
+	callback := s.pay()
+	s.NoError(s.service.ConfirmPayment(context.Background(), callback))
+	req := service.RefundRegistration{RaceID: id.ID(s.race.ID).String(), UserID: id.ID(s.competitor.ID).String()}
+
+	s.Run("not owner", func() {
+		_, err := s.service.Refund(context.Background(), req)
+		s.Equal(service.ErrForbidden, err)
+	})
+
+	s.current = racers.User{ID: racers.UserID(id.Generate())}
+	s.race.Staff = racers.RaceStaff{s.current.ID: racers.StaffCoOrganizer}
+	reg, err := s.service.Refund(context.Background(), req)
+	s.NoError(err, "the co-organizers refund")
+
+	s.Equal(racers.RegistrationRefunded, reg.Status)
+	p, _ := s.gateway.Payment(callback.Payment)
+	s.True(p.Refunded)
+	s.False(s.race.HasCompetitor(s.competitor.ID))
+}
+
+func (s *paymentsSuite) TestRefund_GatewayFails() {
+	s.NoError(s.service.ConfirmPayment(context.Background(), s.pay()))
+	reg := s.race.Registrations[s.competitor.ID]
+	reg.Payment = "unknown"
+	s.race.Registrations[s.competitor.ID] = reg
+	published := len(s.eventBus.PublishCalls())
+
+	s.current = s.owner
+	_, err := s.service.Refund(context.Background(), service.RefundRegistration{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+	})
+	s.Equal(payments.ErrUnknownPayment, err)
+
+	s.Equal(racers.RegistrationRefundPending, s.race.Registrations[s.competitor.ID].Status, "the refund is committed before the gateway")
+	s.False(s.race.HasCompetitor(s.competitor.ID))
+	s.Len(s.eventBus.PublishCalls(), published, "the refund is not published until it is completed")
+}
+
+func (s *paymentsSuite) TestResumeRefunds() {
+	callback := s.pay()
+	s.NoError(s.service.ConfirmPayment(context.Background(), callback))
+	_, err := s.race.Refund(s.competitor.ID)
+	s.NoError(err)
+	s.repo.WithPendingRefundsFunc = func(context.Context) ([]racers.RaceID, error) {
+		return []racers.RaceID{s.race.ID}, nil
+	}
+
+	refunded, err := s.service.ResumeRefunds(context.Background())
+	s.NoError(err)
+	s.Equal(1, refunded)
+
+	s.Equal(racers.RegistrationRefunded, s.race.Registrations[s.competitor.ID].Status)
+	p, _ := s.gateway.Payment(callback.Payment)
+	s.True(p.Refunded)
+
+	calls := s.eventBus.PublishCalls()
+	s.Equal(
+		service.RegistrationRefunded{Race: s.race.ID, Competitor: s.competitor.ID, Payment: callback.Payment, Fee: racers.Money{Amount: 2000, Currency: "EUR"}},
+		calls[len(calls)-1].Events[0].Payload,
+	)
+
+	refunded, err = s.service.ResumeRefunds(context.Background())
+	s.NoError(err)
+	s.Zero(refunded, "completed once")
+}
+
+func (s *paymentsSuite) TestExpireRegistrations() {
+	s.pay()
+	reg := s.race.Registrations[s.competitor.ID]
+	reg.ExpiresAt = time.Now().Add(-time.Second)
+	s.race.Registrations[s.competitor.ID] = reg
+	s.repo.WithExpiredRegistrationsFunc = func(context.Context, time.Time) ([]racers.RaceID, error) {
+		return []racers.RaceID{s.race.ID}, nil
+	}
+
+	expired, err := s.service.ExpireRegistrations(context.Background())
+	s.NoError(err)
+
+	s.Equal(1, expired)
+	s.False(s.race.HasCompetitor(s.competitor.ID))
+	calls := s.eventBus.PublishCalls()
+	s.Equal(service.RegistrationExpired{Race: s.race.ID, Competitor: s.competitor.ID}, calls[len(calls)-1].Events[0].Payload)
+}
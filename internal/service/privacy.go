@@ -0,0 +1,239 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func NewPrivacy(data UserDataRepository, erasures ErasuresRepository, users UsersGetter, uow UnitOfWork, eb EventBus) Privacy {
+	return Privacy{data, erasures, users, uow, eb}
+}
+
+// Privacy serves the rights of the users over their personal data, the export of all of it and its erasure
+type Privacy struct {
+	data     UserDataRepository
+	erasures ErasuresRepository
+	users    UsersGetter
+	uow      UnitOfWork
+	eb       EventBus
+}
+
+// UserData are the records tied to a user by the data set they belong to, each record is a JSON object
+type UserData map[string][]json.RawMessage
+
+// DataExport is the zip file with the personal data of a user
+type DataExport struct {
+	FileName string
+	Data     []byte
+}
+
+// InvalidErasureIDError means the given erasure id is not correct
+type InvalidErasureIDError struct{ error }
+
+func (err InvalidErasureIDError) Error() string {
+	return fmt.Sprintf("invalid erasure id: %s", err.error)
+}
+
+// ErasureStatus is the progress of an erasure
+type ErasureStatus string
+
+const (
+	ErasurePending ErasureStatus = "PENDING"
+	ErasureRunning ErasureStatus = "RUNNING"
+	ErasureDone    ErasureStatus = "DONE"
+)
+
+// Erasure is the request of a user to be anonymized. The records of the user keep counting in the statistics
+// of the races, their user is replaced by a tombstone not linked to the user in any way. The erasure is the
+// only record kept of the user, so the user can follow its status
+type Erasure struct {
+	ID          id.ID
+	User        racers.UserID
+	Status      ErasureStatus
+	RequestedAt time.Time
+	// FinishedAt is nil until the erasure is done
+	FinishedAt *time.Time
+	// Error is the failure of the last attempt, the failed erasures are retried on the next run
+	Error string
+}
+
+// UserDataErased is published when the records of a user are anonymized, the event does not name the user
+type UserDataErased struct {
+	Erasure id.ID
+}
+
+// profile is the personal data of the user held by the identity provider
+type profile struct {
+	ID        racers.UserID `json:"id"`
+	Name      string        `json:"name"`
+	Email     string        `json:"email"`
+	BirthDate *time.Time    `json:"birthDate,omitempty"`
+	Gender    racers.Gender `json:"gender,omitempty"`
+}
+
+// ExportMyData returns a zip with a JSON file of the profile of the current user and one of each data set
+// with records tied to the user
+func (s Privacy) ExportMyData(ctx context.Context) (DataExport, error) {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return DataExport{}, ErrForbidden
+	}
+
+	data, err := s.data.Export(ctx, user.ID)
+	if err != nil {
+		return DataExport{}, err
+	}
+
+	p := profile{ID: user.ID, Name: user.Name, Email: user.Email, Gender: user.Gender}
+	if !user.BirthDate.IsZero() {
+		p.BirthDate = &user.BirthDate
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	if err := writeJSON(w, "profile.json", p); err != nil {
+		return DataExport{}, err
+	}
+
+	sets := make([]string, 0, len(data))
+	for set := range data {
+		sets = append(sets, set)
+	}
+	sort.Strings(sets)
+	for _, set := range sets {
+		if err := writeJSON(w, set+".json", data[set]); err != nil {
+			return DataExport{}, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return DataExport{}, errors.Wrap(err, "closing data export")
+	}
+
+	return DataExport{
+		FileName: fmt.Sprintf("racers-%s-%s.zip", user.ID, time.Now().UTC().Format("20060102")),
+		Data:     buf.Bytes(),
+	}, nil
+}
+
+func writeJSON(w *zip.Writer, name string, v interface{}) error {
+	f, err := w.Create(name)
+	if err != nil {
+		return errors.Wrap(err, "creating %s", name)
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+
+	return errors.Wrap(enc.Encode(v), "writing %s", name)
+}
+
+// EraseMyData requests the erasure of the current user, it is done asynchronously by ProcessErasures.
+// The erasure in progress is returned when the user already requested one
+func (s Privacy) EraseMyData(ctx context.Context) (Erasure, error) {
+	user := s.users.Current(ctx)
+	if user.ID == (racers.UserID{}) {
+		return Erasure{}, ErrForbidden
+	}
+
+	pending, err := s.erasures.PendingFor(ctx, user.ID)
+	if err == nil {
+		return pending, nil
+	}
+	if err != ErrErasureNotFound {
+		return Erasure{}, err
+	}
+
+	erasure := Erasure{ID: id.Generate(), User: user.ID, Status: ErasurePending, RequestedAt: time.Now()}
+	if err := s.erasures.Save(ctx, erasure); err != nil {
+		// a concurrent request of the user saved its erasure first, a user has a single erasure not done
+		if pending, perr := s.erasures.PendingFor(ctx, user.ID); perr == nil {
+			return pending, nil
+		}
+		return Erasure{}, err
+	}
+
+	return erasure, nil
+}
+
+// Erasure returns the erasure, only the user that requested it and the admins are allowed
+func (s Privacy) Erasure(ctx context.Context, erasureID string) (Erasure, error) {
+	eid, err := id.NewID(erasureID)
+	if err != nil {
+		return Erasure{}, InvalidErasureIDError{err}
+	}
+
+	erasure, err := s.erasures.Get(ctx, eid)
+	if err != nil {
+		return Erasure{}, err
+	}
+
+	current := s.users.Current(ctx)
+	if current.ID != erasure.User && !current.Admin {
+		return Erasure{}, ErrForbidden
+	}
+
+	return erasure, nil
+}
+
+// ProcessErasures anonymizes the users of the erasures not done, it returns the number of erasures done.
+// A failed erasure keeps its error and is retried on the next run
+func (s Privacy) ProcessErasures(ctx context.Context) (int, error) {
+	pending, err := s.erasures.Pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		done  int
+		first error
+	)
+	for _, e := range pending {
+		if err := s.erase(ctx, e); err != nil {
+			e.Error = err.Error()
+			if err := s.erasures.Save(ctx, e); err != nil {
+				return done, err
+			}
+			if first == nil {
+				first = errors.Wrap(err, "erasure %s", e.ID)
+			}
+			continue
+		}
+		done++
+	}
+
+	return done, first
+}
+
+// erase replaces the user of the erasure by a new tombstone in all its records and marks the erasure done
+func (s Privacy) erase(ctx context.Context, e Erasure) error {
+	e.Status = ErasureRunning
+	if err := s.erasures.Save(ctx, e); err != nil {
+		return err
+	}
+
+	tombstone := racers.UserID(id.Generate())
+
+	return s.uow(ctx, func(ctx context.Context) error {
+		if err := s.data.Anonymize(ctx, e.User, tombstone); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		e.Status, e.FinishedAt, e.Error = ErasureDone, &now, ""
+		if err := s.erasures.Save(ctx, e); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(UserDataErased{Erasure: e.ID}, systemUser))
+	})
+}
@@ -0,0 +1,245 @@
+package service_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestPrivacy(t *testing.T) {
+	suite.Run(t, new(privacySuite))
+}
+
+type privacySuite struct {
+	suite.Suite
+
+	service service.Privacy
+
+	user     racers.User
+	current  racers.User
+	erasures map[id.ID]service.Erasure
+
+	data     *UserDataRepositoryMock
+	repo     *ErasuresRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *privacySuite) SetupTest() {
+	s.user = racers.User{
+		ID:        racers.UserID(id.Generate()),
+		Name:      "Jane",
+		Email:     "jane@racers.app",
+		BirthDate: time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC),
+		Gender:    racers.GenderFemale,
+	}
+	s.current = s.user
+	s.erasures = make(map[id.ID]service.Erasure)
+
+	s.data = &UserDataRepositoryMock{
+		ExportFunc: func(context.Context, racers.UserID) (service.UserData, error) {
+			return service.UserData{
+				"races_competitors": {json.RawMessage(`{"race_id":"r1"}`), json.RawMessage(`{"race_id":"r2"}`)},
+				"events":            {json.RawMessage(`{"type":"UserJoinedRace"}`)},
+			}, nil
+		},
+	}
+	s.repo = &ErasuresRepositoryMock{
+		GetFunc: func(_ context.Context, eid id.ID) (service.Erasure, error) {
+			e, ok := s.erasures[eid]
+			if !ok {
+				return service.Erasure{}, service.ErrErasureNotFound
+			}
+			return e, nil
+		},
+		PendingFunc: func(context.Context) ([]service.Erasure, error) {
+			var pending []service.Erasure
+			for _, e := range s.erasures {
+				if e.Status != service.ErasureDone {
+					pending = append(pending, e)
+				}
+			}
+			return pending, nil
+		},
+		PendingForFunc: func(_ context.Context, user racers.UserID) (service.Erasure, error) {
+			for _, e := range s.erasures {
+				if e.User == user && e.Status != service.ErasureDone {
+					return e, nil
+				}
+			}
+			return service.Erasure{}, service.ErrErasureNotFound
+		},
+		SaveFunc: func(_ context.Context, e service.Erasure) error {
+			s.erasures[e.ID] = e
+			return nil
+		},
+	}
+	s.eventBus = &EventBusMock{}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+	}
+
+	s.service = service.NewPrivacy(s.data, s.repo, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *privacySuite) TestExportMyData_Anonymous() {
+	s.current = racers.User{}
+
+	_, err := s.service.ExportMyData(context.Background())
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s *privacySuite) TestExportMyData() {
+	export, err := s.service.ExportMyData(context.Background())
+	s.Require().NoError(err)
+
+	s.Contains(export.FileName, id.ID(s.user.ID).String())
+
+	r, err := zip.NewReader(bytes.NewReader(export.Data), int64(len(export.Data)))
+	s.Require().NoError(err)
+
+	files := make(map[string][]byte)
+	names := make([]string, len(r.File))
+	for i, f := range r.File {
+		rc, err := f.Open()
+		s.Require().NoError(err)
+		files[f.Name], err = ioutil.ReadAll(rc)
+		s.Require().NoError(err)
+		rc.Close()
+		names[i] = f.Name
+	}
+	s.Equal([]string{"profile.json", "events.json", "races_competitors.json"}, names)
+
+	var profile map[string]interface{}
+	s.Require().NoError(json.Unmarshal(files["profile.json"], &profile))
+	s.Equal(id.ID(s.user.ID).String(), profile["id"])
+	s.Equal("jane@racers.app", profile["email"])
+	s.Equal("1990-05-01T00:00:00Z", profile["birthDate"])
+
+	var registrations []map[string]string
+	s.Require().NoError(json.Unmarshal(files["races_competitors.json"], &registrations))
+	s.Equal([]map[string]string{{"race_id": "r1"}, {"race_id": "r2"}}, registrations)
+}
+
+func (s *privacySuite) TestEraseMyData() {
+	erasure, err := s.service.EraseMyData(context.Background())
+	s.Require().NoError(err)
+
+	s.Equal(s.user.ID, erasure.User)
+	s.Equal(service.ErasurePending, erasure.Status)
+	s.Empty(s.data.AnonymizeCalls(), "the erasure is done asynchronously")
+
+	s.Run("requested again", func() {
+		again, err := s.service.EraseMyData(context.Background())
+		s.Require().NoError(err)
+
+		s.Equal(erasure.ID, again.ID)
+		s.Len(s.erasures, 1)
+	})
+}
+
+func (s *privacySuite) TestEraseMyData_RequestedConcurrently() {
+	concurrent := service.Erasure{ID: id.Generate(), User: s.user.ID, Status: service.ErasurePending, RequestedAt: time.Now()}
+	s.repo.SaveFunc = func(context.Context, service.Erasure) error {
+		s.erasures[concurrent.ID] = concurrent
+		return errors.New("duplicate key value violates unique constraint")
+	}
+
+	erasure, err := s.service.EraseMyData(context.Background())
+	s.Require().NoError(err)
+
+	s.Equal(concurrent.ID, erasure.ID, "the erasure saved first is returned")
+}
+
+func (s *privacySuite) TestErasure() {
+	erasure, err := s.service.EraseMyData(context.Background())
+	s.Require().NoError(err)
+
+	s.Run("invalid id", func() {
+		_, err := s.service.Erasure(context.Background(), "nope")
+		s.IsType(service.InvalidErasureIDError{}, err)
+	})
+
+	s.Run("not found", func() {
+		_, err := s.service.Erasure(context.Background(), id.Generate().String())
+		s.Equal(service.ErrErasureNotFound, err)
+	})
+
+	s.Run("other user", func() {
+		s.current = racers.User{ID: racers.UserID(id.Generate())}
+		_, err := s.service.Erasure(context.Background(), erasure.ID.String())
+		s.Equal(service.ErrForbidden, err)
+	})
+
+	s.Run("admin", func() {
+		s.current = racers.User{ID: racers.UserID(id.Generate()), Admin: true}
+		got, err := s.service.Erasure(context.Background(), erasure.ID.String())
+		s.Require().NoError(err)
+		s.Equal(erasure, got)
+	})
+}
+
+func (s *privacySuite) TestProcessErasures() {
+	erasure, err := s.service.EraseMyData(context.Background())
+	s.Require().NoError(err)
+
+	done, err := s.service.ProcessErasures(context.Background())
+	s.Require().NoError(err)
+	s.Equal(1, done)
+
+	s.Require().Len(s.data.AnonymizeCalls(), 1)
+	call := s.data.AnonymizeCalls()[0]
+	s.Equal(s.user.ID, call.User)
+	s.NotEqual(s.user.ID, call.Tombstone)
+	s.NotEqual(racers.UserID{}, call.Tombstone)
+
+	stored := s.erasures[erasure.ID]
+	s.Equal(service.ErasureDone, stored.Status)
+	s.NotNil(stored.FinishedAt)
+
+	s.Require().Len(s.eventBus.PublishCalls(), 1)
+	event := s.eventBus.PublishCalls()[0].Events[0]
+	s.Equal(service.UserDataErased{Erasure: erasure.ID}, event.Payload)
+	s.NotEqual(s.user.ID, event.UserID, "the event does not name the user")
+
+	s.Run("nothing pending", func() {
+		done, err := s.service.ProcessErasures(context.Background())
+		s.Require().NoError(err)
+		s.Equal(0, done)
+		s.Len(s.data.AnonymizeCalls(), 1)
+	})
+}
+
+func (s *privacySuite) TestProcessErasures_Failed() {
+	erasure, err := s.service.EraseMyData(context.Background())
+	s.Require().NoError(err)
+
+	s.data.AnonymizeFunc = func(context.Context, racers.UserID, racers.UserID) error { return errors.New("database down") }
+
+	done, err := s.service.ProcessErasures(context.Background())
+	s.Error(err)
+	s.Equal(0, done)
+
+	stored := s.erasures[erasure.ID]
+	s.Equal(service.ErasurePending, stored.Status, "retried on the next run")
+	s.Equal("database down", stored.Error)
+	s.Nil(stored.FinishedAt)
+
+	s.data.AnonymizeFunc = nil
+
+	done, err = s.service.ProcessErasures(context.Background())
+	s.Require().NoError(err)
+	s.Equal(1, done)
+	s.Equal(service.ErasureDone, s.erasures[erasure.ID].Status)
+	s.Empty(s.erasures[erasure.ID].Error)
+}
@@ -7,12 +7,14 @@ import (
 	racers "github.com/xabi93/racers/internal"
 )
 
-func NewRaces(races RacesRepository, users UsersGetter, uow UnitOfWork, eb EventBus) Races {
-	return Races{races, users, eb, uow}
+func NewRaces(races RacesRepository, teams TeamsGetter, orgs OrganizationsGetter, users UsersGetter, uow UnitOfWork, eb EventBus) Races {
+	return Races{races, teams, orgs, users, eb, uow}
 }
 
 type Races struct {
 	races RacesRepository
+	teams TeamsGetter
+	orgs  OrganizationsGetter
 	users UsersGetter
 	eb    EventBus
 	uow   UnitOfWork
@@ -22,12 +24,196 @@ type CreateRace struct {
 	ID   string    `json:"id,omitempty"`
 	Name string    `json:"name,omitempty"`
 	Date time.Time `json:"date,omitempty"`
+	// Description is the free text the race is presented with
+	Description string           `json:"description,omitempty"`
+	Teams       *CreateRaceTeams `json:"teams,omitempty"`
+	Relay       *CreateRaceRelay `json:"relay,omitempty"`
+	// Categories are the distances of the race, competitors join one of them
+	Categories []CreateRaceCategory `json:"categories,omitempty"`
+	// Checkpoints are the intermediate timing points in course order
+	Checkpoints []CreateCheckpoint `json:"checkpoints,omitempty"`
+	// Bibs is nil when the race has no bib numbers
+	Bibs *CreateRaceBibs `json:"bibs,omitempty"`
+	// Venue is nil when the race has no venue
+	Venue *CreateRaceVenue `json:"venue,omitempty"`
+	// Price is nil when the race is free
+	Price *CreateRacePrice `json:"price,omitempty"`
+	// RegistrationDeadline is nil when the registration is open until the race is finished
+	RegistrationDeadline *time.Time `json:"registrationDeadline,omitempty"`
+}
+
+// CreateRacePrice is the entry fee of a race or category
+type CreateRacePrice struct {
+	Currency string `json:"currency,omitempty"`
+	// Amount is in the minor unit of the currency, like cents
+	Amount int64 `json:"amount,omitempty"`
+	// Tiers are the early-bird prices, sorted by date
+	Tiers []CreatePriceTier `json:"tiers,omitempty"`
+}
+
+type CreatePriceTier struct {
+	Until  time.Time `json:"until,omitempty"`
+	Amount int64     `json:"amount,omitempty"`
+}
+
+func (r CreateRacePrice) build() (racers.RacePrice, error) {
+	currency, err := racers.NewCurrency(r.Currency)
+	if err != nil {
+		return racers.RacePrice{}, err
+	}
+
+	tiers := make([]racers.PriceTier, len(r.Tiers))
+	for i, t := range r.Tiers {
+		tiers[i] = racers.PriceTier{Until: t.Until, Amount: t.Amount}
+	}
+
+	return racers.NewRacePrice(currency, r.Amount, tiers...)
+}
+
+// CreateRaceVenue is where the race takes place, the race times are shown in its time zone
+type CreateRaceVenue struct {
+	Name    string  `json:"name,omitempty"`
+	Address string  `json:"address,omitempty"`
+	Lat     float64 `json:"lat,omitempty"`
+	Lon     float64 `json:"lon,omitempty"`
+	// TimeZone is the IANA name of the zone, like Europe/Madrid
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+func (r CreateRaceVenue) build() (racers.Venue, error) {
+	tz, err := racers.NewTimeZone(r.TimeZone)
+	if err != nil {
+		return racers.Venue{}, err
+	}
+
+	return racers.NewVenue(r.Name, r.Address, r.Lat, r.Lon, tz)
+}
+
+// CreateRaceTeams allows teams to enter the race
+type CreateRaceTeams struct {
+	MinMembers int    `json:"min_members,omitempty"`
+	MaxMembers int    `json:"max_members,omitempty"`
+	Scoring    string `json:"scoring,omitempty"`
+	Counting   int    `json:"counting,omitempty"`
+}
+
+func (r CreateRaceTeams) build() (racers.RaceTeams, error) {
+	size, err := racers.NewRaceTeamSize(r.MinMembers, r.MaxMembers)
+	if err != nil {
+		return racers.RaceTeams{}, err
+	}
+
+	scoring, err := racers.NewTeamScoring(r.Scoring)
+	if err != nil {
+		return racers.RaceTeams{}, err
+	}
+
+	return racers.NewRaceTeams(size, scoring, r.Counting)
+}
+
+// CreateRaceRelay makes the race a relay with the given legs in running order
+type CreateRaceRelay struct {
+	Legs               []CreateRelayLeg `json:"legs,omitempty"`
+	AllowRepeatRunners bool             `json:"allow_repeat_runners,omitempty"`
+}
+
+type CreateRelayLeg struct {
+	Name     string `json:"name,omitempty"`
+	Distance int    `json:"distance,omitempty"`
+}
+
+func (r CreateRaceRelay) build() (racers.RaceRelay, error) {
+	legs := make([]racers.RelayLeg, len(r.Legs))
+	for i, l := range r.Legs {
+		name, err := racers.NewRelayLegName(l.Name)
+		if err != nil {
+			return racers.RaceRelay{}, err
+		}
+
+		distance, err := racers.NewDistance(l.Distance)
+		if err != nil {
+			return racers.RaceRelay{}, err
+		}
+
+		legs[i] = racers.RelayLeg{Name: name, Distance: distance}
+	}
+
+	return racers.NewRaceRelay(legs, r.AllowRepeatRunners)
+}
+
+type CreateRaceCategory struct {
+	Name string `json:"name,omitempty"`
+	// Distance in metres
+	Distance  int       `json:"distance,omitempty"`
+	StartTime time.Time `json:"start_time,omitempty"`
+	// Capacity is the max number of competitors, zero for no limit
+	Capacity int `json:"capacity,omitempty"`
+	// MinAge is the age required on the race day, zero for no limit
+	MinAge int `json:"min_age,omitempty"`
+	// Gender is empty when the category is open to any gender
+	Gender string `json:"gender,omitempty"`
+	// BibFrom and BibTo are the bib numbers reserved for the category, zero when it has no range
+	BibFrom int `json:"bib_from,omitempty"`
+	BibTo   int `json:"bib_to,omitempty"`
+	// Price overrides the race price, nil for the race one
+	Price *CreateRacePrice `json:"price,omitempty"`
+}
+
+func (r CreateRaceCategory) build() (racers.RaceCategory, error) {
+	name, err := racers.NewCategoryName(r.Name)
+	if err != nil {
+		return racers.RaceCategory{}, err
+	}
+
+	eligibility := racers.CategoryEligibility{MinAge: r.MinAge}
+	if r.Gender != "" {
+		if eligibility.Gender, err = racers.NewGender(r.Gender); err != nil {
+			return racers.RaceCategory{}, err
+		}
+	}
+
+	category, err := racers.NewRaceCategory(name, racers.Distance(r.Distance), r.StartTime, r.Capacity, eligibility)
+	if err != nil {
+		return racers.RaceCategory{}, err
+	}
+
+	if r.BibFrom != 0 || r.BibTo != 0 {
+		category.Bibs = &racers.BibRange{From: racers.Bib(r.BibFrom), To: racers.Bib(r.BibTo)}
+	}
+
+	if r.Price != nil {
+		price, err := r.Price.build()
+		if err != nil {
+			return racers.RaceCategory{}, err
+		}
+		category.Price = &price
+	}
+
+	return category, nil
+}
+
+// CreateRaceBibs configures how the bib numbers of the race are assigned
+type CreateRaceBibs struct {
+	Strategy string `json:"strategy,omitempty"`
+	// First is the number the sequential strategy starts from
+	First int `json:"first,omitempty"`
+}
+
+func (r CreateRaceBibs) build(categories racers.RaceCategories) (racers.RaceBibs, error) {
+	strategy, err := racers.NewBibStrategy(r.Strategy)
+	if err != nil {
+		return racers.RaceBibs{}, err
+	}
+
+	return racers.NewRaceBibs(strategy, racers.Bib(r.First), categories)
 }
 
 type RaceCreated struct {
 	Race racers.Race `json:"race,omitempty"`
 }
 
+func (e RaceCreated) RaceID() racers.RaceID { return e.Race.ID }
+
 func (s Races) Create(ctx context.Context, r CreateRace) (race racers.Race, err error) {
 	id, err := racers.NewRaceID(r.ID)
 	if err != nil {
@@ -43,10 +229,82 @@ func (s Races) Create(ctx context.Context, r CreateRace) (race racers.Race, err
 	}
 
 	race = racers.Race{
-		ID:    id,
-		Name:  name,
-		Date:  date,
-		Owner: s.users.Current(ctx).ID,
+		ID:          id,
+		Name:        name,
+		Date:        date,
+		Description: r.Description,
+		Owner:       s.users.Current(ctx).ID,
+		Status:      racers.RaceOpen,
+	}
+
+	if r.RegistrationDeadline != nil {
+		if err := race.SetRegistrationDeadline(*r.RegistrationDeadline); err != nil {
+			return racers.Race{}, err
+		}
+	}
+
+	if r.Venue != nil {
+		venue, err := r.Venue.build()
+		if err != nil {
+			return racers.Race{}, err
+		}
+		race.Venue = &venue
+	}
+
+	if r.Price != nil {
+		price, err := r.Price.build()
+		if err != nil {
+			return racers.Race{}, err
+		}
+		race.Price = &price
+	}
+
+	if r.Teams != nil {
+		teams, err := r.Teams.build()
+		if err != nil {
+			return racers.Race{}, err
+		}
+		race.Teams = &teams
+	}
+
+	if r.Relay != nil {
+		relay, err := r.Relay.build()
+		if err != nil {
+			return racers.Race{}, err
+		}
+		race.Relay = &relay
+	}
+
+	if len(r.Categories) > 0 {
+		categories := make([]racers.RaceCategory, len(r.Categories))
+		for i, c := range r.Categories {
+			if categories[i], err = c.build(); err != nil {
+				return racers.Race{}, err
+			}
+		}
+		if race.Categories, err = racers.NewRaceCategories(categories...); err != nil {
+			return racers.Race{}, err
+		}
+	}
+
+	if r.Bibs != nil {
+		bibs, err := r.Bibs.build(race.Categories)
+		if err != nil {
+			return racers.Race{}, err
+		}
+		race.Bibs = &bibs
+	} else {
+		for _, c := range race.Categories {
+			if c.Bibs != nil {
+				return racers.Race{}, racers.InvalidRaceBibsError{Reason: "bib ranges require the category range strategy"}
+			}
+		}
+	}
+
+	if len(r.Checkpoints) > 0 {
+		if race.Checkpoints, err = buildCheckpoints(r.Checkpoints); err != nil {
+			return racers.Race{}, err
+		}
 	}
 
 	exists, err := s.races.Exists(ctx, race)
@@ -92,6 +350,8 @@ func (s Races) Get(ctx context.Context, r GetRace) (racers.Race, error) {
 type JoinRace struct {
 	RaceID string
 	UserID string
+	// Category is required when the race has categories
+	Category string
 }
 
 type UserJoinedRace struct {
@@ -99,40 +359,196 @@ type UserJoinedRace struct {
 	Race racers.Race
 }
 
-func (s Races) Join(ctx context.Context, r JoinRace) error {
+func (e UserJoinedRace) RaceID() racers.RaceID { return e.Race.ID }
+
+// RaceRegistration is the registration of a competitor in a race
+type RaceRegistration struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	racers.Registration
+}
+
+// Join registers the user in the race, the registrations of paid races are pending until the fee is paid.
+// The expired pending registrations are released before, so their spots can be taken
+func (s Races) Join(ctx context.Context, r JoinRace) (RaceRegistration, error) {
 	raceID, err := racers.NewRaceID(r.RaceID)
 	if err != nil {
-		return err
+		return RaceRegistration{}, err
 	}
 
 	competitorID, err := racers.NewUserID(r.UserID)
 	if err != nil {
-		return err
+		return RaceRegistration{}, err
 	}
 
-	race, err := s.races.Get(ctx, raceID)
+	user, err := s.users.Get(ctx, competitorID)
 	if err != nil {
-		return err
+		return RaceRegistration{}, err
 	}
 
-	user, err := s.users.Get(ctx, competitorID)
+	race, err := s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		now := time.Now()
+		expired := race.ExpireRegistrations(now)
+
+		if err := race.Join(user, racers.CategoryName(r.Category), now); err != nil {
+			return nil, err
+		}
+
+		return joinEvents(*race, user, expired, s.users.Current(ctx).ID), nil
+	})
 	if err != nil {
-		return err
+		return RaceRegistration{}, err
 	}
 
-	if err := race.Join(user); err != nil {
-		return err
+	reg, _ := race.Registration(user.ID)
+
+	return RaceRegistration{Race: race.ID, Competitor: user.ID, Registration: reg}, nil
+}
+
+// joinEvents returns the events of the user joining the race, after the expired registrations were released
+func joinEvents(race racers.Race, user racers.User, expired []racers.UserID, by racers.UserID) []Event {
+	events := make([]Event, 0, len(expired)+2)
+	for _, c := range expired {
+		events = append(events, newEvent(RegistrationExpired{Race: race.ID, Competitor: c}, by))
 	}
 
-	return s.uow(ctx, func(ctx context.Context) error {
+	events = append(events, newEvent(UserJoinedRace{Race: race, User: user}, by))
+	if bib, ok := race.BibEntries[user.ID]; ok {
+		events = append(events, newEvent(BibAssigned{Race: race.ID, Competitor: user.ID, Bib: bib}, by))
+	}
+
+	return events
+}
+
+func (s Races) List(ctx context.Context) ([]racers.Race, error) {
+	return s.races.All(ctx)
+}
+
+// update applies the change to the race and saves it with the events of the change in a unit of work. The race
+// is locked from it is read until it is saved, so concurrent changes of a race are applied one after the other
+// and none is lost. A change without events leaves the race as it was
+func (s Races) update(ctx context.Context, id racers.RaceID, change func(*racers.Race) ([]Event, error)) (racers.Race, error) {
+	var race racers.Race
+	err := s.uow(ctx, func(ctx context.Context) error {
+		var err error
+		if race, err = s.races.Get(ctx, id); err != nil {
+			return err
+		}
+
+		events, err := change(&race)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
 		if err := s.races.Save(ctx, race); err != nil {
 			return err
 		}
 
-		return s.eb.Publish(ctx, newEvent(UserJoinedRace{Race: race, User: user}, s.users.Current(ctx).ID))
+		return s.eb.Publish(ctx, events...)
 	})
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return race, nil
 }
 
-func (s Races) List(ctx context.Context) ([]racers.Race, error) {
-	return s.races.All(ctx)
+// checkPermission returns ErrForbidden if the role of the current user in the race lacks the permission
+// and the user is not an admin of the organization owning the race
+func (s Races) checkPermission(ctx context.Context, race racers.Race, p racers.Permission) error {
+	return permit(ctx, s.orgs, race, s.users.Current(ctx).ID, p)
+}
+
+type RecordResult struct {
+	RaceID string
+	// UserID or Bib identify the competitor
+	UserID string
+	Bib    int
+	// Time is the finish time in [[hh:]mm:]ss[.sss] format
+	Time string
+}
+
+type ResultRecorded struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Time       racers.RaceTime
+}
+
+func (e ResultRecorded) RaceID() racers.RaceID { return e.Race }
+
+// RecordResult sets the finish time of a competitor, only the race timekeepers and organizers are allowed
+func (s Races) RecordResult(ctx context.Context, r RecordResult) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	raceTime, err := racers.ParseRaceTime(r.Time)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionResults); err != nil {
+			return nil, err
+		}
+
+		competitorID, err := resolveCompetitor(*race, r.UserID, r.Bib)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := race.Finish(competitorID, raceTime); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(ResultRecorded{Race: race.ID, Competitor: competitorID, Time: raceTime}, s.users.Current(ctx).ID)}, nil
+	})
+}
+
+type EnterTeam struct {
+	RaceID  string
+	TeamID  string
+	Members []string
+}
+
+type TeamEnteredRace struct {
+	Race    racers.RaceID
+	Team    racers.TeamID
+	Members []racers.UserID
+}
+
+func (e TeamEnteredRace) RaceID() racers.RaceID { return e.Race }
+
+// EnterTeam registers a team in a race, done by the team admin
+func (s Races) EnterTeam(ctx context.Context, r EnterTeam) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	teamID, err := racers.NewTeamID(r.TeamID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	members := make([]racers.UserID, len(r.Members))
+	for i, m := range r.Members {
+		if members[i], err = racers.NewUserID(m); err != nil {
+			return racers.Race{}, err
+		}
+	}
+
+	team, err := s.teams.Get(ctx, teamID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := race.EnterTeam(team, s.users.Current(ctx), members...); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(TeamEnteredRace{Race: race.ID, Team: team.ID, Members: race.TeamEntries[team.ID]}, s.users.Current(ctx).ID)}, nil
+	})
 }
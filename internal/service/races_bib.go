@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type AssignBib struct {
+	RaceID string
+	UserID string
+	Bib    int
+}
+
+// BibAssigned is published when a competitor gets a bib number, on registration or by the race owner
+type BibAssigned struct {
+	Race       racers.RaceID
+	Competitor racers.UserID
+	Bib        racers.Bib
+}
+
+func (e BibAssigned) RaceID() racers.RaceID { return e.Race }
+
+// AssignBib overrides the bib number of a competitor, only the race organizers are allowed
+func (s Races) AssignBib(ctx context.Context, r AssignBib) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	competitorID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	bib, err := racers.NewBib(r.Bib)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionEdit); err != nil {
+			return nil, err
+		}
+
+		if err := race.AssignBib(competitorID, bib); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(BibAssigned{Race: race.ID, Competitor: competitorID, Bib: bib}, s.users.Current(ctx).ID)}, nil
+	})
+}
+
+// resolveCompetitor returns the competitor identified by the user id, or by the bib when the user id is empty
+func resolveCompetitor(race racers.Race, userID string, bib int) (racers.UserID, error) {
+	if userID != "" || bib == 0 {
+		return racers.NewUserID(userID)
+	}
+
+	b, err := racers.NewBib(bib)
+	if err != nil {
+		return racers.UserID{}, err
+	}
+
+	return race.CompetitorByBib(b)
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesBib(t *testing.T) {
+	suite.Run(t, new(createBibsRaceSuite))
+	suite.Run(t, new(assignBibSuite))
+}
+
+type createBibsRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.CreateRace
+}
+
+func (s *createBibsRaceSuite) SetupTest() {
+	start := time.Now().AddDate(0, 1, 0)
+	s.req = service.CreateRace{
+		ID:   id.Generate().String(),
+		Name: "Behobia",
+		Date: start,
+		Categories: []service.CreateRaceCategory{
+			{Name: "10K", Distance: 10000, StartTime: start, BibFrom: 1, BibTo: 999},
+			{Name: "20K", Distance: 20000, StartTime: start, BibFrom: 1000, BibTo: 1999},
+		},
+		Bibs: &service.CreateRaceBibs{Strategy: "category_range"},
+	}
+
+	s.service = service.NewRaces(&RacesRepositoryMock{}, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s createBibsRaceSuite) TestCreateBibsRace_InvalidBibs() {
+	s.Run("invalid strategy", func() {
+		req := s.req
+		req.Bibs = &service.CreateRaceBibs{Strategy: "random"}
+
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidBibStrategyError{}))
+	})
+
+	s.Run("ranges without strategy", func() {
+		req := s.req
+		req.Bibs = nil
+
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidRaceBibsError{}))
+	})
+}
+
+func (s createBibsRaceSuite) TestCreateBibsRace_Success() {
+	result, err := s.service.Create(context.Background(), s.req)
+
+	s.NoError(err)
+	s.Equal(&racers.RaceBibs{Strategy: racers.BibStrategyCategoryRange}, result.Bibs)
+	s.Equal(&racers.BibRange{From: 1000, To: 1999}, result.Categories[1].Bibs)
+}
+
+type assignBibSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.AssignBib
+
+	dummyRace  racers.Race
+	competitor racers.User
+	owner      racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *assignBibSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+		GetFunc: func(context.Context, racers.UserID) (racers.User, error) {
+			return s.competitor, nil
+		},
+	}
+
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Behobia"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor.ID),
+		Bibs:        &racers.RaceBibs{Strategy: racers.BibStrategySequential, First: 1},
+		BibEntries:  racers.RaceBibEntries{s.competitor.ID: 1},
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.AssignBib{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+		Bib:    77,
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s assignBibSuite) TestAssignBib_InvalidRequest() {
+	for field, r := range map[string]service.AssignBib{
+		"race_id": {UserID: s.req.UserID, Bib: s.req.Bib},
+		"user_id": {RaceID: s.req.RaceID, Bib: s.req.Bib},
+		"bib":     {RaceID: s.req.RaceID, UserID: s.req.UserID, Bib: -1},
+	} {
+		s.Run(field, func() {
+			_, err := s.service.AssignBib(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s assignBibSuite) TestAssignBib_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.AssignBib(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s assignBibSuite) TestAssignBib_Success() {
+	result, err := s.service.AssignBib(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Equal(racers.RaceBibEntries{s.competitor.ID: 77}, result.BibEntries)
+	s.Len(s.races.SaveCalls(), 1)
+	s.Equal(
+		service.BibAssigned{Race: s.dummyRace.ID, Competitor: s.competitor.ID, Bib: 77},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
+
+func (s assignBibSuite) TestJoinRace_AssignsBib() {
+	joining := racers.User{ID: racers.UserID(id.Generate())}
+	s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
+		return joining, nil
+	}
+
+	_, err := s.service.Join(context.Background(), service.JoinRace{RaceID: s.req.RaceID, UserID: id.ID(joining.ID).String()})
+	s.NoError(err)
+
+	events := s.eventBus.PublishCalls()[0].Events
+	s.Len(events, 2)
+	s.Equal(service.BibAssigned{Race: s.dummyRace.ID, Competitor: joining.ID, Bib: 2}, events[1].Payload)
+}
+
+func (s assignBibSuite) TestRecordResult_ByBib() {
+	result, err := s.service.RecordResult(context.Background(), service.RecordResult{RaceID: s.req.RaceID, Bib: 1, Time: "40:00"})
+	s.NoError(err)
+	s.Equal(racers.RaceResults{s.competitor.ID: racers.RaceTime(40 * time.Minute)}, result.Results)
+
+	_, err = s.service.RecordResult(context.Background(), service.RecordResult{RaceID: s.req.RaceID, Bib: 2, Time: "40:00"})
+	s.True(errors.As(err, &racers.UnknownBibError{}))
+}
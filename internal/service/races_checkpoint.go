@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type CreateCheckpoint struct {
+	Name string `json:"name,omitempty"`
+	// Distance along the course in metres
+	Distance int `json:"distance,omitempty"`
+	// Cutoff is the max time since the start in [[hh:]mm:]ss format, empty for no cutoff
+	Cutoff string `json:"cutoff,omitempty"`
+}
+
+func (r CreateCheckpoint) build() (racers.Checkpoint, error) {
+	name, err := racers.NewCheckpointName(r.Name)
+	if err != nil {
+		return racers.Checkpoint{}, err
+	}
+
+	c := racers.Checkpoint{Name: name, Distance: racers.Distance(r.Distance)}
+	if r.Cutoff != "" {
+		if c.Cutoff, err = racers.ParseRaceTime(r.Cutoff); err != nil {
+			return racers.Checkpoint{}, err
+		}
+	}
+
+	return c, nil
+}
+
+func buildCheckpoints(cc []CreateCheckpoint) (racers.RaceCheckpoints, error) {
+	checkpoints := make([]racers.Checkpoint, len(cc))
+	for i, c := range cc {
+		var err error
+		if checkpoints[i], err = c.build(); err != nil {
+			return nil, err
+		}
+	}
+
+	return racers.NewRaceCheckpoints(checkpoints...)
+}
+
+type Passage struct {
+	// UserID or Bib identify the competitor
+	UserID     string
+	Bib        int
+	Checkpoint string
+	At         time.Time
+}
+
+type RecordPassages struct {
+	RaceID   string
+	Passages []Passage
+}
+
+// RejectedPassage is a passage of the batch that was not recorded
+type RejectedPassage struct {
+	// Index is the position of the passage in the request
+	Index int
+	Err   error
+}
+
+type PassagesRecorded struct {
+	Race     racers.Race
+	Rejected []RejectedPassage
+}
+
+// PassageRecorded is published for each recorded passage, so live trackers can follow the competitors
+type PassageRecorded struct {
+	Race         racers.RaceID
+	Competitor   racers.UserID
+	Checkpoint   racers.CheckpointName
+	At           time.Time
+	Elapsed      racers.RaceTime
+	CutoffMissed bool
+}
+
+func (e PassageRecorded) RaceID() racers.RaceID { return e.Race }
+
+// RecordPassages ingests a batch of checkpoint passages from a timing system, only the race timekeepers and organizers are allowed.
+// Invalid passages are rejected one by one and the rest of the batch is recorded.
+func (s Races) RecordPassages(ctx context.Context, r RecordPassages) (PassagesRecorded, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return PassagesRecorded{}, err
+	}
+
+	var rejected []RejectedPassage
+	race, err := s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionResults); err != nil {
+			return nil, err
+		}
+
+		var recorded []PassageRecorded
+		for i, p := range r.Passages {
+			competitor, err := resolveCompetitor(*race, p.UserID, p.Bib)
+			if err != nil {
+				rejected = append(rejected, RejectedPassage{i, err})
+				continue
+			}
+
+			checkpoint := racers.CheckpointName(p.Checkpoint)
+			if err := race.RecordPassage(competitor, checkpoint, p.At); err != nil {
+				rejected = append(rejected, RejectedPassage{i, err})
+				continue
+			}
+
+			recorded = append(recorded, PassageRecorded{Race: race.ID, Competitor: competitor, Checkpoint: checkpoint, At: p.At})
+		}
+
+		events := make([]Event, len(recorded))
+		for i, e := range recorded {
+			for _, split := range race.Splits(e.Competitor) {
+				if split.Checkpoint == e.Checkpoint {
+					e.Elapsed, e.CutoffMissed = split.Elapsed, split.CutoffMissed
+				}
+			}
+			events[i] = newEvent(e, s.users.Current(ctx).ID)
+		}
+
+		return events, nil
+	})
+	if err != nil {
+		return PassagesRecorded{}, err
+	}
+
+	return PassagesRecorded{Race: race, Rejected: rejected}, nil
+}
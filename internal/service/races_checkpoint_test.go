@@ -0,0 +1,187 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesCheckpoint(t *testing.T) {
+	suite.Run(t, new(createCheckpointsRaceSuite))
+	suite.Run(t, new(recordPassagesSuite))
+}
+
+type createCheckpointsRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.CreateRace
+}
+
+func (s *createCheckpointsRaceSuite) SetupTest() {
+	s.req = service.CreateRace{
+		ID:   id.Generate().String(),
+		Name: "Zegama",
+		Date: time.Now().AddDate(0, 1, 0),
+		Checkpoints: []service.CreateCheckpoint{
+			{Name: "Sancti Spiritu", Distance: 11000},
+			{Name: "Aizkorri", Distance: 21000, Cutoff: "4:30:00"},
+		},
+	}
+
+	s.service = service.NewRaces(&RacesRepositoryMock{}, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s createCheckpointsRaceSuite) TestCreateCheckpointsRace_InvalidCheckpoints() {
+	for name, checkpoints := range map[string][]service.CreateCheckpoint{
+		"without name":   {{Distance: 5000}},
+		"invalid cutoff": {{Name: "first", Distance: 5000, Cutoff: "soon"}},
+		"not sorted":     {{Name: "first", Distance: 5000}, {Name: "second", Distance: 1000}},
+	} {
+		s.Run(name, func() {
+			req := s.req
+			req.Checkpoints = checkpoints
+
+			_, err := s.service.Create(context.Background(), req)
+			s.Error(err)
+		})
+	}
+}
+
+func (s createCheckpointsRaceSuite) TestCreateCheckpointsRace_Success() {
+	result, err := s.service.Create(context.Background(), s.req)
+
+	s.NoError(err)
+	s.Equal(racers.RaceCheckpoints{
+		{Name: "Sancti Spiritu", Distance: 11000},
+		{Name: "Aizkorri", Distance: 21000, Cutoff: racers.RaceTime(4*time.Hour + 30*time.Minute)},
+	}, result.Checkpoints)
+}
+
+type recordPassagesSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.RecordPassages
+
+	dummyRace  racers.Race
+	competitor racers.UserID
+	owner      racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *recordPassagesSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	s.competitor = racers.UserID(id.Generate())
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Zegama"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 0, -1)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor),
+		Checkpoints: racers.RaceCheckpoints{
+			{Name: "Sancti Spiritu", Distance: 11000, Cutoff: racers.RaceTime(time.Hour)},
+		},
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.RecordPassages{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		Passages: []service.Passage{
+			{UserID: id.ID(s.competitor).String(), Checkpoint: "Sancti Spiritu", At: time.Time(s.dummyRace.Date).Add(70 * time.Minute)},
+		},
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s recordPassagesSuite) TestRecordPassages_InvalidRaceID() {
+	s.req.RaceID = "invalid"
+
+	_, err := s.service.RecordPassages(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.InvalidRaceIDError{}))
+}
+
+func (s recordPassagesSuite) TestRecordPassages_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.RecordPassages(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s recordPassagesSuite) TestRecordPassages_AllRejected() {
+	s.req.Passages = []service.Passage{
+		{UserID: "invalid", Checkpoint: "Sancti Spiritu", At: time.Now()},
+		{UserID: id.ID(s.competitor).String(), Checkpoint: "Aizkorri", At: time.Now()},
+	}
+
+	result, err := s.service.RecordPassages(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Len(result.Rejected, 2)
+	s.True(errors.As(result.Rejected[0].Err, &racers.InvalidUserIDError{}))
+	s.True(errors.As(result.Rejected[1].Err, &racers.UnknownCheckpointError{}))
+	s.Len(s.races.SaveCalls(), 0)
+	s.Len(s.eventBus.PublishCalls(), 0)
+}
+
+func (s recordPassagesSuite) TestRecordPassages_PublishEventsFails() {
+	s.eventBus.PublishFunc = func(context.Context, ...service.Event) error {
+		return errors.New("")
+	}
+
+	_, err := s.service.RecordPassages(context.Background(), s.req)
+
+	s.Error(err)
+}
+
+func (s recordPassagesSuite) TestRecordPassages_Success() {
+	s.req.Passages = append(s.req.Passages, service.Passage{UserID: id.ID(s.competitor).String(), Checkpoint: "Aizkorri"})
+
+	result, err := s.service.RecordPassages(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Len(result.Rejected, 1)
+	s.Equal(1, result.Rejected[0].Index)
+
+	at := s.req.Passages[0].At
+	s.Equal(racers.RacePassages{s.competitor: {"Sancti Spiritu": at}}, result.Race.Passages)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(
+		service.PassageRecorded{
+			Race:         s.dummyRace.ID,
+			Competitor:   s.competitor,
+			Checkpoint:   "Sancti Spiritu",
+			At:           at,
+			Elapsed:      racers.RaceTime(70 * time.Minute),
+			CutoffMissed: true,
+		},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/track"
+)
+
+// MaxCourseFileSize is the max size in bytes of an uploaded course file
+const MaxCourseFileSize = 10 << 20
+
+type UploadCourse struct {
+	RaceID string
+	// Category is empty when the course is the race one
+	Category string
+	// File is a GPX or KML document
+	File io.Reader
+}
+
+type CourseUploaded struct {
+	Race     racers.RaceID
+	Category racers.CategoryName
+	Format   racers.CourseFormat
+	Distance racers.Distance
+}
+
+func (e CourseUploaded) RaceID() racers.RaceID { return e.Race }
+
+// UploadCourse parses the track file and attaches the course to the race, only the race organizers are allowed
+func (s Races) UploadCourse(ctx context.Context, r UploadCourse) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r.File, MaxCourseFileSize+1))
+	if err != nil {
+		return racers.Race{}, err
+	}
+	if len(data) > MaxCourseFileSize {
+		return racers.Race{}, ErrCourseFileTooLarge
+	}
+
+	format, points, err := track.Parse(data)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	course, err := racers.NewCourse(points)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	category := racers.CategoryName(r.Category)
+
+	var race racers.Race
+	err = s.uow(ctx, func(ctx context.Context) error {
+		race, err = s.races.Get(ctx, raceID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkPermission(ctx, race, racers.PermissionEdit); err != nil {
+			return err
+		}
+
+		if err := race.SetCourse(category, course); err != nil {
+			return err
+		}
+
+		if err := s.races.Save(ctx, race); err != nil {
+			return err
+		}
+
+		if err := s.races.SaveCourseFile(ctx, race.ID, category, racers.CourseFile{Format: format, Data: data}); err != nil {
+			return err
+		}
+
+		return s.eb.Publish(ctx, newEvent(CourseUploaded{
+			Race:     race.ID,
+			Category: category,
+			Format:   format,
+			Distance: course.Distance,
+		}, s.users.Current(ctx).ID))
+	})
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return race, nil
+}
+
+type GetCourseFile struct {
+	RaceID string
+	// Category is empty to get the race course
+	Category string
+}
+
+// CourseFile returns the course file as it was uploaded
+func (s Races) CourseFile(ctx context.Context, r GetCourseFile) (racers.CourseFile, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.CourseFile{}, err
+	}
+
+	return s.races.CourseFile(ctx, raceID, racers.CategoryName(r.Category))
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesCourse(t *testing.T) {
+	suite.Run(t, new(uploadCourseSuite))
+}
+
+const courseGPX = `<gpx><trk><trkseg>
+<trkpt lat="43.3183" lon="-1.9812"><ele>10</ele></trkpt>
+<trkpt lat="43.3190" lon="-1.9800"><ele>25</ele></trkpt>
+</trkseg></trk></gpx>`
+
+type uploadCourseSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.UploadCourse
+
+	dummyRace racers.Race
+	owner     racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *uploadCourseSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	s.dummyRace = racers.Race{
+		ID:         racers.RaceID(id.Generate()),
+		Name:       racers.RaceName("Behobia"),
+		Date:       racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:      s.owner.ID,
+		Categories: racers.RaceCategories{{Name: "20K", Distance: 20000}},
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.UploadCourse{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		File:   strings.NewReader(courseGPX),
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s uploadCourseSuite) TestUploadCourse_InvalidFile() {
+	for name, file := range map[string]string{
+		"unknown format": "lat,lon\n43,-1",
+		"one point":      `<gpx><trk><trkseg><trkpt lat="43" lon="-1"/></trkseg></trk></gpx>`,
+	} {
+		s.Run(name, func() {
+			req := s.req
+			req.File = strings.NewReader(file)
+
+			_, err := s.service.UploadCourse(context.Background(), req)
+			s.Error(err)
+		})
+	}
+}
+
+func (s uploadCourseSuite) TestUploadCourse_TooLarge() {
+	s.req.File = strings.NewReader(strings.Repeat(" ", service.MaxCourseFileSize+1))
+
+	_, err := s.service.UploadCourse(context.Background(), s.req)
+
+	s.Equal(service.ErrCourseFileTooLarge, err)
+}
+
+func (s uploadCourseSuite) TestUploadCourse_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.UploadCourse(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+	s.Len(s.races.SaveCalls(), 0)
+}
+
+func (s uploadCourseSuite) TestUploadCourse_UnknownCategory() {
+	s.req.Category = "10K"
+
+	_, err := s.service.UploadCourse(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.UnknownCategoryError{}))
+}
+
+func (s uploadCourseSuite) TestUploadCourse_SaveFileFails() {
+	s.races.SaveCourseFileFunc = func(context.Context, racers.RaceID, racers.CategoryName, racers.CourseFile) error {
+		return errors.New("")
+	}
+
+	_, err := s.service.UploadCourse(context.Background(), s.req)
+
+	s.Error(err)
+	s.Len(s.eventBus.PublishCalls(), 0)
+}
+
+func (s uploadCourseSuite) TestUploadCourse_Success() {
+	s.req.Category = "20K"
+
+	result, err := s.service.UploadCourse(context.Background(), s.req)
+	s.NoError(err)
+
+	course := result.Categories[0].Course
+	s.Require().NotNil(course)
+	s.Equal(15, course.ElevationGain)
+	s.Len(course.Points, 2)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Require().Len(s.races.SaveCourseFileCalls(), 1)
+	s.Equal(racers.CategoryName("20K"), s.races.SaveCourseFileCalls()[0].Category)
+	s.Equal(racers.CourseFile{Format: racers.CourseFormatGPX, Data: []byte(courseGPX)}, s.races.SaveCourseFileCalls()[0].File)
+
+	s.Equal(
+		service.CourseUploaded{Race: s.dummyRace.ID, Category: "20K", Format: racers.CourseFormatGPX, Distance: course.Distance},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
+
+func (s uploadCourseSuite) TestCourseFile() {
+	s.Run("invalid race id", func() {
+		_, err := s.service.CourseFile(context.Background(), service.GetCourseFile{})
+		s.True(errors.As(err, &racers.InvalidRaceIDError{}))
+	})
+
+	s.Run("not found", func() {
+		s.races.CourseFileFunc = func(context.Context, racers.RaceID, racers.CategoryName) (racers.CourseFile, error) {
+			return racers.CourseFile{}, service.ErrCourseNotFound
+		}
+
+		_, err := s.service.CourseFile(context.Background(), service.GetCourseFile{RaceID: s.req.RaceID})
+		s.Equal(service.ErrCourseNotFound, err)
+	})
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// StartListEntry is a competitor of the start list, sorted by bib
+type StartListEntry struct {
+	// Bib is zero when the competitor has no bib number
+	Bib        racers.Bib
+	Competitor racers.UserID
+	// Name is empty unless the race staff checking the competitors in exports the list
+	Name     string
+	Category racers.CategoryName
+	// Team is empty when the competitor has not entered the race with a team
+	Team racers.TeamName
+}
+
+// ResultSheetEntry is a finisher of the race, sorted by time
+type ResultSheetEntry struct {
+	Position   int
+	Bib        racers.Bib
+	Competitor racers.UserID
+	// Name is empty unless the race staff recording the results exports them
+	Name             string
+	Category         racers.CategoryName
+	CategoryPosition int
+	Time             racers.RaceTime
+	// Gap is the time behind the winner
+	Gap racers.RaceTime
+}
+
+type ExportRace struct {
+	RaceID string
+}
+
+// StartList streams the start list of the race to fn, the competitor names are personal data
+// only filled for the race staff checking the competitors in
+func (s Races) StartList(ctx context.Context, r ExportRace, fn func(StartListEntry) error) error {
+	raceID, names, err := s.exportAccess(ctx, r, racers.PermissionCheckIn)
+	if err != nil {
+		return err
+	}
+
+	return s.races.StartList(ctx, raceID, func(e StartListEntry) error {
+		e.Name = names[e.Competitor]
+
+		return fn(e)
+	})
+}
+
+// ResultSheet streams the results of the race to fn, the competitor names are personal data
+// only filled for the race staff recording the results
+func (s Races) ResultSheet(ctx context.Context, r ExportRace, fn func(ResultSheetEntry) error) error {
+	raceID, names, err := s.exportAccess(ctx, r, racers.PermissionResults)
+	if err != nil {
+		return err
+	}
+
+	return s.races.ResultSheet(ctx, raceID, func(e ResultSheetEntry) error {
+		e.Name = names[e.Competitor]
+
+		return fn(e)
+	})
+}
+
+// exportAccess returns the race id and the names of its competitors, loaded at once before the rows are
+// streamed. The names are nil unless the current user has the permission in the race
+func (s Races) exportAccess(ctx context.Context, r ExportRace, p racers.Permission) (racers.RaceID, map[racers.UserID]string, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.RaceID{}, nil, err
+	}
+
+	race, err := s.races.Get(ctx, raceID)
+	if err != nil {
+		return racers.RaceID{}, nil, err
+	}
+
+	ok, err := can(ctx, s.orgs, race, s.users.Current(ctx).ID, p)
+	if err != nil || !ok {
+		return raceID, nil, err
+	}
+
+	users, err := s.users.List(ctx, race.Competitors.List())
+	if err != nil {
+		return racers.RaceID{}, nil, err
+	}
+
+	names := make(map[racers.UserID]string, len(users))
+	for _, u := range users {
+		names[u.ID] = u.Name
+	}
+
+	return raceID, names, nil
+}
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesExport(t *testing.T) {
+	suite.Run(t, new(exportRaceSuite))
+}
+
+type exportRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.ExportRace
+
+	race       racers.Race
+	owner      racers.User
+	competitor racers.User
+
+	races *RacesRepositoryMock
+	users *UsersGetterMock
+}
+
+func (s *exportRaceSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate()), Name: "Kilian Jornet"}
+
+	s.race = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor.ID),
+	}
+
+	s.races = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) {
+			return s.race, nil
+		},
+		StartListFunc: func(_ context.Context, _ racers.RaceID, fn func(service.StartListEntry) error) error {
+			return fn(service.StartListEntry{Bib: 1, Competitor: s.competitor.ID, Category: "10K"})
+		},
+		ResultSheetFunc: func(_ context.Context, _ racers.RaceID, fn func(service.ResultSheetEntry) error) error {
+			return fn(service.ResultSheetEntry{Position: 1, Bib: 1, Competitor: s.competitor.ID, CategoryPosition: 1})
+		},
+	}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+		ListFunc: func(context.Context, []racers.UserID) ([]racers.User, error) {
+			return []racers.User{s.competitor}, nil
+		},
+	}
+
+	s.req = service.ExportRace{RaceID: id.ID(s.race.ID).String()}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s exportRaceSuite) TestExport_InvalidRequest() {
+	err := s.service.StartList(context.Background(), service.ExportRace{}, func(service.StartListEntry) error { return nil })
+	s.Error(err)
+}
+
+func (s exportRaceSuite) TestExport_RaceNotFound() {
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return racers.Race{}, service.ErrRaceNotFound
+	}
+
+	err := s.service.ResultSheet(context.Background(), s.req, func(service.ResultSheetEntry) error { return nil })
+	s.Equal(service.ErrRaceNotFound, err)
+}
+
+func (s exportRaceSuite) TestExport_OwnerGetsNames() {
+	var entries []service.StartListEntry
+	err := s.service.StartList(context.Background(), s.req, func(e service.StartListEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	s.NoError(err)
+
+	s.Equal([]service.StartListEntry{{Bib: 1, Competitor: s.competitor.ID, Name: "Kilian Jornet", Category: "10K"}}, entries)
+	s.Equal([]racers.UserID{s.competitor.ID}, s.users.ListCalls()[0].Ids)
+}
+
+func (s exportRaceSuite) TestExport_StaffGetsNames() {
+	timekeeper := racers.User{ID: racers.UserID(id.Generate())}
+	race := s.race
+	race.Staff = racers.RaceStaff{timekeeper.ID: racers.StaffTimekeeper}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) { return race, nil }
+	s.users.CurrentFunc = func(context.Context) racers.User { return timekeeper }
+
+	var entries []service.ResultSheetEntry
+	err := s.service.ResultSheet(context.Background(), s.req, func(e service.ResultSheetEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	s.NoError(err)
+
+	s.Equal("Kilian Jornet", entries[0].Name)
+	s.Len(s.users.ListCalls(), 1, "the names are loaded at once")
+}
+
+func (s exportRaceSuite) TestExport_OthersDoNotGetNames() {
+	s.users.CurrentFunc = func(context.Context) racers.User { return s.competitor }
+
+	var entries []service.ResultSheetEntry
+	err := s.service.ResultSheet(context.Background(), s.req, func(e service.ResultSheetEntry) error {
+		entries = append(entries, e)
+		return nil
+	})
+	s.NoError(err)
+
+	s.Equal([]service.ResultSheetEntry{{Position: 1, Bib: 1, Competitor: s.competitor.ID, CategoryPosition: 1}}, entries)
+	s.Empty(s.users.ListCalls())
+}
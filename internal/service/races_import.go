@@ -0,0 +1,186 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/results"
+)
+
+// MaxResultsFileSize is the max size in bytes of an imported results file
+const MaxResultsFileSize = 10 << 20
+
+type ImportResults struct {
+	RaceID string
+	// Format is the name of a registered results parser
+	Format string
+	// Mapping are the columns of each value, only used by the generic csv format
+	Mapping results.Mapping
+	File    io.Reader
+	// DryRun validates the file and reports the issues without recording the results
+	DryRun bool
+}
+
+// ImportIssueKind is the reason a row of the file is not imported
+type ImportIssueKind string
+
+const (
+	ImportIssueBadBib       ImportIssueKind = "bad_bib"
+	ImportIssueUnmatchedBib ImportIssueKind = "unmatched_bib"
+	ImportIssueDuplicateBib ImportIssueKind = "duplicate_bib"
+	ImportIssueBadTime      ImportIssueKind = "bad_time"
+)
+
+// ImportIssue is a row of the file that is not imported
+type ImportIssue struct {
+	Line int
+	Bib  string
+	Kind ImportIssueKind
+	Err  error
+}
+
+// ImportedResult is a row of the file matched with a competitor
+type ImportedResult struct {
+	Line       int
+	Bib        racers.Bib
+	Competitor racers.UserID
+	Time       racers.RaceTime
+}
+
+type ResultsImport struct {
+	Race    racers.Race
+	Results []ImportedResult
+	// Skipped are the rows of competitors that did not finish, like DNF, DNS or DSQ
+	Skipped int
+	Issues  []ImportIssue
+	// Committed is false on a dry run or when there is nothing to record
+	Committed bool
+}
+
+type ResultsImported struct {
+	Race    racers.RaceID
+	Results racers.RaceResults
+}
+
+func (e ResultsImported) RaceID() racers.RaceID { return e.Race }
+
+// finishStatuses are the statuses of the rows that have a finish time, an empty status means finished
+var finishStatuses = map[string]struct{}{"": {}, "ok": {}, "fin": {}, "finished": {}}
+
+// ImportResults reads a timing system results file and records the finish times of the matched bibs
+// in a single unit of work, only the race timekeepers and organizers are allowed.
+// Rows with issues are reported and skipped, a dry run only reports what would be imported.
+func (s Races) ImportResults(ctx context.Context, r ImportResults) (ResultsImport, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return ResultsImport{}, err
+	}
+
+	parser, err := results.NewParser(r.Format, r.Mapping)
+	if err != nil {
+		return ResultsImport{}, err
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(r.File, MaxResultsFileSize+1))
+	if err != nil {
+		return ResultsImport{}, err
+	}
+	if len(data) > MaxResultsFileSize {
+		return ResultsImport{}, ErrResultsFileTooLarge
+	}
+
+	rows, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		return ResultsImport{}, err
+	}
+
+	var imp ResultsImport
+	race, err := s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionResults); err != nil {
+			return nil, err
+		}
+
+		imp = matchResults(*race, rows)
+		if r.DryRun || len(imp.Results) == 0 {
+			return nil, nil
+		}
+
+		recorded := make(racers.RaceResults, len(imp.Results))
+		for _, res := range imp.Results {
+			if err := race.Finish(res.Competitor, res.Time); err != nil {
+				return nil, err
+			}
+			recorded[res.Competitor] = res.Time
+		}
+		imp.Committed = true
+
+		return []Event{newEvent(ResultsImported{Race: race.ID, Results: recorded}, s.users.Current(ctx).ID)}, nil
+	})
+	if err != nil {
+		return ResultsImport{}, err
+	}
+
+	imp.Race = race
+
+	return imp, nil
+}
+
+// matchResults resolves the bib and time of each row, the chip time is preferred over the gun time
+func matchResults(race racers.Race, rows []results.Row) ResultsImport {
+	var imp ResultsImport
+
+	seen := make(map[racers.Bib]int, len(rows))
+	for _, row := range rows {
+		if _, finished := finishStatuses[strings.ToLower(row.Status)]; !finished {
+			imp.Skipped++
+			continue
+		}
+
+		issue := func(kind ImportIssueKind, err error) {
+			imp.Issues = append(imp.Issues, ImportIssue{Line: row.Line, Bib: row.Bib, Kind: kind, Err: err})
+		}
+
+		n, err := strconv.Atoi(row.Bib)
+		if err != nil {
+			issue(ImportIssueBadBib, fmt.Errorf("invalid bib number: %q", row.Bib))
+			continue
+		}
+		bib, err := racers.NewBib(n)
+		if err != nil {
+			issue(ImportIssueBadBib, err)
+			continue
+		}
+
+		if line, ok := seen[bib]; ok {
+			issue(ImportIssueDuplicateBib, fmt.Errorf("bib %d already read at line %d", bib, line))
+			continue
+		}
+		seen[bib] = row.Line
+
+		competitor, err := race.CompetitorByBib(bib)
+		if err != nil {
+			issue(ImportIssueUnmatchedBib, err)
+			continue
+		}
+
+		value := row.ChipTime
+		if value == "" {
+			value = row.GunTime
+		}
+		t, err := racers.ParseRaceTime(value)
+		if err != nil {
+			issue(ImportIssueBadTime, err)
+			continue
+		}
+
+		imp.Results = append(imp.Results, ImportedResult{Line: row.Line, Bib: bib, Competitor: competitor, Time: t})
+	}
+
+	return imp
+}
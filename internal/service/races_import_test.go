@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/results"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesImport(t *testing.T) {
+	suite.Run(t, new(importResultsSuite))
+}
+
+const chipResults = `bib,chip_time,gun_time,status
+1,40:00,40:05,ok
+2,,45:00,
+2,45:30,45:35,
+3,50:00,50:10,
+4,soon,,
+x,1:00:00,,
+5,,,DNF
+`
+
+type importResultsSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.ImportResults
+
+	dummyRace racers.Race
+	first     racers.UserID
+	second    racers.UserID
+	owner     racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *importResultsSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	s.first, s.second = racers.UserID(id.Generate()), racers.UserID(id.Generate())
+	fourth := racers.UserID(id.Generate())
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Behobia"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 0, -1)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.first, s.second, fourth),
+		Bibs:        &racers.RaceBibs{Strategy: racers.BibStrategySequential, First: 1},
+		BibEntries:  racers.RaceBibEntries{s.first: 1, s.second: 2, fourth: 4},
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.ImportResults{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		Format: results.FormatChip,
+		File:   strings.NewReader(chipResults),
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s importResultsSuite) TestImportResults_InvalidRequest() {
+	for name, r := range map[string]service.ImportResults{
+		"race_id":        {Format: results.FormatChip, File: strings.NewReader(chipResults)},
+		"format":         {RaceID: s.req.RaceID, Format: "xlsx", File: strings.NewReader(chipResults)},
+		"invalid header": {RaceID: s.req.RaceID, Format: results.FormatChip, File: strings.NewReader("a,b\n")},
+	} {
+		s.Run(name, func() {
+			_, err := s.service.ImportResults(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s importResultsSuite) TestImportResults_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.ImportResults(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s importResultsSuite) TestImportResults_DryRun() {
+	s.req.DryRun = true
+
+	imp, err := s.service.ImportResults(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Equal([]service.ImportedResult{
+		{Line: 2, Bib: 1, Competitor: s.first, Time: racers.RaceTime(40 * time.Minute)},
+		{Line: 3, Bib: 2, Competitor: s.second, Time: racers.RaceTime(45 * time.Minute)},
+	}, imp.Results)
+	s.Equal(1, imp.Skipped)
+
+	kinds := make([]service.ImportIssueKind, len(imp.Issues))
+	for i, issue := range imp.Issues {
+		kinds[i] = issue.Kind
+	}
+	s.Equal([]service.ImportIssueKind{
+		service.ImportIssueDuplicateBib,
+		service.ImportIssueUnmatchedBib,
+		service.ImportIssueBadTime,
+		service.ImportIssueBadBib,
+	}, kinds)
+
+	s.False(imp.Committed)
+	s.Len(s.races.SaveCalls(), 0)
+	s.Len(s.eventBus.PublishCalls(), 0)
+}
+
+func (s importResultsSuite) TestImportResults_PublishEventsFails() {
+	s.eventBus.PublishFunc = func(context.Context, ...service.Event) error {
+		return errors.New("")
+	}
+
+	_, err := s.service.ImportResults(context.Background(), s.req)
+
+	s.Error(err)
+}
+
+func (s importResultsSuite) TestImportResults_Success() {
+	imp, err := s.service.ImportResults(context.Background(), s.req)
+	s.NoError(err)
+
+	expected := racers.RaceResults{
+		s.first:  racers.RaceTime(40 * time.Minute),
+		s.second: racers.RaceTime(45 * time.Minute),
+	}
+	s.True(imp.Committed)
+	s.Equal(expected, imp.Race.Results)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(
+		service.ResultsImported{Race: s.dummyRace.ID, Results: expected},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
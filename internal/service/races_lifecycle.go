@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// systemUser is the user of the events published by the scheduled jobs
+var systemUser racers.UserID
+
+// RegistrationClosed is published when the registration deadline of the race passes
+type RegistrationClosed struct {
+	Race racers.RaceID
+}
+
+func (e RegistrationClosed) RaceID() racers.RaceID { return e.Race }
+
+// RaceReminded is published on the race day, before the race starts
+type RaceReminded struct {
+	Race racers.RaceID
+	Date racers.RaceDate
+}
+
+func (e RaceReminded) RaceID() racers.RaceID { return e.Race }
+
+// RaceFinished is published once the race day is over
+type RaceFinished struct {
+	Race racers.RaceID
+}
+
+func (e RaceFinished) RaceID() racers.RaceID { return e.Race }
+
+// CloseRegistrations closes the registration of the races whose deadline passed,
+// it returns the number of races closed
+func (s Races) CloseRegistrations(ctx context.Context) (int, error) {
+	now := time.Now()
+	ids, err := s.races.WithRegistrationDeadline(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.transition(ctx, ids, func(race *racers.Race) (interface{}, bool) {
+		return RegistrationClosed{Race: race.ID}, race.CloseRegistration(now)
+	})
+}
+
+// raceDayWindow is the longest a race day starts before the race, the latest a race starts in its day
+const raceDayWindow = 24 * time.Hour
+
+// RemindRaces reminds the competitors of the races whose day started, it returns the number of races reminded
+func (s Races) RemindRaces(ctx context.Context) (int, error) {
+	now := time.Now()
+	ids, err := s.races.Unreminded(ctx, now, now.Add(raceDayWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	return s.transition(ctx, ids, func(race *racers.Race) (interface{}, bool) {
+		return RaceReminded{Race: race.ID, Date: race.Date}, race.Remind(now)
+	})
+}
+
+// FinishRaces finishes the races whose day is over, it returns the number of races finished
+func (s Races) FinishRaces(ctx context.Context) (int, error) {
+	now := time.Now()
+	ids, err := s.races.Unfinished(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.transition(ctx, ids, func(race *racers.Race) (interface{}, bool) {
+		return RaceFinished{Race: race.ID}, race.MarkFinished(now)
+	})
+}
+
+// transition applies the change to each race in its own unit of work, the races the change returns
+// false for are left untouched. It returns the number of races changed
+func (s Races) transition(ctx context.Context, ids []racers.RaceID, change func(*racers.Race) (interface{}, bool)) (int, error) {
+	var changed int
+	for _, id := range ids {
+		err := s.uow(ctx, func(ctx context.Context) error {
+			race, err := s.races.Get(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			payload, ok := change(&race)
+			if !ok {
+				return nil
+			}
+
+			if err := s.races.Save(ctx, race); err != nil {
+				return err
+			}
+			if err := s.eb.Publish(ctx, newEvent(payload, systemUser)); err != nil {
+				return err
+			}
+			changed++
+
+			return nil
+		})
+		if err != nil {
+			return changed, err
+		}
+	}
+
+	return changed, nil
+}
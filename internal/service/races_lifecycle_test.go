@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesLifecycle(t *testing.T) {
+	suite.Run(t, new(racesLifecycleSuite))
+}
+
+type racesLifecycleSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	races    map[racers.RaceID]racers.Race
+	repo     *RacesRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *racesLifecycleSuite) SetupTest() {
+	s.races = make(map[racers.RaceID]racers.Race)
+	ids := func(context.Context, time.Time) ([]racers.RaceID, error) {
+		var ids []racers.RaceID
+		for id := range s.races {
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(_ context.Context, id racers.RaceID) (racers.Race, error) { return s.races[id], nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.races[race.ID] = race
+			return nil
+		},
+		WithRegistrationDeadlineFunc: ids,
+		UnfinishedFunc:               ids,
+		UnremindedFunc: func(ctx context.Context, from, _ time.Time) ([]racers.RaceID, error) {
+			return ids(ctx, from)
+		},
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewRaces(s.repo, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *racesLifecycleSuite) add(date time.Time) racers.Race {
+	race := racers.Race{ID: racers.RaceID(id.Generate()), Date: racers.RaceDate(date), Status: racers.RaceOpen}
+	s.races[race.ID] = race
+
+	return race
+}
+
+func (s *racesLifecycleSuite) TestCloseRegistrations() {
+	closing := s.add(time.Now().AddDate(0, 0, 7))
+	passed := time.Now().Add(-time.Minute)
+	closing.RegistrationDeadline = &passed
+	s.races[closing.ID] = closing
+
+	open := s.add(time.Now().AddDate(0, 0, 7))
+	upcoming := time.Now().Add(time.Hour)
+	open.RegistrationDeadline = &upcoming
+	s.races[open.ID] = open
+
+	closed, err := s.service.CloseRegistrations(context.Background())
+	s.NoError(err)
+
+	s.Equal(1, closed)
+	s.Equal(racers.RaceRegistrationClosed, s.races[closing.ID].Status)
+	s.Equal(racers.RaceOpen, s.races[open.ID].Status)
+	s.Require().Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(service.RegistrationClosed{Race: closing.ID}, s.eventBus.PublishCalls()[0].Events[0].Payload)
+}
+
+func (s *racesLifecycleSuite) TestRemindRaces() {
+	today := s.add(time.Now().Add(time.Minute))
+
+	reminded, err := s.service.RemindRaces(context.Background())
+	s.NoError(err)
+	s.Equal(1, reminded)
+	s.True(s.races[today.ID].Reminded)
+	s.Equal(service.RaceReminded{Race: today.ID, Date: today.Date}, s.eventBus.PublishCalls()[0].Events[0].Payload)
+
+	reminded, err = s.service.RemindRaces(context.Background())
+	s.NoError(err)
+	s.Zero(reminded, "the races are reminded once")
+}
+
+func (s *racesLifecycleSuite) TestFinishRaces() {
+	past := s.add(time.Now().AddDate(0, 0, -2))
+	future := s.add(time.Now().AddDate(0, 0, 2))
+
+	finished, err := s.service.FinishRaces(context.Background())
+	s.NoError(err)
+
+	s.Equal(1, finished)
+	s.Equal(racers.RaceFinished, s.races[past.ID].Status)
+	s.Equal(racers.RaceOpen, s.races[future.ID].Status)
+	s.Equal(service.RaceFinished{Race: past.ID}, s.eventBus.PublishCalls()[0].Events[0].Payload)
+}
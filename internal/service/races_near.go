@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// MaxNearRadiusKm is the max radius of the races near a point search
+const MaxNearRadiusKm = 1000
+
+type RacesNear struct {
+	Lat      float64
+	Lon      float64
+	RadiusKm float64
+}
+
+func (r RacesNear) validate() error {
+	if err := racers.CheckCoordinates(r.Lat, r.Lon); err != nil {
+		return err
+	}
+
+	if r.RadiusKm <= 0 || r.RadiusKm > MaxNearRadiusKm {
+		return ErrInvalidRadius
+	}
+
+	return nil
+}
+
+// Near returns the races with a venue within the radius of the point, the closest first
+func (s Races) Near(ctx context.Context, r RacesNear) ([]racers.Race, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return s.races.Near(ctx, r.Lat, r.Lon, r.RadiusKm*1000)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type SetRelayLineUp struct {
+	RaceID string
+	TeamID string
+	// Runners are the team members that run each leg, in legs order
+	Runners []string
+}
+
+type RelayLineUpSet struct {
+	Race    racers.RaceID
+	Team    racers.TeamID
+	Runners []racers.UserID
+}
+
+func (e RelayLineUpSet) RaceID() racers.RaceID { return e.Race }
+
+// SetRelayLineUp assigns the team members to the legs of a relay, done by the team admin
+func (s Races) SetRelayLineUp(ctx context.Context, r SetRelayLineUp) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	teamID, err := racers.NewTeamID(r.TeamID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	runners := make([]racers.UserID, len(r.Runners))
+	for i, m := range r.Runners {
+		if runners[i], err = racers.NewUserID(m); err != nil {
+			return racers.Race{}, err
+		}
+	}
+
+	team, err := s.teams.Get(ctx, teamID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := race.SetRelayLineUp(team, s.users.Current(ctx), runners...); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(RelayLineUpSet{Race: race.ID, Team: team.ID, Runners: runners}, s.users.Current(ctx).ID)}, nil
+	})
+}
+
+type RecordLegSplit struct {
+	RaceID string
+	TeamID string
+	// Leg is the position of the leg in the relay, starting from zero
+	Leg int
+	// Time is the leg split time in [[hh:]mm:]ss[.sss] format
+	Time string
+}
+
+type LegSplitRecorded struct {
+	Race racers.RaceID
+	Team racers.TeamID
+	Leg  int
+	Time racers.RaceTime
+}
+
+func (e LegSplitRecorded) RaceID() racers.RaceID { return e.Race }
+
+// RecordLegSplit sets the time a team took to run a relay leg, only the race timekeepers and organizers are allowed
+func (s Races) RecordLegSplit(ctx context.Context, r RecordLegSplit) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	teamID, err := racers.NewTeamID(r.TeamID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	split, err := racers.ParseRaceTime(r.Time)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionResults); err != nil {
+			return nil, err
+		}
+
+		if err := race.RecordLegSplit(teamID, r.Leg, split); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(LegSplitRecorded{Race: race.ID, Team: teamID, Leg: r.Leg, Time: split}, s.users.Current(ctx).ID)}, nil
+	})
+}
@@ -0,0 +1,286 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesRelay(t *testing.T) {
+	suite.Run(t, new(createRelayRaceSuite))
+	suite.Run(t, new(setRelayLineUpSuite))
+	suite.Run(t, new(recordLegSplitSuite))
+}
+
+type createRelayRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.CreateRace
+
+	races *RacesRepositoryMock
+}
+
+func (s *createRelayRaceSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+
+	s.req = service.CreateRace{
+		ID:   id.Generate().String(),
+		Name: "Ekiden",
+		Date: time.Now().AddDate(0, 1, 0),
+		Relay: &service.CreateRaceRelay{
+			Legs: []service.CreateRelayLeg{
+				{Name: "first", Distance: 5000},
+				{Name: "second", Distance: 10000},
+			},
+		},
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s createRelayRaceSuite) TestCreateRelayRace_InvalidLegs() {
+	for name, legs := range map[string][]service.CreateRelayLeg{
+		"one leg":          {{Name: "first", Distance: 5000}},
+		"leg without name": {{Distance: 5000}, {Name: "second", Distance: 5000}},
+		"leg without distance": {
+			{Name: "first", Distance: 5000}, {Name: "second"},
+		},
+	} {
+		s.Run(name, func() {
+			req := s.req
+			req.Relay = &service.CreateRaceRelay{Legs: legs}
+
+			_, err := s.service.Create(context.Background(), req)
+			s.Error(err)
+		})
+	}
+}
+
+func (s createRelayRaceSuite) TestCreateRelayRace_Success() {
+	result, err := s.service.Create(context.Background(), s.req)
+
+	s.NoError(err)
+	s.Equal(&racers.RaceRelay{Legs: []racers.RelayLeg{
+		{Name: "first", Distance: 5000},
+		{Name: "second", Distance: 10000},
+	}}, result.Relay)
+}
+
+type setRelayLineUpSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.SetRelayLineUp
+
+	dummyRace racers.Race
+	dummyTeam racers.Team
+	admin     racers.User
+
+	races    *RacesRepositoryMock
+	teams    *TeamsRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *setRelayLineUpSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.teams = &TeamsRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.admin = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.admin },
+	}
+
+	member := racers.UserID(id.Generate())
+	s.dummyTeam = racers.NewTeam(
+		racers.TeamID(id.Generate()), racers.TeamName("black panthers"), s.admin.ID,
+		racers.TeamMembersOpt(racers.NewTeamMembers(s.admin.ID, member)),
+	)
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Ekiden"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       racers.UserID(id.Generate()),
+		Competitors: racers.NewRaceCompetitors(s.admin.ID, member),
+		Relay: &racers.RaceRelay{Legs: []racers.RelayLeg{
+			{Name: "first", Distance: 5000},
+			{Name: "second", Distance: 10000},
+		}},
+	}
+
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+	s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
+		return s.dummyTeam, nil
+	}
+
+	s.req = service.SetRelayLineUp{
+		RaceID:  id.ID(s.dummyRace.ID).String(),
+		TeamID:  id.ID(s.dummyTeam.ID).String(),
+		Runners: []string{id.ID(s.admin.ID).String(), id.ID(member).String()},
+	}
+
+	s.service = service.NewRaces(s.races, s.teams, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s setRelayLineUpSuite) TestSetRelayLineUp_InvalidRequest() {
+	for field, r := range map[string]service.SetRelayLineUp{
+		"race_id": {TeamID: s.req.TeamID, Runners: s.req.Runners},
+		"team_id": {RaceID: s.req.RaceID, Runners: s.req.Runners},
+		"runners": {RaceID: s.req.RaceID, TeamID: s.req.TeamID, Runners: []string{"invalid"}},
+	} {
+		s.Run(field, func() {
+			_, err := s.service.SetRelayLineUp(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s setRelayLineUpSuite) TestSetRelayLineUp_IncompleteLineUp() {
+	s.req.Runners = s.req.Runners[:1]
+
+	_, err := s.service.SetRelayLineUp(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.IncompleteRelayLineUpError{}))
+	s.Len(s.races.SaveCalls(), 0)
+}
+
+func (s setRelayLineUpSuite) TestSetRelayLineUp_PublishEventsFails() {
+	s.eventBus.PublishFunc = func(context.Context, ...service.Event) error {
+		return errors.New("")
+	}
+
+	_, err := s.service.SetRelayLineUp(context.Background(), s.req)
+
+	s.Error(err)
+}
+
+func (s setRelayLineUpSuite) TestSetRelayLineUp_Success() {
+	result, err := s.service.SetRelayLineUp(context.Background(), s.req)
+	s.NoError(err)
+
+	runners := result.RelayEntries[s.dummyTeam.ID].Runners
+	s.Len(runners, 2)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(
+		service.RelayLineUpSet{Race: s.dummyRace.ID, Team: s.dummyTeam.ID, Runners: runners},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
+
+type recordLegSplitSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.RecordLegSplit
+
+	dummyRace racers.Race
+	teamID    racers.TeamID
+	owner     racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *recordLegSplitSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	runner := racers.UserID(id.Generate())
+	s.teamID = racers.TeamID(id.Generate())
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Ekiden"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(runner),
+		Relay: &racers.RaceRelay{
+			Legs: []racers.RelayLeg{
+				{Name: "first", Distance: 5000},
+				{Name: "second", Distance: 10000},
+			},
+			AllowRepeatRunners: true,
+		},
+		RelayEntries: racers.RelayEntries{
+			s.teamID: {Runners: []racers.UserID{runner, runner}, Splits: make([]racers.RaceTime, 2)},
+		},
+	}
+
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.RecordLegSplit{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		TeamID: id.ID(s.teamID).String(),
+		Leg:    1,
+		Time:   "35:10",
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s recordLegSplitSuite) TestRecordLegSplit_InvalidRequest() {
+	for field, r := range map[string]service.RecordLegSplit{
+		"race_id": {TeamID: s.req.TeamID, Time: s.req.Time},
+		"team_id": {RaceID: s.req.RaceID, Time: s.req.Time},
+		"time":    {RaceID: s.req.RaceID, TeamID: s.req.TeamID},
+	} {
+		s.Run(field, func() {
+			_, err := s.service.RecordLegSplit(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s recordLegSplitSuite) TestRecordLegSplit_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.RecordLegSplit(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s recordLegSplitSuite) TestRecordLegSplit_UnknownLeg() {
+	s.req.Leg = 2
+
+	_, err := s.service.RecordLegSplit(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.UnknownRelayLegError{}))
+}
+
+func (s recordLegSplitSuite) TestRecordLegSplit_Success() {
+	result, err := s.service.RecordLegSplit(context.Background(), s.req)
+	s.NoError(err)
+
+	split := racers.RaceTime(35*time.Minute + 10*time.Second)
+	s.Equal([]racers.RaceTime{0, split}, result.RelayEntries[s.teamID].Splits)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Equal(
+		service.LegSplitRecorded{Race: s.dummyRace.ID, Team: s.teamID, Leg: 1, Time: split},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type RescheduleRace struct {
+	RaceID string
+	Date   time.Time
+}
+
+// RaceRescheduled is published when the race date changes, Sequence is the new schedule revision
+type RaceRescheduled struct {
+	Race     racers.RaceID
+	Date     racers.RaceDate
+	Sequence int
+}
+
+func (e RaceRescheduled) RaceID() racers.RaceID { return e.Race }
+
+// Reschedule moves the race to a new date, only the race organizers are allowed
+func (s Races) Reschedule(ctx context.Context, r RescheduleRace) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	date, err := racers.NewRaceDate(r.Date)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionEdit); err != nil {
+			return nil, err
+		}
+
+		sequence := race.Sequence
+		race.Reschedule(date)
+		if race.Sequence == sequence {
+			return nil, nil
+		}
+
+		return []Event{newEvent(RaceRescheduled{Race: race.ID, Date: race.Date, Sequence: race.Sequence}, s.users.Current(ctx).ID)}, nil
+	})
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesSchedule(t *testing.T) {
+	suite.Run(t, new(rescheduleRaceSuite))
+}
+
+type rescheduleRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.RescheduleRace
+
+	dummyRace racers.Race
+	owner     racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *rescheduleRaceSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	s.dummyRace = racers.Race{
+		ID:    racers.RaceID(id.Generate()),
+		Name:  racers.RaceName("Behobia"),
+		Date:  racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner: s.owner.ID,
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.RescheduleRace{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		Date:   time.Time(s.dummyRace.Date).AddDate(0, 0, 7),
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s rescheduleRaceSuite) TestReschedule_InvalidRequest() {
+	s.Run("race_id", func() {
+		_, err := s.service.Reschedule(context.Background(), service.RescheduleRace{Date: s.req.Date})
+		s.True(errors.As(err, &racers.InvalidRaceIDError{}))
+	})
+
+	s.Run("past date", func() {
+		_, err := s.service.Reschedule(context.Background(), service.RescheduleRace{RaceID: s.req.RaceID, Date: time.Now().AddDate(0, 0, -1)})
+		s.True(errors.As(err, &racers.InvalidRaceDateError{}))
+	})
+}
+
+func (s rescheduleRaceSuite) TestReschedule_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.Reschedule(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s rescheduleRaceSuite) TestReschedule_SameDate() {
+	_, err := s.service.Reschedule(context.Background(), service.RescheduleRace{RaceID: s.req.RaceID, Date: time.Time(s.dummyRace.Date)})
+	s.NoError(err)
+
+	s.Empty(s.races.SaveCalls())
+	s.Empty(s.eventBus.PublishCalls())
+}
+
+func (s rescheduleRaceSuite) TestReschedule_Success() {
+	result, err := s.service.Reschedule(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Equal(racers.RaceDate(s.req.Date), result.Date)
+	s.Equal(1, result.Sequence)
+	s.Len(s.races.SaveCalls(), 1)
+	s.Equal(
+		service.RaceRescheduled{Race: s.dummyRace.ID, Date: racers.RaceDate(s.req.Date), Sequence: 1},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
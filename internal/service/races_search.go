@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+const (
+	defaultSearchSize = 20
+	maxSearchSize     = 100
+)
+
+type SearchRaces struct {
+	Query string
+	// From and To limit the race dates, both included
+	From *time.Time
+	To   *time.Time
+	// Near limits the races to the ones with a venue around a point
+	Near  *RacesNear
+	First int
+}
+
+// RaceSearch is the validated search the repository runs
+type RaceSearch struct {
+	Query string
+	From  *time.Time
+	To    *time.Time
+	// Lat, Lon and Radius in metres filter by venue when Radius is not zero
+	Lat    float64
+	Lon    float64
+	Radius float64
+	Limit  int
+}
+
+// RaceMatch is a race found by a search
+type RaceMatch struct {
+	Race racers.Race
+	// Rank is the relevance of the race for the query, higher first
+	Rank float64
+	// Snippet is the HTML escaped text that matched, with the matching words in <mark> tags
+	Snippet string
+}
+
+// Search finds races by name, venue and description, tolerating typos in the name
+func (s Races) Search(ctx context.Context, r SearchRaces) ([]RaceMatch, error) {
+	search := RaceSearch{Query: strings.TrimSpace(r.Query), From: r.From, To: r.To, Limit: r.First}
+	if search.Query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	if r.Near != nil {
+		if err := r.Near.validate(); err != nil {
+			return nil, err
+		}
+		search.Lat, search.Lon, search.Radius = r.Near.Lat, r.Near.Lon, r.Near.RadiusKm*1000
+	}
+
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchSize
+	}
+	if search.Limit > maxSearchSize {
+		search.Limit = maxSearchSize
+	}
+
+	return s.races.Search(ctx, search)
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesSearch(t *testing.T) {
+	suite.Run(t, new(searchRacesSuite))
+}
+
+type searchRacesSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	races *RacesRepositoryMock
+}
+
+func (s *searchRacesSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{
+		SearchFunc: func(context.Context, service.RaceSearch) ([]service.RaceMatch, error) {
+			return []service.RaceMatch{}, nil
+		},
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s searchRacesSuite) TestSearch_InvalidRequest() {
+	s.Run("empty query", func() {
+		_, err := s.service.Search(context.Background(), service.SearchRaces{Query: "  "})
+		s.Equal(service.ErrEmptySearchQuery, err)
+	})
+
+	s.Run("coordinates", func() {
+		_, err := s.service.Search(context.Background(), service.SearchRaces{Query: "behobia", Near: &service.RacesNear{Lat: -91, RadiusKm: 10}})
+		s.True(errors.As(err, &racers.InvalidCoordinatesError{}))
+	})
+
+	s.Run("radius", func() {
+		_, err := s.service.Search(context.Background(), service.SearchRaces{Query: "behobia", Near: &service.RacesNear{Lat: 43.3}})
+		s.Equal(service.ErrInvalidRadius, err)
+	})
+}
+
+func (s searchRacesSuite) TestSearch_Success() {
+	from := time.Now()
+
+	s.Run("defaults", func() {
+		_, err := s.service.Search(context.Background(), service.SearchRaces{Query: " behobia "})
+		s.NoError(err)
+
+		s.Equal(service.RaceSearch{Query: "behobia", Limit: 20}, s.races.SearchCalls()[0].Search)
+	})
+
+	s.Run("filters", func() {
+		_, err := s.service.Search(context.Background(), service.SearchRaces{
+			Query: "behobia",
+			From:  &from,
+			Near:  &service.RacesNear{Lat: 43.3, Lon: -1.98, RadiusKm: 5},
+			First: 500,
+		})
+		s.NoError(err)
+
+		s.Equal(
+			service.RaceSearch{Query: "behobia", From: &from, Lat: 43.3, Lon: -1.98, Radius: 5000, Limit: 100},
+			s.races.SearchCalls()[1].Search,
+		)
+	})
+}
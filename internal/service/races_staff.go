@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type RaceStaffMember struct {
+	RaceID string
+	UserID string
+	// Role is ignored when the user is removed
+	Role string
+}
+
+// RaceStaffAdded is published when the owner grants a user a role in the race, or changes its role
+type RaceStaffAdded struct {
+	Race racers.RaceID
+	User racers.UserID
+	Role racers.StaffRole
+}
+
+func (e RaceStaffAdded) RaceID() racers.RaceID { return e.Race }
+
+// RaceStaffRemoved is published when the owner revokes the role of a user
+type RaceStaffRemoved struct {
+	Race racers.RaceID
+	User racers.UserID
+	Role racers.StaffRole
+}
+
+func (e RaceStaffRemoved) RaceID() racers.RaceID { return e.Race }
+
+// RaceOwnershipTransferred is published when the owner hands the race over to another user
+type RaceOwnershipTransferred struct {
+	Race racers.RaceID
+	From racers.UserID
+	To   racers.UserID
+}
+
+func (e RaceOwnershipTransferred) RaceID() racers.RaceID { return e.Race }
+
+// AddStaff grants an existing user a role in the race, replacing its previous role, only the race owner is allowed
+func (s Races) AddStaff(ctx context.Context, r RaceStaffMember) (racers.Race, error) {
+	role, err := racers.NewStaffRole(r.Role)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.changeStaff(ctx, r, func(race *racers.Race, u racers.UserID) (interface{}, error) {
+		if _, err := s.users.Get(ctx, u); err != nil {
+			return nil, err
+		}
+
+		return RaceStaffAdded{Race: race.ID, User: u, Role: role}, race.AddStaff(u, role)
+	})
+}
+
+// RemoveStaff revokes the role of a user in the race, only the race owner is allowed
+func (s Races) RemoveStaff(ctx context.Context, r RaceStaffMember) (racers.Race, error) {
+	return s.changeStaff(ctx, r, func(race *racers.Race, u racers.UserID) (interface{}, error) {
+		role, err := race.RemoveStaff(u)
+
+		return RaceStaffRemoved{Race: race.ID, User: u, Role: role}, err
+	})
+}
+
+// TransferOwnership hands the race over to an existing user, the current owner stays as co-organizer.
+// Only the race owner is allowed
+func (s Races) TransferOwnership(ctx context.Context, r RaceStaffMember) (racers.Race, error) {
+	return s.changeStaff(ctx, r, func(race *racers.Race, u racers.UserID) (interface{}, error) {
+		if _, err := s.users.Get(ctx, u); err != nil {
+			return nil, err
+		}
+
+		from := race.Owner
+
+		return RaceOwnershipTransferred{Race: race.ID, From: from, To: u}, race.TransferOwnership(u)
+	})
+}
+
+// changeStaff applies the change to the staff of the race and publishes the event it returns
+func (s Races) changeStaff(ctx context.Context, r RaceStaffMember, change func(*racers.Race, racers.UserID) (interface{}, error)) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	userID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionStaff); err != nil {
+			return nil, err
+		}
+
+		event, err := change(race, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(event, s.users.Current(ctx).ID)}, nil
+	})
+}
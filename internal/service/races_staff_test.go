@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesStaff(t *testing.T) {
+	suite.Run(t, new(raceStaffSuite))
+}
+
+type raceStaffSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	race       racers.Race
+	owner      racers.User
+	member     racers.User
+	competitor racers.User
+	current    racers.User
+
+	repo     *RacesRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *raceStaffSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.member = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.owner
+
+	s.race = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor.ID),
+	}
+	s.repo = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+	}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc: func(_ context.Context, id racers.UserID) (racers.User, error) {
+			for _, u := range []racers.User{s.owner, s.member, s.competitor} {
+				if u.ID == id {
+					return u, nil
+				}
+			}
+			return racers.User{}, service.ErrUserNotFound
+		},
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewRaces(s.repo, nil, nil, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *raceStaffSuite) addStaff(role racers.StaffRole) {
+	_, err := s.service.AddStaff(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.member.ID).String(),
+		Role:   string(role),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *raceStaffSuite) TestAddStaff_NotOwner() {
+	s.addStaff(racers.StaffCoOrganizer)
+	s.current = s.member
+
+	_, err := s.service.AddStaff(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+		Role:   string(racers.StaffVolunteer),
+	})
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s *raceStaffSuite) TestAddStaff_InvalidRole() {
+	_, err := s.service.AddStaff(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.member.ID).String(),
+		Role:   string(racers.StaffOwner),
+	})
+
+	s.True(errors.As(err, &racers.InvalidStaffRoleError{}))
+	s.Empty(s.repo.SaveCalls())
+}
+
+func (s *raceStaffSuite) TestTimekeeper() {
+	s.addStaff(racers.StaffTimekeeper)
+	s.current = s.member
+
+	_, err := s.service.RecordResult(context.Background(), service.RecordResult{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+		Time:   "40:00",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.service.Reschedule(context.Background(), service.RescheduleRace{
+		RaceID: id.ID(s.race.ID).String(),
+		Date:   time.Now().AddDate(0, 2, 0),
+	})
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s *raceStaffSuite) TestCoOrganizer() {
+	s.addStaff(racers.StaffCoOrganizer)
+	s.current = s.member
+
+	_, err := s.service.Reschedule(context.Background(), service.RescheduleRace{
+		RaceID: id.ID(s.race.ID).String(),
+		Date:   time.Now().AddDate(0, 2, 0),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *raceStaffSuite) TestRemoveStaff() {
+	s.addStaff(racers.StaffVolunteer)
+
+	race, err := s.service.RemoveStaff(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.member.ID).String(),
+	})
+	s.Require().NoError(err)
+
+	s.Empty(race.Staff)
+	calls := s.eventBus.PublishCalls()
+	s.Equal(
+		service.RaceStaffRemoved{Race: s.race.ID, User: s.member.ID, Role: racers.StaffVolunteer},
+		calls[len(calls)-1].Events[0].Payload,
+	)
+}
+
+func (s *raceStaffSuite) TestTransferOwnership() {
+	race, err := s.service.TransferOwnership(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.member.ID).String(),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(s.member.ID, race.Owner)
+	role, _ := race.Role(s.owner.ID)
+	s.Equal(racers.StaffCoOrganizer, role)
+	s.Equal(
+		service.RaceOwnershipTransferred{Race: s.race.ID, From: s.owner.ID, To: s.member.ID},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+
+	_, err = s.service.TransferOwnership(context.Background(), service.RaceStaffMember{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+	})
+	s.Equal(service.ErrForbidden, err, "the previous owner can not transfer it anymore")
+}
@@ -17,6 +17,8 @@ func TestRacesService(t *testing.T) {
 	suite.Run(t, new(getRaceSuite))
 	suite.Run(t, new(joinRaceSuite))
 	suite.Run(t, new(listRacesSuite))
+	suite.Run(t, new(recordResultSuite))
+	suite.Run(t, new(enterTeamSuite))
 }
 
 type createRaceSuite struct {
@@ -42,7 +44,7 @@ func (s *createRaceSuite) SetupTest() {
 		Date: time.Now().AddDate(0, 1, 0),
 	}
 
-	s.service = service.NewRaces(s.races, s.users, service.NoopUnitOfWork, s.eventBus)
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
 }
 
 func (s createRaceSuite) TestCreateRace_InvalidRequest() {
@@ -58,6 +60,63 @@ func (s createRaceSuite) TestCreateRace_InvalidRequest() {
 	}
 }
 
+func (s createRaceSuite) TestCreateRace_InvalidTeams() {
+	s.req.Teams = &service.CreateRaceTeams{MinMembers: 2, MaxMembers: 4, Scoring: "fastest", Counting: 2}
+
+	_, err := s.service.Create(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.InvalidTeamScoringError{}))
+	s.Len(s.races.SaveCalls(), 0)
+}
+
+func (s createRaceSuite) TestCreateRace_WithTeams() {
+	s.req.Teams = &service.CreateRaceTeams{MinMembers: 2, MaxMembers: 4, Scoring: "best_times", Counting: 2}
+
+	result, err := s.service.Create(context.Background(), s.req)
+
+	s.NoError(err)
+	s.Equal(&racers.RaceTeams{
+		Size:     racers.RaceTeamSize{Min: 2, Max: 4},
+		Scoring:  racers.TeamScoringBestTimes,
+		Counting: 2,
+	}, result.Teams)
+}
+
+func (s createRaceSuite) TestCreateRace_InvalidCategories() {
+	start := s.req.Date.Add(time.Hour)
+	for name, categories := range map[string][]service.CreateRaceCategory{
+		"without name":     {{Distance: 5000, StartTime: start}},
+		"without distance": {{Name: "5K", StartTime: start}},
+		"without start":    {{Name: "5K", Distance: 5000}},
+		"invalid gender":   {{Name: "5K", Distance: 5000, StartTime: start, Gender: "any"}},
+		"duplicated name":  {{Name: "5K", Distance: 5000, StartTime: start}, {Name: "5K", Distance: 5000, StartTime: start}},
+	} {
+		s.Run(name, func() {
+			req := s.req
+			req.Categories = categories
+
+			_, err := s.service.Create(context.Background(), req)
+			s.Error(err)
+		})
+	}
+}
+
+func (s createRaceSuite) TestCreateRace_WithCategories() {
+	start := s.req.Date.Add(time.Hour)
+	s.req.Categories = []service.CreateRaceCategory{
+		{Name: "10K", Distance: 10000, StartTime: start, Capacity: 500, MinAge: 18},
+		{Name: "5K women", Distance: 5000, StartTime: start, Gender: "female"},
+	}
+
+	result, err := s.service.Create(context.Background(), s.req)
+
+	s.NoError(err)
+	s.Equal(racers.RaceCategories{
+		{Name: "10K", Distance: 10000, StartTime: start, Capacity: 500, Eligibility: racers.CategoryEligibility{MinAge: 18}},
+		{Name: "5K women", Distance: 5000, StartTime: start, Eligibility: racers.CategoryEligibility{Gender: racers.GenderFemale}},
+	}, result.Categories)
+}
+
 func (s createRaceSuite) TestCreateRace_CheckExistsFails() {
 	s.races.ExistsFunc = func(context.Context, racers.Race) (bool, error) {
 		return false, errors.New("")
@@ -113,10 +172,11 @@ func (s createRaceSuite) TestCreateRace_Success() {
 	s.NoError(err)
 
 	expected := racers.Race{
-		ID:    racers.RaceID(id.MustParse(s.req.ID)),
-		Name:  racers.RaceName(s.req.Name),
-		Date:  racers.RaceDate(s.req.Date),
-		Owner: racers.UserID{},
+		ID:     racers.RaceID(id.MustParse(s.req.ID)),
+		Name:   racers.RaceName(s.req.Name),
+		Date:   racers.RaceDate(s.req.Date),
+		Owner:  racers.UserID{},
+		Status: racers.RaceOpen,
 	}
 	s.Equal(expected, result)
 
@@ -149,7 +209,7 @@ func (s *getRaceSuite) SetupTest() {
 		ID: id.Generate().String(),
 	}
 
-	s.service = service.NewRaces(s.races, s.users, service.NoopUnitOfWork, nil)
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, nil)
 }
 
 func (s getRaceSuite) TestGetRace_InvalidRequest() {
@@ -210,7 +270,7 @@ func (s *joinRaceSuite) SetupTest() {
 		UserID: id.ID(s.dummyUser.ID).String(),
 	}
 
-	s.service = service.NewRaces(s.races, s.users, service.NoopUnitOfWork, s.eventBus)
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
 }
 
 func (s joinRaceSuite) TestJoinRace_InvalidRequest() {
@@ -219,7 +279,7 @@ func (s joinRaceSuite) TestJoinRace_InvalidRequest() {
 		"user_id": {RaceID: s.req.RaceID},
 	} {
 		s.Run(field, func() {
-			err := s.service.Join(context.Background(), r)
+			_, err := s.service.Join(context.Background(), r)
 			s.Error(err)
 		})
 	}
@@ -230,7 +290,7 @@ func (s joinRaceSuite) TestJoinRace_FailsGettingRace() {
 		return racers.Race{}, errors.New("")
 	}
 
-	err := s.service.Join(context.Background(), s.req)
+	_, err := s.service.Join(context.Background(), s.req)
 	s.Error(err)
 }
 
@@ -243,12 +303,12 @@ func (s joinRaceSuite) TestJoinRace_FailsGettingUser() {
 		return racers.User{}, errors.New("")
 	}
 
-	err := s.service.Join(context.Background(), s.req)
+	_, err := s.service.Join(context.Background(), s.req)
 	s.Error(err)
 }
 
 func (s joinRaceSuite) TestJoinRace_FailsJoiningRace() {
-	s.dummyRace.Join(s.dummyUser)
+	s.dummyRace.Join(s.dummyUser, "", time.Now())
 
 	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
 		return s.dummyRace, nil
@@ -258,7 +318,7 @@ func (s joinRaceSuite) TestJoinRace_FailsJoiningRace() {
 		return s.dummyUser, nil
 	}
 
-	err := s.service.Join(context.Background(), s.req)
+	_, err := s.service.Join(context.Background(), s.req)
 	s.Error(err)
 }
 
@@ -274,7 +334,7 @@ func (s joinRaceSuite) TestJoinRace_FailsSaving() {
 		return s.dummyUser, nil
 	}
 
-	err := s.service.Join(context.Background(), s.req)
+	_, err := s.service.Join(context.Background(), s.req)
 	s.Error(err)
 }
 
@@ -294,7 +354,8 @@ func (s joinRaceSuite) TestJoinRace_PublishEventsFails() {
 		return errors.New("")
 	}
 
-	s.Error(s.service.Join(context.Background(), s.req))
+	_, err := s.service.Join(context.Background(), s.req)
+	s.Error(err)
 }
 
 func (s joinRaceSuite) TestJoinRace_Success() {
@@ -313,7 +374,8 @@ func (s joinRaceSuite) TestJoinRace_Success() {
 		return nil
 	}
 
-	s.NoError(s.service.Join(context.Background(), s.req))
+	_, err := s.service.Join(context.Background(), s.req)
+	s.NoError(err)
 
 	s.Len(s.races.SaveCalls(), 1)
 
@@ -331,6 +393,53 @@ func (s joinRaceSuite) TestJoinRace_Success() {
 	)
 }
 
+func (s joinRaceSuite) TestJoinRace_ReadsRaceInUnitOfWork() {
+	type unitOfWork struct{}
+	uow := func(ctx context.Context, work service.Work) error {
+		return work(context.WithValue(ctx, unitOfWork{}, true))
+	}
+
+	var readIn bool
+	s.races.GetFunc = func(ctx context.Context, _ racers.RaceID) (racers.Race, error) {
+		readIn = ctx.Value(unitOfWork{}) != nil
+		return s.dummyRace, nil
+	}
+	s.races.SaveFunc = func(context.Context, racers.Race) error { return nil }
+	s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) { return s.dummyUser, nil }
+	s.eventBus.PublishFunc = func(context.Context, ...service.Event) error { return nil }
+
+	_, err := service.NewRaces(s.races, nil, nil, s.users, uow, s.eventBus).Join(context.Background(), s.req)
+	s.Require().NoError(err)
+
+	s.True(readIn, "the race is read in the unit of work it is saved in, so it is locked meanwhile")
+}
+
+func (s joinRaceSuite) TestJoinRace_WithCategory() {
+	s.dummyRace.Categories = racers.RaceCategories{
+		{Name: "10K", Distance: 10000, StartTime: time.Time(s.dummyRace.Date)},
+	}
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+	s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
+		return s.dummyUser, nil
+	}
+
+	s.Run("without category", func() {
+		_, err := s.service.Join(context.Background(), s.req)
+		s.True(errors.As(err, &racers.UnknownCategoryError{}))
+	})
+
+	s.Run("with category", func() {
+		req := s.req
+		req.Category = "10K"
+
+		_, err := s.service.Join(context.Background(), req)
+		s.NoError(err)
+		s.Equal(racers.RaceCategoryEntries{s.dummyUser.ID: "10K"}, s.races.SaveCalls()[0].Race.CategoryEntries)
+	})
+}
+
 type listRacesSuite struct {
 	suite.Suite
 
@@ -342,7 +451,7 @@ type listRacesSuite struct {
 func (s *listRacesSuite) SetupTest() {
 	s.races = &RacesRepositoryMock{}
 
-	s.service = service.NewRaces(s.races, nil, service.NoopUnitOfWork, nil)
+	s.service = service.NewRaces(s.races, nil, nil, nil, service.NoopUnitOfWork, nil)
 }
 
 func (s listRacesSuite) TestListRaces_Success() {
@@ -366,3 +475,214 @@ func (s listRacesSuite) TestListRaces_Success() {
 	s.Equal(races, result)
 	s.NoError(err)
 }
+
+type recordResultSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.RecordResult
+
+	dummyRace racers.Race
+	owner     racers.User
+
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *recordResultSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	competitor := racers.UserID(id.Generate())
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Black Mamba Race"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(competitor),
+	}
+
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+
+	s.req = service.RecordResult{
+		RaceID: id.ID(s.dummyRace.ID).String(),
+		UserID: id.ID(competitor).String(),
+		Time:   "1:02:03",
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s recordResultSuite) TestRecordResult_InvalidRequest() {
+	for field, r := range map[string]service.RecordResult{
+		"race_id": {UserID: s.req.UserID, Time: s.req.Time},
+		"user_id": {RaceID: s.req.RaceID, Time: s.req.Time},
+		"time":    {RaceID: s.req.RaceID, UserID: s.req.UserID, Time: "1h"},
+	} {
+		s.Run(field, func() {
+			_, err := s.service.RecordResult(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s recordResultSuite) TestRecordResult_NotOwner() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.RecordResult(context.Background(), s.req)
+
+	s.Equal(service.ErrForbidden, err)
+	s.Len(s.races.SaveCalls(), 0)
+}
+
+func (s recordResultSuite) TestRecordResult_CompetitorNotInRace() {
+	s.req.UserID = id.Generate().String()
+
+	_, err := s.service.RecordResult(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.CompetitorNotInRaceError{}))
+}
+
+func (s recordResultSuite) TestRecordResult_Success() {
+	result, err := s.service.RecordResult(context.Background(), s.req)
+	s.NoError(err)
+
+	competitor := racers.UserID(id.MustParse(s.req.UserID))
+	expectedTime := racers.RaceTime(time.Hour + 2*time.Minute + 3*time.Second)
+	s.Equal(racers.RaceResults{competitor: expectedTime}, result.Results)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(
+		service.ResultRecorded{Race: s.dummyRace.ID, Competitor: competitor, Time: expectedTime},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
+
+type enterTeamSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.EnterTeam
+
+	dummyRace racers.Race
+	dummyTeam racers.Team
+	admin     racers.User
+
+	races    *RacesRepositoryMock
+	teams    *TeamsRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *enterTeamSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{}
+	s.teams = &TeamsRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.admin = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.admin },
+	}
+
+	member := racers.UserID(id.Generate())
+	s.dummyTeam = racers.NewTeam(
+		racers.TeamID(id.Generate()), racers.TeamName("black panthers"), s.admin.ID,
+		racers.TeamMembersOpt(racers.NewTeamMembers(s.admin.ID, member)),
+	)
+	s.dummyRace = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Name:        racers.RaceName("Black Mamba Race"),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       racers.UserID(id.Generate()),
+		Competitors: racers.NewRaceCompetitors(s.admin.ID, member),
+		Teams:       &racers.RaceTeams{Size: racers.RaceTeamSize{Min: 2, Max: 2}, Scoring: racers.TeamScoringBestTimes, Counting: 2},
+	}
+
+	s.races.GetFunc = func(context.Context, racers.RaceID) (racers.Race, error) {
+		return s.dummyRace, nil
+	}
+	s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
+		return s.dummyTeam, nil
+	}
+
+	s.req = service.EnterTeam{
+		RaceID:  id.ID(s.dummyRace.ID).String(),
+		TeamID:  id.ID(s.dummyTeam.ID).String(),
+		Members: []string{id.ID(s.admin.ID).String(), id.ID(member).String()},
+	}
+
+	s.service = service.NewRaces(s.races, s.teams, nil, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s enterTeamSuite) TestEnterTeam_InvalidRequest() {
+	for field, r := range map[string]service.EnterTeam{
+		"race_id": {TeamID: s.req.TeamID, Members: s.req.Members},
+		"team_id": {RaceID: s.req.RaceID, Members: s.req.Members},
+		"members": {RaceID: s.req.RaceID, TeamID: s.req.TeamID, Members: []string{"invalid"}},
+	} {
+		s.Run(field, func() {
+			_, err := s.service.EnterTeam(context.Background(), r)
+			s.Error(err)
+		})
+	}
+}
+
+func (s enterTeamSuite) TestEnterTeam_FailsGettingTeam() {
+	s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
+		return racers.Team{}, service.ErrTeamNotFound
+	}
+
+	_, err := s.service.EnterTeam(context.Background(), s.req)
+
+	s.Equal(service.ErrTeamNotFound, err)
+}
+
+func (s enterTeamSuite) TestEnterTeam_NotAdmin() {
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return racers.User{ID: racers.UserID(id.Generate())}
+	}
+
+	_, err := s.service.EnterTeam(context.Background(), s.req)
+
+	s.True(errors.As(err, &racers.NotTeamAdminError{}))
+	s.Len(s.races.SaveCalls(), 0)
+}
+
+func (s enterTeamSuite) TestEnterTeam_FailsSaving() {
+	s.races.SaveFunc = func(context.Context, racers.Race) error {
+		return errors.New("")
+	}
+
+	_, err := s.service.EnterTeam(context.Background(), s.req)
+
+	s.Error(err)
+	s.Len(s.eventBus.PublishCalls(), 0)
+}
+
+func (s enterTeamSuite) TestEnterTeam_Success() {
+	result, err := s.service.EnterTeam(context.Background(), s.req)
+	s.NoError(err)
+
+	members := result.TeamEntries[s.dummyTeam.ID]
+	s.Len(members, 2)
+
+	s.Len(s.races.SaveCalls(), 1)
+	s.Len(s.eventBus.PublishCalls(), 1)
+	s.Equal(
+		service.TeamEnteredRace{Race: s.dummyRace.ID, Team: s.dummyTeam.ID, Members: members},
+		s.eventBus.PublishCalls()[0].Events[0].Payload,
+	)
+}
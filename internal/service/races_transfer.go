@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type SetTransferPolicy struct {
+	RaceID  string
+	Allowed bool
+	// Deadline is nil when the registrations can be transferred until the race starts
+	Deadline *time.Time
+}
+
+// TransferPolicyChanged is published when the owner allows or disallows the transfers of the registrations,
+// Policy is nil when they are disallowed
+type TransferPolicyChanged struct {
+	Race   racers.RaceID
+	Policy *racers.TransferPolicy
+}
+
+func (e TransferPolicyChanged) RaceID() racers.RaceID { return e.Race }
+
+// SetTransferPolicy configures whether the competitors can transfer their registrations and until when,
+// only the race organizers are allowed
+func (s Races) SetTransferPolicy(ctx context.Context, r SetTransferPolicy) (racers.Race, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	return s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := s.checkPermission(ctx, *race, racers.PermissionEdit); err != nil {
+			return nil, err
+		}
+
+		if r.Allowed {
+			if err := race.AllowTransfers(r.Deadline); err != nil {
+				return nil, err
+			}
+		} else {
+			race.DisallowTransfers()
+		}
+
+		return []Event{newEvent(TransferPolicyChanged{Race: race.ID, Policy: race.Transfers}, s.users.Current(ctx).ID)}, nil
+	})
+}
+
+type OfferRegistrationTransfer struct {
+	RaceID string
+	// UserID is the user the registration is offered to
+	UserID string
+}
+
+// RegistrationTransferOffered is published when a competitor offers the registration to another user
+type RegistrationTransferOffered struct {
+	Race racers.RaceID
+	From racers.UserID
+	To   racers.UserID
+}
+
+func (e RegistrationTransferOffered) RaceID() racers.RaceID { return e.Race }
+
+// RegistrationOffer is the registration a competitor offered to another user
+type RegistrationOffer struct {
+	Race racers.RaceID
+	From racers.UserID
+	racers.RegistrationTransfer
+}
+
+// OfferTransfer offers the registration of the current user to another user, who has to accept it
+func (s Races) OfferTransfer(ctx context.Context, r OfferRegistrationTransfer) (RegistrationOffer, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return RegistrationOffer{}, err
+	}
+
+	toID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return RegistrationOffer{}, err
+	}
+
+	to, err := s.users.Get(ctx, toID)
+	if err != nil {
+		return RegistrationOffer{}, err
+	}
+
+	from := s.users.Current(ctx).ID
+	race, err := s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := race.OfferTransfer(from, to.ID, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(RegistrationTransferOffered{Race: race.ID, From: from, To: to.ID}, from)}, nil
+	})
+	if err != nil {
+		return RegistrationOffer{}, err
+	}
+
+	return RegistrationOffer{Race: race.ID, From: from, RegistrationTransfer: race.RegistrationTransfers[from]}, nil
+}
+
+type WithdrawRegistrationTransfer struct {
+	RaceID string
+}
+
+// RegistrationTransferWithdrawn is published when a competitor drops the offer of the registration
+type RegistrationTransferWithdrawn struct {
+	Race racers.RaceID
+	From racers.UserID
+}
+
+func (e RegistrationTransferWithdrawn) RaceID() racers.RaceID { return e.Race }
+
+// WithdrawTransfer drops the offer of the registration of the current user
+func (s Races) WithdrawTransfer(ctx context.Context, r WithdrawRegistrationTransfer) error {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return err
+	}
+
+	from := s.users.Current(ctx).ID
+	_, err = s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := race.WithdrawTransfer(from); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(RegistrationTransferWithdrawn{Race: race.ID, From: from}, from)}, nil
+	})
+
+	return err
+}
+
+type AcceptRegistrationTransfer struct {
+	RaceID string
+	// UserID is the competitor that offered the registration
+	UserID string
+}
+
+// RegistrationTransferred is published when a user accepts the registration offered by a competitor,
+// Bib is zero when the race has no bib numbers
+type RegistrationTransferred struct {
+	Race     racers.RaceID
+	From     racers.UserID
+	To       racers.UserID
+	Category racers.CategoryName
+	Bib      racers.Bib
+}
+
+func (e RegistrationTransferred) RaceID() racers.RaceID { return e.Race }
+
+// AcceptTransfer hands the registration offered to the current user over, the eligibility of the current
+// user is checked again
+func (s Races) AcceptTransfer(ctx context.Context, r AcceptRegistrationTransfer) (RaceRegistration, error) {
+	raceID, err := racers.NewRaceID(r.RaceID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	fromID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	// the eligibility is checked with the stored profile of the user, as when joining
+	to, err := s.users.Get(ctx, s.users.Current(ctx).ID)
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	race, err := s.update(ctx, raceID, func(race *racers.Race) ([]Event, error) {
+		if err := race.AcceptTransfer(fromID, to, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return []Event{newEvent(RegistrationTransferred{
+			Race:     race.ID,
+			From:     fromID,
+			To:       to.ID,
+			Category: race.CategoryEntries[to.ID],
+			Bib:      race.BibEntries[to.ID],
+		}, to.ID)}, nil
+	})
+	if err != nil {
+		return RaceRegistration{}, err
+	}
+
+	reg, _ := race.Registration(to.ID)
+
+	return RaceRegistration{Race: race.ID, Competitor: to.ID, Registration: reg}, nil
+}
@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesTransfer(t *testing.T) {
+	suite.Run(t, new(transferRegistrationSuite))
+}
+
+type transferRegistrationSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	race       racers.Race
+	owner      racers.User
+	competitor racers.User
+	friend     racers.User
+	current    racers.User
+
+	races    *RacesRepositoryMock
+	eventBus *EventBusMock
+}
+
+func (s *transferRegistrationSuite) SetupTest() {
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.competitor = racers.User{ID: racers.UserID(id.Generate())}
+	s.friend = racers.User{ID: racers.UserID(id.Generate())}
+	s.current = s.owner
+
+	s.race = racers.Race{
+		ID:          racers.RaceID(id.Generate()),
+		Date:        racers.RaceDate(time.Now().AddDate(0, 1, 0)),
+		Owner:       s.owner.ID,
+		Competitors: racers.NewRaceCompetitors(s.competitor.ID),
+	}
+	s.races = &RacesRepositoryMock{
+		GetFunc: func(context.Context, racers.RaceID) (racers.Race, error) { return s.race, nil },
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.race = race
+			return nil
+		},
+	}
+	users := &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.current },
+		GetFunc: func(_ context.Context, id racers.UserID) (racers.User, error) {
+			for _, u := range []racers.User{s.owner, s.competitor, s.friend} {
+				if u.ID == id {
+					return u, nil
+				}
+			}
+			return racers.User{}, service.ErrUserNotFound
+		},
+	}
+	s.eventBus = &EventBusMock{}
+
+	s.service = service.NewRaces(s.races, nil, nil, users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s *transferRegistrationSuite) allowTransfers() {
+	_, err := s.service.SetTransferPolicy(context.Background(), service.SetTransferPolicy{RaceID: id.ID(s.race.ID).String(), Allowed: true})
+	s.Require().NoError(err)
+}
+
+func (s *transferRegistrationSuite) TestSetTransferPolicy_NotOwner() {
+	s.current = s.competitor
+
+	_, err := s.service.SetTransferPolicy(context.Background(), service.SetTransferPolicy{RaceID: id.ID(s.race.ID).String(), Allowed: true})
+
+	s.Equal(service.ErrForbidden, err)
+	s.Empty(s.races.SaveCalls())
+}
+
+func (s *transferRegistrationSuite) TestOfferTransfer_NotAllowed() {
+	s.current = s.competitor
+
+	_, err := s.service.OfferTransfer(context.Background(), service.OfferRegistrationTransfer{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.friend.ID).String(),
+	})
+
+	s.True(errors.As(err, &racers.RegistrationTransferNotAllowedError{}))
+}
+
+func (s *transferRegistrationSuite) TestTransfer() {
+	s.allowTransfers()
+
+	s.current = s.competitor
+	_, err := s.service.OfferTransfer(context.Background(), service.OfferRegistrationTransfer{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.friend.ID).String(),
+	})
+	s.Require().NoError(err)
+
+	s.current = s.friend
+	reg, err := s.service.AcceptTransfer(context.Background(), service.AcceptRegistrationTransfer{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+	})
+	s.Require().NoError(err)
+
+	s.Equal(s.friend.ID, reg.Competitor)
+	s.True(s.race.HasCompetitor(s.friend.ID))
+	s.False(s.race.HasCompetitor(s.competitor.ID))
+
+	calls := s.eventBus.PublishCalls()
+	s.Equal(
+		service.RegistrationTransferOffered{Race: s.race.ID, From: s.competitor.ID, To: s.friend.ID},
+		calls[len(calls)-2].Events[0].Payload,
+	)
+	s.Equal(
+		service.RegistrationTransferred{Race: s.race.ID, From: s.competitor.ID, To: s.friend.ID},
+		calls[len(calls)-1].Events[0].Payload,
+	)
+}
+
+func (s *transferRegistrationSuite) TestAcceptTransfer_NotOffered() {
+	s.allowTransfers()
+	s.current = s.friend
+
+	_, err := s.service.AcceptTransfer(context.Background(), service.AcceptRegistrationTransfer{
+		RaceID: id.ID(s.race.ID).String(),
+		UserID: id.ID(s.competitor.ID).String(),
+	})
+
+	s.True(errors.As(err, &racers.RegistrationTransferNotFoundError{}))
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestRacesVenue(t *testing.T) {
+	suite.Run(t, new(createVenueRaceSuite))
+	suite.Run(t, new(racesNearSuite))
+}
+
+type createVenueRaceSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	req service.CreateRace
+}
+
+func (s *createVenueRaceSuite) SetupTest() {
+	s.req = service.CreateRace{
+		ID:   id.Generate().String(),
+		Name: "Tokyo Marathon",
+		Date: time.Now().AddDate(0, 1, 0),
+		Venue: &service.CreateRaceVenue{
+			Name:     "Tokyo Metropolitan Government Building",
+			Address:  "2-8-1 Nishishinjuku, Shinjuku",
+			Lat:      35.6896,
+			Lon:      139.6917,
+			TimeZone: "Asia/Tokyo",
+		},
+	}
+
+	s.service = service.NewRaces(&RacesRepositoryMock{}, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s createVenueRaceSuite) TestCreateVenueRace_InvalidVenue() {
+	s.Run("time zone", func() {
+		req := s.req
+		venue := *req.Venue
+		venue.TimeZone = "Asia/Nowhere"
+		req.Venue = &venue
+
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidTimeZoneError{}))
+	})
+
+	s.Run("coordinates", func() {
+		req := s.req
+		venue := *req.Venue
+		venue.Lat = 135
+		req.Venue = &venue
+
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidVenueError{}))
+	})
+}
+
+func (s createVenueRaceSuite) TestCreateVenueRace_Success() {
+	result, err := s.service.Create(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Equal("Asia/Tokyo", result.Location().String())
+	s.Equal(s.req.Venue.Name, result.Venue.Name)
+}
+
+type racesNearSuite struct {
+	suite.Suite
+
+	service service.Races
+
+	races *RacesRepositoryMock
+}
+
+func (s *racesNearSuite) SetupTest() {
+	s.races = &RacesRepositoryMock{
+		NearFunc: func(context.Context, float64, float64, float64) ([]racers.Race, error) {
+			return []racers.Race{}, nil
+		},
+	}
+
+	s.service = service.NewRaces(s.races, nil, nil, &UsersGetterMock{}, service.NoopUnitOfWork, &EventBusMock{})
+}
+
+func (s racesNearSuite) TestNear_InvalidRequest() {
+	s.Run("coordinates", func() {
+		_, err := s.service.Near(context.Background(), service.RacesNear{Lat: 43.3, Lon: 181, RadiusKm: 10})
+		s.True(errors.As(err, &racers.InvalidCoordinatesError{}))
+	})
+
+	for name, radius := range map[string]float64{"zero radius": 0, "radius too big": service.MaxNearRadiusKm + 1} {
+		s.Run(name, func() {
+			_, err := s.service.Near(context.Background(), service.RacesNear{Lat: 43.3, Lon: -1.98, RadiusKm: radius})
+			s.Equal(service.ErrInvalidRadius, err)
+		})
+	}
+}
+
+func (s racesNearSuite) TestNear_Success() {
+	_, err := s.service.Near(context.Background(), service.RacesNear{Lat: 43.3, Lon: -1.98, RadiusKm: 25})
+	s.NoError(err)
+
+	call := s.races.NearCalls()[0]
+	s.Equal(43.3, call.Lat)
+	s.Equal(-1.98, call.Lon)
+	s.Equal(25000.0, call.Radius)
+}
@@ -2,16 +2,50 @@ package service
 
 import (
 	"context"
+	"time"
 
 	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
 )
 
-//go:generate moq -stub -pkg service_test -out mock_repository_test.go . RacesRepository TeamsRepository UsersGetter
+//go:generate moq -stub -pkg service_test -out mock_repository_test.go . RacesRepository TeamsRepository UsersGetter OrganizationsRepository CalendarTokensRepository SeriesRepository DiscountCodesRepository NotificationPreferencesRepository UserDataRepository ErasuresRepository PaymentGateway
 
 type RacesRepository interface {
 	RacesGetter
 	Exists(ctx context.Context, race racers.Race) (bool, error)
+	// Save stores the race, giving back the redemptions of the discount codes of the registrations it
+	// no longer has
 	Save(ctx context.Context, race racers.Race) error
+	// SaveCourseFile stores the original file of the race course, category is empty for the race course
+	SaveCourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName, file racers.CourseFile) error
+	CourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName) (racers.CourseFile, error)
+	// Owner returns the owner of the race without loading the aggregate
+	Owner(ctx context.Context, id racers.RaceID) (racers.UserID, error)
+	// StartList and ResultSheet call fn with each row in order as they are read, stopping on the first error
+	StartList(ctx context.Context, id racers.RaceID, fn func(StartListEntry) error) error
+	ResultSheet(ctx context.Context, id racers.RaceID, fn func(ResultSheetEntry) error) error
+	// ByOrganization returns the races of the organization sorted by date
+	ByOrganization(ctx context.Context, id racers.OrganizationID) ([]racers.Race, error)
+	// Upcoming returns the races run from the given instant on sorted by date
+	Upcoming(ctx context.Context, from time.Time) ([]racers.Race, error)
+	// ByCompetitor returns the races the user joined sorted by date, past races included
+	ByCompetitor(ctx context.Context, id racers.UserID) ([]racers.Race, error)
+	// Near returns the races with a venue at most radius metres away from the point, the closest first
+	Near(ctx context.Context, lat, lon float64, radius float64) ([]racers.Race, error)
+	// Search returns the races matching the text and the filters, the most relevant first
+	Search(ctx context.Context, search RaceSearch) ([]RaceMatch, error)
+	// WithExpiredRegistrations returns the races with pending registrations expired at the given instant
+	WithExpiredRegistrations(ctx context.Context, now time.Time) ([]racers.RaceID, error)
+	// WithPendingRefunds returns the races with registrations pending to be refunded
+	WithPendingRefunds(ctx context.Context) ([]racers.RaceID, error)
+	// WithRegistrationDeadline returns the open races whose registration deadline passed at the given instant
+	WithRegistrationDeadline(ctx context.Context, now time.Time) ([]racers.RaceID, error)
+	// Unreminded returns the races not finished nor reminded starting in the period
+	Unreminded(ctx context.Context, from, to time.Time) ([]racers.RaceID, error)
+	// Unfinished returns the races not finished that started before the given instant
+	Unfinished(ctx context.Context, before time.Time) ([]racers.RaceID, error)
+	// CancelledWithCompetitors returns the cancelled races with competitors left to transfer or release
+	CancelledWithCompetitors(ctx context.Context) ([]racers.RaceID, error)
 }
 
 type RacesGetter interface {
@@ -31,5 +65,69 @@ type TeamsGetter interface {
 
 type UsersGetter interface {
 	Get(ctx context.Context, id racers.UserID) (racers.User, error)
+	// List returns the users with the ids at once, the ones that no longer exist are left out
+	List(ctx context.Context, ids []racers.UserID) ([]racers.User, error)
 	Current(ctx context.Context) racers.User
 }
+
+type OrganizationsRepository interface {
+	OrganizationsGetter
+	Exists(ctx context.Context, id racers.OrganizationID) (bool, error)
+	Save(ctx context.Context, org racers.Organization) error
+}
+
+type OrganizationsGetter interface {
+	// Get returns the organization with its members, ErrOrganizationNotFound if it does not exist
+	Get(ctx context.Context, id racers.OrganizationID) (racers.Organization, error)
+}
+
+type CalendarTokensRepository interface {
+	// Save stores the token, replacing the previous token of the user
+	Save(ctx context.Context, token CalendarToken) error
+	// Get returns the token with the secret hash, ErrCalendarTokenNotFound if it does not exist
+	Get(ctx context.Context, secretHash string) (CalendarToken, error)
+	Delete(ctx context.Context, user racers.UserID) error
+}
+
+type SeriesRepository interface {
+	Exists(ctx context.Context, id racers.SeriesID) (bool, error)
+	// Get returns the series with its instances, ErrSeriesNotFound if it does not exist
+	Get(ctx context.Context, id racers.SeriesID) (racers.Series, error)
+	// Save stores the series, the instances are stored with their races
+	Save(ctx context.Context, series racers.Series) error
+}
+
+type DiscountCodesRepository interface {
+	Exists(ctx context.Context, race racers.RaceID, code string) (bool, error)
+	// Get returns the code of the race, ErrDiscountCodeNotFound if it does not exist
+	Get(ctx context.Context, race racers.RaceID, code string) (racers.DiscountCode, error)
+	// List returns the codes of the race sorted by code
+	List(ctx context.Context, race racers.RaceID) ([]racers.DiscountCode, error)
+	// Save stores the definition of the code, keeping its redemptions
+	Save(ctx context.Context, code racers.DiscountCode) error
+	// Redeem counts a redemption of the code atomically, it returns false when no redemptions are left
+	Redeem(ctx context.Context, race racers.RaceID, code string) (bool, error)
+}
+
+type NotificationPreferencesRepository interface {
+	// Get returns the preferences of the user, ErrNotificationPreferencesNotFound if the user never changed them
+	Get(ctx context.Context, user racers.UserID) (NotificationPreferences, error)
+	Save(ctx context.Context, prefs NotificationPreferences) error
+}
+
+type UserDataRepository interface {
+	// Export returns the records tied to the user by data set
+	Export(ctx context.Context, user racers.UserID) (UserData, error)
+	// Anonymize replaces the user by the tombstone in all its records, the ones only meaningful to the user are removed
+	Anonymize(ctx context.Context, user, tombstone racers.UserID) error
+}
+
+type ErasuresRepository interface {
+	// Get returns the erasure, ErrErasureNotFound if it does not exist
+	Get(ctx context.Context, erasure id.ID) (Erasure, error)
+	// Pending returns the erasures not done, the oldest first
+	Pending(ctx context.Context) ([]Erasure, error)
+	// PendingFor returns the erasure of the user not done, ErrErasureNotFound if there is none
+	PendingFor(ctx context.Context, user racers.UserID) (Erasure, error)
+	Save(ctx context.Context, erasure Erasure) error
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func NewSeries(series SeriesRepository, races RacesRepository, users UsersGetter, uow UnitOfWork, eb EventBus) Series {
+	return Series{series, races, users, uow, eb}
+}
+
+// Series manages the recurring races, generating their races ahead of time
+type Series struct {
+	series SeriesRepository
+	races  RacesRepository
+	users  UsersGetter
+	uow    UnitOfWork
+	eb     EventBus
+}
+
+// SeriesDefinition is the data of a series, its races are created from it
+type SeriesDefinition struct {
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Venue       *CreateRaceVenue `json:"venue,omitempty"`
+	// Start is the date of the first race, the next ones keep its time of the day in the venue time zone
+	Start time.Time `json:"start,omitempty"`
+	// Rule is the RFC 5545 recurrence rule, like FREQ=MONTHLY;BYDAY=1SA
+	Rule string `json:"rule,omitempty"`
+	// Points are the standings points by position, empty for the default table
+	Points []int `json:"points,omitempty"`
+	// BestOf is the number of best races counted in the standings, zero to count them all
+	BestOf int `json:"best_of,omitempty"`
+}
+
+func (r SeriesDefinition) build() (racers.SeriesTemplate, racers.Recurrence, racers.SeriesScoring, error) {
+	name, err := racers.NewRaceName(r.Name)
+	if err != nil {
+		return racers.SeriesTemplate{}, racers.Recurrence{}, racers.SeriesScoring{}, err
+	}
+
+	template := racers.SeriesTemplate{Name: name, Description: r.Description}
+	if r.Venue != nil {
+		venue, err := r.Venue.build()
+		if err != nil {
+			return racers.SeriesTemplate{}, racers.Recurrence{}, racers.SeriesScoring{}, err
+		}
+		template.Venue = &venue
+	}
+
+	recurrence, err := racers.ParseRecurrence(r.Rule)
+	if err != nil {
+		return racers.SeriesTemplate{}, racers.Recurrence{}, racers.SeriesScoring{}, err
+	}
+
+	scoring, err := racers.NewSeriesScoring(r.Points, r.BestOf)
+	if err != nil {
+		return racers.SeriesTemplate{}, racers.Recurrence{}, racers.SeriesScoring{}, err
+	}
+
+	return template, recurrence, scoring, nil
+}
+
+// start returns the start truncated to seconds, so the occurrences match the stored ones
+func (r SeriesDefinition) start() time.Time {
+	return r.Start.Truncate(time.Second)
+}
+
+type CreateSeries struct {
+	ID string `json:"id,omitempty"`
+	SeriesDefinition
+}
+
+type SeriesCreated struct {
+	Series racers.Series `json:"series,omitempty"`
+}
+
+// Create creates the series owned by the current user and generates its races up to the horizon
+func (s Series) Create(ctx context.Context, r CreateSeries) (racers.Series, error) {
+	seriesID, err := racers.NewSeriesID(r.ID)
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	template, recurrence, scoring, err := r.build()
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	if _, err := racers.NewRaceDate(r.Start); err != nil {
+		return racers.Series{}, err
+	}
+
+	exists, err := s.series.Exists(ctx, seriesID)
+	if err != nil {
+		return racers.Series{}, err
+	}
+	if exists {
+		return racers.Series{}, ErrSeriesAlreadyExists
+	}
+
+	series := racers.Series{
+		ID:         seriesID,
+		Owner:      s.users.Current(ctx).ID,
+		Template:   template,
+		Start:      r.start(),
+		Recurrence: recurrence,
+		Scoring:    scoring,
+	}
+
+	races := s.generate(&series)
+
+	events := []Event{newEvent(SeriesCreated{Series: series}, s.users.Current(ctx).ID)}
+	err = s.uow(ctx, func(ctx context.Context) error {
+		return s.save(ctx, series, races, s.raceEvents(ctx, races, events))
+	})
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	return series, nil
+}
+
+type UpdateSeries struct {
+	ID string `json:"id,omitempty"`
+	SeriesDefinition
+}
+
+type SeriesUpdated struct {
+	Series racers.Series `json:"series,omitempty"`
+}
+
+// SeriesRaceDetached is published when a series update leaves a future race without occurrence,
+// the race is kept as a standalone race
+type SeriesRaceDetached struct {
+	Series racers.SeriesID
+	Race   racers.RaceID
+}
+
+func (e SeriesRaceDetached) RaceID() racers.RaceID { return e.Race }
+
+// Update changes the series, only the races not run yet are changed, only the series owner is allowed
+func (s Series) Update(ctx context.Context, r UpdateSeries) (racers.Series, error) {
+	seriesID, err := racers.NewSeriesID(r.ID)
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	template, recurrence, scoring, err := r.build()
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	current := s.users.Current(ctx).ID
+
+	// the races are read in the unit of work they are saved in, so they are locked meanwhile
+	var series racers.Series
+	err = s.uow(ctx, func(ctx context.Context) error {
+		series, err = s.series.Get(ctx, seriesID)
+		if err != nil {
+			return err
+		}
+
+		if current != series.Owner {
+			return ErrForbidden
+		}
+
+		series.Scoring = scoring
+		kept, detached := series.Update(time.Now(), template, r.start(), recurrence)
+
+		events := []Event{newEvent(SeriesUpdated{Series: series}, current)}
+		races := make([]racers.Race, 0, len(kept)+len(detached))
+		for _, k := range kept {
+			race, err := s.races.Get(ctx, k.Race)
+			if err != nil {
+				return err
+			}
+
+			race.ApplySeriesTemplate(series.Template)
+			if !k.To.Equal(k.From) {
+				race.Reschedule(racers.RaceDate(k.To))
+				race.Series.Occurrence = k.To
+				events = append(events, newEvent(RaceRescheduled{Race: race.ID, Date: race.Date, Sequence: race.Sequence}, current))
+			}
+			races = append(races, race)
+		}
+		for _, raceID := range detached {
+			race, err := s.races.Get(ctx, raceID)
+			if err != nil {
+				return err
+			}
+
+			race.Series = nil
+			races = append(races, race)
+			events = append(events, newEvent(SeriesRaceDetached{Series: series.ID, Race: race.ID}, current))
+		}
+
+		created := s.generate(&series)
+
+		return s.save(ctx, series, append(races, created...), s.raceEvents(ctx, created, events))
+	})
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	return series, nil
+}
+
+type GetSeries struct {
+	ID string `json:"id,omitempty"`
+}
+
+// Generate creates the races of the series up to the horizon that were not created yet,
+// only the series owner is allowed. Generating again creates no races until the horizon moves forward
+func (s Series) Generate(ctx context.Context, r GetSeries) ([]racers.Race, error) {
+	series, err := s.Get(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.users.Current(ctx).ID != series.Owner {
+		return nil, ErrForbidden
+	}
+
+	races := s.generate(&series)
+	if len(races) == 0 {
+		return races, nil
+	}
+
+	err = s.uow(ctx, func(ctx context.Context) error {
+		return s.save(ctx, series, races, s.raceEvents(ctx, races, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return races, nil
+}
+
+func (s Series) Get(ctx context.Context, r GetSeries) (racers.Series, error) {
+	seriesID, err := racers.NewSeriesID(r.ID)
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	return s.series.Get(ctx, seriesID)
+}
+
+// Standings returns the season standings of the series
+func (s Series) Standings(ctx context.Context, r GetSeries) ([]racers.SeriesStanding, error) {
+	series, err := s.Get(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	races := make([]racers.Race, 0, len(series.Instances))
+	for _, i := range series.Instances {
+		race, err := s.races.Get(ctx, i.Race)
+		if err != nil {
+			return nil, err
+		}
+		races = append(races, race)
+	}
+
+	return series.Standings(races), nil
+}
+
+// generate returns the new races of the pending occurrences of the series
+func (s Series) generate(series *racers.Series) []racers.Race {
+	pending := series.Pending(time.Now())
+	races := make([]racers.Race, len(pending))
+	for i, o := range pending {
+		races[i] = series.NewInstance(racers.RaceID(id.Generate()), o)
+	}
+
+	return races
+}
+
+// raceEvents appends the RaceCreated events of the races to the events
+func (s Series) raceEvents(ctx context.Context, races []racers.Race, events []Event) []Event {
+	for _, r := range races {
+		events = append(events, newEvent(RaceCreated{Race: r}, s.users.Current(ctx).ID))
+	}
+
+	return events
+}
+
+// save stores the series with the changed races and publishes the events, it must run in a unit of work
+func (s Series) save(ctx context.Context, series racers.Series, races []racers.Race, events []Event) error {
+	if err := s.series.Save(ctx, series); err != nil {
+		return err
+	}
+
+	for _, r := range races {
+		if err := s.races.Save(ctx, r); err != nil {
+			return err
+		}
+	}
+
+	return s.eb.Publish(ctx, events...)
+}
@@ -0,0 +1,196 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+func TestSeries(t *testing.T) {
+	suite.Run(t, new(seriesSuite))
+}
+
+type seriesSuite struct {
+	suite.Suite
+
+	service service.Series
+
+	req service.CreateSeries
+
+	owner racers.User
+	saved map[racers.RaceID]racers.Race
+
+	series   *SeriesRepositoryMock
+	races    *RacesRepositoryMock
+	users    *UsersGetterMock
+	eventBus *EventBusMock
+}
+
+func (s *seriesSuite) SetupTest() {
+	s.series = &SeriesRepositoryMock{}
+	s.eventBus = &EventBusMock{}
+
+	s.owner = racers.User{ID: racers.UserID(id.Generate())}
+	s.users = &UsersGetterMock{
+		CurrentFunc: func(context.Context) racers.User { return s.owner },
+	}
+
+	s.saved = make(map[racers.RaceID]racers.Race)
+	s.races = &RacesRepositoryMock{
+		SaveFunc: func(_ context.Context, race racers.Race) error {
+			s.saved[race.ID] = race
+			return nil
+		},
+		GetFunc: func(_ context.Context, id racers.RaceID) (racers.Race, error) {
+			race, ok := s.saved[id]
+			if !ok {
+				return racers.Race{}, service.ErrRaceNotFound
+			}
+			return race, nil
+		},
+	}
+
+	s.req = service.CreateSeries{
+		ID: id.Generate().String(),
+		SeriesDefinition: service.SeriesDefinition{
+			Name:  "Monthly parkrun",
+			Start: time.Now().Add(time.Hour),
+			Rule:  "FREQ=MONTHLY",
+		},
+	}
+
+	s.service = service.NewSeries(s.series, s.races, s.users, service.NoopUnitOfWork, s.eventBus)
+}
+
+func (s seriesSuite) TestCreate_InvalidRequest() {
+	s.Run("id", func() {
+		req := s.req
+		req.ID = ""
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidSeriesIDError{}))
+	})
+
+	s.Run("rule", func() {
+		req := s.req
+		req.Rule = "FREQ=YEARLY"
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidRecurrenceError{}))
+	})
+
+	s.Run("points", func() {
+		req := s.req
+		req.Points = []int{1, 2}
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidSeriesScoringError{}))
+	})
+
+	s.Run("past start", func() {
+		req := s.req
+		req.Start = time.Now().AddDate(0, 0, -1)
+		_, err := s.service.Create(context.Background(), req)
+		s.True(errors.As(err, &racers.InvalidRaceDateError{}))
+	})
+}
+
+func (s seriesSuite) TestCreate_AlreadyExists() {
+	s.series.ExistsFunc = func(context.Context, racers.SeriesID) (bool, error) { return true, nil }
+
+	_, err := s.service.Create(context.Background(), s.req)
+
+	s.Equal(service.ErrSeriesAlreadyExists, err)
+}
+
+func (s seriesSuite) TestCreate_Success() {
+	series, err := s.service.Create(context.Background(), s.req)
+	s.NoError(err)
+
+	s.Equal(s.owner.ID, series.Owner)
+	s.Len(s.series.SaveCalls(), 1)
+	s.Len(series.Instances, len(s.saved))
+	s.GreaterOrEqual(len(series.Instances), 3)
+
+	for _, i := range series.Instances {
+		race := s.saved[i.Race]
+		s.Equal(racers.RaceName("Monthly parkrun"), race.Name)
+		s.Equal(racers.RaceDate(i.Occurrence), race.Date)
+	}
+
+	events := s.eventBus.PublishCalls()[0].Events
+	s.Len(events, len(series.Instances)+1)
+	s.Equal(service.SeriesCreated{Series: series}, events[0].Payload)
+}
+
+func (s seriesSuite) TestGenerate_Idempotent() {
+	series, err := s.service.Create(context.Background(), s.req)
+	s.NoError(err)
+	s.series.GetFunc = func(context.Context, racers.SeriesID) (racers.Series, error) { return series, nil }
+
+	races, err := s.service.Generate(context.Background(), service.GetSeries{ID: s.req.ID})
+	s.NoError(err)
+
+	s.Empty(races)
+	s.Len(s.eventBus.PublishCalls(), 1)
+}
+
+func (s seriesSuite) TestUpdate_NotOwner() {
+	s.series.GetFunc = func(context.Context, racers.SeriesID) (racers.Series, error) {
+		return racers.Series{Owner: racers.UserID(id.Generate())}, nil
+	}
+
+	_, err := s.service.Update(context.Background(), service.UpdateSeries{ID: s.req.ID, SeriesDefinition: s.req.SeriesDefinition})
+
+	s.Equal(service.ErrForbidden, err)
+}
+
+func (s seriesSuite) TestUpdate_FutureRaces() {
+	series, err := s.service.Create(context.Background(), s.req)
+	s.NoError(err)
+	s.series.GetFunc = func(context.Context, racers.SeriesID) (racers.Series, error) { return series, nil }
+
+	def := s.req.SeriesDefinition
+	def.Name = "Weekly parkrun"
+	def.Start = def.Start.Add(24 * time.Hour)
+	def.Rule = "FREQ=MONTHLY;COUNT=2"
+
+	updated, err := s.service.Update(context.Background(), service.UpdateSeries{ID: s.req.ID, SeriesDefinition: def})
+	s.NoError(err)
+
+	s.Len(updated.Instances, 2)
+	for _, i := range updated.Instances {
+		race := s.saved[i.Race]
+		s.Equal(racers.RaceName("Weekly parkrun"), race.Name)
+		s.Equal(racers.RaceDate(i.Occurrence), race.Date)
+		s.Equal(1, race.Sequence)
+	}
+
+	var detached int
+	for _, race := range s.saved {
+		if race.Series == nil {
+			detached++
+		}
+	}
+	s.Equal(len(series.Instances)-2, detached)
+}
+
+func (s seriesSuite) TestStandings() {
+	competitor := racers.UserID(id.Generate())
+	raceID := racers.RaceID(id.Generate())
+	s.saved[raceID] = racers.Race{ID: raceID, Results: racers.RaceResults{competitor: racers.RaceTime(time.Hour)}}
+	s.series.GetFunc = func(context.Context, racers.SeriesID) (racers.Series, error) {
+		return racers.Series{
+			Scoring:   racers.SeriesScoring{Points: racers.DefaultSeriesPoints},
+			Instances: []racers.SeriesInstance{{Race: raceID}},
+		}, nil
+	}
+
+	standings, err := s.service.Standings(context.Background(), service.GetSeries{ID: s.req.ID})
+	s.NoError(err)
+
+	s.Equal([]racers.SeriesStanding{{Position: 1, Competitor: competitor, Points: 25, Races: 1}}, standings)
+}
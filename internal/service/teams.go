@@ -6,13 +6,15 @@ import (
 	racers "github.com/xabi93/racers/internal"
 )
 
-func NewTeams(teams TeamsRepository, users UsersGetter) Teams {
-	return Teams{teams, users}
+func NewTeams(teams TeamsRepository, users UsersGetter, uow UnitOfWork, eb EventBus) Teams {
+	return Teams{teams, users, uow, eb}
 }
 
 type Teams struct {
 	teams TeamsRepository
 	users UsersGetter
+	uow   UnitOfWork
+	eb    EventBus
 }
 
 type CreateTeam struct {
@@ -21,6 +23,12 @@ type CreateTeam struct {
 	AdminID string
 }
 
+type TeamCreated struct {
+	Team  racers.TeamID
+	Name  racers.TeamName
+	Admin racers.UserID
+}
+
 func (t Teams) Create(ctx context.Context, r CreateTeam) error {
 	id, err := racers.NewTeamID(r.ID)
 	if err != nil {
@@ -42,47 +50,51 @@ func (t Teams) Create(ctx context.Context, r CreateTeam) error {
 		return err
 	}
 
-	return t.teams.Save(ctx, racers.CreateTeam(id, name, admin))
-}
+	team := racers.CreateTeam(id, name, admin)
 
-type JoinTeam struct {
-	TeamID string
-	UserID string
+	return t.uow(ctx, func(ctx context.Context) error {
+		if err := t.teams.Save(ctx, team); err != nil {
+			return err
+		}
+
+		return t.eb.Publish(ctx, newEvent(TeamCreated{Team: team.ID, Name: team.Name, Admin: team.Admin}, t.users.Current(ctx).ID))
+	})
 }
 
-func (t Teams) Join(ctx context.Context, r JoinTeam) error {
-	teamID, err := racers.NewTeamID(r.TeamID)
-	if err != nil {
-		return err
-	}
-	userID, err := racers.NewUserID(r.UserID)
-	if err != nil {
-		return err
-	}
+// teamChange applies a change to a team and returns the event payload that describes it
+type teamChange func(team *racers.Team, current racers.User) (interface{}, error)
 
-	team, err := t.teams.Get(ctx, teamID)
+// change gets the team, applies the change by the current user, saves the team and publishes the change event,
+// all in a unit of work so the team is locked and concurrent changes are not lost
+func (t Teams) change(ctx context.Context, teamID string, apply teamChange) (racers.Team, error) {
+	id, err := racers.NewTeamID(teamID)
 	if err != nil {
-		return err
+		return racers.Team{}, err
 	}
 
-	user, err := t.users.Get(ctx, userID)
-	if err != nil {
-		return err
-	}
+	current := t.users.Current(ctx)
 
-	userTeam, err := t.teams.ByMember(ctx, userID)
-	if err != nil {
-		return err
-	}
+	var team racers.Team
+	err = t.uow(ctx, func(ctx context.Context) error {
+		var err error
+		if team, err = t.teams.Get(ctx, id); err != nil {
+			return err
+		}
 
-	team, err = racers.JoinTeam(team, user, userTeam)
-	if err != nil {
-		return err
-	}
+		payload, err := apply(&team, current)
+		if err != nil {
+			return err
+		}
 
-	if err := t.teams.Save(ctx, team); err != nil {
-		return err
+		if err := t.teams.Save(ctx, team); err != nil {
+			return err
+		}
+
+		return t.eb.Publish(ctx, newEvent(payload, current.ID))
+	})
+	if err != nil {
+		return racers.Team{}, err
 	}
 
-	return nil
+	return team, nil
 }
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+type InviteToTeam struct {
+	TeamID string
+	UserID string
+}
+
+type TeamInvitationSent struct {
+	Team      racers.TeamID
+	User      racers.UserID
+	ExpiresAt time.Time
+}
+
+// Invite invites a user to join the team, done by the team admin
+func (t Teams) Invite(ctx context.Context, r InviteToTeam) (racers.Team, error) {
+	userID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return racers.Team{}, err
+	}
+
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if _, err := t.users.Get(ctx, userID); err != nil {
+			return nil, err
+		}
+
+		if err := team.Invite(current, userID, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return TeamInvitationSent{Team: team.ID, User: userID, ExpiresAt: team.Invitations[userID]}, nil
+	})
+}
+
+// AnswerTeamInvitation is the answer of the current user to an invitation
+type AnswerTeamInvitation struct {
+	TeamID string
+}
+
+type TeamInvitationAccepted struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// AcceptInvitation makes the current user join the team that invited it
+func (t Teams) AcceptInvitation(ctx context.Context, r AnswerTeamInvitation) (racers.Team, error) {
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		userTeam, err := t.teams.ByMember(ctx, current.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := team.AcceptInvitation(current, userTeam, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return TeamInvitationAccepted{Team: team.ID, User: current.ID}, nil
+	})
+}
+
+type TeamInvitationDeclined struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// DeclineInvitation discards the invitation of the team to the current user
+func (t Teams) DeclineInvitation(ctx context.Context, r AnswerTeamInvitation) (racers.Team, error) {
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if err := team.DeclineInvitation(current); err != nil {
+			return nil, err
+		}
+
+		return TeamInvitationDeclined{Team: team.ID, User: current.ID}, nil
+	})
+}
+
+type RequestJoinTeam struct {
+	TeamID string
+}
+
+type TeamJoinRequested struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// RequestJoin asks the team admin to let the current user join the team
+func (t Teams) RequestJoin(ctx context.Context, r RequestJoinTeam) (racers.Team, error) {
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		userTeam, err := t.teams.ByMember(ctx, current.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := team.RequestJoin(current, userTeam); err != nil {
+			return nil, err
+		}
+
+		return TeamJoinRequested{Team: team.ID, User: current.ID}, nil
+	})
+}
+
+// AnswerJoinRequest is the answer of the team admin to the join request of a user
+type AnswerJoinRequest struct {
+	TeamID string
+	UserID string
+}
+
+type TeamJoinRequestApproved struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// ApproveJoinRequest adds to the team the user that requested to join, done by the team admin
+func (t Teams) ApproveJoinRequest(ctx context.Context, r AnswerJoinRequest) (racers.Team, error) {
+	userID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return racers.Team{}, err
+	}
+
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		userTeam, err := t.teams.ByMember(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := team.ApproveJoinRequest(current, userID, userTeam); err != nil {
+			return nil, err
+		}
+
+		return TeamJoinRequestApproved{Team: team.ID, User: userID}, nil
+	})
+}
+
+type TeamJoinRequestRejected struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// RejectJoinRequest discards the join request of a user, done by the team admin
+func (t Teams) RejectJoinRequest(ctx context.Context, r AnswerJoinRequest) (racers.Team, error) {
+	userID, err := racers.NewUserID(r.UserID)
+	if err != nil {
+		return racers.Team{}, err
+	}
+
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if err := team.RejectJoinRequest(current, userID); err != nil {
+			return nil, err
+		}
+
+		return TeamJoinRequestRejected{Team: team.ID, User: userID}, nil
+	})
+}
+
+type LeaveTeam struct {
+	TeamID string
+}
+
+type TeamLeft struct {
+	Team racers.TeamID
+	User racers.UserID
+}
+
+// Leave removes the current user from the team members
+func (t Teams) Leave(ctx context.Context, r LeaveTeam) (racers.Team, error) {
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if err := team.Leave(current); err != nil {
+			return nil, err
+		}
+
+		return TeamLeft{Team: team.ID, User: current.ID}, nil
+	})
+}
+
+type RemoveTeamMember struct {
+	TeamID   string
+	MemberID string
+}
+
+type TeamMemberRemoved struct {
+	Team   racers.TeamID
+	Member racers.UserID
+}
+
+// RemoveMember removes a member from the team, done by the team admin
+func (t Teams) RemoveMember(ctx context.Context, r RemoveTeamMember) (racers.Team, error) {
+	memberID, err := racers.NewUserID(r.MemberID)
+	if err != nil {
+		return racers.Team{}, err
+	}
+
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if err := team.RemoveMember(current, memberID); err != nil {
+			return nil, err
+		}
+
+		return TeamMemberRemoved{Team: team.ID, Member: memberID}, nil
+	})
+}
+
+type TransferTeamAdmin struct {
+	TeamID string
+	// To is the member that becomes the team admin
+	To string
+}
+
+type TeamAdminTransferred struct {
+	Team racers.TeamID
+	From racers.UserID
+	To   racers.UserID
+}
+
+// TransferAdmin makes other member the team admin, done by the current team admin
+func (t Teams) TransferAdmin(ctx context.Context, r TransferTeamAdmin) (racers.Team, error) {
+	to, err := racers.NewUserID(r.To)
+	if err != nil {
+		return racers.Team{}, err
+	}
+
+	return t.change(ctx, r.TeamID, func(team *racers.Team, current racers.User) (interface{}, error) {
+		if err := team.TransferAdmin(current, to); err != nil {
+			return nil, err
+		}
+
+		return TeamAdminTransferred{Team: team.ID, From: current.ID, To: to}, nil
+	})
+}
@@ -0,0 +1,309 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+)
+
+// withTeam makes the test service return the given team and the current user
+func (s testTeamsService) withTeam(team racers.Team, current racers.User) testTeamsService {
+	s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
+		return team, nil
+	}
+	s.users.CurrentFunc = func(context.Context) racers.User {
+		return current
+	}
+
+	return s
+}
+
+func TestInviteToTeam(t *testing.T) {
+	require := require.New(t)
+
+	userID := racers.UserID(id.Generate())
+	req := service.InviteToTeam{
+		TeamID: id.ID(teamID).String(),
+		UserID: id.ID(userID).String(),
+	}
+
+	t.Run("Scenario: invalid request", func(t *testing.T) {
+		for field, r := range map[string]service.InviteToTeam{
+			"team id": {UserID: req.UserID},
+			"user id": {TeamID: req.TeamID},
+		} {
+			t.Run(fmt.Sprintf("when invalid %s", field), func(t *testing.T) {
+				s := newTestTeamsService()
+				_, err := s.service.Invite(context.Background(), r)
+				require.Error(err)
+			})
+		}
+	})
+
+	t.Run("When the team does not exist, returns ErrTeamNotFound", func(t *testing.T) {
+		s := newTestTeamsService()
+		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
+			return racers.Team{}, service.ErrTeamNotFound
+		}
+
+		_, err := s.service.Invite(context.Background(), req)
+		require.True(errors.Is(err, service.ErrTeamNotFound))
+	})
+
+	t.Run("When the invited user does not exist, returns ErrUserNotFound", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), teamAdmin)
+		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
+			return racers.User{}, service.ErrUserNotFound
+		}
+
+		_, err := s.service.Invite(context.Background(), req)
+		require.True(errors.Is(err, service.ErrUserNotFound))
+		require.Len(s.teams.SaveCalls(), 0)
+	})
+
+	t.Run("When invited by a user that is not the admin, returns NotTeamAdminError", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), racers.User{ID: userID})
+
+		_, err := s.service.Invite(context.Background(), req)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+		require.Len(s.teams.SaveCalls(), 0)
+	})
+
+	t.Run("When fails publishing the event, returns the error", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), teamAdmin)
+		s.events.PublishFunc = func(context.Context, ...service.Event) error {
+			return errors.New("")
+		}
+
+		_, err := s.service.Invite(context.Background(), req)
+		require.Error(err)
+	})
+
+	t.Run("When the admin invites a user, saves the invitation and publishes TeamInvitationSent", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), teamAdmin)
+
+		team, err := s.service.Invite(context.Background(), req)
+		require.NoError(err)
+
+		expiresAt, ok := team.Invitations[userID]
+		require.True(ok)
+		require.WithinDuration(time.Now().Add(racers.TeamInvitationTTL), expiresAt, time.Minute)
+
+		require.Len(s.teams.SaveCalls(), 1)
+		require.Len(s.events.PublishCalls(), 1)
+		event := s.events.PublishCalls()[0].Events[0]
+		require.Equal(service.TeamInvitationSent{Team: teamID, User: userID, ExpiresAt: expiresAt}, event.Payload)
+		require.Equal(teamAdminID, event.UserID)
+	})
+
+	t.Run("When the admin invites a user, the team is read in the unit of work saving it", func(t *testing.T) {
+		type unitOfWork struct{}
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), teamAdmin)
+		s.service = service.NewTeams(s.teams, s.users, func(ctx context.Context, work service.Work) error {
+			return work(context.WithValue(ctx, unitOfWork{}, true))
+		}, s.events)
+		get := s.teams.GetFunc
+		s.teams.GetFunc = func(ctx context.Context, id racers.TeamID) (racers.Team, error) {
+			require.NotNil(ctx.Value(unitOfWork{}), "the team is locked until it is saved")
+			return get(ctx, id)
+		}
+
+		_, err := s.service.Invite(context.Background(), req)
+		require.NoError(err)
+		require.Len(s.teams.GetCalls(), 1)
+	})
+}
+
+func TestAnswerTeamInvitation(t *testing.T) {
+	require := require.New(t)
+
+	user := racers.User{ID: racers.UserID(id.Generate())}
+	req := service.AnswerTeamInvitation{TeamID: id.ID(teamID).String()}
+
+	invitedTeam := func() racers.Team {
+		return racers.NewTeam(teamID, teamName, teamAdminID,
+			racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID)),
+			racers.TeamInvitationsOpt(racers.TeamInvitations{user.ID: time.Now().Add(time.Hour)}),
+		)
+	}
+
+	t.Run("When accepts being member of other team, returns UserAlreadyInTeamError", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(invitedTeam(), user)
+		other := racers.NewTeam(racers.TeamID(id.Generate()), teamName, user.ID)
+		s.teams.ByMemberFunc = func(context.Context, racers.UserID) (*racers.Team, error) {
+			return &other, nil
+		}
+
+		_, err := s.service.AcceptInvitation(context.Background(), req)
+		require.True(errors.As(err, &racers.UserAlreadyInTeamError{}))
+		require.Len(s.teams.SaveCalls(), 0)
+	})
+
+	t.Run("When accepts, joins the team and publishes TeamInvitationAccepted", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(invitedTeam(), user)
+
+		team, err := s.service.AcceptInvitation(context.Background(), req)
+		require.NoError(err)
+		require.ElementsMatch([]racers.UserID{teamAdminID, user.ID}, team.Members.List())
+
+		require.Len(s.teams.SaveCalls(), 1)
+		require.Equal(
+			service.TeamInvitationAccepted{Team: teamID, User: user.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+
+	t.Run("When declines, removes the invitation and publishes TeamInvitationDeclined", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(invitedTeam(), user)
+
+		team, err := s.service.DeclineInvitation(context.Background(), req)
+		require.NoError(err)
+		require.Empty(team.Invitations)
+
+		require.Equal(
+			service.TeamInvitationDeclined{Team: teamID, User: user.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+}
+
+func TestTeamJoinRequest(t *testing.T) {
+	require := require.New(t)
+
+	user := racers.User{ID: racers.UserID(id.Generate())}
+
+	t.Run("When requests to join, adds the request and publishes TeamJoinRequested", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(racers.CreateTeam(teamID, teamName, teamAdmin), user)
+
+		team, err := s.service.RequestJoin(context.Background(), service.RequestJoinTeam{TeamID: id.ID(teamID).String()})
+		require.NoError(err)
+		require.Equal([]racers.UserID{user.ID}, team.JoinRequests.List())
+
+		require.Equal(len(s.teams.ByMemberCalls()), 1)
+		require.Equal(s.teams.ByMemberCalls()[0].ID, user.ID)
+		require.Equal(
+			service.TeamJoinRequested{Team: teamID, User: user.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+
+	req := service.AnswerJoinRequest{
+		TeamID: id.ID(teamID).String(),
+		UserID: id.ID(user.ID).String(),
+	}
+	requestedTeam := func() racers.Team {
+		return racers.NewTeam(teamID, teamName, teamAdminID,
+			racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID)),
+			racers.TeamJoinRequestsOpt(racers.NewTeamJoinRequests(user.ID)),
+		)
+	}
+
+	t.Run("When approved by a user that is not the admin, returns NotTeamAdminError", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(requestedTeam(), user)
+
+		_, err := s.service.ApproveJoinRequest(context.Background(), req)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When the admin approves, the user joins and publishes TeamJoinRequestApproved", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(requestedTeam(), teamAdmin)
+
+		team, err := s.service.ApproveJoinRequest(context.Background(), req)
+		require.NoError(err)
+		require.ElementsMatch([]racers.UserID{teamAdminID, user.ID}, team.Members.List())
+
+		require.Equal(
+			service.TeamJoinRequestApproved{Team: teamID, User: user.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+
+	t.Run("When the admin rejects, removes the request and publishes TeamJoinRequestRejected", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(requestedTeam(), teamAdmin)
+
+		team, err := s.service.RejectJoinRequest(context.Background(), req)
+		require.NoError(err)
+		require.Empty(team.JoinRequests.List())
+
+		require.Equal(
+			service.TeamJoinRequestRejected{Team: teamID, User: user.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+}
+
+func TestTeamMembership(t *testing.T) {
+	require := require.New(t)
+
+	member := racers.User{ID: racers.UserID(id.Generate())}
+	team := func() racers.Team {
+		return racers.NewTeam(teamID, teamName, teamAdminID, racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID, member.ID)))
+	}
+
+	t.Run("When the admin leaves, returns TeamAdminCannotLeaveError", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(team(), teamAdmin)
+
+		_, err := s.service.Leave(context.Background(), service.LeaveTeam{TeamID: id.ID(teamID).String()})
+		require.True(errors.As(err, &racers.TeamAdminCannotLeaveError{}))
+		require.Len(s.teams.SaveCalls(), 0)
+	})
+
+	t.Run("When a member leaves, publishes TeamLeft", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(team(), member)
+
+		result, err := s.service.Leave(context.Background(), service.LeaveTeam{TeamID: id.ID(teamID).String()})
+		require.NoError(err)
+		require.Equal([]racers.UserID{teamAdminID}, result.Members.List())
+
+		require.Equal(
+			service.TeamLeft{Team: teamID, User: member.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+
+	t.Run("When the admin removes a member, publishes TeamMemberRemoved", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(team(), teamAdmin)
+
+		result, err := s.service.RemoveMember(context.Background(), service.RemoveTeamMember{
+			TeamID:   id.ID(teamID).String(),
+			MemberID: id.ID(member.ID).String(),
+		})
+		require.NoError(err)
+		require.Equal([]racers.UserID{teamAdminID}, result.Members.List())
+
+		require.Equal(
+			service.TeamMemberRemoved{Team: teamID, Member: member.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+
+	t.Run("When invalid new admin id, returns InvalidUserIDError", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(team(), teamAdmin)
+
+		_, err := s.service.TransferAdmin(context.Background(), service.TransferTeamAdmin{TeamID: id.ID(teamID).String()})
+		require.True(errors.As(err, &racers.InvalidUserIDError{}))
+	})
+
+	t.Run("When the admin transfers the role, publishes TeamAdminTransferred", func(t *testing.T) {
+		s := newTestTeamsService().withTeam(team(), teamAdmin)
+
+		result, err := s.service.TransferAdmin(context.Background(), service.TransferTeamAdmin{
+			TeamID: id.ID(teamID).String(),
+			To:     id.ID(member.ID).String(),
+		})
+		require.NoError(err)
+		require.Equal(member.ID, result.Admin)
+
+		require.Equal(
+			service.TeamAdminTransferred{Team: teamID, From: teamAdminID, To: member.ID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
+	})
+}
@@ -23,15 +23,17 @@ type testTeamsService struct {
 	service service.Teams
 	teams   *TeamsRepositoryMock
 	users   *UsersGetterMock
+	events  *EventBusMock
 }
 
 func newTestTeamsService() testTeamsService {
 	s := testTeamsService{
-		teams: &TeamsRepositoryMock{},
-		users: &UsersGetterMock{},
+		teams:  &TeamsRepositoryMock{},
+		users:  &UsersGetterMock{},
+		events: &EventBusMock{},
 	}
 
-	s.service = service.NewTeams(s.teams, s.users)
+	s.service = service.NewTeams(s.teams, s.users, service.NoopUnitOfWork, s.events)
 
 	return s
 }
@@ -104,147 +106,11 @@ func TestCreateTeam(t *testing.T) {
 		savedTeam := s.teams.SaveCalls()[0].Team
 
 		require.Equal(racers.NewTeam(teamID, teamName, teamAdminID, racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID))), savedTeam)
-	})
-}
-
-func TestJoinTeam(t *testing.T) {
-	require := require.New(t)
-
-	team := racers.NewTeam(teamID, teamName, teamAdminID)
-
-	newMemberID := racers.UserID(id.Generate())
-	newMember := racers.User{ID: newMemberID}
-
-	req := service.JoinTeam{
-		TeamID: id.ID(teamID).String(),
-		UserID: id.ID(newMemberID).String(),
-	}
-
-	t.Run("Scenario: invalid request", func(t *testing.T) {
-		type testCase struct {
-			req service.JoinTeam
-		}
-		for field, c := range map[string]testCase{
-			"team id": {req: service.JoinTeam{UserID: req.UserID}},
-			"user id": {req: service.JoinTeam{TeamID: req.TeamID}},
-		} {
-			t.Run(fmt.Sprintf("when invalid %s", field), func(t *testing.T) {
-				s := newTestTeamsService()
-				err := s.service.Join(context.Background(), c.req)
-				require.Error(err)
-			})
-		}
-	})
-
-	t.Run("When valid request, but fails on getting the team", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return racers.Team{}, service.ErrTeamNotFound
-		}
-
-		require.Error(s.service.Join(context.Background(), req))
-	})
-
-	t.Run("When valid request, but fails on getting the user", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return racers.Team{}, nil
-		}
-
-		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
-			return racers.User{}, service.ErrUserNotFound
-		}
-
-		require.Error(s.service.Join(context.Background(), req))
-	})
-
-	t.Run("When valid request, but fails when tries to get the user team", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return team, nil
-		}
-		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
-			return newMember, nil
-		}
-		s.teams.ByMemberFunc = func(context.Context, racers.UserID) (*racers.Team, error) {
-			return nil, errors.New("")
-		}
-
-		require.Error(s.service.Join(context.Background(), req))
-	})
-
-	t.Run("When valid request, and the user is already in other team", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return team, nil
-		}
-		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
-			return newMember, nil
-		}
-		s.teams.ByMemberFunc = func(context.Context, racers.UserID) (*racers.Team, error) {
-			return &team, nil
-		}
-
-		err := s.service.Join(context.Background(), req)
-
-		var expectedErr racers.UserAlreadyInTeamError
-		require.True(errors.As(err, &expectedErr))
-		require.Equal(expectedErr.TeamID, teamID)
-		require.Equal(expectedErr.UserID, newMemberID)
-	})
-
-	t.Run("When valid request, and fails saving", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return team, nil
-		}
-		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
-			return newMember, nil
-		}
-		s.teams.ByMemberFunc = func(context.Context, racers.UserID) (*racers.Team, error) {
-			return nil, nil
-		}
-		s.teams.SaveFunc = func(context.Context, racers.Team) error {
-			return errors.New("")
-		}
-
-		require.Error(s.service.Join(context.Background(), req))
-	})
 
-	t.Run("When valid request, and saves team", func(t *testing.T) {
-		s := newTestTeamsService()
-
-		team := racers.NewTeam(teamID, teamName, teamAdminID)
-		s.teams.GetFunc = func(context.Context, racers.TeamID) (racers.Team, error) {
-			return team, nil
-		}
-		s.users.GetFunc = func(context.Context, racers.UserID) (racers.User, error) {
-			return newMember, nil
-		}
-		s.teams.ByMemberFunc = func(context.Context, racers.UserID) (*racers.Team, error) {
-			return nil, nil
-		}
-		s.teams.SaveFunc = func(context.Context, racers.Team) error {
-			return nil
-		}
-
-		err := s.service.Join(context.Background(), req)
-		require.NoError(err)
-
-		require.Equal(len(s.teams.GetCalls()), 1)
-		require.Equal(s.teams.GetCalls()[0].ID, teamID)
-
-		require.Equal(len(s.users.GetCalls()), 1)
-		require.Equal(s.users.GetCalls()[0].ID, newMemberID)
-
-		require.Equal(len(s.teams.ByMemberCalls()), 1)
-		require.Equal(s.teams.ByMemberCalls()[0].ID, newMemberID)
-
-		require.Equal(len(s.teams.SaveCalls()), 1)
+		require.Equal(len(s.events.PublishCalls()), 1)
+		require.Equal(
+			service.TeamCreated{Team: teamID, Name: teamName, Admin: teamAdminID},
+			s.events.PublishCalls()[0].Events[0].Payload,
+		)
 	})
 }
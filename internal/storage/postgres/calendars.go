@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type calendarToken struct {
+	UserID     racers.UserID `db:"user_id"`
+	SecretHash string        `db:"secret_hash"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
+
+func (calendarToken) TableName() string {
+	return "calendar_tokens"
+}
+
+func NewCalendarTokens(db *gorm.DB) CalendarTokens {
+	return CalendarTokens{Repository{db}}
+}
+
+type CalendarTokens struct {
+	repo Repository
+}
+
+func (r CalendarTokens) Save(ctx context.Context, token service.CalendarToken) error {
+	return r.repo.DB(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"secret_hash", "created_at"}),
+		}).
+		Create(&calendarToken{UserID: token.User, SecretHash: token.SecretHash, CreatedAt: token.CreatedAt}).
+		Error
+}
+
+func (r CalendarTokens) Get(ctx context.Context, secretHash string) (service.CalendarToken, error) {
+	var token calendarToken
+	if err := r.repo.DB(ctx).Where("secret_hash = ?", secretHash).Take(&token).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return service.CalendarToken{}, service.ErrCalendarTokenNotFound
+		}
+		return service.CalendarToken{}, err
+	}
+
+	return service.CalendarToken{User: token.UserID, SecretHash: token.SecretHash, CreatedAt: token.CreatedAt}, nil
+}
+
+func (r CalendarTokens) Delete(ctx context.Context, user racers.UserID) error {
+	return r.repo.DB(ctx).Where("user_id = ?", user).Delete(&calendarToken{}).Error
+}
@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"reflect"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type raceCheckpoint struct {
+	RaceID    racers.RaceID         `db:"race_id"`
+	Position  int                   `db:"position"`
+	Name      racers.CheckpointName `db:"name"`
+	DistanceM racers.Distance       `db:"distance_m"`
+	// CutoffMs is null when the checkpoint has no cutoff
+	CutoffMs *int64 `db:"cutoff_ms"`
+}
+
+func (raceCheckpoint) TableName() string {
+	return "race_checkpoints"
+}
+
+type racePassage struct {
+	RaceID       racers.RaceID         `db:"race_id"`
+	CompetitorID racers.UserID         `db:"competitor_id"`
+	Checkpoint   racers.CheckpointName `db:"checkpoint"`
+	PassedAt     time.Time             `db:"passed_at"`
+}
+
+func (racePassage) TableName() string {
+	return "race_passages"
+}
+
+func (r Races) loadCheckpoints(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var checkpoints []raceCheckpoint
+	if err := db.Where("race_id IN ?", ids).Order("position").Find(&checkpoints).Error; err != nil {
+		return err
+	}
+	for _, c := range checkpoints {
+		checkpoint := racers.Checkpoint{Name: c.Name, Distance: c.DistanceM}
+		if c.CutoffMs != nil {
+			checkpoint.Cutoff = racers.RaceTime(time.Duration(*c.CutoffMs) * time.Millisecond)
+		}
+
+		race := byID[c.RaceID]
+		race.Checkpoints = append(race.Checkpoints, checkpoint)
+	}
+
+	var passages []racePassage
+	if err := db.Where("race_id IN ?", ids).Find(&passages).Error; err != nil {
+		return err
+	}
+	for _, p := range passages {
+		race := byID[p.RaceID]
+		if race.Passages == nil {
+			race.Passages = make(racers.RacePassages)
+		}
+		if race.Passages[p.CompetitorID] == nil {
+			race.Passages[p.CompetitorID] = make(map[racers.CheckpointName]time.Time)
+		}
+		race.Passages[p.CompetitorID][p.Checkpoint] = p.PassedAt
+	}
+
+	return nil
+}
+
+// saveCheckpoints rewrites the checkpoints only when their definition changed, and writes only the passages
+// added or changed and removes the ones dropped, the passages of a race grow with every batch of the timing system
+func (r Races) saveCheckpoints(db *gorm.DB, in racers.Race) error {
+	checkpoints := make([]raceCheckpoint, len(in.Checkpoints))
+	names := make([]racers.CheckpointName, len(in.Checkpoints))
+	for i, c := range in.Checkpoints {
+		checkpoints[i] = raceCheckpoint{RaceID: in.ID, Position: i, Name: c.Name, DistanceM: c.Distance}
+		if c.Cutoff != 0 {
+			ms := time.Duration(c.Cutoff).Milliseconds()
+			checkpoints[i].CutoffMs = &ms
+		}
+		names[i] = c.Name
+	}
+
+	var stored []raceCheckpoint
+	if err := db.Where("race_id = ?", in.ID).Order("position").Find(&stored).Error; err != nil {
+		return err
+	}
+	changed := !reflect.DeepEqual(checkpoints, stored) && (len(checkpoints) > 0 || len(stored) > 0)
+
+	if changed && len(checkpoints) > 0 {
+		err := db.
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "race_id"}, {Name: "name"}},
+				DoUpdates: clause.AssignmentColumns([]string{"position", "distance_m", "cutoff_ms"}),
+			}).
+			Create(&checkpoints).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := r.savePassages(db, in); err != nil {
+		return err
+	}
+
+	if !changed {
+		return nil
+	}
+
+	// the checkpoints removed go with their passages. NOT IN an empty list matches nothing, without
+	// checkpoints all of them go
+	passages, removed := db.Where("race_id = ?", in.ID), db.Where("race_id = ?", in.ID)
+	if len(names) > 0 {
+		passages, removed = passages.Where("checkpoint NOT IN ?", names), removed.Where("name NOT IN ?", names)
+	}
+	if err := passages.Delete(&racePassage{}).Error; err != nil {
+		return err
+	}
+
+	return removed.Delete(&raceCheckpoint{}).Error
+}
+
+// savePassages inserts the passages not stored yet, updates the ones with other time and removes the ones
+// not in the race anymore
+func (r Races) savePassages(db *gorm.DB, in racers.Race) error {
+	var stored []racePassage
+	if err := db.Where("race_id = ?", in.ID).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	var removed [][]interface{}
+	for _, p := range stored {
+		if _, ok := in.Passages[p.CompetitorID][p.Checkpoint]; !ok {
+			removed = append(removed, []interface{}{p.CompetitorID, p.Checkpoint})
+		}
+	}
+	if len(removed) > 0 {
+		if err := db.Where("race_id = ? AND (competitor_id, checkpoint) IN ?", in.ID, removed).Delete(&racePassage{}).Error; err != nil {
+			return err
+		}
+	}
+
+	storedAt := make(map[racers.UserID]map[racers.CheckpointName]time.Time, len(stored))
+	for _, p := range stored {
+		if storedAt[p.CompetitorID] == nil {
+			storedAt[p.CompetitorID] = make(map[racers.CheckpointName]time.Time)
+		}
+		storedAt[p.CompetitorID][p.Checkpoint] = p.PassedAt
+	}
+
+	var passages []racePassage
+	for competitor, byCheckpoint := range in.Passages {
+		for checkpoint, at := range byCheckpoint {
+			// the database keeps the times in microseconds
+			if prev, ok := storedAt[competitor][checkpoint]; ok && prev.Equal(at.Truncate(time.Microsecond)) {
+				continue
+			}
+			passages = append(passages, racePassage{RaceID: in.ID, CompetitorID: competitor, Checkpoint: checkpoint, PassedAt: at})
+		}
+	}
+	if len(passages) == 0 {
+		return nil
+	}
+
+	return db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "race_id"}, {Name: "competitor_id"}, {Name: "checkpoint"}},
+			DoUpdates: clause.AssignmentColumns([]string{"passed_at"}),
+		}).
+		Create(&passages).
+		Error
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type raceCourse struct {
+	RaceID racers.RaceID `db:"race_id"`
+	// Category is empty for the race course
+	Category       racers.CategoryName `db:"category"`
+	DistanceM      racers.Distance     `db:"distance_m"`
+	ElevationGainM int                 `db:"elevation_gain_m"`
+	ElevationLossM int                 `db:"elevation_loss_m"`
+	// Points are stored as a json array of [lat, lon, elevation] tuples
+	Points string `db:"points"`
+}
+
+func (raceCourse) TableName() string {
+	return "race_courses"
+}
+
+func newRaceCourse(raceID racers.RaceID, category racers.CategoryName, c racers.Course) (raceCourse, error) {
+	points := make([][3]float64, len(c.Points))
+	for i, p := range c.Points {
+		points[i] = [3]float64{p.Lat, p.Lon, p.Elevation}
+	}
+
+	b, err := json.Marshal(points)
+	if err != nil {
+		return raceCourse{}, err
+	}
+
+	return raceCourse{
+		RaceID:         raceID,
+		Category:       category,
+		DistanceM:      c.Distance,
+		ElevationGainM: c.ElevationGain,
+		ElevationLossM: c.ElevationLoss,
+		Points:         string(b),
+	}, nil
+}
+
+func (c raceCourse) toDomain() (racers.Course, error) {
+	var points [][3]float64
+	if err := json.Unmarshal([]byte(c.Points), &points); err != nil {
+		return racers.Course{}, err
+	}
+
+	course := racers.Course{
+		Points:        make([]racers.CoursePoint, len(points)),
+		Distance:      c.DistanceM,
+		ElevationGain: c.ElevationGainM,
+		ElevationLoss: c.ElevationLossM,
+	}
+	for i, p := range points {
+		course.Points[i] = racers.CoursePoint{Lat: p[0], Lon: p[1], Elevation: p[2]}
+	}
+
+	return course, nil
+}
+
+type raceCourseFile struct {
+	RaceID   racers.RaceID       `db:"race_id"`
+	Category racers.CategoryName `db:"category"`
+	Format   racers.CourseFormat `db:"format"`
+	Data     []byte              `db:"data"`
+}
+
+func (raceCourseFile) TableName() string {
+	return "race_course_files"
+}
+
+// loadCourses sets the courses of the races and their categories, categories have to be loaded before
+func (r Races) loadCourses(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var courses []raceCourse
+	if err := db.Where("race_id IN ?", ids).Find(&courses).Error; err != nil {
+		return err
+	}
+
+	for _, c := range courses {
+		course, err := c.toDomain()
+		if err != nil {
+			return err
+		}
+
+		// courses of categories that no longer exist are ignored
+		_ = byID[c.RaceID].SetCourse(c.Category, course)
+	}
+
+	return nil
+}
+
+func (r Races) saveCourses(db *gorm.DB, in racers.Race) error {
+	var rows []raceCourse
+	add := func(category racers.CategoryName, c *racers.Course) error {
+		if c == nil {
+			return nil
+		}
+
+		row, err := newRaceCourse(in.ID, category, *c)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+
+		return nil
+	}
+
+	if err := add("", in.Course); err != nil {
+		return err
+	}
+	for _, c := range in.Categories {
+		if err := add(c.Name, c.Course); err != nil {
+			return err
+		}
+	}
+
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceCourse{}).Error; err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return db.Create(&rows).Error
+}
+
+func (r Races) SaveCourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName, file racers.CourseFile) error {
+	return r.repo.DB(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "race_id"}, {Name: "category"}},
+			DoUpdates: clause.AssignmentColumns([]string{"format", "data", "uploaded_at"}),
+		}).
+		Create(&raceCourseFile{RaceID: id, Category: category, Format: file.Format, Data: file.Data}).
+		Error
+}
+
+func (r Races) CourseFile(ctx context.Context, id racers.RaceID, category racers.CategoryName) (racers.CourseFile, error) {
+	var file raceCourseFile
+	err := r.repo.DB(ctx).
+		Where("race_id = ? AND category = ?", id, category).
+		Take(&file).
+		Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.CourseFile{}, service.ErrCourseNotFound
+		}
+		return racers.CourseFile{}, err
+	}
+
+	return racers.CourseFile{Format: file.Format, Data: file.Data}, nil
+}
@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -10,6 +12,8 @@ import (
 )
 
 type Config struct {
+	// User is the role the service connects as, it must be neither superuser nor BYPASSRLS as the row level
+	// security policies isolating the tenants do not apply to them
 	User         string `env:"DATABASE_USER" envDefault:"racers"`
 	Password     string `env:"DATABASE_PASS" envDefault:"racers"`
 	Host         string `env:"DATABASE_HOST" envDefault:"localhost"`
@@ -43,3 +47,26 @@ func Connect(c Config) (*sql.DB, error) {
 
 	return conn, conn.Ping()
 }
+
+// ErrPrivilegedRole means the connection role bypasses the row level security, a superuser or a BYPASSRLS role
+// reads and writes the rows of every tenant
+var ErrPrivilegedRole = errors.New("postgres: the role bypasses the row level security, connect as a role neither superuser nor BYPASSRLS")
+
+// querier runs a query on a connection or in a transaction
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// CheckRole returns ErrPrivilegedRole if the role of the connection bypasses the row level security policies
+func CheckRole(ctx context.Context, conn querier) error {
+	var bypass bool
+	err := conn.QueryRowContext(ctx, "SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user").Scan(&bypass)
+	if err != nil {
+		return err
+	}
+	if bypass {
+		return ErrPrivilegedRole
+	}
+
+	return nil
+}
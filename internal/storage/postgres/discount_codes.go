@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type discountCode struct {
+	RaceID         racers.RaceID       `db:"race_id"`
+	Code           string              `db:"code"`
+	Percent        int                 `db:"percent"`
+	Amount         int64               `db:"amount"`
+	MaxRedemptions int                 `db:"max_redemptions"`
+	ValidFrom      *time.Time          `db:"valid_from"`
+	ValidUntil     *time.Time          `db:"valid_until"`
+	Category       racers.CategoryName `db:"category"`
+	Redemptions    int                 `db:"redemptions"`
+}
+
+func (discountCode) TableName() string {
+	return "race_discount_codes"
+}
+
+func newDiscountCode(c racers.DiscountCode) discountCode {
+	dc := discountCode{
+		RaceID:         c.Race,
+		Code:           c.Code,
+		Percent:        c.Discount.Percent,
+		Amount:         c.Discount.Amount,
+		MaxRedemptions: c.MaxRedemptions,
+		Category:       c.Category,
+		Redemptions:    c.Redemptions,
+	}
+	if !c.ValidFrom.IsZero() {
+		from := c.ValidFrom
+		dc.ValidFrom = &from
+	}
+	if !c.ValidUntil.IsZero() {
+		until := c.ValidUntil
+		dc.ValidUntil = &until
+	}
+
+	return dc
+}
+
+func (c discountCode) toDomain() racers.DiscountCode {
+	code := racers.DiscountCode{
+		Race:           c.RaceID,
+		Code:           c.Code,
+		Discount:       racers.Discount{Percent: c.Percent, Amount: c.Amount},
+		MaxRedemptions: c.MaxRedemptions,
+		Category:       c.Category,
+		Redemptions:    c.Redemptions,
+	}
+	if c.ValidFrom != nil {
+		code.ValidFrom = *c.ValidFrom
+	}
+	if c.ValidUntil != nil {
+		code.ValidUntil = *c.ValidUntil
+	}
+
+	return code
+}
+
+func NewDiscountCodes(db *gorm.DB) DiscountCodes {
+	return DiscountCodes{Repository{db}}
+}
+
+type DiscountCodes struct {
+	repo Repository
+}
+
+func (r DiscountCodes) Exists(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+	var count int64
+	if err := r.repo.DB(ctx).Model(&discountCode{}).Where("race_id = ? AND code = ?", race, code).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r DiscountCodes) Get(ctx context.Context, race racers.RaceID, code string) (racers.DiscountCode, error) {
+	var dc discountCode
+	if err := r.repo.DB(ctx).Where("race_id = ? AND code = ?", race, code).Take(&dc).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.DiscountCode{}, service.ErrDiscountCodeNotFound
+		}
+		return racers.DiscountCode{}, err
+	}
+
+	return dc.toDomain(), nil
+}
+
+func (r DiscountCodes) List(ctx context.Context, race racers.RaceID) ([]racers.DiscountCode, error) {
+	var codes []discountCode
+	if err := r.repo.DB(ctx).Where("race_id = ?", race).Order("code").Find(&codes).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]racers.DiscountCode, len(codes))
+	for i, c := range codes {
+		result[i] = c.toDomain()
+	}
+
+	return result, nil
+}
+
+func (r DiscountCodes) Save(ctx context.Context, code racers.DiscountCode) error {
+	dc := newDiscountCode(code)
+
+	return r.repo.DB(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "race_id"}, {Name: "code"}},
+			// the redemptions are only changed by Redeem
+			DoUpdates: clause.AssignmentColumns([]string{"percent", "amount", "max_redemptions", "valid_from", "valid_until", "category"}),
+		}).
+		Create(&dc).
+		Error
+}
+
+// Redeem increments the redemptions in a single conditional update, concurrent redemptions wait on the row lock
+// and check the limit again once the previous one commits
+func (r DiscountCodes) Redeem(ctx context.Context, race racers.RaceID, code string) (bool, error) {
+	res := r.repo.DB(ctx).
+		Model(&discountCode{}).
+		Where("race_id = ? AND code = ?", race, code).
+		Where("max_redemptions = 0 OR redemptions < max_redemptions").
+		Update("redemptions", gorm.Expr("redemptions + 1"))
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
@@ -10,19 +10,36 @@ import (
 	"github.com/xabi93/racers/internal/id"
 	"github.com/xabi93/racers/internal/service"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type event struct {
-	ID         id.ID         `gorm:"type:uuid"`
-	Payload    string        `db:"payload"`
-	UserID     racers.UserID `db:"user_id"`
-	OccurredAt time.Time     `db:"occurred_at"`
+	ID      id.ID          `gorm:"type:uuid"`
+	Type    string         `db:"type"`
+	Payload string         `db:"payload"`
+	UserID  racers.UserID  `db:"user_id"`
+	RaceID  *racers.RaceID `db:"race_id"`
+	// OrganizationID is the organization of the event, or the one owning its race when it was published
+	OrganizationID *racers.OrganizationID `db:"organization_id"`
+	OccurredAt     time.Time              `db:"occurred_at"`
 }
 
 func (event) TableName() string {
 	return "events"
 }
 
+func (e event) toStored() service.StoredEvent {
+	return service.StoredEvent{
+		ID:             e.ID,
+		Type:           e.Type,
+		UserID:         e.UserID,
+		RaceID:         e.RaceID,
+		OrganizationID: e.OrganizationID,
+		Payload:        json.RawMessage(e.Payload),
+		OccurredAt:     e.OccurredAt,
+	}
+}
+
 func NewEvents(db *gorm.DB) Events {
 	return Events{Repository{db}}
 }
@@ -37,17 +54,137 @@ func (e Events) Publish(ctx context.Context, events ...service.Event) error {
 		return nil
 	}
 	eventsDB := make([]event, len(events))
+	var raceEvents []id.ID
 	for i, e := range events {
 		payload, err := json.Marshal(e.Payload)
 		if err != nil {
 			return errors.Wrap(err, "marshaling event")
 		}
 		eventsDB[i] = event{
-			ID:         e.ID,
-			OccurredAt: e.OccurredAt,
-			Payload:    string(payload),
-			UserID:     e.UserID,
+			ID:             e.ID,
+			Type:           e.Name(),
+			OccurredAt:     e.OccurredAt,
+			Payload:        string(payload),
+			UserID:         e.UserID,
+			RaceID:         e.RaceID(),
+			OrganizationID: e.OrganizationID(),
+		}
+		if eventsDB[i].RaceID != nil && eventsDB[i].OrganizationID == nil {
+			raceEvents = append(raceEvents, e.ID)
+		}
+	}
+	if err := db.Create(&eventsDB).Error; err != nil {
+		return errors.Wrap(err, "saving events")
+	}
+	if len(raceEvents) == 0 {
+		return nil
+	}
+
+	return errors.Wrap(db.Exec(
+		`UPDATE events SET organization_id = races.organization_id FROM races
+		WHERE events.tenant_id = ? AND races.tenant_id = events.tenant_id AND events.race_id = races.id
+		AND races.organization_id IS NOT NULL AND events.id IN ?`,
+		tenantOf(ctx), raceEvents,
+	).Error, "setting events organization")
+}
+
+func (e Events) Find(ctx context.Context, f service.EventsFilter, after *service.EventsCursor, limit int) ([]service.StoredEvent, error) {
+	query := e.repo.DB(ctx).
+		Model(&event{}).
+		Order("occurred_at DESC, id DESC").
+		Limit(limit)
+
+	if f.UserID != nil {
+		query = query.Where("user_id = ?", *f.UserID)
+	}
+	if f.RaceID != nil {
+		query = query.Where("race_id = ?", *f.RaceID)
+	}
+	if f.OrganizationID != nil {
+		query = query.Where("organization_id = ?", *f.OrganizationID)
+	}
+	if f.Type != "" {
+		query = query.Where("type = ?", f.Type)
+	}
+	if f.From != nil {
+		query = query.Where("occurred_at >= ?", *f.From)
+	}
+	if f.To != nil {
+		query = query.Where("occurred_at < ?", *f.To)
+	}
+	if after != nil {
+		query = query.Where("(occurred_at, id) < (?, ?)", after.OccurredAt, after.ID)
+	}
+
+	var eventsDB []event
+	if err := query.Find(&eventsDB).Error; err != nil {
+		return nil, errors.Wrap(err, "finding events")
+	}
+
+	result := make([]service.StoredEvent, len(eventsDB))
+	for i, e := range eventsDB {
+		result[i] = e.toStored()
+	}
+
+	return result, nil
+}
+
+// After returns the events published after the cursor and before the given time, the oldest first
+func (e Events) After(ctx context.Context, after *service.EventsCursor, before time.Time, limit int) ([]service.StoredEvent, error) {
+	query := e.repo.DB(ctx).
+		Model(&event{}).
+		Where("occurred_at < ?", before).
+		Order("occurred_at ASC, id ASC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(occurred_at, id) > (?, ?)", after.OccurredAt, after.ID)
+	}
+
+	var eventsDB []event
+	if err := query.Find(&eventsDB).Error; err != nil {
+		return nil, errors.Wrap(err, "reading events")
+	}
+
+	result := make([]service.StoredEvent, len(eventsDB))
+	for i, e := range eventsDB {
+		result[i] = e.toStored()
+	}
+
+	return result, nil
+}
+
+type eventConsumer struct {
+	Name       string    `db:"name"`
+	OccurredAt time.Time `db:"occurred_at"`
+	EventID    id.ID     `gorm:"type:uuid"`
+}
+
+func (eventConsumer) TableName() string {
+	return "event_consumers"
+}
+
+// Cursor returns the last event processed by the consumer, nil when it did not process any
+func (e Events) Cursor(ctx context.Context, consumer string) (*service.EventsCursor, error) {
+	var c eventConsumer
+	if err := e.repo.DB(ctx).Where("name = ?", consumer).Take(&c).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
 		}
+		return nil, errors.Wrap(err, "getting cursor")
 	}
-	return errors.Wrap(db.Create(&eventsDB).Error, "saving events")
+
+	return &service.EventsCursor{OccurredAt: c.OccurredAt, ID: c.EventID}, nil
+}
+
+func (e Events) SaveCursor(ctx context.Context, consumer string, cursor service.EventsCursor) error {
+	c := eventConsumer{Name: consumer, OccurredAt: cursor.OccurredAt, EventID: cursor.ID}
+
+	return errors.Wrap(e.repo.DB(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"occurred_at", "event_id"}),
+		}).
+		Create(&c).
+		Error, "saving cursor")
 }
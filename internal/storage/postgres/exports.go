@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+func (r Races) Owner(ctx context.Context, id racers.RaceID) (racers.UserID, error) {
+	var raceDB race
+	if err := r.repo.DB(ctx).Select("owner_id").Take(&raceDB, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.UserID{}, service.ErrRaceNotFound
+		}
+		return racers.UserID{}, err
+	}
+
+	return raceDB.OwnerID, nil
+}
+
+type startListRow struct {
+	CompetitorID racers.UserID
+	Bib          *racers.Bib
+	Category     *racers.CategoryName
+	Team         *racers.TeamName
+}
+
+// StartList reads the competitors sorted by bib, the ones without bib go last in registration order
+func (r Races) StartList(ctx context.Context, id racers.RaceID, fn func(service.StartListEntry) error) error {
+	db := r.repo.DB(ctx)
+	rows, err := db.Table("races_competitors AS rc").
+		Select("rc.competitor_id, rc.bib, rc.category, t.name AS team").
+		Joins("LEFT JOIN race_team_entries AS te ON te.tenant_id = rc.tenant_id AND te.race_id = rc.race_id AND te.member_id = rc.competitor_id").
+		Joins("LEFT JOIN teams AS t ON t.tenant_id = te.tenant_id AND t.id = te.team_id").
+		Where("rc.tenant_id = ? AND rc.race_id = ?", tenantOf(ctx), id).
+		Order("rc.bib NULLS LAST, rc.register_at, rc.competitor_id").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row startListRow
+		if err := db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+
+		e := service.StartListEntry{Competitor: row.CompetitorID}
+		if row.Bib != nil {
+			e.Bib = *row.Bib
+		}
+		if row.Category != nil {
+			e.Category = *row.Category
+		}
+		if row.Team != nil {
+			e.Team = *row.Team
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+type resultSheetRow struct {
+	CompetitorID     racers.UserID
+	Bib              *racers.Bib
+	Category         *racers.CategoryName
+	Position         int
+	CategoryPosition int
+	TimeMs           int64
+	GapMs            int64
+}
+
+// ResultSheet reads the results sorted by time, ranking them in the database so the rows can be streamed
+func (r Races) ResultSheet(ctx context.Context, id racers.RaceID, fn func(service.ResultSheetEntry) error) error {
+	db := r.repo.DB(ctx)
+	rows, err := db.Table("race_results AS rr").
+		Select(`rr.competitor_id, rc.bib, rc.category,
+			RANK() OVER (ORDER BY rr.time_ms) AS position,
+			RANK() OVER (PARTITION BY rc.category ORDER BY rr.time_ms) AS category_position,
+			rr.time_ms,
+			rr.time_ms - MIN(rr.time_ms) OVER () AS gap_ms`).
+		Joins("LEFT JOIN races_competitors AS rc ON rc.tenant_id = rr.tenant_id AND rc.race_id = rr.race_id AND rc.competitor_id = rr.competitor_id").
+		Where("rr.tenant_id = ? AND rr.race_id = ?", tenantOf(ctx), id).
+		Order("rr.time_ms, rr.competitor_id").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row resultSheetRow
+		if err := db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+
+		e := service.ResultSheetEntry{
+			Position:         row.Position,
+			Competitor:       row.CompetitorID,
+			CategoryPosition: row.CategoryPosition,
+			Time:             racers.RaceTime(time.Duration(row.TimeMs) * time.Millisecond),
+			Gap:              racers.RaceTime(time.Duration(row.GapMs) * time.Millisecond),
+		}
+		if row.Bib != nil {
+			e.Bib = *row.Bib
+		}
+		if row.Category != nil {
+			e.Category = *row.Category
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
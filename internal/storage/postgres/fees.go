@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"gorm.io/gorm"
+)
+
+type racePrice struct {
+	RaceID racers.RaceID `db:"race_id"`
+	// Category is empty for the race price
+	Category racers.CategoryName `db:"category"`
+	Currency racers.Currency     `db:"currency"`
+	Amount   int64               `db:"amount"`
+}
+
+func (racePrice) TableName() string {
+	return "race_prices"
+}
+
+type racePriceTier struct {
+	RaceID   racers.RaceID       `db:"race_id"`
+	Category racers.CategoryName `db:"category"`
+	Position int                 `db:"position"`
+	Until    time.Time           `db:"until"`
+	Amount   int64               `db:"amount"`
+}
+
+func (racePriceTier) TableName() string {
+	return "race_price_tiers"
+}
+
+type raceRegistration struct {
+	RaceID       racers.RaceID             `db:"race_id"`
+	CompetitorID racers.UserID             `db:"competitor_id"`
+	Status       racers.RegistrationStatus `db:"status"`
+	FeeAmount    int64                     `db:"fee_amount"`
+	FeeCurrency  racers.Currency           `db:"fee_currency"`
+	PaymentID    string                    `db:"payment_id"`
+	// ExpiresAt is null once the registration is confirmed
+	ExpiresAt *time.Time `db:"expires_at"`
+	Code      string     `db:"code"`
+}
+
+func (raceRegistration) TableName() string {
+	return "race_registrations"
+}
+
+// loadFees fills the prices of the races and their categories and the registrations of their competitors
+func (r Races) loadFees(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var prices []racePrice
+	if err := db.Where("race_id IN ?", ids).Find(&prices).Error; err != nil {
+		return err
+	}
+	var tiers []racePriceTier
+	if err := db.Where("race_id IN ?", ids).Order("position").Find(&tiers).Error; err != nil {
+		return err
+	}
+
+	type priceKey struct {
+		race     racers.RaceID
+		category racers.CategoryName
+	}
+	tiersByPrice := make(map[priceKey][]racers.PriceTier)
+	for _, t := range tiers {
+		k := priceKey{t.RaceID, t.Category}
+		tiersByPrice[k] = append(tiersByPrice[k], racers.PriceTier{Until: t.Until, Amount: t.Amount})
+	}
+
+	for _, p := range prices {
+		price := &racers.RacePrice{Currency: p.Currency, Amount: p.Amount, Tiers: tiersByPrice[priceKey{p.RaceID, p.Category}]}
+
+		race := byID[p.RaceID]
+		if p.Category == "" {
+			race.Price = price
+			continue
+		}
+		for i := range race.Categories {
+			if race.Categories[i].Name == p.Category {
+				race.Categories[i].Price = price
+			}
+		}
+	}
+
+	var registrations []raceRegistration
+	if err := db.Where("race_id IN ?", ids).Find(&registrations).Error; err != nil {
+		return err
+	}
+	for _, reg := range registrations {
+		registration := racers.Registration{
+			Status:  reg.Status,
+			Fee:     racers.Money{Amount: reg.FeeAmount, Currency: reg.FeeCurrency},
+			Payment: reg.PaymentID,
+			Code:    reg.Code,
+		}
+		if reg.ExpiresAt != nil {
+			registration.ExpiresAt = *reg.ExpiresAt
+		}
+
+		race := byID[reg.RaceID]
+		if race.Registrations == nil {
+			race.Registrations = make(racers.RaceRegistrations)
+		}
+		race.Registrations[reg.CompetitorID] = registration
+	}
+
+	return nil
+}
+
+func (r Races) saveFees(db *gorm.DB, in racers.Race) error {
+	// the tiers are removed by cascade
+	if err := db.Where("race_id = ?", in.ID).Delete(&racePrice{}).Error; err != nil {
+		return err
+	}
+
+	var (
+		prices []racePrice
+		tiers  []racePriceTier
+	)
+	addPrice := func(category racers.CategoryName, p *racers.RacePrice) {
+		if p == nil {
+			return
+		}
+
+		prices = append(prices, racePrice{RaceID: in.ID, Category: category, Currency: p.Currency, Amount: p.Amount})
+		for i, t := range p.Tiers {
+			tiers = append(tiers, racePriceTier{RaceID: in.ID, Category: category, Position: i, Until: t.Until, Amount: t.Amount})
+		}
+	}
+	addPrice("", in.Price)
+	for _, c := range in.Categories {
+		addPrice(c.Name, c.Price)
+	}
+	if len(prices) > 0 {
+		if err := db.Create(&prices).Error; err != nil {
+			return err
+		}
+	}
+	if len(tiers) > 0 {
+		if err := db.Create(&tiers).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := r.releaseCodes(db, in); err != nil {
+		return err
+	}
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceRegistration{}).Error; err != nil {
+		return err
+	}
+	registrations := make([]raceRegistration, 0, len(in.Registrations))
+	for c, reg := range in.Registrations {
+		row := raceRegistration{
+			RaceID:       in.ID,
+			CompetitorID: c,
+			Status:       reg.Status,
+			FeeAmount:    reg.Fee.Amount,
+			FeeCurrency:  reg.Fee.Currency,
+			PaymentID:    reg.Payment,
+			Code:         reg.Code,
+		}
+		if !reg.ExpiresAt.IsZero() {
+			expiresAt := reg.ExpiresAt
+			row.ExpiresAt = &expiresAt
+		}
+		registrations = append(registrations, row)
+	}
+	if len(registrations) == 0 {
+		return nil
+	}
+
+	return db.Create(&registrations).Error
+}
+
+// releaseCodes gives back the redemptions of the discount codes used by the registrations the race no
+// longer has, so the expired and withdrawn registrations do not use up the codes they were made with
+func (r Races) releaseCodes(db *gorm.DB, in racers.Race) error {
+	var stored []raceRegistration
+	if err := db.Where("race_id = ? AND code <> ''", in.ID).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	released := make(map[string]int)
+	for _, reg := range stored {
+		released[reg.Code]++
+	}
+	for _, reg := range in.Registrations {
+		if reg.Code != "" {
+			released[reg.Code]--
+		}
+	}
+
+	for code, n := range released {
+		if n <= 0 {
+			continue
+		}
+
+		err := db.
+			Model(&discountCode{}).
+			Where("race_id = ? AND code = ?", in.ID, code).
+			Update("redemptions", gorm.Expr("GREATEST(redemptions - ?, 0)", n)).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r Races) WithExpiredRegistrations(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&raceRegistration{}).
+		Distinct("race_id").
+		Where("status = ? AND expires_at <= ?", racers.RegistrationPendingPayment, now).
+		Pluck("race_id", &ids).
+		Error
+
+	return ids, err
+}
+
+func (r Races) WithPendingRefunds(ctx context.Context) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&raceRegistration{}).
+		Distinct("race_id").
+		Where("status = ?", racers.RegistrationRefundPending).
+		Pluck("race_id", &ids).
+		Error
+
+	return ids, err
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/xabi93/racers/internal/jobs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// jobsLockSpace is the first key of the advisory locks of the jobs, the second is the hash of the job name
+const jobsLockSpace = 43
+
+type jobRun struct {
+	Job         string    `db:"job"`
+	ScheduledAt time.Time `db:"scheduled_at"`
+	StartedAt   time.Time `db:"started_at"`
+	// FinishedAt is null while the run is running
+	FinishedAt *time.Time     `db:"finished_at"`
+	Status     jobs.RunStatus `db:"status"`
+	Processed  int            `db:"processed"`
+	Error      string         `db:"error"`
+}
+
+func (jobRun) TableName() string {
+	return "job_runs"
+}
+
+func newJobRun(r jobs.Run) jobRun {
+	run := jobRun{
+		Job:         r.Job,
+		ScheduledAt: r.ScheduledAt,
+		StartedAt:   r.StartedAt,
+		Status:      r.Status,
+		Processed:   r.Processed,
+		Error:       r.Error,
+	}
+	if !r.FinishedAt.IsZero() {
+		finished := r.FinishedAt
+		run.FinishedAt = &finished
+	}
+
+	return run
+}
+
+func NewJobRuns(db *gorm.DB) JobRuns {
+	return JobRuns{Repository{db}}
+}
+
+// JobRuns stores the runs of the scheduled jobs, the replicas are elected with session advisory locks
+// so a replica that dies releases its locks with its connection
+type JobRuns struct {
+	repo Repository
+}
+
+func (r JobRuns) Lock(ctx context.Context, job string) (func() error, bool, error) {
+	db, err := r.repo.db.DB()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the session locks belong to the connection, it is kept out of the pool until unlocked
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1, hashtext($2))", jobsLockSpace, job).Scan(&locked); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !locked {
+		return nil, false, conn.Close()
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1, hashtext($2))", jobsLockSpace, job)
+		return err
+	}, true, nil
+}
+
+func (r JobRuns) Start(ctx context.Context, run jobs.Run) (bool, error) {
+	dbRun := newJobRun(run)
+
+	res := r.repo.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&dbRun)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected == 1, nil
+}
+
+func (r JobRuns) Finish(ctx context.Context, run jobs.Run) error {
+	dbRun := newJobRun(run)
+
+	return r.repo.DB(ctx).
+		Model(&jobRun{}).
+		Where("job = ? AND scheduled_at = ?", run.Job, run.ScheduledAt).
+		Updates(map[string]interface{}{
+			"finished_at": dbRun.FinishedAt,
+			"status":      dbRun.Status,
+			"processed":   dbRun.Processed,
+			"error":       dbRun.Error,
+		}).
+		Error
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// WithRegistrationDeadline returns the open races whose registration deadline passed at the given instant
+func (r Races) WithRegistrationDeadline(ctx context.Context, now time.Time) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&race{}).
+		Where("status = ? AND registration_deadline <= ?", racers.RaceOpen, now).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// Unreminded returns the races not finished, cancelled nor reminded starting in the period
+func (r Races) Unreminded(ctx context.Context, from, to time.Time) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&race{}).
+		Where("NOT reminded AND status NOT IN (?, ?) AND date >= ? AND date < ?", racers.RaceFinished, racers.RaceCancelled, from, to).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// Unfinished returns the races not finished nor cancelled that started before the given instant
+func (r Races) Unfinished(ctx context.Context, before time.Time) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&race{}).
+		Where("status NOT IN (?, ?) AND date < ?", racers.RaceFinished, racers.RaceCancelled, before).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
+
+// CancelledWithCompetitors returns the cancelled races with competitors left to transfer or release
+func (r Races) CancelledWithCompetitors(ctx context.Context) ([]racers.RaceID, error) {
+	var ids []racers.RaceID
+	err := r.repo.DB(ctx).
+		Model(&race{}).
+		Where("status = ? AND EXISTS (SELECT 1 FROM races_competitors rc WHERE rc.race_id = races.id)", racers.RaceCancelled).
+		Pluck("id", &ids).
+		Error
+
+	return ids, err
+}
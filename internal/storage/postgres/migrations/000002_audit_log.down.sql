@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS events_type_occurred_at_id_idx;
+DROP INDEX IF EXISTS events_race_id_occurred_at_id_idx;
+DROP INDEX IF EXISTS events_user_id_occurred_at_id_idx;
+DROP INDEX IF EXISTS events_occurred_at_id_idx;
+
+ALTER TABLE events DROP COLUMN IF EXISTS race_id;
+ALTER TABLE events DROP COLUMN IF EXISTS type;
+
+COMMIT;
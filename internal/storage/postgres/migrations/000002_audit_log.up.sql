@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT '';
+ALTER TABLE events ADD COLUMN IF NOT EXISTS race_id UUID;
+
+-- The events published before these columns only carried the payload: RaceCreated {"race": race}
+-- and UserJoinedRace {"User": user, "Race": race}
+UPDATE events SET
+	type = CASE WHEN payload ? 'race' THEN 'RaceCreated' WHEN payload ? 'Race' THEN 'UserJoinedRace' ELSE type END,
+	race_id = COALESCE(payload->'race'->>'ID', payload->'Race'->>'ID')::UUID
+WHERE type = '';
+
+CREATE INDEX IF NOT EXISTS events_occurred_at_id_idx ON events (occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS events_user_id_occurred_at_id_idx ON events (user_id, occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS events_race_id_occurred_at_id_idx ON events (race_id, occurred_at DESC, id DESC) WHERE race_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS events_type_occurred_at_id_idx ON events (type, occurred_at DESC, id DESC);
+
+COMMIT;
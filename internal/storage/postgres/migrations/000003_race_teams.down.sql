@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_team_entries;
+DROP TABLE IF EXISTS race_results;
+
+DROP INDEX IF EXISTS team_members_member_id_idx;
+ALTER TABLE team_members DROP CONSTRAINT IF EXISTS team_members_pkey;
+ALTER TABLE team_members ADD PRIMARY KEY (team_id);
+
+ALTER TABLE races DROP COLUMN IF EXISTS team_counting;
+ALTER TABLE races DROP COLUMN IF EXISTS team_scoring;
+ALTER TABLE races DROP COLUMN IF EXISTS team_max_members;
+ALTER TABLE races DROP COLUMN IF EXISTS team_min_members;
+
+COMMIT;
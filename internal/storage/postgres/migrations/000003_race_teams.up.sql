@@ -0,0 +1,31 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS team_min_members INT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS team_max_members INT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS team_scoring TEXT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS team_counting INT;
+
+ALTER TABLE team_members DROP CONSTRAINT IF EXISTS team_members_pkey;
+ALTER TABLE team_members ADD PRIMARY KEY (team_id, member_id);
+CREATE UNIQUE INDEX IF NOT EXISTS team_members_member_id_idx ON team_members (member_id);
+
+CREATE TABLE IF NOT EXISTS race_results (
+	race_id UUID REFERENCES races (id),
+	competitor_id UUID,
+	time_ms BIGINT NOT NULL,
+
+	PRIMARY KEY(race_id, competitor_id)
+);
+
+CREATE TABLE IF NOT EXISTS race_team_entries (
+	race_id UUID REFERENCES races (id),
+	team_id UUID NOT NULL REFERENCES teams (id),
+	member_id UUID,
+	position INT NOT NULL,
+
+	PRIMARY KEY(race_id, member_id)
+);
+
+CREATE INDEX IF NOT EXISTS race_team_entries_team_id_idx ON race_team_entries (race_id, team_id);
+
+COMMIT;
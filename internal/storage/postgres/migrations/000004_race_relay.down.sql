@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_relay_entries;
+DROP TABLE IF EXISTS race_relay_legs;
+
+ALTER TABLE races DROP COLUMN IF EXISTS relay_allow_repeat_runners;
+
+COMMIT;
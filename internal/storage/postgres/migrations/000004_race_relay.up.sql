@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS relay_allow_repeat_runners BOOLEAN;
+
+CREATE TABLE IF NOT EXISTS race_relay_legs (
+	race_id UUID REFERENCES races (id),
+	position INT NOT NULL,
+	name TEXT NOT NULL,
+	distance_m INT NOT NULL,
+
+	PRIMARY KEY(race_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS race_relay_entries (
+	race_id UUID REFERENCES races (id),
+	team_id UUID NOT NULL REFERENCES teams (id),
+	leg INT NOT NULL,
+	runner_id UUID NOT NULL,
+	split_ms BIGINT,
+
+	PRIMARY KEY(race_id, team_id, leg)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_join_requests;
+DROP TABLE IF EXISTS team_invitations;
+
+COMMIT;
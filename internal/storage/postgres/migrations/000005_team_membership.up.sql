@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS team_invitations (
+	team_id UUID REFERENCES teams (id),
+	user_id UUID,
+	expires_at TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(team_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS team_join_requests (
+	team_id UUID REFERENCES teams (id),
+	user_id UUID,
+
+	PRIMARY KEY(team_id, user_id)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE races_competitors DROP COLUMN IF EXISTS category;
+
+DROP TABLE IF EXISTS race_categories;
+
+COMMIT;
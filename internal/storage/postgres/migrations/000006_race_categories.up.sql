@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_categories (
+	race_id UUID REFERENCES races (id),
+	name TEXT NOT NULL,
+	position INT NOT NULL,
+	distance_m INT NOT NULL,
+	start_time TIMESTAMP NOT NULL,
+	capacity INT NOT NULL DEFAULT 0,
+	min_age INT NOT NULL DEFAULT 0,
+	gender TEXT NOT NULL DEFAULT '',
+
+	PRIMARY KEY(race_id, name)
+);
+
+ALTER TABLE races_competitors ADD COLUMN IF NOT EXISTS category TEXT;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_course_files;
+DROP TABLE IF EXISTS race_courses;
+
+COMMIT;
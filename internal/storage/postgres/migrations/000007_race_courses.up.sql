@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_courses (
+	race_id UUID REFERENCES races (id),
+	category TEXT NOT NULL DEFAULT '',
+	distance_m INT NOT NULL,
+	elevation_gain_m INT NOT NULL,
+	elevation_loss_m INT NOT NULL,
+	points JSONB NOT NULL,
+
+	PRIMARY KEY(race_id, category)
+);
+
+CREATE TABLE IF NOT EXISTS race_course_files (
+	race_id UUID REFERENCES races (id),
+	category TEXT NOT NULL DEFAULT '',
+	format TEXT NOT NULL,
+	data BYTEA NOT NULL,
+	uploaded_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+	PRIMARY KEY(race_id, category)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_passages;
+DROP TABLE IF EXISTS race_checkpoints;
+
+COMMIT;
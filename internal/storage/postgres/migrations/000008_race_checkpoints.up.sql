@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_checkpoints (
+	race_id UUID REFERENCES races (id),
+	position INT NOT NULL,
+	name TEXT NOT NULL,
+	distance_m INT NOT NULL,
+	cutoff_ms BIGINT,
+
+	PRIMARY KEY(race_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS race_passages (
+	race_id UUID REFERENCES races (id),
+	competitor_id UUID NOT NULL,
+	checkpoint TEXT NOT NULL,
+	passed_at TIMESTAMP NOT NULL,
+
+	PRIMARY KEY(race_id, competitor_id, checkpoint),
+	FOREIGN KEY(race_id, checkpoint) REFERENCES race_checkpoints (race_id, name)
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE races_competitors DROP CONSTRAINT IF EXISTS races_competitors_bib_key;
+ALTER TABLE races_competitors DROP COLUMN IF EXISTS bib;
+
+ALTER TABLE race_categories DROP COLUMN IF EXISTS bib_to;
+ALTER TABLE race_categories DROP COLUMN IF EXISTS bib_from;
+
+ALTER TABLE races DROP COLUMN IF EXISTS bib_first;
+ALTER TABLE races DROP COLUMN IF EXISTS bib_strategy;
+
+COMMIT;
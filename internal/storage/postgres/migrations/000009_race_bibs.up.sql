@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS bib_strategy TEXT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS bib_first INT;
+
+ALTER TABLE race_categories ADD COLUMN IF NOT EXISTS bib_from INT;
+ALTER TABLE race_categories ADD COLUMN IF NOT EXISTS bib_to INT;
+
+ALTER TABLE races_competitors ADD COLUMN IF NOT EXISTS bib INT;
+ALTER TABLE races_competitors ADD CONSTRAINT races_competitors_bib_key UNIQUE (race_id, bib);
+
+COMMIT;
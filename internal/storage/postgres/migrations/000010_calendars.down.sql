@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS calendar_tokens;
+
+ALTER TABLE races DROP COLUMN IF EXISTS sequence;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS sequence INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS calendar_tokens (
+	user_id UUID PRIMARY KEY,
+	secret_hash TEXT NOT NULL UNIQUE,
+	created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP INDEX IF EXISTS races_venue_lat_lon_idx;
+
+ALTER TABLE races DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE races DROP COLUMN IF EXISTS venue_lon;
+ALTER TABLE races DROP COLUMN IF EXISTS venue_lat;
+ALTER TABLE races DROP COLUMN IF EXISTS venue_address;
+ALTER TABLE races DROP COLUMN IF EXISTS venue_name;
+
+ALTER TABLE race_passages ALTER COLUMN passed_at TYPE TIMESTAMP USING passed_at AT TIME ZONE 'UTC';
+ALTER TABLE race_categories ALTER COLUMN start_time TYPE TIMESTAMP USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE races ALTER COLUMN date TYPE TIMESTAMP USING date AT TIME ZONE 'UTC';
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE races ALTER COLUMN date TYPE TIMESTAMPTZ USING date AT TIME ZONE 'UTC';
+ALTER TABLE race_categories ALTER COLUMN start_time TYPE TIMESTAMPTZ USING start_time AT TIME ZONE 'UTC';
+ALTER TABLE race_passages ALTER COLUMN passed_at TYPE TIMESTAMPTZ USING passed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS venue_name TEXT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS venue_address TEXT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS venue_lat DOUBLE PRECISION;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS venue_lon DOUBLE PRECISION;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS time_zone TEXT;
+
+CREATE INDEX IF NOT EXISTS races_venue_lat_lon_idx ON races (venue_lat, venue_lon) WHERE venue_lat IS NOT NULL;
+
+COMMIT;
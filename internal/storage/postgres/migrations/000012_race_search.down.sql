@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS races_name_trgm_idx;
+DROP INDEX IF EXISTS races_search_idx;
+
+ALTER TABLE races DROP COLUMN IF EXISTS search;
+ALTER TABLE races DROP COLUMN IF EXISTS description;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '';
+ALTER TABLE races ADD COLUMN IF NOT EXISTS search TSVECTOR;
+
+UPDATE races SET search =
+	setweight(to_tsvector('simple', name), 'A') ||
+	setweight(to_tsvector('simple', COALESCE(venue_name, '') || ' ' || COALESCE(venue_address, '')), 'B') ||
+	setweight(to_tsvector('simple', description), 'C');
+
+CREATE INDEX IF NOT EXISTS races_search_idx ON races USING GIN (search);
+CREATE INDEX IF NOT EXISTS races_name_trgm_idx ON races USING GIN (name gin_trgm_ops);
+
+COMMIT;
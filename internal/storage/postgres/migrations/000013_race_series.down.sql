@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE races DROP COLUMN IF EXISTS series_occurrence;
+ALTER TABLE races DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS race_series_points;
+DROP TABLE IF EXISTS race_series;
+
+COMMIT;
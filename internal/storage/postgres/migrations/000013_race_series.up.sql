@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_series (
+	id UUID PRIMARY KEY,
+	owner_id UUID NOT NULL,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	start TIMESTAMPTZ NOT NULL,
+	rule TEXT NOT NULL,
+	best_of INT NOT NULL DEFAULT 0,
+	venue_name TEXT,
+	venue_address TEXT,
+	venue_lat DOUBLE PRECISION,
+	venue_lon DOUBLE PRECISION,
+	time_zone TEXT
+);
+
+CREATE TABLE IF NOT EXISTS race_series_points (
+	series_id UUID NOT NULL REFERENCES race_series (id) ON DELETE CASCADE,
+	position INT NOT NULL,
+	points INT NOT NULL,
+	PRIMARY KEY (series_id, position)
+);
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES race_series (id);
+ALTER TABLE races ADD COLUMN IF NOT EXISTS series_occurrence TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS races_series_id_idx ON races (series_id) WHERE series_id IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_registrations;
+DROP TABLE IF EXISTS race_price_tiers;
+DROP TABLE IF EXISTS race_prices;
+
+COMMIT;
@@ -0,0 +1,35 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_prices (
+	race_id UUID NOT NULL REFERENCES races (id) ON DELETE CASCADE,
+	-- category is empty for the race price
+	category TEXT NOT NULL DEFAULT '',
+	currency CHAR(3) NOT NULL,
+	amount BIGINT NOT NULL,
+	PRIMARY KEY (race_id, category)
+);
+
+CREATE TABLE IF NOT EXISTS race_price_tiers (
+	race_id UUID NOT NULL,
+	category TEXT NOT NULL DEFAULT '',
+	position INT NOT NULL,
+	until TIMESTAMPTZ NOT NULL,
+	amount BIGINT NOT NULL,
+	PRIMARY KEY (race_id, category, position),
+	FOREIGN KEY (race_id, category) REFERENCES race_prices (race_id, category) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS race_registrations (
+	race_id UUID NOT NULL REFERENCES races (id) ON DELETE CASCADE,
+	competitor_id UUID NOT NULL,
+	status TEXT NOT NULL,
+	fee_amount BIGINT NOT NULL,
+	fee_currency CHAR(3) NOT NULL,
+	payment_id TEXT NOT NULL DEFAULT '',
+	expires_at TIMESTAMPTZ,
+	PRIMARY KEY (race_id, competitor_id)
+);
+
+CREATE INDEX IF NOT EXISTS race_registrations_pending_idx ON race_registrations (expires_at) WHERE status = 'PENDING_PAYMENT';
+
+COMMIT;
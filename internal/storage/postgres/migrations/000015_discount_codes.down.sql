@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE race_registrations DROP COLUMN IF EXISTS code;
+
+DROP TABLE IF EXISTS race_discount_codes;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS race_discount_codes (
+	race_id UUID NOT NULL REFERENCES races (id) ON DELETE CASCADE,
+	code TEXT NOT NULL,
+	percent INT NOT NULL DEFAULT 0,
+	amount BIGINT NOT NULL DEFAULT 0,
+	-- max_redemptions is 0 for no limit
+	max_redemptions INT NOT NULL DEFAULT 0,
+	valid_from TIMESTAMPTZ,
+	valid_until TIMESTAMPTZ,
+	-- category is empty when the code is valid for any category
+	category TEXT NOT NULL DEFAULT '',
+	redemptions INT NOT NULL DEFAULT 0,
+	PRIMARY KEY (race_id, code),
+	CHECK (max_redemptions = 0 OR redemptions <= max_redemptions)
+);
+
+ALTER TABLE race_registrations ADD COLUMN IF NOT EXISTS code TEXT NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS event_consumers;
+DROP TABLE IF EXISTS notification_opt_outs;
+DROP TABLE IF EXISTS notification_preferences;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+	user_id UUID PRIMARY KEY,
+	locale TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS notification_opt_outs (
+	user_id UUID NOT NULL,
+	kind TEXT NOT NULL,
+	PRIMARY KEY (user_id, kind)
+);
+
+-- event_consumers are the position of the processes reading the events in order
+CREATE TABLE IF NOT EXISTS event_consumers (
+	name TEXT PRIMARY KEY,
+	occurred_at TIMESTAMPTZ NOT NULL,
+	event_id UUID NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS job_runs;
+
+DROP INDEX IF EXISTS races_status_date_idx;
+
+ALTER TABLE races DROP COLUMN IF EXISTS reminded;
+ALTER TABLE races DROP COLUMN IF EXISTS registration_deadline;
+ALTER TABLE races DROP COLUMN IF EXISTS status;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'OPEN';
+ALTER TABLE races ADD COLUMN IF NOT EXISTS registration_deadline TIMESTAMPTZ;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS reminded BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS races_status_date_idx ON races (status, date);
+
+-- job_runs are the runs of the scheduled jobs, a job runs once at each scheduled time
+CREATE TABLE IF NOT EXISTS job_runs (
+	job TEXT NOT NULL,
+	scheduled_at TIMESTAMPTZ NOT NULL,
+	started_at TIMESTAMPTZ NOT NULL,
+	finished_at TIMESTAMPTZ,
+	status TEXT NOT NULL,
+	processed INT NOT NULL DEFAULT 0,
+	error TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (job, scheduled_at)
+);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE races DROP COLUMN IF EXISTS transfer_category;
+ALTER TABLE races DROP COLUMN IF EXISTS transfer_race_id;
+ALTER TABLE races DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE races DROP COLUMN IF EXISTS cancel_reason;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS cancel_reason TEXT;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ;
+-- transfer_race_id and transfer_category are null when the competitors of the cancelled race are released
+ALTER TABLE races ADD COLUMN IF NOT EXISTS transfer_race_id UUID REFERENCES races (id);
+ALTER TABLE races ADD COLUMN IF NOT EXISTS transfer_category TEXT;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_registration_transfers;
+
+ALTER TABLE races DROP COLUMN IF EXISTS registration_transfer_deadline;
+ALTER TABLE races DROP COLUMN IF EXISTS registration_transfers_allowed;
+
+COMMIT;
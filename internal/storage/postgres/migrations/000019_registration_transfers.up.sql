@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS registration_transfers_allowed BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE races ADD COLUMN IF NOT EXISTS registration_transfer_deadline TIMESTAMPTZ;
+
+-- race_registration_transfers are the registrations offered by the competitors, until the user accepts them
+CREATE TABLE IF NOT EXISTS race_registration_transfers (
+	race_id UUID NOT NULL REFERENCES races (id) ON DELETE CASCADE,
+	from_id UUID NOT NULL,
+	to_id UUID NOT NULL,
+	offered_at TIMESTAMPTZ NOT NULL,
+	PRIMARY KEY (race_id, from_id)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS race_staff;
+
+ALTER TABLE races_competitors DROP COLUMN IF EXISTS checked_in_at;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE races_competitors ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ;
+
+-- race_staff are the users the owner allows to check the competitors in
+CREATE TABLE IF NOT EXISTS race_staff (
+	race_id UUID NOT NULL REFERENCES races (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL,
+	PRIMARY KEY (race_id, user_id)
+);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE race_staff DROP COLUMN IF EXISTS role;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- the staff granted before the roles checked the competitors in
+ALTER TABLE race_staff ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'VOLUNTEER';
+ALTER TABLE race_staff ALTER COLUMN role DROP DEFAULT;
+
+COMMIT;
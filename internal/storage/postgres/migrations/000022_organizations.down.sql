@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE events DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE races DROP COLUMN IF EXISTS organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
+
+COMMIT;
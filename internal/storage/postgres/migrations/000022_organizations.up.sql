@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS organizations (
+	id UUID PRIMARY KEY,
+	name VARCHAR(255) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+	organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL,
+	role VARCHAR(20) NOT NULL,
+	PRIMARY KEY (organization_id, user_id)
+);
+
+ALTER TABLE races ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations (id);
+CREATE INDEX IF NOT EXISTS races_organization_id_idx ON races (organization_id) WHERE organization_id IS NOT NULL;
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS organization_id UUID;
+CREATE INDEX IF NOT EXISTS events_organization_id_occurred_at_id_idx ON events (organization_id, occurred_at DESC, id DESC) WHERE organization_id IS NOT NULL;
+
+COMMIT;
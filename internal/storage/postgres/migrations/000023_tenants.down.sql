@@ -0,0 +1,43 @@
+BEGIN;
+
+ALTER TABLE event_consumers DROP CONSTRAINT event_consumers_pkey;
+ALTER TABLE event_consumers ADD PRIMARY KEY (name);
+
+ALTER TABLE notification_opt_outs DROP CONSTRAINT notification_opt_outs_pkey;
+ALTER TABLE notification_opt_outs ADD PRIMARY KEY (user_id, kind);
+
+ALTER TABLE notification_preferences DROP CONSTRAINT notification_preferences_pkey;
+ALTER TABLE notification_preferences ADD PRIMARY KEY (user_id);
+
+ALTER TABLE calendar_tokens DROP CONSTRAINT calendar_tokens_pkey;
+ALTER TABLE calendar_tokens ADD PRIMARY KEY (user_id);
+
+DROP INDEX IF EXISTS team_members_tenant_id_member_id_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS team_members_member_id_idx ON team_members (member_id);
+
+DROP INDEX IF EXISTS events_tenant_id_occurred_at_id_idx;
+DROP INDEX IF EXISTS races_tenant_id_date_idx;
+
+DO $$
+DECLARE
+	t TEXT;
+BEGIN
+	FOREACH t IN ARRAY ARRAY[
+		'races', 'races_competitors', 'teams', 'team_members', 'events', 'race_results', 'race_team_entries',
+		'race_relay_legs', 'race_relay_entries', 'team_invitations', 'team_join_requests', 'race_categories',
+		'race_courses', 'race_course_files', 'race_checkpoints', 'race_passages', 'calendar_tokens', 'race_series',
+		'race_series_points', 'race_prices', 'race_price_tiers', 'race_registrations', 'race_discount_codes',
+		'notification_preferences', 'notification_opt_outs', 'event_consumers', 'race_registration_transfers',
+		'race_staff', 'organizations', 'organization_members'
+	] LOOP
+		EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON %I', t);
+		EXECUTE format('ALTER TABLE %I NO FORCE ROW LEVEL SECURITY', t);
+		EXECUTE format('ALTER TABLE %I DISABLE ROW LEVEL SECURITY', t);
+		EXECUTE format('ALTER TABLE %I DROP COLUMN IF EXISTS tenant_id', t);
+	END LOOP;
+END
+$$;
+
+DROP TABLE IF EXISTS tenants;
+
+COMMIT;
@@ -0,0 +1,55 @@
+BEGIN;
+
+-- tenants are the federations the service is run for, their data is strictly separated
+CREATE TABLE IF NOT EXISTS tenants (
+	id VARCHAR(63) PRIMARY KEY,
+	name VARCHAR(255) NOT NULL
+);
+
+-- the data before the tenants belongs to the default one
+INSERT INTO tenants (id, name) VALUES ('default', 'Default') ON CONFLICT DO NOTHING;
+
+-- Every table but the tenants and the job runs gets the tenant of its rows, taken from the tenant the
+-- session is set to when inserting. The row level security policies only let the sessions see and write
+-- the rows of their tenant, forced for the owner of the tables too. Data migrations must set app.tenant_id
+DO $$
+DECLARE
+	t TEXT;
+BEGIN
+	FOREACH t IN ARRAY ARRAY[
+		'races', 'races_competitors', 'teams', 'team_members', 'events', 'race_results', 'race_team_entries',
+		'race_relay_legs', 'race_relay_entries', 'team_invitations', 'team_join_requests', 'race_categories',
+		'race_courses', 'race_course_files', 'race_checkpoints', 'race_passages', 'calendar_tokens', 'race_series',
+		'race_series_points', 'race_prices', 'race_price_tiers', 'race_registrations', 'race_discount_codes',
+		'notification_preferences', 'notification_opt_outs', 'event_consumers', 'race_registration_transfers',
+		'race_staff', 'organizations', 'organization_members'
+	] LOOP
+		EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS tenant_id VARCHAR(63) NOT NULL DEFAULT ''default'' REFERENCES tenants (id)', t);
+		EXECUTE format('ALTER TABLE %I ALTER COLUMN tenant_id SET DEFAULT current_setting(''app.tenant_id'')', t);
+		EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+		EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+		EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = current_setting(''app.tenant_id'', true)) WITH CHECK (tenant_id = current_setting(''app.tenant_id'', true))', t);
+	END LOOP;
+END
+$$;
+
+CREATE INDEX IF NOT EXISTS races_tenant_id_date_idx ON races (tenant_id, date);
+CREATE INDEX IF NOT EXISTS events_tenant_id_occurred_at_id_idx ON events (tenant_id, occurred_at DESC, id DESC);
+
+-- the keys by user or name are unique within a tenant, the same user can be in several of them
+DROP INDEX IF EXISTS team_members_member_id_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS team_members_tenant_id_member_id_idx ON team_members (tenant_id, member_id);
+
+ALTER TABLE calendar_tokens DROP CONSTRAINT calendar_tokens_pkey;
+ALTER TABLE calendar_tokens ADD PRIMARY KEY (tenant_id, user_id);
+
+ALTER TABLE notification_preferences DROP CONSTRAINT notification_preferences_pkey;
+ALTER TABLE notification_preferences ADD PRIMARY KEY (tenant_id, user_id);
+
+ALTER TABLE notification_opt_outs DROP CONSTRAINT notification_opt_outs_pkey;
+ALTER TABLE notification_opt_outs ADD PRIMARY KEY (tenant_id, user_id, kind);
+
+ALTER TABLE event_consumers DROP CONSTRAINT event_consumers_pkey;
+ALTER TABLE event_consumers ADD PRIMARY KEY (tenant_id, name);
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+-- privacy_erasures are the requests of the users to be anonymized, processed by a scheduled job
+CREATE TABLE IF NOT EXISTS privacy_erasures (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL,
+	status TEXT NOT NULL,
+	requested_at TIMESTAMPTZ NOT NULL,
+	finished_at TIMESTAMPTZ,
+	error TEXT NOT NULL DEFAULT '',
+	tenant_id VARCHAR(63) NOT NULL DEFAULT current_setting('app.tenant_id') REFERENCES tenants (id)
+);
+
+CREATE INDEX IF NOT EXISTS privacy_erasures_tenant_id_requested_at_idx ON privacy_erasures (tenant_id, requested_at) WHERE status <> 'DONE';
+-- a user has a single erasure not done at a time
+CREATE UNIQUE INDEX IF NOT EXISTS privacy_erasures_tenant_id_user_id_idx ON privacy_erasures (tenant_id, user_id) WHERE status <> 'DONE';
+
+ALTER TABLE privacy_erasures ENABLE ROW LEVEL SECURITY;
+ALTER TABLE privacy_erasures FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON privacy_erasures
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- tenant_members are the users that belong to each tenant besides the ones with a token bound to it, the
+-- administrators of a tenant are not of the others
+CREATE TABLE IF NOT EXISTS tenant_members (
+	tenant_id VARCHAR(63) NOT NULL DEFAULT current_setting('app.tenant_id') REFERENCES tenants (id),
+	user_id UUID NOT NULL,
+	admin BOOLEAN NOT NULL DEFAULT FALSE,
+
+	PRIMARY KEY (tenant_id, user_id)
+);
+
+ALTER TABLE tenant_members ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_members FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_members
+	USING (tenant_id = current_setting('app.tenant_id', true))
+	WITH CHECK (tenant_id = current_setting('app.tenant_id', true));
+
+COMMIT;
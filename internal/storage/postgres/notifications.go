@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationPreferences struct {
+	UserID racers.UserID `db:"user_id"`
+	Locale string        `db:"locale"`
+}
+
+func (notificationPreferences) TableName() string {
+	return "notification_preferences"
+}
+
+type notificationOptOut struct {
+	UserID racers.UserID            `db:"user_id"`
+	Kind   service.NotificationKind `db:"kind"`
+}
+
+func (notificationOptOut) TableName() string {
+	return "notification_opt_outs"
+}
+
+func NewNotificationPreferences(db *gorm.DB) NotificationPreferences {
+	return NotificationPreferences{Repository{db}}
+}
+
+type NotificationPreferences struct {
+	repo Repository
+}
+
+func (r NotificationPreferences) Get(ctx context.Context, user racers.UserID) (service.NotificationPreferences, error) {
+	db := r.repo.DB(ctx)
+
+	var p notificationPreferences
+	if err := db.Where("user_id = ?", user).Take(&p).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return service.NotificationPreferences{}, service.ErrNotificationPreferencesNotFound
+		}
+		return service.NotificationPreferences{}, err
+	}
+
+	var optOuts []notificationOptOut
+	if err := db.Where("user_id = ?", user).Order("kind").Find(&optOuts).Error; err != nil {
+		return service.NotificationPreferences{}, err
+	}
+
+	prefs := service.NotificationPreferences{User: p.UserID, Locale: p.Locale}
+	for _, o := range optOuts {
+		prefs.Disabled = append(prefs.Disabled, o.Kind)
+	}
+
+	return prefs, nil
+}
+
+// Save replaces the preferences of the user
+func (r NotificationPreferences) Save(ctx context.Context, prefs service.NotificationPreferences) error {
+	return r.repo.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		p := notificationPreferences{UserID: prefs.User, Locale: prefs.Locale}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"locale"}),
+		}).Create(&p).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("user_id = ?", prefs.User).Delete(&notificationOptOut{}).Error; err != nil {
+			return err
+		}
+		if len(prefs.Disabled) == 0 {
+			return nil
+		}
+
+		optOuts := make([]notificationOptOut, len(prefs.Disabled))
+		for i, k := range prefs.Disabled {
+			optOuts[i] = notificationOptOut{UserID: prefs.User, Kind: k}
+		}
+
+		return tx.Create(&optOuts).Error
+	})
+}
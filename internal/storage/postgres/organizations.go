@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+type organization struct {
+	ID   racers.OrganizationID   `db:"id"`
+	Name racers.OrganizationName `db:"name"`
+}
+
+func (organization) TableName() string {
+	return "organizations"
+}
+
+type organizationMember struct {
+	OrganizationID racers.OrganizationID   `db:"organization_id"`
+	UserID         racers.UserID           `db:"user_id"`
+	Role           racers.OrganizationRole `db:"role"`
+}
+
+func (organizationMember) TableName() string {
+	return "organization_members"
+}
+
+func NewOrganizations(db *gorm.DB) Organizations {
+	return Organizations{Repository{db}}
+}
+
+type Organizations struct {
+	repo Repository
+}
+
+func (r Organizations) Exists(ctx context.Context, id racers.OrganizationID) (bool, error) {
+	var count int64
+	if err := r.repo.DB(ctx).Model(&organization{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r Organizations) Get(ctx context.Context, id racers.OrganizationID) (racers.Organization, error) {
+	db := r.repo.DB(ctx)
+
+	var dbOrg organization
+	if err := db.Take(&dbOrg, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.Organization{}, service.ErrOrganizationNotFound
+		}
+		return racers.Organization{}, err
+	}
+
+	var members []organizationMember
+	if err := db.Where("organization_id = ?", id).Find(&members).Error; err != nil {
+		return racers.Organization{}, err
+	}
+
+	result := racers.Organization{ID: dbOrg.ID, Name: dbOrg.Name, Members: make(racers.OrganizationMembers, len(members))}
+	for _, m := range members {
+		result.Members[m.UserID] = m.Role
+	}
+
+	return result, nil
+}
+
+func (r Organizations) Save(ctx context.Context, in racers.Organization) error {
+	db := r.repo.DB(ctx)
+
+	if err := db.Save(&organization{ID: in.ID, Name: in.Name}).Error; err != nil {
+		return err
+	}
+
+	if err := db.Where("organization_id = ?", in.ID).Delete(&organizationMember{}).Error; err != nil {
+		return err
+	}
+	if len(in.Members) == 0 {
+		return nil
+	}
+
+	rows := make([]organizationMember, 0, len(in.Members))
+	for u, role := range in.Members {
+		rows = append(rows, organizationMember{OrganizationID: in.ID, UserID: u, Role: role})
+	}
+
+	return db.Create(&rows).Error
+}
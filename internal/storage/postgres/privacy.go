@@ -0,0 +1,360 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+// userDataSet is a table with records tied to a user by some of its columns
+type userDataSet struct {
+	table   string
+	columns []string
+	// remove is set when the records are only meaningful to the user, they are removed on erasure instead of
+	// being kept for the statistics
+	remove bool
+}
+
+// userDataSets are all the tables referencing users but the events and the erasures, a new table with user
+// columns must be added here
+var userDataSets = []userDataSet{
+	{table: "races", columns: []string{"owner_id"}},
+	{table: "race_series", columns: []string{"owner_id"}},
+	{table: "races_competitors", columns: []string{"competitor_id"}},
+	{table: "race_registrations", columns: []string{"competitor_id"}},
+	{table: "race_registration_transfers", columns: []string{"from_id", "to_id"}},
+	{table: "race_results", columns: []string{"competitor_id"}},
+	{table: "race_passages", columns: []string{"competitor_id"}},
+	{table: "race_team_entries", columns: []string{"member_id"}},
+	{table: "race_relay_entries", columns: []string{"runner_id"}},
+	{table: "race_staff", columns: []string{"user_id"}},
+	{table: "teams", columns: []string{"admin_id"}},
+	{table: "team_members", columns: []string{"member_id"}},
+	{table: "team_invitations", columns: []string{"user_id"}, remove: true},
+	{table: "team_join_requests", columns: []string{"user_id"}, remove: true},
+	{table: "organization_members", columns: []string{"user_id"}},
+	{table: "calendar_tokens", columns: []string{"user_id"}, remove: true},
+	{table: "notification_preferences", columns: []string{"user_id"}, remove: true},
+	{table: "notification_opt_outs", columns: []string{"user_id"}, remove: true},
+	{table: "tenant_members", columns: []string{"user_id"}, remove: true},
+}
+
+// exportedOmitted are the columns left out of the exports, they are internal to the service
+const exportedOmitted = "- 'tenant_id' - 'search' - 'secret_hash'"
+
+// eventUsersKind is how a payload field names users
+type eventUsersKind int
+
+const (
+	// userValue fields are a user
+	userValue eventUsersKind = iota
+	// userList fields are a list of users
+	userList
+	// userKeys fields are an object keyed by the users
+	userKeys
+)
+
+// eventUsers is a payload field naming users, path is the field in the payload. When each is set, the field is
+// an object with the users in every value, at the each path of the value, or the value itself when it is "{}"
+type eventUsers struct {
+	path string
+	kind eventUsersKind
+	each string
+}
+
+// eventsUsers are the payload fields naming users in all the events, as the payloads are marshaled. A new event
+// naming users in a new field must be added here
+var eventsUsers = append([]eventUsers{
+	{path: "{Competitor}"},
+	{path: "{User}"},
+	{path: "{User,ID}"},
+	{path: "{Admin}"},
+	{path: "{Member}"},
+	{path: "{From}"},
+	{path: "{To}"},
+	{path: "{Runners}", kind: userList},
+	{path: "{Members}", kind: userList},
+	{path: "{Results}", kind: userKeys},
+	{path: "{series,Owner}"},
+}, append(raceUsers("race"), raceUsers("Race")...)...)
+
+// raceUsers are the fields naming users in the race of the events carrying the whole race
+func raceUsers(field string) []eventUsers {
+	fields := []eventUsers{
+		{path: "Owner"},
+		{path: "Registrations", kind: userKeys},
+		{path: "CategoryEntries", kind: userKeys},
+		{path: "BibEntries", kind: userKeys},
+		{path: "Results", kind: userKeys},
+		{path: "Passages", kind: userKeys},
+		{path: "CheckIns", kind: userKeys},
+		{path: "Staff", kind: userKeys},
+		{path: "RegistrationTransfers", kind: userKeys},
+		{path: "RegistrationTransfers", each: "{To}"},
+		{path: "TeamEntries", kind: userList, each: "{}"},
+		{path: "RelayEntries", kind: userList, each: "{Runners}"},
+	}
+	for i := range fields {
+		fields[i].path = fmt.Sprintf("{%s,%s}", field, fields[i].path)
+	}
+
+	return fields
+}
+
+// matches is the condition of the events naming the user in the field
+func (f eventUsers) matches() string {
+	field := fmt.Sprintf("payload #> '%s'", f.path)
+	if f.each == "" {
+		return f.kind.matches(field)
+	}
+
+	return fmt.Sprintf(
+		"CASE WHEN jsonb_typeof(%s) = 'object' THEN EXISTS (SELECT 1 FROM jsonb_each(%s) AS t WHERE %s) ELSE FALSE END",
+		field, field, f.kind.matches(fmt.Sprintf("t.value #> '%s'", f.each)),
+	)
+}
+
+// anonymized is the payload with the user replaced by the tombstone in the field, of the events matching it
+func (f eventUsers) anonymized() string {
+	field := fmt.Sprintf("payload #> '%s'", f.path)
+	if f.each == "" {
+		return fmt.Sprintf("jsonb_set(payload, '%s', %s)", f.path, f.kind.anonymized(field))
+	}
+
+	value := fmt.Sprintf("t.value #> '%s'", f.each)
+	replaced := fmt.Sprintf("jsonb_set(t.value, '%s', %s)", f.each, f.kind.anonymized(value))
+	if f.each == "{}" {
+		replaced = f.kind.anonymized(value)
+	}
+	return fmt.Sprintf(
+		"jsonb_set(payload, '%s', (SELECT jsonb_object_agg(t.key, CASE WHEN %s THEN %s ELSE t.value END) FROM jsonb_each(%s) AS t))",
+		f.path, f.kind.matches(value), replaced, field,
+	)
+}
+
+func (k eventUsersKind) matches(value string) string {
+	switch k {
+	case userList, userKeys:
+		return fmt.Sprintf("jsonb_exists(%s, @user)", value)
+	default:
+		return fmt.Sprintf("(%s) #>> '{}' = @user", value)
+	}
+}
+
+func (k eventUsersKind) anonymized(value string) string {
+	const tombstone = "to_jsonb(CAST(@tombstone AS TEXT))"
+	switch k {
+	case userList:
+		return fmt.Sprintf(
+			"(SELECT jsonb_agg(CASE WHEN e #>> '{}' = @user THEN %s ELSE e END) FROM jsonb_array_elements(%s) AS e)",
+			tombstone, value,
+		)
+	case userKeys:
+		return fmt.Sprintf(
+			"((%s) - CAST(@user AS TEXT)) || jsonb_build_object(CAST(@tombstone AS TEXT), (%s) -> CAST(@user AS TEXT))",
+			value, value,
+		)
+	default:
+		return tombstone
+	}
+}
+
+// userEventsCondition matches the events published by the user or naming the user in their payload
+var userEventsCondition = func() string {
+	conditions := []string{"user_id = @user"}
+	for _, f := range eventsUsers {
+		conditions = append(conditions, f.matches())
+	}
+
+	return fmt.Sprintf("tenant_id = @tenant AND (%s)", strings.Join(conditions, " OR "))
+}()
+
+func NewUserData(db *gorm.DB) UserData {
+	return UserData{Repository{db}}
+}
+
+// UserData reads and anonymizes the records of the users in all the tables
+type UserData struct {
+	repo Repository
+}
+
+func (r UserData) Export(ctx context.Context, user racers.UserID) (service.UserData, error) {
+	db := r.repo.DB(ctx)
+	vars := map[string]interface{}{"tenant": tenantOf(ctx), "user": id.ID(user).String()}
+
+	result := make(service.UserData)
+	for _, set := range userDataSets {
+		query := fmt.Sprintf(
+			"SELECT to_jsonb(t) %s FROM %s AS t WHERE tenant_id = @tenant AND (%s)",
+			exportedOmitted, set.table, userColumnsCondition(set.columns),
+		)
+		if err := exportRecords(db, set.table, query, vars, result); err != nil {
+			return nil, err
+		}
+	}
+
+	query := fmt.Sprintf("SELECT to_jsonb(t) %s FROM events AS t WHERE %s ORDER BY occurred_at, id", exportedOmitted, userEventsCondition)
+	if err := exportRecords(db, "events", query, vars, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// exportRecords adds the records of the query to the data set, the empty data sets are left out
+func exportRecords(db *gorm.DB, set, query string, vars map[string]interface{}, data service.UserData) error {
+	rows, err := db.Raw(query, vars).Rows()
+	if err != nil {
+		return errors.Wrap(err, "exporting %s", set)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record []byte
+		if err := rows.Scan(&record); err != nil {
+			return errors.Wrap(err, "exporting %s", set)
+		}
+		data[set] = append(data[set], json.RawMessage(record))
+	}
+
+	return errors.Wrap(rows.Err(), "exporting %s", set)
+}
+
+// Anonymize must run in a unit of work, so the user is erased from all the tables or from none
+func (r UserData) Anonymize(ctx context.Context, user, tombstone racers.UserID) error {
+	db := r.repo.DB(ctx)
+	vars := map[string]interface{}{
+		"tenant":    tenantOf(ctx),
+		"user":      id.ID(user).String(),
+		"tombstone": id.ID(tombstone).String(),
+	}
+
+	for _, set := range userDataSets {
+		if set.remove {
+			query := fmt.Sprintf("DELETE FROM %s WHERE tenant_id = @tenant AND (%s)", set.table, userColumnsCondition(set.columns))
+			if err := db.Exec(query, vars).Error; err != nil {
+				return errors.Wrap(err, "removing %s", set.table)
+			}
+			continue
+		}
+
+		for _, c := range set.columns {
+			query := fmt.Sprintf("UPDATE %s SET %s = @tombstone WHERE tenant_id = @tenant AND %s = @user", set.table, c, c)
+			if err := db.Exec(query, vars).Error; err != nil {
+				return errors.Wrap(err, "anonymizing %s", set.table)
+			}
+		}
+	}
+
+	if err := db.Exec("UPDATE events SET user_id = @tombstone WHERE tenant_id = @tenant AND user_id = @user", vars).Error; err != nil {
+		return errors.Wrap(err, "anonymizing events")
+	}
+	for _, f := range eventsUsers {
+		query := fmt.Sprintf("UPDATE events SET payload = %s WHERE tenant_id = @tenant AND %s", f.anonymized(), f.matches())
+		if err := db.Exec(query, vars).Error; err != nil {
+			return errors.Wrap(err, "anonymizing events %s", f.path)
+		}
+	}
+
+	return nil
+}
+
+// userColumnsCondition matches the records with the user in any of the columns
+func userColumnsCondition(columns []string) string {
+	conditions := make([]string, len(columns))
+	for i, c := range columns {
+		conditions[i] = c + " = @user"
+	}
+
+	return strings.Join(conditions, " OR ")
+}
+
+type erasure struct {
+	ID          id.ID                 `db:"id"`
+	UserID      racers.UserID         `db:"user_id"`
+	Status      service.ErasureStatus `db:"status"`
+	RequestedAt time.Time             `db:"requested_at"`
+	// FinishedAt is null until the erasure is done
+	FinishedAt *time.Time `db:"finished_at"`
+	Error      string     `db:"error"`
+}
+
+func (erasure) TableName() string {
+	return "privacy_erasures"
+}
+
+func (e erasure) toDomain() service.Erasure {
+	return service.Erasure{
+		ID:          e.ID,
+		User:        e.UserID,
+		Status:      e.Status,
+		RequestedAt: e.RequestedAt,
+		FinishedAt:  e.FinishedAt,
+		Error:       e.Error,
+	}
+}
+
+func NewErasures(db *gorm.DB) Erasures {
+	return Erasures{Repository{db}}
+}
+
+type Erasures struct {
+	repo Repository
+}
+
+func (r Erasures) Get(ctx context.Context, eid id.ID) (service.Erasure, error) {
+	var e erasure
+	if err := r.repo.DB(ctx).Take(&e, "id = ?", eid).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return service.Erasure{}, service.ErrErasureNotFound
+		}
+		return service.Erasure{}, err
+	}
+
+	return e.toDomain(), nil
+}
+
+func (r Erasures) Pending(ctx context.Context) ([]service.Erasure, error) {
+	var rows []erasure
+	if err := r.repo.DB(ctx).Where("status <> ?", service.ErasureDone).Order("requested_at, id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]service.Erasure, len(rows))
+	for i, e := range rows {
+		result[i] = e.toDomain()
+	}
+
+	return result, nil
+}
+
+func (r Erasures) PendingFor(ctx context.Context, user racers.UserID) (service.Erasure, error) {
+	var e erasure
+	if err := r.repo.DB(ctx).Take(&e, "user_id = ? AND status <> ?", user, service.ErasureDone).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return service.Erasure{}, service.ErrErasureNotFound
+		}
+		return service.Erasure{}, err
+	}
+
+	return e.toDomain(), nil
+}
+
+func (r Erasures) Save(ctx context.Context, in service.Erasure) error {
+	return r.repo.DB(ctx).Save(&erasure{
+		ID:          in.ID,
+		UserID:      in.User,
+		Status:      in.Status,
+		RequestedAt: in.RequestedAt,
+		FinishedAt:  in.FinishedAt,
+		Error:       in.Error,
+	}).Error
+}
@@ -2,31 +2,248 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"math"
 	"time"
 
 	racers "github.com/xabi93/racers/internal"
 	"github.com/xabi93/racers/internal/service"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type race struct {
-	ID      racers.RaceID   `db:"id"`
-	Name    racers.RaceName `db:"name"`
-	Date    time.Time       `db:"date"`
-	OwnerID racers.UserID   `db:"owner_id"`
+	ID             racers.RaceID   `db:"id"`
+	Name           racers.RaceName `db:"name"`
+	Date           time.Time       `db:"date"`
+	Description    string          `db:"description"`
+	OwnerID        racers.UserID   `db:"owner_id"`
+	TeamMinMembers *int            `db:"team_min_members"`
+	TeamMaxMembers *int            `db:"team_max_members"`
+	TeamScoring    *string         `db:"team_scoring"`
+	TeamCounting   *int            `db:"team_counting"`
+	// RelayAllowRepeatRunners is null when the race is not a relay
+	RelayAllowRepeatRunners *bool `db:"relay_allow_repeat_runners"`
+	// BibStrategy and BibFirst are null when the race has no bib numbers
+	BibStrategy *racers.BibStrategy `db:"bib_strategy"`
+	BibFirst    *racers.Bib         `db:"bib_first"`
+	Sequence    int                 `db:"sequence"`
+	// Venue columns are null when the race has no venue
+	VenueName    *string  `db:"venue_name"`
+	VenueAddress *string  `db:"venue_address"`
+	VenueLat     *float64 `db:"venue_lat"`
+	VenueLon     *float64 `db:"venue_lon"`
+	TimeZone     *string  `db:"time_zone"`
+	// SeriesID and SeriesOccurrence are null when the race was not generated by a series
+	SeriesID         *racers.SeriesID `db:"series_id"`
+	SeriesOccurrence *time.Time       `db:"series_occurrence"`
+	// Status is the lifecycle stage of the race, the open races are stored as OPEN
+	Status racers.RaceStatus `db:"status"`
+	// RegistrationDeadline is null when the registration is open until the race is finished
+	RegistrationDeadline *time.Time `db:"registration_deadline"`
+	Reminded             bool       `db:"reminded"`
+	// Cancellation columns are null unless the race is cancelled, the transfer ones when the competitors are released
+	CancelReason     *string              `db:"cancel_reason"`
+	CancelledAt      *time.Time           `db:"cancelled_at"`
+	TransferRaceID   *racers.RaceID       `db:"transfer_race_id"`
+	TransferCategory *racers.CategoryName `db:"transfer_category"`
+	// RegistrationTransfersAllowed is set when the competitors can transfer their registrations
+	RegistrationTransfersAllowed bool `db:"registration_transfers_allowed"`
+	// RegistrationTransferDeadline is null when the registrations can be transferred until the race starts
+	RegistrationTransferDeadline *time.Time `db:"registration_transfer_deadline"`
+	// OrganizationID is null when the race is owned by its owner alone
+	OrganizationID *racers.OrganizationID `db:"organization_id"`
 }
 
 func (race) TableName() string {
 	return "races"
 }
 
-func (r race) toDomain() racers.Race {
-	return racers.Race{
-		ID:    r.ID,
-		Name:  r.Name,
-		Date:  racers.RaceDate(r.Date),
-		Owner: r.OwnerID,
+func newRace(r racers.Race) race {
+	dbRace := race{
+		ID:                   r.ID,
+		Name:                 r.Name,
+		Date:                 time.Time(r.Date),
+		Description:          r.Description,
+		OwnerID:              r.Owner,
+		Sequence:             r.Sequence,
+		Status:               r.Status,
+		Reminded:             r.Reminded,
+		RegistrationDeadline: r.RegistrationDeadline,
+		OrganizationID:       r.Organization,
 	}
+	if dbRace.Status == "" {
+		dbRace.Status = racers.RaceOpen
+	}
+	if r.Teams != nil {
+		scoring := string(r.Teams.Scoring)
+		dbRace.TeamMinMembers = &r.Teams.Size.Min
+		dbRace.TeamMaxMembers = &r.Teams.Size.Max
+		dbRace.TeamScoring = &scoring
+		dbRace.TeamCounting = &r.Teams.Counting
+	}
+	if r.Relay != nil {
+		dbRace.RelayAllowRepeatRunners = &r.Relay.AllowRepeatRunners
+	}
+	if r.Bibs != nil {
+		dbRace.BibStrategy = &r.Bibs.Strategy
+		dbRace.BibFirst = &r.Bibs.First
+	}
+	if v := r.Venue; v != nil {
+		tz := v.TimeZone.String()
+		dbRace.VenueName, dbRace.VenueAddress = &v.Name, &v.Address
+		dbRace.VenueLat, dbRace.VenueLon = &v.Lat, &v.Lon
+		dbRace.TimeZone = &tz
+	}
+	if r.Series != nil {
+		dbRace.SeriesID = &r.Series.Series
+		dbRace.SeriesOccurrence = &r.Series.Occurrence
+	}
+	if r.Transfers != nil {
+		dbRace.RegistrationTransfersAllowed = true
+		dbRace.RegistrationTransferDeadline = r.Transfers.Deadline
+	}
+	if c := r.Cancellation; c != nil {
+		dbRace.CancelReason, dbRace.CancelledAt = &c.Reason, &c.At
+		if c.Transfer != nil {
+			dbRace.TransferRaceID, dbRace.TransferCategory = &c.Transfer.Race, &c.Transfer.Category
+		}
+	}
+
+	return dbRace
+}
+
+func (r race) toDomain() (racers.Race, error) {
+	result := racers.Race{
+		ID:                   r.ID,
+		Name:                 r.Name,
+		Date:                 racers.RaceDate(r.Date),
+		Description:          r.Description,
+		Owner:                r.OwnerID,
+		Sequence:             r.Sequence,
+		Status:               r.Status,
+		Reminded:             r.Reminded,
+		RegistrationDeadline: r.RegistrationDeadline,
+		Organization:         r.OrganizationID,
+	}
+	if r.TeamScoring != nil {
+		result.Teams = &racers.RaceTeams{
+			Size:     racers.RaceTeamSize{Min: *r.TeamMinMembers, Max: *r.TeamMaxMembers},
+			Scoring:  racers.TeamScoring(*r.TeamScoring),
+			Counting: *r.TeamCounting,
+		}
+	}
+	if r.RelayAllowRepeatRunners != nil {
+		result.Relay = &racers.RaceRelay{AllowRepeatRunners: *r.RelayAllowRepeatRunners}
+	}
+	if r.BibStrategy != nil {
+		result.Bibs = &racers.RaceBibs{Strategy: *r.BibStrategy, First: *r.BibFirst}
+	}
+	if r.TimeZone != nil {
+		tz, err := racers.NewTimeZone(*r.TimeZone)
+		if err != nil {
+			return racers.Race{}, err
+		}
+		result.Venue = &racers.Venue{
+			Name:     *r.VenueName,
+			Address:  *r.VenueAddress,
+			Lat:      *r.VenueLat,
+			Lon:      *r.VenueLon,
+			TimeZone: tz,
+		}
+	}
+	if r.SeriesID != nil {
+		result.Series = &racers.SeriesInstance{Series: *r.SeriesID, Race: r.ID, Occurrence: *r.SeriesOccurrence}
+	}
+	if r.RegistrationTransfersAllowed {
+		result.Transfers = &racers.TransferPolicy{Deadline: r.RegistrationTransferDeadline}
+	}
+	if r.CancelReason != nil {
+		result.Cancellation = &racers.RaceCancellation{Reason: *r.CancelReason, At: *r.CancelledAt}
+		if r.TransferRaceID != nil {
+			result.Cancellation.Transfer = &racers.RaceTransfer{Race: *r.TransferRaceID, Category: *r.TransferCategory}
+		}
+	}
+
+	return result, nil
+}
+
+type raceCompetitor struct {
+	RaceID       racers.RaceID `db:"race_id"`
+	CompetitorID racers.UserID `db:"competitor_id"`
+	// Category is null when the race has no categories
+	Category *racers.CategoryName `db:"category"`
+	// Bib is null until the competitor gets a bib number
+	Bib *racers.Bib `db:"bib"`
+	// CheckedInAt is null until the competitor picks up the packet
+	CheckedInAt *time.Time `db:"checked_in_at"`
+}
+
+func (raceCompetitor) TableName() string {
+	return "races_competitors"
+}
+
+type raceResult struct {
+	RaceID       racers.RaceID `db:"race_id"`
+	CompetitorID racers.UserID `db:"competitor_id"`
+	TimeMs       int64         `db:"time_ms"`
+}
+
+func (raceResult) TableName() string {
+	return "race_results"
+}
+
+type raceTeamEntry struct {
+	RaceID   racers.RaceID `db:"race_id"`
+	TeamID   racers.TeamID `db:"team_id"`
+	MemberID racers.UserID `db:"member_id"`
+	Position int           `db:"position"`
+}
+
+func (raceTeamEntry) TableName() string {
+	return "race_team_entries"
+}
+
+type raceRelayLeg struct {
+	RaceID    racers.RaceID       `db:"race_id"`
+	Position  int                 `db:"position"`
+	Name      racers.RelayLegName `db:"name"`
+	DistanceM racers.Distance     `db:"distance_m"`
+}
+
+func (raceRelayLeg) TableName() string {
+	return "race_relay_legs"
+}
+
+type raceRelayEntry struct {
+	RaceID   racers.RaceID `db:"race_id"`
+	TeamID   racers.TeamID `db:"team_id"`
+	Leg      int           `db:"leg"`
+	RunnerID racers.UserID `db:"runner_id"`
+	// SplitMs is null until the leg split is recorded
+	SplitMs *int64 `db:"split_ms"`
+}
+
+func (raceRelayEntry) TableName() string {
+	return "race_relay_entries"
+}
+
+type raceCategory struct {
+	RaceID    racers.RaceID       `db:"race_id"`
+	Name      racers.CategoryName `db:"name"`
+	Position  int                 `db:"position"`
+	DistanceM racers.Distance     `db:"distance_m"`
+	StartTime time.Time           `db:"start_time"`
+	Capacity  int                 `db:"capacity"`
+	MinAge    int                 `db:"min_age"`
+	Gender    racers.Gender       `db:"gender"`
+	// BibFrom and BibTo are null when the category has no bib range
+	BibFrom *racers.Bib `db:"bib_from"`
+	BibTo   *racers.Bib `db:"bib_to"`
+}
+
+func (raceCategory) TableName() string {
+	return "race_categories"
 }
 
 func NewRaces(db *gorm.DB) Races {
@@ -43,6 +260,83 @@ func (r Races) All(ctx context.Context) ([]racers.Race, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	return r.scan(db, rows)
+}
+
+// ByOrganization returns the races of the organization sorted by date
+func (r Races) ByOrganization(ctx context.Context, id racers.OrganizationID) ([]racers.Race, error) {
+	db := r.repo.DB(ctx)
+	rows, err := db.Model(&race{}).Where("organization_id = ?", id).Order("date, id").Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scan(db, rows)
+}
+
+func (r Races) Upcoming(ctx context.Context, from time.Time) ([]racers.Race, error) {
+	db := r.repo.DB(ctx)
+	rows, err := db.Model(&race{}).Where("date >= ?", from).Order("date, id").Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scan(db, rows)
+}
+
+func (r Races) ByCompetitor(ctx context.Context, id racers.UserID) ([]racers.Race, error) {
+	db := r.repo.DB(ctx)
+	rows, err := db.Model(&race{}).
+		Where("id IN (SELECT race_id FROM races_competitors WHERE competitor_id = ?)", id).
+		Order("date, id").
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scan(db, rows)
+}
+
+// earthRadius is the mean radius in metres the distances between venues are computed with
+const earthRadius = 6371008.8
+
+// nearQuery selects the races with a venue within the radius, with the haversine distance
+// and a latitude range to use the venue index
+const nearQuery = `
+SELECT * FROM (
+	SELECT *, 2 * @radius_earth * ASIN(SQRT(
+		POWER(SIN(RADIANS(venue_lat - @lat) / 2), 2) +
+		COS(RADIANS(@lat)) * COS(RADIANS(venue_lat)) * POWER(SIN(RADIANS(venue_lon - @lon) / 2), 2)
+	)) AS distance_m
+	FROM races
+	WHERE tenant_id = @tenant AND venue_lat BETWEEN @min_lat AND @max_lat
+) AS near
+WHERE distance_m <= @radius
+ORDER BY distance_m, id`
+
+func (r Races) Near(ctx context.Context, lat, lon float64, radius float64) ([]racers.Race, error) {
+	db := r.repo.DB(ctx)
+
+	degrees := radius / earthRadius * 180 / math.Pi
+	rows, err := db.Raw(nearQuery, map[string]interface{}{
+		"radius_earth": earthRadius,
+		"lat":          lat,
+		"lon":          lon,
+		"min_lat":      lat - degrees,
+		"max_lat":      lat + degrees,
+		"radius":       radius,
+		"tenant":       tenantOf(ctx),
+	}).Rows()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.scan(db, rows)
+}
+
+// scan reads the races of the rows with their relations, closing the rows
+func (r Races) scan(db *gorm.DB, rows *sql.Rows) ([]racers.Race, error) {
 	defer rows.Close()
 
 	result := make([]racers.Race, 0)
@@ -51,22 +345,197 @@ func (r Races) All(ctx context.Context) ([]racers.Race, error) {
 		if err := db.ScanRows(rows, &dbRace); err != nil {
 			return nil, err
 		}
-		result = append(result, dbRace.toDomain())
+		domain, err := dbRace.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, domain)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadRelations(db, result); err != nil {
+		return nil, err
 	}
 
 	return result, nil
 }
 
 func (r Races) Get(ctx context.Context, id racers.RaceID) (racers.Race, error) {
+	db := r.repo.DB(ctx)
+
+	// the race is locked in a unit of work, the race is read to be changed and saved and the changes of
+	// others in the meantime would be overwritten
 	var raceDB race
-	if err := r.repo.DB(ctx).Take(&raceDB, id).Error; err != nil {
+	if err := r.repo.ForUpdate(ctx).Take(&raceDB, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return racers.Race{}, service.ErrRaceNotFound
 		}
 		return racers.Race{}, err
 	}
 
-	return raceDB.toDomain(), nil
+	domain, err := raceDB.toDomain()
+	if err != nil {
+		return racers.Race{}, err
+	}
+
+	result := []racers.Race{domain}
+	if err := r.loadRelations(db, result); err != nil {
+		return racers.Race{}, err
+	}
+
+	return result[0], nil
+}
+
+// loadRelations fills the competitors, categories, courses, checkpoints, fees, transfers, staff, results, team entries and relay of the given races
+func (r Races) loadRelations(db *gorm.DB, races []racers.Race) error {
+	if len(races) == 0 {
+		return nil
+	}
+
+	ids := make([]racers.RaceID, len(races))
+	byID := make(map[racers.RaceID]*racers.Race, len(races))
+	for i := range races {
+		ids[i] = races[i].ID
+		byID[races[i].ID] = &races[i]
+	}
+
+	var competitors []raceCompetitor
+	if err := db.Where("race_id IN ?", ids).Order("register_at").Find(&competitors).Error; err != nil {
+		return err
+	}
+	competitorsByRace := make(map[racers.RaceID][]racers.UserID)
+	for _, c := range competitors {
+		competitorsByRace[c.RaceID] = append(competitorsByRace[c.RaceID], c.CompetitorID)
+
+		race := byID[c.RaceID]
+		if c.Bib != nil {
+			if race.BibEntries == nil {
+				race.BibEntries = make(racers.RaceBibEntries)
+			}
+			race.BibEntries[c.CompetitorID] = *c.Bib
+		}
+		if c.CheckedInAt != nil {
+			if race.CheckIns == nil {
+				race.CheckIns = make(racers.RaceCheckIns)
+			}
+			race.CheckIns[c.CompetitorID] = *c.CheckedInAt
+		}
+
+		if c.Category == nil {
+			continue
+		}
+
+		if race.CategoryEntries == nil {
+			race.CategoryEntries = make(racers.RaceCategoryEntries)
+		}
+		race.CategoryEntries[c.CompetitorID] = *c.Category
+	}
+	for raceID, c := range competitorsByRace {
+		byID[raceID].Competitors = racers.NewRaceCompetitors(c...)
+	}
+
+	var categories []raceCategory
+	if err := db.Where("race_id IN ?", ids).Order("position").Find(&categories).Error; err != nil {
+		return err
+	}
+	for _, c := range categories {
+		category := racers.RaceCategory{
+			Name:        c.Name,
+			Distance:    c.DistanceM,
+			StartTime:   c.StartTime,
+			Capacity:    c.Capacity,
+			Eligibility: racers.CategoryEligibility{MinAge: c.MinAge, Gender: c.Gender},
+		}
+		if c.BibFrom != nil {
+			category.Bibs = &racers.BibRange{From: *c.BibFrom, To: *c.BibTo}
+		}
+
+		race := byID[c.RaceID]
+		race.Categories = append(race.Categories, category)
+	}
+
+	var results []raceResult
+	if err := db.Where("race_id IN ?", ids).Find(&results).Error; err != nil {
+		return err
+	}
+	for _, res := range results {
+		race := byID[res.RaceID]
+		if race.Results == nil {
+			race.Results = make(racers.RaceResults)
+		}
+		race.Results[res.CompetitorID] = racers.RaceTime(time.Duration(res.TimeMs) * time.Millisecond)
+	}
+
+	var entries []raceTeamEntry
+	if err := db.Where("race_id IN ?", ids).Order("position").Find(&entries).Error; err != nil {
+		return err
+	}
+	for _, e := range entries {
+		race := byID[e.RaceID]
+		if race.TeamEntries == nil {
+			race.TeamEntries = make(racers.RaceTeamEntries)
+		}
+		race.TeamEntries[e.TeamID] = append(race.TeamEntries[e.TeamID], e.MemberID)
+	}
+
+	if err := r.loadCourses(db, ids, byID); err != nil {
+		return err
+	}
+
+	if err := r.loadCheckpoints(db, ids, byID); err != nil {
+		return err
+	}
+
+	if err := r.loadFees(db, ids, byID); err != nil {
+		return err
+	}
+
+	if err := r.loadTransfers(db, ids, byID); err != nil {
+		return err
+	}
+
+	if err := r.loadStaff(db, ids, byID); err != nil {
+		return err
+	}
+
+	return r.loadRelay(db, ids, byID)
+}
+
+func (r Races) loadRelay(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var legs []raceRelayLeg
+	if err := db.Where("race_id IN ?", ids).Order("position").Find(&legs).Error; err != nil {
+		return err
+	}
+	for _, l := range legs {
+		if race := byID[l.RaceID]; race.Relay != nil {
+			race.Relay.Legs = append(race.Relay.Legs, racers.RelayLeg{Name: l.Name, Distance: l.DistanceM})
+		}
+	}
+
+	var entries []raceRelayEntry
+	if err := db.Where("race_id IN ?", ids).Order("leg").Find(&entries).Error; err != nil {
+		return err
+	}
+	for _, e := range entries {
+		race := byID[e.RaceID]
+		if race.RelayEntries == nil {
+			race.RelayEntries = make(racers.RelayEntries)
+		}
+
+		var split racers.RaceTime
+		if e.SplitMs != nil {
+			split = racers.RaceTime(time.Duration(*e.SplitMs) * time.Millisecond)
+		}
+
+		entry := race.RelayEntries[e.TeamID]
+		entry.Runners = append(entry.Runners, e.RunnerID)
+		entry.Splits = append(entry.Splits, split)
+		race.RelayEntries[e.TeamID] = entry
+	}
+
+	return nil
 }
 
 func (r Races) Exists(ctx context.Context, in racers.Race) (bool, error) {
@@ -82,10 +551,183 @@ func (r Races) Exists(ctx context.Context, in racers.Race) (bool, error) {
 }
 
 func (r Races) Save(ctx context.Context, in racers.Race) error {
-	return r.repo.DB(ctx).Create(&race{
-		ID:      in.ID,
-		Name:    in.Name,
-		Date:    time.Time(in.Date),
-		OwnerID: in.Owner,
-	}).Error
+	db := r.repo.DB(ctx)
+
+	dbRace := newRace(in)
+	if err := db.Save(&dbRace).Error; err != nil {
+		return err
+	}
+
+	if err := r.index(db, in.ID); err != nil {
+		return err
+	}
+
+	if err := r.saveCategories(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveCourses(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveCompetitors(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveCheckpoints(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveFees(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveTransfers(db, in); err != nil {
+		return err
+	}
+
+	if err := r.saveStaff(db, in); err != nil {
+		return err
+	}
+
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceResult{}).Error; err != nil {
+		return err
+	}
+	results := make([]raceResult, 0, len(in.Results))
+	for c, t := range in.Results {
+		results = append(results, raceResult{
+			RaceID:       in.ID,
+			CompetitorID: c,
+			TimeMs:       time.Duration(t).Milliseconds(),
+		})
+	}
+	if len(results) > 0 {
+		if err := db.Create(&results).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceTeamEntry{}).Error; err != nil {
+		return err
+	}
+	var entries []raceTeamEntry
+	for team, members := range in.TeamEntries {
+		for i, m := range members {
+			entries = append(entries, raceTeamEntry{RaceID: in.ID, TeamID: team, MemberID: m, Position: i})
+		}
+	}
+	if len(entries) > 0 {
+		if err := db.Create(&entries).Error; err != nil {
+			return err
+		}
+	}
+
+	return r.saveRelay(db, in)
+}
+
+func (r Races) saveRelay(db *gorm.DB, in racers.Race) error {
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceRelayEntry{}).Error; err != nil {
+		return err
+	}
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceRelayLeg{}).Error; err != nil {
+		return err
+	}
+
+	if in.Relay == nil {
+		return nil
+	}
+
+	legs := make([]raceRelayLeg, len(in.Relay.Legs))
+	for i, l := range in.Relay.Legs {
+		legs[i] = raceRelayLeg{RaceID: in.ID, Position: i, Name: l.Name, DistanceM: l.Distance}
+	}
+	if len(legs) > 0 {
+		if err := db.Create(&legs).Error; err != nil {
+			return err
+		}
+	}
+
+	var entries []raceRelayEntry
+	for team, entry := range in.RelayEntries {
+		for leg, runner := range entry.Runners {
+			row := raceRelayEntry{RaceID: in.ID, TeamID: team, Leg: leg, RunnerID: runner}
+			if split := entry.Splits[leg]; split != 0 {
+				ms := time.Duration(split).Milliseconds()
+				row.SplitMs = &ms
+			}
+			entries = append(entries, row)
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	return db.Create(&entries).Error
+}
+
+func (r Races) saveCategories(db *gorm.DB, in racers.Race) error {
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceCategory{}).Error; err != nil {
+		return err
+	}
+
+	if len(in.Categories) == 0 {
+		return nil
+	}
+
+	rows := make([]raceCategory, len(in.Categories))
+	for i, c := range in.Categories {
+		rows[i] = raceCategory{
+			RaceID:    in.ID,
+			Name:      c.Name,
+			Position:  i,
+			DistanceM: c.Distance,
+			StartTime: c.StartTime,
+			Capacity:  c.Capacity,
+			MinAge:    c.Eligibility.MinAge,
+			Gender:    c.Eligibility.Gender,
+		}
+		if c.Bibs != nil {
+			rows[i].BibFrom = &c.Bibs.From
+			rows[i].BibTo = &c.Bibs.To
+		}
+	}
+
+	return db.Create(&rows).Error
+}
+
+// saveCompetitors keeps the registration time of the competitors that were already in the race,
+// the unique bib constraint guarantees no number is assigned twice
+func (r Races) saveCompetitors(db *gorm.DB, in racers.Race) error {
+	competitors := in.Competitors.List()
+
+	remove := db.Where("race_id = ?", in.ID)
+	if len(competitors) > 0 {
+		remove = remove.Where("competitor_id NOT IN ?", competitors)
+	}
+	if err := remove.Delete(&raceCompetitor{}).Error; err != nil {
+		return err
+	}
+
+	if len(competitors) == 0 {
+		return nil
+	}
+
+	rows := make([]raceCompetitor, len(competitors))
+	for i, c := range competitors {
+		rows[i] = raceCompetitor{RaceID: in.ID, CompetitorID: c}
+		if category, ok := in.CategoryEntries[c]; ok {
+			rows[i].Category = &category
+		}
+		if bib, ok := in.BibEntries[c]; ok {
+			rows[i].Bib = &bib
+		}
+		if at, ok := in.CheckIns[c]; ok {
+			rows[i].CheckedInAt = &at
+		}
+	}
+
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "race_id"}, {Name: "competitor_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"category", "bib", "checked_in_at"}),
+	}).Create(&rows).Error
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/xabi93/racers/internal/service"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var transactionContextKey struct{}
@@ -16,24 +17,39 @@ type Repository struct{ db *gorm.DB }
 func (r Repository) DB(ctx context.Context) *gorm.DB {
 	tx, ok := ctx.Value(transactionContextKey).(*gorm.DB)
 	if !ok {
-		return r.db
+		return r.db.WithContext(ctx)
 	}
 
 	return tx
 }
 
+// ForUpdate returns the database of the context locking the rows it reads until the unit of work of the
+// context ends, so they can not be changed meanwhile. Outside of a unit of work nothing is locked
+func (r Repository) ForUpdate(ctx context.Context) *gorm.DB {
+	tx, ok := ctx.Value(transactionContextKey).(*gorm.DB)
+	if !ok {
+		return r.db.WithContext(ctx)
+	}
+
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"})
+}
+
 func New(conn *sql.DB) (*gorm.DB, error) {
 	db, err := gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
 
+	if err := registerTenantScope(db); err != nil {
+		return nil, err
+	}
+
 	return db, err
 }
 
 func TransactionFactory(db *gorm.DB) service.UnitOfWork {
 	return func(ctx context.Context, w service.Work) error {
-		return db.Transaction(func(tx *gorm.DB) error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			return w(context.WithValue(ctx, transactionContextKey, tx))
 		})
 	}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+// searchDocument is the weighted text the races are found by, the name first, then the venue and the description
+const searchDocument = `
+	setweight(to_tsvector('simple', name), 'A') ||
+	setweight(to_tsvector('simple', COALESCE(venue_name, '') || ' ' || COALESCE(venue_address, '')), 'B') ||
+	setweight(to_tsvector('simple', description), 'C')`
+
+// index updates the search document of the race, called on every save so the index is in sync
+func (r Races) index(db *gorm.DB, id racers.RaceID) error {
+	return db.Exec("UPDATE races SET search = "+searchDocument+" WHERE id = ? AND tenant_id = ?", id, tenantOf(db.Statement.Context)).Error
+}
+
+// searchQuery finds the races matching the text, or with a name similar to it to tolerate typos,
+// ranking the full text match plus the name similarity
+const searchQuery = `
+SELECT * FROM (
+	SELECT races.*,
+		ts_rank(search, query) + word_similarity(@text, name) AS rank,
+		ts_headline('simple',
+			REPLACE(REPLACE(REPLACE(name || ' ' || description, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+			query, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5'
+		) AS snippet,
+		CASE WHEN @radius > 0 THEN 2 * @radius_earth * ASIN(SQRT(
+			POWER(SIN(RADIANS(venue_lat - @lat) / 2), 2) +
+			COS(RADIANS(@lat)) * COS(RADIANS(venue_lat)) * POWER(SIN(RADIANS(venue_lon - @lon) / 2), 2)
+		)) END AS distance_m
+	FROM races, websearch_to_tsquery('simple', @text) AS query
+	WHERE tenant_id = @tenant AND (search @@ query OR @text <% name)
+	%s
+) AS found
+WHERE @radius = 0 OR distance_m <= @radius
+ORDER BY rank DESC, date, id
+LIMIT @limit`
+
+type raceMatch struct {
+	race
+	Rank    float64
+	Snippet string
+}
+
+func (r Races) Search(ctx context.Context, search service.RaceSearch) ([]service.RaceMatch, error) {
+	db := r.repo.DB(ctx)
+
+	vars := map[string]interface{}{
+		"text":         search.Query,
+		"radius":       search.Radius,
+		"radius_earth": earthRadius,
+		"lat":          search.Lat,
+		"lon":          search.Lon,
+		"limit":        search.Limit,
+		"tenant":       tenantOf(ctx),
+	}
+	var filters []string
+	if search.From != nil {
+		filters = append(filters, "AND date >= @from")
+		vars["from"] = *search.From
+	}
+	if search.To != nil {
+		filters = append(filters, "AND date <= @to")
+		vars["to"] = *search.To
+	}
+	if search.Radius > 0 {
+		filters = append(filters, "AND venue_lat IS NOT NULL")
+	}
+
+	rows, err := db.Raw(strings.Replace(searchQuery, "%s", strings.Join(filters, "\n\t"), 1), vars).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		matches []service.RaceMatch
+		races   []racers.Race
+	)
+	for rows.Next() {
+		var m raceMatch
+		if err := db.ScanRows(rows, &m); err != nil {
+			return nil, err
+		}
+		domain, err := m.race.toDomain()
+		if err != nil {
+			return nil, err
+		}
+		races = append(races, domain)
+		matches = append(matches, service.RaceMatch{Rank: m.Rank, Snippet: m.Snippet})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadRelations(db, races); err != nil {
+		return nil, err
+	}
+	for i := range matches {
+		matches[i].Race = races[i]
+	}
+
+	return matches, nil
+}
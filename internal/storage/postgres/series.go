@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+type series struct {
+	ID          racers.SeriesID `db:"id"`
+	OwnerID     racers.UserID   `db:"owner_id"`
+	Name        racers.RaceName `db:"name"`
+	Description string          `db:"description"`
+	Start       time.Time       `db:"start"`
+	Rule        string          `db:"rule"`
+	BestOf      int             `db:"best_of"`
+	// Venue columns are null when the series races have no venue
+	VenueName    *string  `db:"venue_name"`
+	VenueAddress *string  `db:"venue_address"`
+	VenueLat     *float64 `db:"venue_lat"`
+	VenueLon     *float64 `db:"venue_lon"`
+	TimeZone     *string  `db:"time_zone"`
+}
+
+func (series) TableName() string {
+	return "race_series"
+}
+
+func newSeries(s racers.Series) series {
+	dbSeries := series{
+		ID:          s.ID,
+		OwnerID:     s.Owner,
+		Name:        s.Template.Name,
+		Description: s.Template.Description,
+		Start:       s.Start,
+		Rule:        s.Recurrence.String(),
+		BestOf:      s.Scoring.BestOf,
+	}
+	if v := s.Template.Venue; v != nil {
+		tz := v.TimeZone.String()
+		dbSeries.VenueName, dbSeries.VenueAddress = &v.Name, &v.Address
+		dbSeries.VenueLat, dbSeries.VenueLon = &v.Lat, &v.Lon
+		dbSeries.TimeZone = &tz
+	}
+
+	return dbSeries
+}
+
+func (s series) toDomain() (racers.Series, error) {
+	recurrence, err := racers.ParseRecurrence(s.Rule)
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	result := racers.Series{
+		ID:         s.ID,
+		Owner:      s.OwnerID,
+		Template:   racers.SeriesTemplate{Name: s.Name, Description: s.Description},
+		Start:      s.Start,
+		Recurrence: recurrence,
+		Scoring:    racers.SeriesScoring{BestOf: s.BestOf},
+	}
+	if s.TimeZone != nil {
+		tz, err := racers.NewTimeZone(*s.TimeZone)
+		if err != nil {
+			return racers.Series{}, err
+		}
+		result.Template.Venue = &racers.Venue{
+			Name:     *s.VenueName,
+			Address:  *s.VenueAddress,
+			Lat:      *s.VenueLat,
+			Lon:      *s.VenueLon,
+			TimeZone: tz,
+		}
+	}
+
+	return result, nil
+}
+
+type seriesPoints struct {
+	SeriesID racers.SeriesID `db:"series_id"`
+	Position int             `db:"position"`
+	Points   int             `db:"points"`
+}
+
+func (seriesPoints) TableName() string {
+	return "race_series_points"
+}
+
+func NewSeries(db *gorm.DB) Series {
+	return Series{Repository{db}}
+}
+
+type Series struct {
+	repo Repository
+}
+
+func (r Series) Exists(ctx context.Context, id racers.SeriesID) (bool, error) {
+	var count int64
+	if err := r.repo.DB(ctx).Model(&series{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r Series) Get(ctx context.Context, id racers.SeriesID) (racers.Series, error) {
+	db := r.repo.DB(ctx)
+
+	var dbSeries series
+	if err := db.Take(&dbSeries, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.Series{}, service.ErrSeriesNotFound
+		}
+		return racers.Series{}, err
+	}
+
+	result, err := dbSeries.toDomain()
+	if err != nil {
+		return racers.Series{}, err
+	}
+
+	var points []seriesPoints
+	if err := db.Where("series_id = ?", id).Order("position").Find(&points).Error; err != nil {
+		return racers.Series{}, err
+	}
+	for _, p := range points {
+		result.Scoring.Points = append(result.Scoring.Points, p.Points)
+	}
+
+	var instances []race
+	if err := db.Select("id", "series_id", "series_occurrence").Where("series_id = ?", id).Order("series_occurrence").Find(&instances).Error; err != nil {
+		return racers.Series{}, err
+	}
+	for _, i := range instances {
+		result.Instances = append(result.Instances, racers.SeriesInstance{Series: id, Race: i.ID, Occurrence: *i.SeriesOccurrence})
+	}
+
+	return result, nil
+}
+
+func (r Series) Save(ctx context.Context, in racers.Series) error {
+	db := r.repo.DB(ctx)
+
+	dbSeries := newSeries(in)
+	if err := db.Save(&dbSeries).Error; err != nil {
+		return err
+	}
+
+	if err := db.Where("series_id = ?", in.ID).Delete(&seriesPoints{}).Error; err != nil {
+		return err
+	}
+	points := make([]seriesPoints, len(in.Scoring.Points))
+	for i, p := range in.Scoring.Points {
+		points[i] = seriesPoints{SeriesID: in.ID, Position: i + 1, Points: p}
+	}
+	if len(points) == 0 {
+		return nil
+	}
+
+	return db.Create(&points).Error
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	racers "github.com/xabi93/racers/internal"
+	"gorm.io/gorm"
+)
+
+type raceStaff struct {
+	RaceID racers.RaceID    `db:"race_id"`
+	UserID racers.UserID    `db:"user_id"`
+	Role   racers.StaffRole `db:"role"`
+}
+
+func (raceStaff) TableName() string {
+	return "race_staff"
+}
+
+// loadStaff fills the staff of the races with their roles
+func (r Races) loadStaff(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var staff []raceStaff
+	if err := db.Where("race_id IN ?", ids).Find(&staff).Error; err != nil {
+		return err
+	}
+
+	for _, s := range staff {
+		race := byID[s.RaceID]
+		if race.Staff == nil {
+			race.Staff = make(racers.RaceStaff)
+		}
+		race.Staff[s.UserID] = s.Role
+	}
+
+	return nil
+}
+
+func (r Races) saveStaff(db *gorm.DB, in racers.Race) error {
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceStaff{}).Error; err != nil {
+		return err
+	}
+
+	staff := make([]raceStaff, 0, len(in.Staff))
+	for u, role := range in.Staff {
+		staff = append(staff, raceStaff{RaceID: in.ID, UserID: u, Role: role})
+	}
+	if len(staff) == 0 {
+		return nil
+	}
+
+	return db.Create(&staff).Error
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/service"
+	"gorm.io/gorm"
+)
+
+type team struct {
+	ID      racers.TeamID   `db:"id"`
+	Name    racers.TeamName `db:"name"`
+	AdminID racers.UserID   `db:"admin_id"`
+}
+
+func (team) TableName() string {
+	return "teams"
+}
+
+type teamMember struct {
+	TeamID   racers.TeamID `db:"team_id"`
+	MemberID racers.UserID `db:"member_id"`
+}
+
+func (teamMember) TableName() string {
+	return "team_members"
+}
+
+type teamInvitation struct {
+	TeamID    racers.TeamID `db:"team_id"`
+	UserID    racers.UserID `db:"user_id"`
+	ExpiresAt time.Time     `db:"expires_at"`
+}
+
+func (teamInvitation) TableName() string {
+	return "team_invitations"
+}
+
+type teamJoinRequest struct {
+	TeamID racers.TeamID `db:"team_id"`
+	UserID racers.UserID `db:"user_id"`
+}
+
+func (teamJoinRequest) TableName() string {
+	return "team_join_requests"
+}
+
+func NewTeams(db *gorm.DB) Teams {
+	return Teams{Repository{db}}
+}
+
+type Teams struct {
+	repo Repository
+}
+
+func (t Teams) Get(ctx context.Context, id racers.TeamID) (racers.Team, error) {
+	db := t.repo.DB(ctx)
+
+	// the team is locked in a unit of work, the team is read to be changed and saved and the changes of
+	// others in the meantime would be overwritten
+	var teamDB team
+	if err := t.repo.ForUpdate(ctx).Take(&teamDB, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return racers.Team{}, service.ErrTeamNotFound
+		}
+		return racers.Team{}, err
+	}
+
+	var members []teamMember
+	if err := db.Where("team_id = ?", id).Find(&members).Error; err != nil {
+		return racers.Team{}, err
+	}
+
+	membersIDs := make([]racers.UserID, len(members))
+	for i, m := range members {
+		membersIDs[i] = m.MemberID
+	}
+
+	var invitations []teamInvitation
+	if err := db.Where("team_id = ?", id).Find(&invitations).Error; err != nil {
+		return racers.Team{}, err
+	}
+
+	var teamInvitations racers.TeamInvitations
+	if len(invitations) > 0 {
+		teamInvitations = make(racers.TeamInvitations, len(invitations))
+		for _, i := range invitations {
+			teamInvitations[i.UserID] = i.ExpiresAt
+		}
+	}
+
+	var requests []teamJoinRequest
+	if err := db.Where("team_id = ?", id).Find(&requests).Error; err != nil {
+		return racers.Team{}, err
+	}
+
+	requestsIDs := make([]racers.UserID, len(requests))
+	for i, r := range requests {
+		requestsIDs[i] = r.UserID
+	}
+
+	return racers.NewTeam(
+		teamDB.ID, teamDB.Name, teamDB.AdminID,
+		racers.TeamMembersOpt(racers.NewTeamMembers(membersIDs...)),
+		racers.TeamInvitationsOpt(teamInvitations),
+		racers.TeamJoinRequestsOpt(racers.NewTeamJoinRequests(requestsIDs...)),
+	), nil
+}
+
+func (t Teams) ByMember(ctx context.Context, id racers.UserID) (*racers.Team, error) {
+	var member teamMember
+	if err := t.repo.DB(ctx).Where("member_id = ?", id).Take(&member).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result, err := t.Get(ctx, member.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (t Teams) Save(ctx context.Context, in racers.Team) error {
+	db := t.repo.DB(ctx)
+
+	if err := db.Save(&team{ID: in.ID, Name: in.Name, AdminID: in.Admin}).Error; err != nil {
+		return err
+	}
+
+	if err := db.Where("team_id = ?", in.ID).Delete(&teamMember{}).Error; err != nil {
+		return err
+	}
+
+	if members := in.Members.List(); len(members) > 0 {
+		rows := make([]teamMember, len(members))
+		for i, m := range members {
+			rows[i] = teamMember{TeamID: in.ID, MemberID: m}
+		}
+		if err := db.Create(&rows).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := db.Where("team_id = ?", in.ID).Delete(&teamInvitation{}).Error; err != nil {
+		return err
+	}
+	if len(in.Invitations) > 0 {
+		rows := make([]teamInvitation, 0, len(in.Invitations))
+		for u, expiresAt := range in.Invitations {
+			rows = append(rows, teamInvitation{TeamID: in.ID, UserID: u, ExpiresAt: expiresAt})
+		}
+		if err := db.Create(&rows).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := db.Where("team_id = ?", in.ID).Delete(&teamJoinRequest{}).Error; err != nil {
+		return err
+	}
+	requests := in.JoinRequests.List()
+	if len(requests) == 0 {
+		return nil
+	}
+
+	rows := make([]teamJoinRequest, len(requests))
+	for i, u := range requests {
+		rows[i] = teamJoinRequest{TeamID: in.ID, UserID: u}
+	}
+
+	return db.Create(&rows).Error
+}
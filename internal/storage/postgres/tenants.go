@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/errors"
+	"github.com/xabi93/racers/internal/tenant"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// tenantSetting is the setting the row level security policies read the tenant of the session from
+const tenantSetting = "app.tenant_id"
+
+// untenanted are the tables shared by all the tenants
+var untenanted = map[string]bool{
+	"tenants":  true,
+	"job_runs": true,
+}
+
+// Instance keys of the statements bound to a connection of the pool
+const (
+	tenantPoolKey = "tenant:pool"
+	tenantConnKey = "tenant:conn"
+)
+
+// tenantOf returns the tenant of the context, empty when there is none so nothing is visible
+func tenantOf(ctx context.Context) string {
+	id, _ := tenant.FromContext(ctx)
+
+	return string(id)
+}
+
+// registerTenantScope scopes the statements to the tenant of their context. The queries of the models
+// get a tenant_id condition and every statement runs with the tenant setting the row level security
+// policies check, so a query missing the condition can not read nor write the rows of other tenants
+func registerTenantScope(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("*").Register("tenant:bind", bindTenant),
+		cb.Create().After("*").Register("tenant:release", releaseTenant),
+		cb.Query().Before("*").Register("tenant:bind", scopeTenant),
+		cb.Query().After("*").Register("tenant:release", releaseTenant),
+		cb.Update().Before("*").Register("tenant:bind", scopeTenant),
+		cb.Update().After("*").Register("tenant:release", releaseTenant),
+		cb.Delete().Before("*").Register("tenant:bind", scopeTenant),
+		cb.Delete().After("*").Register("tenant:release", releaseTenant),
+		cb.Row().Before("*").Register("tenant:bind", scopeTenant),
+		cb.Row().After("*").Register("tenant:release", releaseRows),
+		cb.Raw().Before("*").Register("tenant:bind", bindTenant),
+		cb.Raw().After("*").Register("tenant:release", releaseTenant),
+	} {
+		if err != nil {
+			return errors.Wrap(err, "registering tenant scope")
+		}
+	}
+
+	return nil
+}
+
+// scopeTenant adds the tenant condition to the queries built from a tenanted model and binds the tenant
+func scopeTenant(db *gorm.DB) {
+	stmt := db.Statement
+	if stmt.Schema != nil && stmt.Table == stmt.Schema.Table && !untenanted[stmt.Table] && stmt.SQL.Len() == 0 {
+		stmt.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: "tenant_id"}, Value: tenantOf(stmt.Context)},
+		}})
+	}
+
+	bindTenant(db)
+}
+
+// bindTenant sets the tenant of the session the statement runs in. In a transaction it is set for the
+// transaction, otherwise the statement is bound to a connection of the pool with the tenant set
+func bindTenant(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+
+	ctx := db.Statement.Context
+	switch pool := db.Statement.ConnPool.(type) {
+	case *sql.Tx:
+		_, err := pool.ExecContext(ctx, "SELECT set_config($1, $2, true)", tenantSetting, tenantOf(ctx))
+		db.AddError(err)
+	case *sql.DB:
+		conn, err := pool.Conn(ctx)
+		if err != nil {
+			db.AddError(err)
+			return
+		}
+		if _, err := conn.ExecContext(ctx, "SELECT set_config($1, $2, false)", tenantSetting, tenantOf(ctx)); err != nil {
+			conn.Close()
+			db.AddError(err)
+			return
+		}
+
+		db.InstanceSet(tenantPoolKey, pool)
+		db.InstanceSet(tenantConnKey, conn)
+		db.Statement.ConnPool = conn
+	}
+}
+
+// releaseTenant returns the connection the statement was bound to
+func releaseTenant(db *gorm.DB) {
+	if conn := unbindTenant(db); conn != nil {
+		db.AddError(conn.Close())
+	}
+}
+
+// releaseRows returns the connection the statement was bound to once its rows are closed, Close waits for them
+func releaseRows(db *gorm.DB) {
+	if conn := unbindTenant(db); conn != nil {
+		go conn.Close()
+	}
+}
+
+// unbindTenant restores the pool of the statement, it returns the connection it was bound to
+func unbindTenant(db *gorm.DB) *sql.Conn {
+	v, ok := db.InstanceGet(tenantConnKey)
+	if !ok {
+		return nil
+	}
+	pool, _ := db.InstanceGet(tenantPoolKey)
+	db.Statement.ConnPool = pool.(*sql.DB)
+
+	return v.(*sql.Conn)
+}
+
+type tenantRow struct {
+	ID   tenant.ID `db:"id"`
+	Name string    `db:"name"`
+}
+
+func (tenantRow) TableName() string {
+	return "tenants"
+}
+
+func NewTenants(db *gorm.DB) Tenants {
+	return Tenants{Repository{db}}
+}
+
+// Tenants are the federations the service is run for, they are added by the operators
+type Tenants struct {
+	repo Repository
+}
+
+func (r Tenants) All(ctx context.Context) ([]tenant.ID, error) {
+	var rows []tenantRow
+	if err := r.repo.DB(ctx).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]tenant.ID, len(rows))
+	for i, t := range rows {
+		result[i] = t.ID
+	}
+
+	return result, nil
+}
+
+func (r Tenants) Exists(ctx context.Context, id tenant.ID) (bool, error) {
+	var count int64
+	if err := r.repo.DB(ctx).Model(&tenantRow{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+type tenantMember struct {
+	UserID racers.UserID `db:"user_id"`
+	Admin  bool          `db:"admin"`
+}
+
+func (tenantMember) TableName() string {
+	return "tenant_members"
+}
+
+func NewTenantMembers(db *gorm.DB) TenantMembers {
+	return TenantMembers{Repository{db}}
+}
+
+// TenantMembers are the users of each tenant, they are added by the operators
+type TenantMembers struct {
+	repo Repository
+}
+
+func (r TenantMembers) Membership(ctx context.Context, user racers.UserID) (tenant.Membership, bool, error) {
+	var m tenantMember
+	if err := r.repo.DB(ctx).Take(&m, "user_id = ?", user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return tenant.Membership{}, false, nil
+		}
+		return tenant.Membership{}, false, err
+	}
+
+	return tenant.Membership{Admin: m.Admin}, true, nil
+}
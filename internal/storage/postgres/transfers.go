@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"time"
+
+	racers "github.com/xabi93/racers/internal"
+	"gorm.io/gorm"
+)
+
+type raceRegistrationTransfer struct {
+	RaceID    racers.RaceID `db:"race_id"`
+	FromID    racers.UserID `db:"from_id"`
+	ToID      racers.UserID `db:"to_id"`
+	OfferedAt time.Time     `db:"offered_at"`
+}
+
+func (raceRegistrationTransfer) TableName() string {
+	return "race_registration_transfers"
+}
+
+// loadTransfers fills the registration transfers offered in the races
+func (r Races) loadTransfers(db *gorm.DB, ids []racers.RaceID, byID map[racers.RaceID]*racers.Race) error {
+	var transfers []raceRegistrationTransfer
+	if err := db.Where("race_id IN ?", ids).Find(&transfers).Error; err != nil {
+		return err
+	}
+
+	for _, t := range transfers {
+		race := byID[t.RaceID]
+		if race.RegistrationTransfers == nil {
+			race.RegistrationTransfers = make(racers.RegistrationTransfers)
+		}
+		race.RegistrationTransfers[t.FromID] = racers.RegistrationTransfer{To: t.ToID, OfferedAt: t.OfferedAt}
+	}
+
+	return nil
+}
+
+func (r Races) saveTransfers(db *gorm.DB, in racers.Race) error {
+	if err := db.Where("race_id = ?", in.ID).Delete(&raceRegistrationTransfer{}).Error; err != nil {
+		return err
+	}
+
+	transfers := make([]raceRegistrationTransfer, 0, len(in.RegistrationTransfers))
+	for from, t := range in.RegistrationTransfers {
+		transfers = append(transfers, raceRegistrationTransfer{RaceID: in.ID, FromID: from, ToID: t.To, OfferedAt: t.OfferedAt})
+	}
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	return db.Create(&transfers).Error
+}
@@ -59,6 +59,20 @@ func TeamMembersOpt(c TeamMembers) teamOption {
 	}
 }
 
+// TeamInvitationsOpt is an optional parameter for NewTeam to initialize a team with pending invitations
+func TeamInvitationsOpt(i TeamInvitations) teamOption {
+	return func(r *Team) {
+		r.Invitations = i
+	}
+}
+
+// TeamJoinRequestsOpt is an optional parameter for NewTeam to initialize a team with pending join requests
+func TeamJoinRequestsOpt(j TeamJoinRequests) teamOption {
+	return func(r *Team) {
+		r.JoinRequests = j
+	}
+}
+
 // NewTeam is a constructor for Team
 func NewTeam(id TeamID, name TeamName, admin UserID, opts ...teamOption) Team {
 	r := Team{
@@ -97,6 +111,10 @@ type Team struct {
 	Name    TeamName    `json:"name,omitempty"`
 	Admin   UserID      `json:"admin,omitempty"`
 	Members TeamMembers `json:"members,omitempty"`
+	// Invitations are the users invited by the admin that have not answered yet
+	Invitations TeamInvitations `json:"invitations,omitempty"`
+	// JoinRequests are the users that asked to join and wait for the admin approval
+	JoinRequests TeamJoinRequests `json:"join_requests,omitempty"`
 }
 
 // UserAlreadyInTeamError a user cannot join a team because it's already in a team
@@ -108,20 +126,3 @@ type UserAlreadyInTeamError struct {
 func (err UserAlreadyInTeamError) Error() string {
 	return fmt.Sprintf("user %s cant join is member of %s team", err.UserID, err.TeamID)
 }
-
-// Join add a user as a team member
-func (t *Team) join(u User) {
-	t.Members.add(u.ID)
-}
-
-// JoinTeam is a domain service that checks if the given user is in a team and in that case returns UserAlreadyInTeamError
-// if not adds the user to the team
-func JoinTeam(joinTeam Team, u User, t *Team) (Team, error) {
-	if t != nil {
-		return Team{}, UserAlreadyInTeamError{UserID: u.ID, TeamID: t.ID}
-	}
-
-	joinTeam.join(u)
-
-	return joinTeam, nil
-}
@@ -0,0 +1,226 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// TeamInvitationTTL is how long an invitation to join a team is valid
+const TeamInvitationTTL = 7 * 24 * time.Hour
+
+// TeamInvitations are the pending invitations of a team, with the time each one expires by invited user
+type TeamInvitations map[UserID]time.Time
+
+// TeamJoinRequests are the users waiting for the admin approval to join a team
+type TeamJoinRequests struct{ userList }
+
+// NewTeamJoinRequests is a constructor that given a users ids it builds TeamJoinRequests list
+func NewTeamJoinRequests(users ...UserID) TeamJoinRequests {
+	ul := make(userList, len(users))
+	for _, u := range users {
+		ul.add(u)
+	}
+
+	return TeamJoinRequests{ul}
+}
+
+// TeamInvitationNotFoundError means the user has not a pending invitation to the team
+type TeamInvitationNotFoundError struct {
+	TeamID TeamID
+	UserID UserID
+}
+
+func (err TeamInvitationNotFoundError) Error() string {
+	return fmt.Sprintf("user %s is not invited to team %s", err.UserID, err.TeamID)
+}
+
+// TeamInvitationExpiredError means the invitation to join the team is no longer valid
+type TeamInvitationExpiredError struct {
+	TeamID    TeamID
+	UserID    UserID
+	ExpiredAt time.Time
+}
+
+func (err TeamInvitationExpiredError) Error() string {
+	return fmt.Sprintf("invitation of user %s to team %s expired at %s", err.UserID, err.TeamID, err.ExpiredAt.Format(time.RFC3339))
+}
+
+// TeamJoinRequestNotFoundError means the user has not requested to join the team
+type TeamJoinRequestNotFoundError struct {
+	TeamID TeamID
+	UserID UserID
+}
+
+func (err TeamJoinRequestNotFoundError) Error() string {
+	return fmt.Sprintf("user %s has not requested to join team %s", err.UserID, err.TeamID)
+}
+
+// TeamAdminCannotLeaveError means the admin has to transfer the admin role before leaving the team
+type TeamAdminCannotLeaveError struct {
+	TeamID TeamID
+	UserID UserID
+}
+
+func (err TeamAdminCannotLeaveError) Error() string {
+	return fmt.Sprintf("user %s cannot leave team %s without transferring the admin role", err.UserID, err.TeamID)
+}
+
+func (t Team) checkAdmin(u User) error {
+	if t.Admin != u.ID {
+		return NotTeamAdminError{t.ID, u.ID}
+	}
+
+	return nil
+}
+
+// add sets the user as a team member and clears its pending invitation and join request
+func (t *Team) add(u UserID) {
+	t.Members.add(u)
+	delete(t.Invitations, u)
+	t.JoinRequests.remove(u)
+}
+
+// Invite invites, by the team admin, a user to join the team until the invitation expires.
+// Inviting again a user renews the invitation.
+func (t *Team) Invite(by User, user UserID, now time.Time) error {
+	if err := t.checkAdmin(by); err != nil {
+		return err
+	}
+
+	if t.Members.is(user) {
+		return UserAlreadyInTeamError{UserID: user, TeamID: t.ID}
+	}
+
+	if t.Invitations == nil {
+		t.Invitations = make(TeamInvitations)
+	}
+	t.Invitations[user] = now.Add(TeamInvitationTTL)
+
+	return nil
+}
+
+// AcceptInvitation adds the invited user to the team, userTeam is the team the user is member of, if any
+func (t *Team) AcceptInvitation(u User, userTeam *Team, now time.Time) error {
+	expiresAt, ok := t.Invitations[u.ID]
+	if !ok {
+		return TeamInvitationNotFoundError{t.ID, u.ID}
+	}
+
+	if !now.Before(expiresAt) {
+		return TeamInvitationExpiredError{t.ID, u.ID, expiresAt}
+	}
+
+	if userTeam != nil {
+		return UserAlreadyInTeamError{UserID: u.ID, TeamID: userTeam.ID}
+	}
+
+	t.add(u.ID)
+
+	return nil
+}
+
+// DeclineInvitation discards the invitation of the user to the team
+func (t *Team) DeclineInvitation(u User) error {
+	if _, ok := t.Invitations[u.ID]; !ok {
+		return TeamInvitationNotFoundError{t.ID, u.ID}
+	}
+
+	delete(t.Invitations, u.ID)
+
+	return nil
+}
+
+// RequestJoin asks the team admin to let the user join, userTeam is the team the user is member of, if any
+func (t *Team) RequestJoin(u User, userTeam *Team) error {
+	if userTeam != nil {
+		return UserAlreadyInTeamError{UserID: u.ID, TeamID: userTeam.ID}
+	}
+
+	t.JoinRequests.add(u.ID)
+
+	return nil
+}
+
+// ApproveJoinRequest adds, by the team admin, the user that requested to join the team.
+// userTeam is the team the user is member of, if any
+func (t *Team) ApproveJoinRequest(by User, user UserID, userTeam *Team) error {
+	if err := t.checkAdmin(by); err != nil {
+		return err
+	}
+
+	if !t.JoinRequests.is(user) {
+		return TeamJoinRequestNotFoundError{t.ID, user}
+	}
+
+	if userTeam != nil {
+		return UserAlreadyInTeamError{UserID: user, TeamID: userTeam.ID}
+	}
+
+	t.add(user)
+
+	return nil
+}
+
+// RejectJoinRequest discards, by the team admin, the request of the user to join the team
+func (t *Team) RejectJoinRequest(by User, user UserID) error {
+	if err := t.checkAdmin(by); err != nil {
+		return err
+	}
+
+	if !t.JoinRequests.is(user) {
+		return TeamJoinRequestNotFoundError{t.ID, user}
+	}
+
+	t.JoinRequests.remove(user)
+
+	return nil
+}
+
+// Leave removes the user from the team members, the admin cannot leave without transferring the admin role
+func (t *Team) Leave(u User) error {
+	if !t.Members.is(u.ID) {
+		return NotTeamMemberError{t.ID, u.ID}
+	}
+
+	if t.Admin == u.ID {
+		return TeamAdminCannotLeaveError{t.ID, u.ID}
+	}
+
+	t.Members.remove(u.ID)
+
+	return nil
+}
+
+// RemoveMember removes, by the team admin, a member from the team
+func (t *Team) RemoveMember(by User, member UserID) error {
+	if err := t.checkAdmin(by); err != nil {
+		return err
+	}
+
+	if !t.Members.is(member) {
+		return NotTeamMemberError{t.ID, member}
+	}
+
+	if member == t.Admin {
+		return TeamAdminCannotLeaveError{t.ID, member}
+	}
+
+	t.Members.remove(member)
+
+	return nil
+}
+
+// TransferAdmin makes, by the current team admin, other member the team admin
+func (t *Team) TransferAdmin(by User, to UserID) error {
+	if err := t.checkAdmin(by); err != nil {
+		return err
+	}
+
+	if !t.Members.is(to) {
+		return NotTeamMemberError{t.ID, to}
+	}
+
+	t.Admin = to
+
+	return nil
+}
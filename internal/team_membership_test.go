@@ -0,0 +1,207 @@
+package racers_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+)
+
+func TestTeamInvitations(t *testing.T) {
+	require := require.New(t)
+
+	admin := racers.User{ID: teamAdminID}
+	invited := racers.User{ID: userID}
+	now := time.Now()
+
+	newTeam := func() racers.Team {
+		return racers.CreateTeam(teamID, teamName, admin)
+	}
+
+	t.Run("When invites a user that is not the admin, returns NotTeamAdminError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.Invite(invited, userID, now)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When invites a member, returns UserAlreadyInTeamError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.Invite(admin, teamAdminID, now)
+		require.True(errors.As(err, &racers.UserAlreadyInTeamError{}))
+	})
+
+	t.Run("When the admin invites a user, adds the invitation until it expires", func(t *testing.T) {
+		team := newTeam()
+
+		require.NoError(team.Invite(admin, userID, now))
+		require.Equal(racers.TeamInvitations{userID: now.Add(racers.TeamInvitationTTL)}, team.Invitations)
+	})
+
+	t.Run("When accepts without invitation, returns TeamInvitationNotFoundError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.AcceptInvitation(invited, nil, now)
+		require.True(errors.As(err, &racers.TeamInvitationNotFoundError{}))
+	})
+
+	t.Run("When accepts an expired invitation, returns TeamInvitationExpiredError", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.Invite(admin, userID, now))
+
+		err := team.AcceptInvitation(invited, nil, now.Add(racers.TeamInvitationTTL))
+		require.True(errors.As(err, &racers.TeamInvitationExpiredError{}))
+	})
+
+	t.Run("When accepts being member of other team, returns UserAlreadyInTeamError", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.Invite(admin, userID, now))
+		other := racers.NewTeam(racers.TeamID(id.Generate()), teamName, userID)
+
+		err := team.AcceptInvitation(invited, &other, now)
+		require.True(errors.Is(err, racers.UserAlreadyInTeamError{UserID: userID, TeamID: other.ID}))
+	})
+
+	t.Run("When accepts a valid invitation, joins the team and removes the invitation", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.Invite(admin, userID, now))
+
+		require.NoError(team.AcceptInvitation(invited, nil, now))
+		require.ElementsMatch([]racers.UserID{teamAdminID, userID}, team.Members.List())
+		require.Empty(team.Invitations)
+	})
+
+	t.Run("When declines an invitation, removes it", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.Invite(admin, userID, now))
+
+		require.NoError(team.DeclineInvitation(invited))
+		require.Empty(team.Invitations)
+
+		err := team.DeclineInvitation(invited)
+		require.True(errors.As(err, &racers.TeamInvitationNotFoundError{}))
+	})
+}
+
+func TestTeamJoinRequests(t *testing.T) {
+	require := require.New(t)
+
+	admin := racers.User{ID: teamAdminID}
+	user := racers.User{ID: userID}
+
+	newTeam := func() racers.Team {
+		return racers.CreateTeam(teamID, teamName, admin)
+	}
+
+	t.Run("When requests being member of a team, returns UserAlreadyInTeamError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.RequestJoin(admin, &team)
+		require.True(errors.As(err, &racers.UserAlreadyInTeamError{}))
+	})
+
+	t.Run("When approved by a user that is not the admin, returns NotTeamAdminError", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.RequestJoin(user, nil))
+
+		err := team.ApproveJoinRequest(user, userID, nil)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When approves a user that did not request, returns TeamJoinRequestNotFoundError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.ApproveJoinRequest(admin, userID, nil)
+		require.True(errors.As(err, &racers.TeamJoinRequestNotFoundError{}))
+	})
+
+	t.Run("When the admin approves a request, the user joins the team", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.RequestJoin(user, nil))
+
+		require.NoError(team.ApproveJoinRequest(admin, userID, nil))
+		require.ElementsMatch([]racers.UserID{teamAdminID, userID}, team.Members.List())
+		require.Empty(team.JoinRequests.List())
+	})
+
+	t.Run("When the admin rejects a request, removes it", func(t *testing.T) {
+		team := newTeam()
+		require.NoError(team.RequestJoin(user, nil))
+
+		require.NoError(team.RejectJoinRequest(admin, userID))
+		require.Empty(team.JoinRequests.List())
+		require.ElementsMatch([]racers.UserID{teamAdminID}, team.Members.List())
+	})
+}
+
+func TestTeamMembership(t *testing.T) {
+	require := require.New(t)
+
+	admin := racers.User{ID: teamAdminID}
+	member := racers.User{ID: userID}
+
+	newTeam := func() racers.Team {
+		return racers.NewTeam(teamID, teamName, teamAdminID, racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID, userID)))
+	}
+
+	t.Run("When the admin leaves, returns TeamAdminCannotLeaveError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.Leave(admin)
+		require.True(errors.As(err, &racers.TeamAdminCannotLeaveError{}))
+	})
+
+	t.Run("When a user that is not member leaves, returns NotTeamMemberError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.Leave(racers.User{ID: racers.UserID(id.Generate())})
+		require.True(errors.As(err, &racers.NotTeamMemberError{}))
+	})
+
+	t.Run("When a member leaves, is removed from the team", func(t *testing.T) {
+		team := newTeam()
+
+		require.NoError(team.Leave(member))
+		require.Equal([]racers.UserID{teamAdminID}, team.Members.List())
+	})
+
+	t.Run("When a member removes other member, returns NotTeamAdminError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.RemoveMember(member, teamAdminID)
+		require.True(errors.As(err, &racers.NotTeamAdminError{}))
+	})
+
+	t.Run("When the admin removes itself, returns TeamAdminCannotLeaveError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.RemoveMember(admin, teamAdminID)
+		require.True(errors.As(err, &racers.TeamAdminCannotLeaveError{}))
+	})
+
+	t.Run("When the admin removes a member, is removed from the team", func(t *testing.T) {
+		team := newTeam()
+
+		require.NoError(team.RemoveMember(admin, userID))
+		require.Equal([]racers.UserID{teamAdminID}, team.Members.List())
+	})
+
+	t.Run("When the admin transfers the role to a user that is not member, returns NotTeamMemberError", func(t *testing.T) {
+		team := newTeam()
+
+		err := team.TransferAdmin(admin, racers.UserID(id.Generate()))
+		require.True(errors.As(err, &racers.NotTeamMemberError{}))
+	})
+
+	t.Run("When the admin transfers the role to a member, the member is the new admin and the old one can leave", func(t *testing.T) {
+		team := newTeam()
+
+		require.NoError(team.TransferAdmin(admin, userID))
+		require.Equal(userID, team.Admin)
+		require.NoError(team.Leave(admin))
+	})
+}
@@ -60,29 +60,7 @@ func TestNewTeam(t *testing.T) {
 func TestCreateTeam(t *testing.T) {
 	require := require.New(t)
 
-	r := racers.CreateTeam(teamID, teamName, racers.User{teamAdminID})
+	r := racers.CreateTeam(teamID, teamName, racers.User{ID: teamAdminID})
 
 	require.Equal(r, racers.NewTeam(teamID, teamName, teamAdminID, racers.TeamMembersOpt(racers.NewTeamMembers(teamAdminID))))
 }
-
-func TestJoinTeam(t *testing.T) {
-	require := require.New(t)
-	t.Run(`Given a team with only with admin,
-	When joins other user with team,
-	Then returns UserAlreadyInTeam error`, func(t *testing.T) {
-		team := racers.NewTeam(teamID, teamName, teamAdminID)
-
-		_, err := racers.JoinTeam(team, racers.User{userID}, &team)
-
-		require.True(errors.Is(err, racers.UserAlreadyInTeamError{UserID: userID, TeamID: teamID}))
-	})
-
-	t.Run(`Given a team with only with admin,
-	When joins other user without team,
-	Then returns no error and generates event`, func(t *testing.T) {
-		team := racers.NewTeam(teamID, teamName, teamAdminID)
-
-		team, err := racers.JoinTeam(team, racers.User{userID}, nil)
-		require.NoError(err)
-	})
-}
@@ -0,0 +1,114 @@
+package tenant
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/xabi93/racers/internal/errors"
+)
+
+// Header is the request header naming the tenant
+const Header = "X-Tenant"
+
+var (
+	// ErrConflict means the request names several tenants
+	ErrConflict = errors.New("the request names different tenants")
+	// ErrNotMember means the current user does not belong to the tenant of the request
+	ErrNotMember = errors.New("the user does not belong to the tenant")
+)
+
+// Resolver finds the tenant of the requests
+type Resolver struct {
+	// Domain is the base domain the tenants are served as subdomains of, like racers.app for club.racers.app.
+	// The subdomains are not resolved when it is empty
+	Domain string
+}
+
+// Resolve returns the tenant of the request from the claim of the token, the header or the subdomain.
+// They must agree when several are present, the Default tenant is returned when none is
+func (r Resolver) Resolve(req *http.Request, claim string) (ID, error) {
+	var found []string
+	if claim != "" {
+		found = append(found, claim)
+	}
+	if h := req.Header.Get(Header); h != "" {
+		found = append(found, h)
+	}
+	if sub := r.subdomain(req.Host); sub != "" {
+		found = append(found, sub)
+	}
+
+	if len(found) == 0 {
+		return Default, nil
+	}
+	for _, f := range found[1:] {
+		if f != found[0] {
+			return "", ErrConflict
+		}
+	}
+
+	return NewID(found[0])
+}
+
+// subdomain returns the first label of the host under the domain, empty when the host is the domain itself
+func (r Resolver) subdomain(host string) string {
+	if r.Domain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	sub := strings.TrimSuffix(strings.ToLower(host), "."+r.Domain)
+	if sub == host || strings.Contains(sub, ".") {
+		return ""
+	}
+
+	return sub
+}
+
+// Admit returns the context of the request in the tenant, ErrNotMember when the current user does not belong to it
+type Admit func(ctx context.Context, id ID) (context.Context, error)
+
+// Middleware carries the tenant of the requests in their context. claim returns the tenant the token of the
+// current user is bound to, empty when it is valid for all of them, exists reports if the tenant is served and
+// admit lets in only the users of the tenant
+func Middleware(r Resolver, claim func(context.Context) string, exists func(context.Context, ID) (bool, error), admit Admit) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			id, err := r.Resolve(req, claim(req.Context()))
+			switch {
+			case errors.Is(err, ErrConflict):
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			case err != nil:
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			ok, err := exists(req.Context(), id)
+			switch {
+			case err != nil:
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			case !ok:
+				http.Error(w, "unknown tenant", http.StatusNotFound)
+				return
+			}
+
+			ctx, err := admit(WithID(req.Context(), id), id)
+			switch {
+			case errors.Is(err, ErrNotMember):
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			case err != nil:
+				http.Error(w, "internal error", http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(w, req.WithContext(ctx))
+		})
+	}
+}
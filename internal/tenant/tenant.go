@@ -0,0 +1,83 @@
+// Package tenant identifies the federation a request is served for, its data is isolated from the other tenants
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/xabi93/racers/internal/errors"
+)
+
+// Default is the tenant of the requests that do not name one, the data stored before the tenants
+// were introduced belongs to it
+const Default ID = "default"
+
+type (
+	// ID is the slug of a tenant, it is used as its subdomain
+	ID             string
+	InvalidIDError struct{ Value string }
+)
+
+func (err InvalidIDError) Error() string {
+	return fmt.Sprintf("invalid tenant: %q", err.Value)
+}
+
+var idPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// NewID validates the slug and returns an ID instance
+func NewID(s string) (ID, error) {
+	if !idPattern.MatchString(s) {
+		return "", InvalidIDError{s}
+	}
+
+	return ID(s), nil
+}
+
+type contextKey struct{}
+
+// WithID returns the context of the work done for the tenant
+func WithID(ctx context.Context, id ID) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the tenant the work is done for, false when the context has none
+func FromContext(ctx context.Context) (ID, bool) {
+	id, ok := ctx.Value(contextKey{}).(ID)
+
+	return id, ok
+}
+
+// Membership is the role of a user in a tenant
+type Membership struct {
+	// Admin is set for the administrators of the tenant, the administrators of a tenant are not of the others
+	Admin bool
+}
+
+// Lister lists the tenants the service is run for
+type Lister interface {
+	All(ctx context.Context) ([]ID, error)
+}
+
+// Each runs the work for every tenant, it returns the sum of the items processed and the first error,
+// after the work is run for all of them
+func Each(ctx context.Context, tenants Lister, work func(ctx context.Context) (int, error)) (int, error) {
+	ids, err := tenants.All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		processed int
+		first     error
+	)
+	for _, id := range ids {
+		n, err := work(WithID(ctx, id))
+		processed += n
+		if err != nil && first == nil {
+			first = errors.Wrap(err, "tenant %s", id)
+		}
+	}
+
+	return processed, first
+}
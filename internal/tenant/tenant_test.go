@@ -0,0 +1,151 @@
+package tenant_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xabi93/racers/internal/tenant"
+)
+
+func TestNewID(t *testing.T) {
+	for _, s := range []string{"default", "fed-1", "a"} {
+		t.Run(s, func(t *testing.T) {
+			id, err := tenant.NewID(s)
+			require.NoError(t, err)
+			require.Equal(t, tenant.ID(s), id)
+		})
+	}
+
+	for _, s := range []string{"", "Fed", "-fed", "fed-", "fed.one", "fed_one"} {
+		t.Run("invalid "+s, func(t *testing.T) {
+			_, err := tenant.NewID(s)
+			require.Equal(t, tenant.InvalidIDError{Value: s}, err)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r := tenant.Resolver{Domain: "racers.app"}
+
+	for _, tc := range []struct {
+		name   string
+		host   string
+		header string
+		claim  string
+		id     tenant.ID
+		err    error
+	}{
+		{name: "none", host: "racers.app", id: tenant.Default},
+		{name: "header", host: "racers.app", header: "fed", id: "fed"},
+		{name: "subdomain", host: "fed.racers.app:8080", id: "fed"},
+		{name: "claim", host: "racers.app", claim: "fed", id: "fed"},
+		{name: "agreeing", host: "fed.racers.app", header: "fed", claim: "fed", id: "fed"},
+		{name: "other domain", host: "fed.example.com", id: tenant.Default},
+		{name: "nested subdomain", host: "a.fed.racers.app", id: tenant.Default},
+		{name: "conflicting header", host: "fed.racers.app", header: "other", err: tenant.ErrConflict},
+		{name: "conflicting claim", host: "racers.app", header: "fed", claim: "other", err: tenant.ErrConflict},
+		{name: "invalid", host: "racers.app", header: "Fed", err: tenant.InvalidIDError{Value: "Fed"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/graph", nil)
+			req.Host = tc.host
+			if tc.header != "" {
+				req.Header.Set(tenant.Header, tc.header)
+			}
+
+			id, err := r.Resolve(req, tc.claim)
+			require.Equal(t, tc.err, err)
+			require.Equal(t, tc.id, id)
+		})
+	}
+
+	t.Run("without domain", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/graph", nil)
+		req.Host = "fed.racers.app"
+
+		id, err := tenant.Resolver{}.Resolve(req, "")
+		require.NoError(t, err)
+		require.Equal(t, tenant.Default, id)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	var served tenant.ID
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served, _ = tenant.FromContext(r.Context())
+	})
+	exists := func(_ context.Context, id tenant.ID) (bool, error) {
+		if id == "broken" {
+			return false, errors.New("broken")
+		}
+		return id != "unknown", nil
+	}
+	admit := func(ctx context.Context, id tenant.ID) (context.Context, error) {
+		if inCtx, _ := tenant.FromContext(ctx); inCtx != id {
+			return nil, errors.New("the tenant is not in the context")
+		}
+		switch id {
+		case "closed":
+			return nil, tenant.ErrNotMember
+		case "faulty":
+			return nil, errors.New("faulty")
+		}
+		return ctx, nil
+	}
+
+	for _, tc := range []struct {
+		header string
+		claim  string
+		status int
+	}{
+		{header: "fed", status: http.StatusOK},
+		{header: "fed", claim: "other", status: http.StatusForbidden},
+		{header: "Fed", status: http.StatusBadRequest},
+		{header: "unknown", status: http.StatusNotFound},
+		{header: "broken", status: http.StatusInternalServerError},
+		{header: "closed", status: http.StatusForbidden},
+		{header: "faulty", status: http.StatusInternalServerError},
+	} {
+		t.Run(tc.header+" "+tc.claim, func(t *testing.T) {
+			served = ""
+			h := tenant.Middleware(tenant.Resolver{}, func(context.Context) string { return tc.claim }, exists, admit)(next)
+
+			req := httptest.NewRequest(http.MethodGet, "/graph", nil)
+			req.Header.Set(tenant.Header, tc.header)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			require.Equal(t, tc.status, w.Code)
+			if tc.status == http.StatusOK {
+				require.Equal(t, tenant.ID(tc.header), served)
+			} else {
+				require.Empty(t, served)
+			}
+		})
+	}
+}
+
+type lister []tenant.ID
+
+func (l lister) All(context.Context) ([]tenant.ID, error) { return l, nil }
+
+func TestEach(t *testing.T) {
+	var seen []tenant.ID
+	processed, err := tenant.Each(context.Background(), lister{"a", "b", "c"}, func(ctx context.Context) (int, error) {
+		id, ok := tenant.FromContext(ctx)
+		require.True(t, ok)
+		seen = append(seen, id)
+		if id == "b" {
+			return 1, errors.New("failed")
+		}
+		return 2, nil
+	})
+
+	require.Equal(t, []tenant.ID{"a", "b", "c"}, seen)
+	require.Equal(t, 5, processed)
+	require.EqualError(t, err, "tenant b: failed")
+}
@@ -0,0 +1,194 @@
+// Package track parses the GPX and KML files race courses are uploaded with
+package track
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	racers "github.com/xabi93/racers/internal"
+)
+
+// ErrUnknownFormat means the file is neither a GPX nor a KML document
+var ErrUnknownFormat = errors.New("unknown track format, expected gpx or kml")
+
+// ParseError means the file is a track document but its content is not valid
+type ParseError struct {
+	Format racers.CourseFormat
+	error
+}
+
+func (err ParseError) Error() string {
+	return fmt.Sprintf("invalid %s track: %s", err.Format, err.error)
+}
+
+func (err ParseError) Unwrap() error {
+	return err.error
+}
+
+// Parse detects the format of the document by its root element and returns the track points in order
+func Parse(data []byte) (racers.CourseFormat, []racers.CoursePoint, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", nil, ErrUnknownFormat
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch strings.ToLower(start.Name.Local) {
+		case "gpx":
+			points, err := parseGPX(dec)
+			if err != nil {
+				return "", nil, ParseError{racers.CourseFormatGPX, err}
+			}
+			return racers.CourseFormatGPX, points, nil
+		case "kml":
+			points, err := parseKML(dec)
+			if err != nil {
+				return "", nil, ParseError{racers.CourseFormatKML, err}
+			}
+			return racers.CourseFormatKML, points, nil
+		}
+
+		return "", nil, ErrUnknownFormat
+	}
+}
+
+type gpxPoint struct {
+	Lat       float64  `xml:"lat,attr"`
+	Lon       float64  `xml:"lon,attr"`
+	Elevation *float64 `xml:"ele"`
+}
+
+// parseGPX returns the track points, or the route points when the document has no track
+func parseGPX(dec *xml.Decoder) ([]racers.CoursePoint, error) {
+	var trk, rte []racers.CoursePoint
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		name := start.Name.Local
+		if name != "trkpt" && name != "rtept" {
+			continue
+		}
+
+		var p gpxPoint
+		if err := dec.DecodeElement(&p, &start); err != nil {
+			return nil, err
+		}
+
+		point := racers.CoursePoint{Lat: p.Lat, Lon: p.Lon, NoElevation: p.Elevation == nil}
+		if p.Elevation != nil {
+			point.Elevation = *p.Elevation
+		}
+
+		if name == "trkpt" {
+			trk = append(trk, point)
+		} else {
+			rte = append(rte, point)
+		}
+	}
+
+	if len(trk) > 0 {
+		return trk, nil
+	}
+
+	return rte, nil
+}
+
+// parseKML returns the points of the LineString coordinates and gx:Track coords of the document
+func parseKML(dec *xml.Decoder) ([]racers.CoursePoint, error) {
+	var (
+		points     []racers.CoursePoint
+		lineString bool
+	)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.EndElement:
+			if t.Name.Local == "LineString" {
+				lineString = false
+			}
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "LineString":
+				lineString = true
+			case "coordinates":
+				if !lineString {
+					continue
+				}
+
+				var s string
+				if err := dec.DecodeElement(&s, &t); err != nil {
+					return nil, err
+				}
+
+				for _, tuple := range strings.Fields(s) {
+					p, err := parseKMLCoord(strings.Split(tuple, ","))
+					if err != nil {
+						return nil, err
+					}
+					points = append(points, p)
+				}
+			case "coord":
+				var s string
+				if err := dec.DecodeElement(&s, &t); err != nil {
+					return nil, err
+				}
+
+				p, err := parseKMLCoord(strings.Fields(s))
+				if err != nil {
+					return nil, err
+				}
+				points = append(points, p)
+			}
+		}
+	}
+
+	return points, nil
+}
+
+// parseKMLCoord parses a lon, lat and optional altitude tuple
+func parseKMLCoord(values []string) (racers.CoursePoint, error) {
+	if len(values) < 2 || len(values) > 3 {
+		return racers.CoursePoint{}, fmt.Errorf("invalid coordinate %q", strings.Join(values, ","))
+	}
+
+	parsed := make([]float64, 3)
+	for i, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return racers.CoursePoint{}, fmt.Errorf("invalid coordinate %q", strings.Join(values, ","))
+		}
+		parsed[i] = f
+	}
+
+	return racers.CoursePoint{Lon: parsed[0], Lat: parsed[1], Elevation: parsed[2], NoElevation: len(values) < 3}, nil
+}
@@ -0,0 +1,76 @@
+package track_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/track"
+)
+
+func TestParse(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("when the document is not a track returns ErrUnknownFormat", func(t *testing.T) {
+		for name, doc := range map[string]string{
+			"not xml":   "lat,lon",
+			"other xml": "<html><body></body></html>",
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := track.Parse([]byte(doc))
+				require.True(errors.Is(err, track.ErrUnknownFormat))
+			})
+		}
+	})
+
+	t.Run("when gpx returns the track points", func(t *testing.T) {
+		format, points, err := track.Parse([]byte(`<?xml version="1.0"?>
+<gpx version="1.1" xmlns="http://www.topografix.com/GPX/1/1">
+  <rte><rtept lat="1" lon="1"></rtept></rte>
+  <trk><trkseg>
+    <trkpt lat="43.3183" lon="-1.9812"><ele>10.5</ele></trkpt>
+    <trkpt lat="43.3190" lon="-1.9800"><ele>12</ele></trkpt>
+  </trkseg></trk>
+</gpx>`))
+
+		require.NoError(err)
+		require.Equal(racers.CourseFormatGPX, format)
+		require.Equal([]racers.CoursePoint{
+			{Lat: 43.3183, Lon: -1.9812, Elevation: 10.5},
+			{Lat: 43.3190, Lon: -1.9800, Elevation: 12},
+		}, points)
+	})
+
+	t.Run("when gpx without track returns the route points", func(t *testing.T) {
+		_, points, err := track.Parse([]byte(`<gpx><rte><rtept lat="1" lon="2"/><rtept lat="3" lon="4"/></rte></gpx>`))
+
+		require.NoError(err)
+		require.Equal([]racers.CoursePoint{{Lat: 1, Lon: 2, NoElevation: true}, {Lat: 3, Lon: 4, NoElevation: true}}, points, "the points have no elevation")
+	})
+
+	t.Run("when kml returns the line string coordinates", func(t *testing.T) {
+		format, points, err := track.Parse([]byte(`<?xml version="1.0"?>
+<kml xmlns="http://www.opengis.net/kml/2.2">
+  <Document><Placemark>
+    <Point><coordinates>0,0</coordinates></Point>
+    <LineString><coordinates>
+      -1.9812,43.3183,10.5 -1.9800,43.3190
+    </coordinates></LineString>
+  </Placemark></Document>
+</kml>`))
+
+		require.NoError(err)
+		require.Equal(racers.CourseFormatKML, format)
+		require.Equal([]racers.CoursePoint{
+			{Lat: 43.3183, Lon: -1.9812, Elevation: 10.5},
+			{Lat: 43.3190, Lon: -1.9800, NoElevation: true},
+		}, points)
+	})
+
+	t.Run("when kml with invalid coordinates returns ParseError", func(t *testing.T) {
+		_, _, err := track.Parse([]byte(`<kml><LineString><coordinates>a,b</coordinates></LineString></kml>`))
+
+		require.True(errors.As(err, &track.ParseError{}))
+	})
+}
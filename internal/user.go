@@ -3,6 +3,7 @@ package racers
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/xabi93/racers/internal/id"
 )
@@ -47,6 +48,11 @@ func (ul *userList) add(id UserID) {
 	(*ul)[id] = struct{}{}
 }
 
+// remove removes a user from the list
+func (ul userList) remove(id UserID) {
+	delete(ul, id)
+}
+
 // List returns a list of users
 func (ul userList) List() []UserID {
 	l := make([]UserID, 0, len(ul))
@@ -63,4 +69,29 @@ var ErrUnknownUser = errors.New("unknown user")
 // User represents a user in the service
 type User struct {
 	ID UserID
+	// Name is the display name, personal data only shown to the user and the organizers
+	Name string
+	// Email is where the user is notified, personal data never shown to other users
+	Email string
+	// Admin users are the service administrators
+	Admin bool
+	// Tenant is the tenant the token of the user is bound to, empty when it is valid for all of them
+	Tenant string
+	// BirthDate is zero when the user has not set it
+	BirthDate time.Time
+	Gender    Gender
+}
+
+// Age returns the years the user has on the given day, zero if the birth date is unknown
+func (u User) Age(on time.Time) int {
+	if u.BirthDate.IsZero() {
+		return 0
+	}
+
+	age := on.Year() - u.BirthDate.Year()
+	if on.Month() < u.BirthDate.Month() || (on.Month() == u.BirthDate.Month() && on.Day() < u.BirthDate.Day()) {
+		age--
+	}
+
+	return age
 }
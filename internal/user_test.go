@@ -3,6 +3,7 @@ package racers_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	racers "github.com/xabi93/racers/internal"
@@ -26,3 +27,13 @@ func TestUserID(t *testing.T) {
 		require.NoError(err)
 	})
 }
+
+func TestUserAge(t *testing.T) {
+	require := require.New(t)
+
+	day := time.Date(2030, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(0, racers.User{}.Age(day))
+	require.Equal(30, racers.User{BirthDate: time.Date(2000, 3, 10, 0, 0, 0, 0, time.UTC)}.Age(day))
+	require.Equal(29, racers.User{BirthDate: time.Date(2000, 3, 11, 0, 0, 0, 0, time.UTC)}.Age(day))
+}
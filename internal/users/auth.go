@@ -15,14 +15,14 @@ func AuthMiddleware(users Users) func(http.Handler) http.Handler {
 				return
 			}
 
-			user, err := users.Verify(r.Context(), splitAuth[0])
+			ctx, err := users.Authenticate(r.Context(), splitAuth[1])
 			if err != nil {
 				http.Error(w, "Invalid User", http.StatusForbidden)
 				return
 			}
 
 			// and call the next with our new context
-			next.ServeHTTP(w, r.WithContext(users.setCurrent(r.Context(), user)))
+			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
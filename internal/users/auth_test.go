@@ -0,0 +1,51 @@
+package users_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/users"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	u := users.Users{UsersProvider: users.Mock{}}
+
+	serve := func(t *testing.T, authorization string) (*httptest.ResponseRecorder, racers.User) {
+		t.Helper()
+
+		var current racers.User
+		handler := users.AuthMiddleware(u)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			current = u.Current(r.Context())
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec, current
+	}
+
+	t.Run("anonymous", func(t *testing.T) {
+		rec, current := serve(t, "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, racers.User{}, current)
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		rec, current := serve(t, "Bearer "+id.ID(users.KilianID).String())
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, users.KilianID, current.ID, "the token follows the bearer prefix")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		rec, _ := serve(t, "Bearer invalid")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
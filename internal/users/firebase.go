@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"time"
 
 	racers "github.com/xabi93/racers/internal"
 	"github.com/xabi93/racers/internal/id"
@@ -10,6 +11,41 @@ import (
 	"firebase.google.com/go/auth"
 )
 
+// adminClaim is the custom claim set on the service administrators tokens
+const adminClaim = "admin"
+
+// tenantClaim is the custom claim binding the tokens to a tenant
+const tenantClaim = "tenant"
+
+// Profile custom claims, birth date in YYYY-MM-DD format
+const (
+	birthDateClaim = "birthdate"
+	genderClaim    = "gender"
+)
+
+// Identity claims of the tokens
+const (
+	nameClaim  = "name"
+	emailClaim = "email"
+)
+
+// withProfile fills the user birth date and gender from the custom claims, invalid values are ignored
+func withProfile(u racers.User, claims map[string]interface{}) racers.User {
+	if s, ok := claims[birthDateClaim].(string); ok {
+		if d, err := time.Parse("2006-01-02", s); err == nil {
+			u.BirthDate = d
+		}
+	}
+
+	if s, ok := claims[genderClaim].(string); ok {
+		if g, err := racers.NewGender(s); err == nil {
+			u.Gender = g
+		}
+	}
+
+	return u
+}
+
 func NewFirebase(c *auth.Client) Firebase {
 	return Firebase{c}
 }
@@ -19,7 +55,7 @@ type Firebase struct {
 }
 
 func (f Firebase) Get(ctx context.Context, userID racers.UserID) (racers.User, error) {
-	_, err := f.cli.GetUser(ctx, id.ID(userID).String())
+	u, err := f.cli.GetUser(ctx, id.ID(userID).String())
 	if auth.IsUserNotFound(err) {
 		return racers.User{}, service.ErrUserNotFound
 	}
@@ -27,7 +63,44 @@ func (f Firebase) Get(ctx context.Context, userID racers.UserID) (racers.User, e
 		return racers.User{}, err
 	}
 
-	return racers.User{ID: userID}, nil
+	admin, _ := u.CustomClaims[adminClaim].(bool)
+
+	return withProfile(racers.User{ID: userID, Name: u.DisplayName, Email: u.Email, Admin: admin}, u.CustomClaims), nil
+}
+
+// getUsersBatch is the max users Firebase gets at once
+const getUsersBatch = 100
+
+func (f Firebase) List(ctx context.Context, ids []racers.UserID) ([]racers.User, error) {
+	users := make([]racers.User, 0, len(ids))
+	for start := 0; start < len(ids); start += getUsersBatch {
+		end := start + getUsersBatch
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		identifiers := make([]auth.UserIdentifier, 0, end-start)
+		for _, userID := range ids[start:end] {
+			identifiers = append(identifiers, auth.UIDIdentifier{UID: id.ID(userID).String()})
+		}
+
+		res, err := f.cli.GetUsers(ctx, identifiers)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, u := range res.Users {
+			userID, err := id.NewID(u.UID)
+			if err != nil {
+				return nil, err
+			}
+
+			admin, _ := u.CustomClaims[adminClaim].(bool)
+			users = append(users, withProfile(racers.User{ID: racers.UserID(userID), Name: u.DisplayName, Email: u.Email, Admin: admin}, u.CustomClaims))
+		}
+	}
+
+	return users, nil
 }
 
 func (f Firebase) Verify(ctx context.Context, token string) (racers.User, error) {
@@ -41,5 +114,10 @@ func (f Firebase) Verify(ctx context.Context, token string) (racers.User, error)
 		return racers.User{}, err
 	}
 
-	return racers.User{ID: racers.UserID(id)}, nil
+	admin, _ := t.Claims[adminClaim].(bool)
+	name, _ := t.Claims[nameClaim].(string)
+	email, _ := t.Claims[emailClaim].(string)
+	tenant, _ := t.Claims[tenantClaim].(string)
+
+	return withProfile(racers.User{ID: racers.UserID(id), Name: name, Email: email, Admin: admin, Tenant: tenant}, t.Claims), nil
 }
@@ -3,6 +3,7 @@ package users
 import (
 	"context"
 	"errors"
+	"time"
 
 	racers "github.com/xabi93/racers/internal"
 	"github.com/xabi93/racers/internal/id"
@@ -11,10 +12,14 @@ import (
 
 var _ UsersProvider = Mock{}
 
-var KilianID = racers.UserID(id.MustParse("9487F894-5B6A-4D6D-A0E4-6D2EF44C7020"))
+var (
+	KilianID = racers.UserID(id.MustParse("9487F894-5B6A-4D6D-A0E4-6D2EF44C7020"))
+	AdminID  = racers.UserID(id.MustParse("5C0D4D5B-6F0B-4A8B-9E64-2B1D0C1F7A10"))
+)
 
 var usersDB = map[racers.UserID]racers.User{
-	KilianID: {ID: KilianID},
+	KilianID: {ID: KilianID, Name: "Kilian Jornet", Email: "kilian@racers.local", BirthDate: time.Date(1987, 10, 27, 0, 0, 0, 0, time.UTC), Gender: racers.GenderMale},
+	AdminID:  {ID: AdminID, Name: "Admin", Email: "admin@racers.local", Admin: true},
 }
 
 type Mock struct{}
@@ -28,6 +33,17 @@ func (Mock) Get(ctx context.Context, id racers.UserID) (racers.User, error) {
 	return u, nil
 }
 
+func (Mock) List(ctx context.Context, ids []racers.UserID) ([]racers.User, error) {
+	users := make([]racers.User, 0, len(ids))
+	for _, id := range ids {
+		if u, ok := usersDB[id]; ok {
+			users = append(users, u)
+		}
+	}
+
+	return users, nil
+}
+
 func (Mock) Verify(ctx context.Context, token string) (racers.User, error) {
 	userID, err := id.NewID(token)
 	if err != nil {
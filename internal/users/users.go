@@ -4,12 +4,15 @@ import (
 	"context"
 
 	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/tenant"
 )
 
 var loggedUserCtxKey struct{}
 
 type UsersProvider interface {
 	Get(ctx context.Context, id racers.UserID) (racers.User, error)
+	// List returns the users with the ids at once, the ones that do not exist are left out
+	List(ctx context.Context, ids []racers.UserID) ([]racers.User, error)
 	Verify(ctx context.Context, token string) (racers.User, error)
 }
 
@@ -19,7 +22,7 @@ type Users struct {
 
 func (Users) Current(ctx context.Context) racers.User {
 	u, ok := ctx.Value(loggedUserCtxKey).(racers.User)
-	if ok {
+	if !ok {
 		return racers.User{}
 	}
 
@@ -29,3 +32,41 @@ func (Users) Current(ctx context.Context) racers.User {
 func (Users) setCurrent(ctx context.Context, u racers.User) context.Context {
 	return context.WithValue(ctx, loggedUserCtxKey, u)
 }
+
+// Authenticate verifies the token and returns the context with its user as the current one
+func (u Users) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	user, err := u.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.setCurrent(ctx, user), nil
+}
+
+// TenantMembers finds the role of the users in the tenant of the context
+type TenantMembers interface {
+	Membership(ctx context.Context, user racers.UserID) (tenant.Membership, bool, error)
+}
+
+// Admit scopes the current user to the tenant, the users must be members of the tenant and they are admins
+// only when they are admins of it. The token bound to the tenant carries the role of the user in it, as the
+// token valid for all of them does in the default tenant
+func (u Users) Admit(members TenantMembers) tenant.Admit {
+	return func(ctx context.Context, id tenant.ID) (context.Context, error) {
+		user := u.Current(ctx)
+		if user.ID == (racers.UserID{}) || user.Tenant == string(id) || (user.Tenant == "" && id == tenant.Default) {
+			return ctx, nil
+		}
+
+		m, ok, err := members.Membership(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, tenant.ErrNotMember
+		}
+		user.Admin = m.Admin
+
+		return u.setCurrent(ctx, user), nil
+	}
+}
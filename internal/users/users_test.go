@@ -0,0 +1,62 @@
+package users_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/tenant"
+	"github.com/xabi93/racers/internal/users"
+)
+
+type members map[racers.UserID]tenant.Membership
+
+func (m members) Membership(_ context.Context, user racers.UserID) (tenant.Membership, bool, error) {
+	membership, ok := m[user]
+	return membership, ok, nil
+}
+
+func TestAdmit(t *testing.T) {
+	u := users.Users{UsersProvider: users.Mock{}}
+	admit := u.Admit(members{users.AdminID: {Admin: true}})
+
+	authenticated := func(t *testing.T, user racers.UserID) context.Context {
+		t.Helper()
+		ctx, err := u.Authenticate(context.Background(), id.ID(user).String())
+		require.NoError(t, err)
+		return ctx
+	}
+
+	t.Run("anonymous", func(t *testing.T) {
+		ctx, err := admit(context.Background(), "fed")
+		require.NoError(t, err)
+		require.Equal(t, racers.User{}, u.Current(ctx))
+	})
+
+	t.Run("default tenant", func(t *testing.T) {
+		ctx, err := admit(authenticated(t, users.KilianID), tenant.Default)
+		require.NoError(t, err)
+		require.Equal(t, users.KilianID, u.Current(ctx).ID)
+	})
+
+	t.Run("not member", func(t *testing.T) {
+		_, err := admit(authenticated(t, users.KilianID), "fed")
+		require.Equal(t, tenant.ErrNotMember, err)
+	})
+
+	t.Run("admin of the tenant", func(t *testing.T) {
+		ctx, err := admit(authenticated(t, users.AdminID), "fed")
+		require.NoError(t, err)
+		require.True(t, u.Current(ctx).Admin)
+	})
+
+	t.Run("admin of other tenant", func(t *testing.T) {
+		admit := u.Admit(members{users.AdminID: {}})
+
+		ctx, err := admit(authenticated(t, users.AdminID), "fed")
+		require.NoError(t, err)
+		require.False(t, u.Current(ctx).Admin, "the admin of the service is not admin of the tenant")
+	})
+}
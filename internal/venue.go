@@ -0,0 +1,99 @@
+package racers
+
+import (
+	"fmt"
+	"time"
+)
+
+// InvalidTimeZoneError means the name is not an IANA time zone
+type InvalidTimeZoneError struct{ Name string }
+
+func (err InvalidTimeZoneError) Error() string {
+	return fmt.Sprintf("invalid time zone: %q", err.Name)
+}
+
+// NewTimeZone loads the IANA time zone with the name, the server local zone is not allowed
+func NewTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, InvalidTimeZoneError{name}
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, InvalidTimeZoneError{name}
+	}
+
+	return loc, nil
+}
+
+// InvalidCoordinatesError means the latitude or longitude are out of range
+type InvalidCoordinatesError struct {
+	Lat float64
+	Lon float64
+}
+
+func (err InvalidCoordinatesError) Error() string {
+	return fmt.Sprintf("invalid coordinates: %f,%f", err.Lat, err.Lon)
+}
+
+// CheckCoordinates returns InvalidCoordinatesError if the latitude or longitude are out of range
+func CheckCoordinates(lat, lon float64) error {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return InvalidCoordinatesError{lat, lon}
+	}
+
+	return nil
+}
+
+// InvalidVenueError means the venue of a race is not valid
+type InvalidVenueError struct{ Reason string }
+
+func (err InvalidVenueError) Error() string {
+	return fmt.Sprintf("invalid venue: %s", err.Reason)
+}
+
+// Venue is where a race takes place
+type Venue struct {
+	Name    string
+	Address string
+	Lat     float64
+	Lon     float64
+	// TimeZone is the zone the race times are shown in
+	TimeZone *time.Location
+}
+
+// NewVenue validates the venue data and returns a Venue instance
+func NewVenue(name, address string, lat, lon float64, tz *time.Location) (Venue, error) {
+	if name == "" {
+		return Venue{}, InvalidVenueError{"empty name"}
+	}
+
+	if err := CheckCoordinates(lat, lon); err != nil {
+		return Venue{}, InvalidVenueError{err.Error()}
+	}
+
+	if tz == nil {
+		return Venue{}, InvalidVenueError{"missing time zone"}
+	}
+
+	return Venue{Name: name, Address: address, Lat: lat, Lon: lon, TimeZone: tz}, nil
+}
+
+// DistanceTo returns the distance in metres from the venue to the point
+func (v Venue) DistanceTo(lat, lon float64) float64 {
+	return haversine(CoursePoint{Lat: v.Lat, Lon: v.Lon}, CoursePoint{Lat: lat, Lon: lon})
+}
+
+// Location returns the time zone of the race, UTC when the race has no venue
+func (r Race) Location() *time.Location {
+	if r.Venue == nil {
+		return time.UTC
+	}
+
+	return r.Venue.TimeZone
+}
+
+// LocalDate returns the race date in the race time zone
+func (r Race) LocalDate() time.Time {
+	return time.Time(r.Date).In(r.Location())
+}
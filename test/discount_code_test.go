@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
+)
+
+// TestDiscountCodeRelease expires a pending registration made with a discount code, the code gets its
+// redemption back and another competitor can redeem it
+func TestDiscountCodeRelease(t *testing.T) {
+	require := require.New(t)
+
+	conn, err := postgres.Connect(conf.Postgres)
+	require.NoError(err)
+	defer conn.Close()
+
+	db, err := postgres.New(conn)
+	require.NoError(err)
+
+	const tenantID = "discount-code-release"
+	_, err = conn.Exec("INSERT INTO tenants (id, name) VALUES ($1, $1) ON CONFLICT DO NOTHING", tenantID)
+	require.NoError(err)
+	ctx := tenant.WithID(context.Background(), tenantID)
+
+	owner := racers.UserID(id.Generate())
+	races := service.NewRaces(postgres.NewRaces(db), nil, nil, anyUser{owner}, postgres.TransactionFactory(db), postgres.NewEvents(db))
+
+	date := time.Now().AddDate(0, 1, 0).Truncate(time.Second).UTC()
+	race, err := races.Create(ctx, service.CreateRace{
+		ID:         id.Generate().String(),
+		Name:       "Black Mamba Race",
+		Date:       date,
+		Categories: []service.CreateRaceCategory{{Name: "10K", Distance: 10000, StartTime: date}},
+	})
+	require.NoError(err)
+
+	codes := postgres.NewDiscountCodes(db)
+	require.NoError(codes.Save(ctx, racers.DiscountCode{Race: race.ID, Code: "CLUB", Discount: racers.Discount{Percent: 10}, MaxRedemptions: 1}))
+
+	redeemed, err := codes.Redeem(ctx, race.ID, "CLUB")
+	require.NoError(err)
+	require.True(redeemed)
+
+	repo := postgres.NewRaces(db)
+	stored, err := repo.Get(ctx, race.ID)
+	require.NoError(err)
+	stored.Registrations = racers.RaceRegistrations{
+		racers.UserID(id.Generate()): {
+			Status:    racers.RegistrationPendingPayment,
+			Fee:       racers.Money{Amount: 900, Currency: "EUR"},
+			ExpiresAt: time.Now().Add(-time.Minute),
+			Code:      "CLUB",
+		},
+	}
+	require.NoError(repo.Save(ctx, stored))
+
+	stored, err = repo.Get(ctx, race.ID)
+	require.NoError(err)
+	require.Len(stored.ExpireRegistrations(time.Now()), 1)
+	require.NoError(repo.Save(ctx, stored))
+
+	code, err := codes.Get(ctx, race.ID, "CLUB")
+	require.NoError(err)
+	require.Zero(code.Redemptions)
+
+	redeemed, err = codes.Redeem(ctx, race.ID, "CLUB")
+	require.NoError(err)
+	require.True(redeemed)
+}
@@ -0,0 +1,107 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	racers "github.com/xabi93/racers/internal"
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
+)
+
+// anyUser is a users getter knowing every user, the competitors are made up by the test
+type anyUser struct{ current racers.UserID }
+
+func (u anyUser) Get(_ context.Context, id racers.UserID) (racers.User, error) {
+	return racers.User{ID: id, Name: "Runner"}, nil
+}
+
+func (u anyUser) List(_ context.Context, ids []racers.UserID) ([]racers.User, error) {
+	users := make([]racers.User, len(ids))
+	for i, id := range ids {
+		users[i] = racers.User{ID: id, Name: "Runner"}
+	}
+
+	return users, nil
+}
+
+func (u anyUser) Current(context.Context) racers.User { return racers.User{ID: u.current} }
+
+// TestConcurrentJoins joins a full category concurrently, the category never takes more competitors than its
+// capacity. The tests connection runs every statement in the same transaction, so this test connects on its own
+// and runs in its own tenant, the data is dropped with the database container
+func TestConcurrentJoins(t *testing.T) {
+	require := require.New(t)
+
+	conn, err := postgres.Connect(conf.Postgres)
+	require.NoError(err)
+	defer conn.Close()
+
+	db, err := postgres.New(conn)
+	require.NoError(err)
+
+	const tenantID = "concurrent-joins"
+	_, err = conn.Exec("INSERT INTO tenants (id, name) VALUES ($1, $1) ON CONFLICT DO NOTHING", tenantID)
+	require.NoError(err)
+	ctx := tenant.WithID(context.Background(), tenantID)
+
+	owner := racers.UserID(id.Generate())
+	races := service.NewRaces(postgres.NewRaces(db), nil, nil, anyUser{owner}, postgres.TransactionFactory(db), postgres.NewEvents(db))
+
+	const capacity, competitors = 3, 10
+	date := time.Now().AddDate(0, 1, 0).Truncate(time.Second).UTC()
+	race, err := races.Create(ctx, service.CreateRace{
+		ID:         id.Generate().String(),
+		Name:       "Black Mamba Race",
+		Date:       date,
+		Categories: []service.CreateRaceCategory{{Name: "10K", Distance: 10000, StartTime: date, Capacity: capacity}},
+	})
+	require.NoError(err)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		joined int
+		full   int
+		failed []error
+	)
+	for i := 0; i < competitors; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := races.Join(ctx, service.JoinRace{
+				RaceID:   id.ID(race.ID).String(),
+				UserID:   id.Generate().String(),
+				Category: "10K",
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				joined++
+			case errors.As(err, &racers.CategoryFullError{}):
+				full++
+			default:
+				failed = append(failed, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Empty(failed)
+	require.Equal(capacity, joined)
+	require.Equal(competitors-capacity, full)
+
+	stored, err := postgres.NewRaces(db).Get(ctx, race.ID)
+	require.NoError(err)
+	require.Len(stored.CategoryEntries, capacity)
+	require.Len(stored.Competitors.List(), capacity)
+}
@@ -0,0 +1,99 @@
+package test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/server"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/service"
+	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
+	"github.com/xabi93/racers/internal/users"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/require"
+)
+
+type exportMyDataResult struct {
+	ExportMyData struct {
+		Typename string `json:"__typename"`
+		FileName string `json:"fileName"`
+		Data     string `json:"data"`
+	}
+}
+
+// exportedFiles returns the files of the data export of the user of the client
+func exportedFiles(t *testing.T, c *client.Client) []string {
+	t.Helper()
+
+	var resp exportMyDataResult
+	c.MustPost(`query { exportMyData { __typename ...on DataExport { fileName data } } }`, &resp)
+	require.Equal(t, reflect.TypeOf(models.DataExport{}).Name(), resp.ExportMyData.Typename)
+
+	data, err := base64.StdEncoding.DecodeString(resp.ExportMyData.Data)
+	require.NoError(t, err)
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make([]string, len(r.File))
+	for i, f := range r.File {
+		files[i] = f.Name
+	}
+
+	return files
+}
+
+type erasureResult struct {
+	Typename string `json:"__typename"`
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+}
+
+func TestPrivacy(t *testing.T) {
+	require := require.New(t)
+	s := newSuite(t)
+	defer s.db.Close()
+
+	kilian := client.New(s.handler, client.Path(server.GraphEndpoint), client.AddHeader("Authorization", "Bearer "+id.ID(users.KilianID).String()))
+
+	race := blackMambaRace
+	race.ID = id.Generate().String()
+	require.Equal(reflect.TypeOf(models.Race{}).Name(), createRace(kilian, race).CreateRace.Typename)
+
+	t.Run("export", func(t *testing.T) {
+		require.Subset(exportedFiles(t, kilian), []string{"profile.json", "races.json", "events.json"})
+	})
+
+	var erasure erasureResult
+	t.Run("erase", func(t *testing.T) {
+		var resp struct{ EraseMyData erasureResult }
+		kilian.MustPost(`mutation { eraseMyData { __typename ...on Erasure { id status } } }`, &resp)
+
+		erasure = resp.EraseMyData
+		require.Equal(reflect.TypeOf(models.Erasure{}).Name(), erasure.Typename)
+		require.Equal(string(service.ErasurePending), erasure.Status)
+	})
+
+	t.Run("processed", func(t *testing.T) {
+		db, err := postgres.New(s.db)
+		require.NoError(err)
+		privacy := service.NewPrivacy(postgres.NewUserData(db), postgres.NewErasures(db), users.Users{UsersProvider: users.Mock{}}, postgres.TransactionFactory(db), postgres.NewEvents(db))
+
+		done, err := privacy.ProcessErasures(tenant.WithID(context.Background(), tenant.Default))
+		require.NoError(err)
+		require.Equal(1, done)
+
+		var resp struct{ Erasure erasureResult }
+		kilian.MustPost(`query($id: ID!) { erasure(id: $id) { __typename ...on Erasure { id status } } }`, &resp, client.Var("id", erasure.ID))
+		require.Equal(string(service.ErasureDone), resp.Erasure.Status)
+
+		require.Equal([]string{"profile.json"}, exportedFiles(t, kilian), "nothing is tied to the user anymore")
+		require.Equal(reflect.TypeOf(models.Race{}).Name(), getRace(kilian, id.MustParse(race.ID)).Race.Typename, "the race is kept")
+	})
+}
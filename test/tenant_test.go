@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/xabi93/racers/internal/id"
+	"github.com/xabi93/racers/internal/server"
+	"github.com/xabi93/racers/internal/server/graph/models"
+	"github.com/xabi93/racers/internal/storage/postgres"
+	"github.com/xabi93/racers/internal/tenant"
+	"github.com/xabi93/racers/internal/users"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantIsolation(t *testing.T) {
+	require := require.New(t)
+	s := newSuite(t)
+	defer s.db.Close()
+
+	fedA := s.tenantClient(t, "fed-a")
+	fedB := s.tenantClient(t, "fed-b")
+
+	race := blackMambaRace
+	race.ID = id.Generate().String()
+	require.Equal(reflect.TypeOf(models.Race{}).Name(), createRace(fedA, race).CreateRace.Typename)
+
+	t.Run("the tenant finds its race", func(t *testing.T) {
+		resp := getRace(fedA, id.MustParse(race.ID))
+
+		require.Equal(reflect.TypeOf(models.Race{}).Name(), resp.Race.Typename)
+		require.Equal(race.Name, resp.Race.Name)
+	})
+
+	t.Run("other tenants do not find it", func(t *testing.T) {
+		require.Equal(reflect.TypeOf(models.RaceNotFound{}).Name(), getRace(fedB, id.MustParse(race.ID)).Race.Typename)
+		require.Equal(reflect.TypeOf(models.RaceNotFound{}).Name(), getRace(s.graphql, id.MustParse(race.ID)).Race.Typename)
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		c := client.New(s.handler, client.Path(server.GraphEndpoint), client.AddHeader(tenant.Header, "fed-c"))
+
+		var resp getRaceResult
+		require.Error(c.Post(`query($id: ID!) { race(id: $id) { __typename } }`, &resp, client.Var("id", race.ID)))
+	})
+
+	// the superuser the tests connect as bypasses the policies, they are checked in a transaction as a role
+	// neither superuser nor BYPASSRLS, like the one the service connects as
+	t.Run("row level security", func(t *testing.T) {
+		ctx := context.Background()
+		require.Equal(postgres.ErrPrivilegedRole, postgres.CheckRole(ctx, s.db))
+
+		tx, err := s.db.BeginTx(ctx, nil)
+		require.NoError(err)
+		defer tx.Rollback()
+
+		for _, stmt := range []string{
+			"CREATE ROLE racers_tenant_test NOLOGIN NOSUPERUSER NOBYPASSRLS",
+			"GRANT SELECT, UPDATE ON races TO racers_tenant_test",
+			"SET LOCAL ROLE racers_tenant_test",
+		} {
+			_, err := tx.ExecContext(ctx, stmt)
+			require.NoError(err)
+		}
+		require.NoError(postgres.CheckRole(ctx, tx))
+
+		count := func(tenant string) int {
+			_, err := tx.ExecContext(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenant)
+			require.NoError(err)
+
+			var n int
+			require.NoError(tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM races WHERE id = $1", race.ID).Scan(&n))
+			return n
+		}
+		require.Equal(0, count("fed-b"))
+		require.Equal(0, count(""))
+		require.Equal(1, count("fed-a"))
+
+		_, err = tx.ExecContext(ctx, "UPDATE races SET tenant_id = 'fed-b' WHERE id = $1", race.ID)
+		require.Error(err, "moving the race to another tenant")
+	})
+}
+
+func TestTenantMembers(t *testing.T) {
+	require := require.New(t)
+	s := newSuite(t)
+	defer s.db.Close()
+
+	s.tenantClient(t, "fed-a")
+	asAdmin := func(fed tenant.ID) *client.Client {
+		return client.New(s.handler, client.Path(server.GraphEndpoint),
+			client.AddHeader(tenant.Header, string(fed)),
+			client.AddHeader("Authorization", "Bearer "+id.ID(users.AdminID).String()),
+		)
+	}
+	auditLog := func(c *client.Client) (string, error) {
+		var resp struct {
+			AuditLog struct {
+				Typename string `json:"__typename"`
+			}
+		}
+		err := c.Post(`{ auditLog { __typename } }`, &resp)
+		return resp.AuditLog.Typename, err
+	}
+
+	t.Run("the admin of the service is admin of the default tenant", func(t *testing.T) {
+		typename, err := auditLog(asAdmin(tenant.Default))
+		require.NoError(err)
+		require.Equal(reflect.TypeOf(models.AuditLog{}).Name(), typename)
+	})
+
+	t.Run("the admin can not read other tenants naming them", func(t *testing.T) {
+		_, err := auditLog(asAdmin("fed-a"))
+		require.Error(err)
+	})
+
+	t.Run("the admin is a regular member of other tenants", func(t *testing.T) {
+		_, err := s.db.Exec("INSERT INTO tenant_members (tenant_id, user_id, admin) VALUES ('fed-a', $1, FALSE)", id.ID(users.AdminID).String())
+		require.NoError(err)
+
+		typename, err := auditLog(asAdmin("fed-a"))
+		require.NoError(err)
+		require.Equal(reflect.TypeOf(models.Forbidden{}).Name(), typename)
+	})
+
+	t.Run("the admin of the tenant", func(t *testing.T) {
+		_, err := s.db.Exec("UPDATE tenant_members SET admin = TRUE WHERE tenant_id = 'fed-a' AND user_id = $1", id.ID(users.AdminID).String())
+		require.NoError(err)
+
+		typename, err := auditLog(asAdmin("fed-a"))
+		require.NoError(err)
+		require.Equal(reflect.TypeOf(models.AuditLog{}).Name(), typename)
+	})
+}
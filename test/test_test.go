@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	stdlog "log"
+	"net/http"
 	"os"
 	"testing"
 
@@ -16,12 +17,26 @@ import (
 	"github.com/xabi93/racers/internal/server/graph/models"
 	"github.com/xabi93/racers/internal/storage/postgres"
 	"github.com/xabi93/racers/internal/storage/postgres/test"
+	"github.com/xabi93/racers/internal/tenant"
 	"github.com/xabi93/racers/internal/users"
 )
 
 var conf server.Conf
 
+// testSecrets are the secrets of the service in the tests, unless they are set in the environment
+var testSecrets = map[string]string{
+	"PAYMENT_SECRET":      "payment-secret",
+	"CHECK_IN_SECRET":     "check-in-secret",
+	"NOTIFICATION_SECRET": "notification-secret",
+}
+
 func TestMain(m *testing.M) {
+	for name, value := range testSecrets {
+		if os.Getenv(name) == "" {
+			os.Setenv(name, value)
+		}
+	}
+
 	var err error
 	conf, err = server.LoadConf()
 	if err != nil {
@@ -118,12 +133,25 @@ func newSuite(t *testing.T) suite {
 	return suite{
 		graphql: client.New(srv.Handler(), client.Path(server.GraphEndpoint)),
 		db:      db,
+		handler: srv.Handler(),
 	}
 }
 
 type suite struct {
 	graphql *client.Client
 	db      *sql.DB
+	handler http.Handler
+}
+
+// tenantClient returns a client of the tenant, the tenant is added to the service if it is not
+func (s suite) tenantClient(t *testing.T, id tenant.ID) *client.Client {
+	t.Helper()
+
+	if _, err := s.db.Exec("INSERT INTO tenants (id, name) VALUES ($1, $1) ON CONFLICT DO NOTHING", id); err != nil {
+		t.Fatalf("adding tenant %s", err)
+	}
+
+	return client.New(s.handler, client.Path(server.GraphEndpoint), client.AddHeader(tenant.Header, string(id)))
 }
 
 type getRaceResult struct {